@@ -17,7 +17,11 @@ struct JMXConfig {
   14: i64 requestTimeoutMs,
   15: bool verbose,
   16: bool enableInternalStats,
-  17: i64 maxInternalStatsSize
+  17: i64 maxInternalStatsSize,
+  18: i64 discoveryCacheTtlMs,
+  19: i64 maxMBeansPerPattern,
+  20: i64 maxAttributesPerMBean,
+  21: i64 maxResponsesPerCall
 }
 
 enum ResponseType {
@@ -28,6 +32,26 @@ enum ResponseType {
   ERROR  = 5,
 }
 
+enum ErrorKind {
+  UNKNOWN               = 1,
+  ATTRIBUTE_NOT_FOUND   = 2,
+  MBEAN_NOT_FOUND       = 3,
+  UNSUPPORTED_OPERATION = 4,
+  UNSUPPORTED_TYPE      = 5,
+  SERIALIZATION         = 6,
+  SECURITY              = 7,
+  NULL_VALUE            = 8,
+  GETTER_EXCEPTION      = 9,
+  NOT_RETURNED          = 10,
+}
+
+struct AttributeError {
+  1: ErrorKind kind,
+  2: string exceptionClass,
+  3: string causeClass,
+  4: string causeMessage
+}
+
 struct AttributeResponse {
   1: string statusMsg,
   2: string name,
@@ -35,7 +59,54 @@ struct AttributeResponse {
   4: string stringValue,
   5: double doubleValue,
   6: i64 intValue,
-  7: bool boolValue
+  7: bool boolValue,
+  8: optional AttributeError error
+}
+
+enum QueryLimit {
+  MBEANS_PER_PATTERN = 0,
+  ATTRIBUTES_PER_MBEAN = 1,
+  RESPONSES_PER_CALL = 2
+}
+
+struct Truncation {
+  1: QueryLimit limit,
+  2: i64 limitValue,
+  3: string mBeanName,
+  4: i64 droppedCount,
+  5: list<string> dropped,
+  6: i64 timestamp,
+  7: i64 evictedBefore
+}
+
+struct MBeanAttributeInfo {
+  1: string name,
+  2: string type,
+  3: string description,
+  4: bool readable,
+  5: bool writable
+}
+
+struct MBeanParameterInfo {
+  1: string name,
+  2: string type,
+  3: string description
+}
+
+struct MBeanOperationInfo {
+  1: string name,
+  2: string returnType,
+  3: string description,
+  4: list<MBeanParameterInfo> signature,
+  5: i32 impact
+}
+
+struct MBeanInfo {
+  1: string name,
+  2: string className,
+  3: string description,
+  4: list<MBeanAttributeInfo> attributes,
+  5: list<MBeanOperationInfo> operations
 }
 
 struct InternalStat {
@@ -45,13 +116,104 @@ struct InternalStat {
     4: i64 responseCount,
     5: double milliseconds,
     6: i64 startTimestamp,
-    7: bool successful
+    7: bool successful,
+    8: i32 requestId
+}
+
+struct InternalMetric {
+    1: string statType,
+    2: i64 count,
+    3: i64 errors,
+    4: double totalMs,
+    5: double maxMs,
+    6: list<double> bucketBoundsMs,
+    7: list<i64> bucketCounts
+}
+
+struct InternalMetrics {
+    1: i64 startTimestamp,
+    2: list<InternalMetric> metrics,
+    3: bool samplesEnabled,
+    4: i64 maxSamples,
+    5: i64 bufferedSamples,
+    6: i64 evictedSamples
+}
+
+struct ServerInfo {
+  1: string mBeanServerId,
+  2: string specificationVersion,
+  3: string implementationName,
+  4: string implementationVersion,
+  5: string implementationVendor,
+  6: string defaultDomain,
+  7: string connectionId
+}
+
+enum ThreadState {
+  NEW           = 1,
+  RUNNABLE      = 2,
+  BLOCKED       = 3,
+  WAITING       = 4,
+  TIMED_WAITING = 5,
+  TERMINATED    = 6,
+}
+
+struct LockInfo {
+  1: string className,
+  2: i32 identityHashCode
+}
+
+struct StackFrame {
+  1: string className,
+  2: string methodName,
+  3: string fileName,
+  4: i32 lineNumber,
+  5: bool nativeMethod,
+  6: list<LockInfo> lockedMonitors
+}
+
+struct ThreadInfo {
+  1: i64 threadId,
+  2: string threadName,
+  3: ThreadState threadState,
+  4: i64 blockedCount,
+  5: i64 blockedTimeMs,
+  6: i64 waitedCount,
+  7: i64 waitedTimeMs,
+  8: optional LockInfo lockInfo,
+  9: i64 lockOwnerId,
+  10: string lockOwnerName,
+  11: bool inNative,
+  12: bool suspended,
+  13: list<StackFrame> stackTrace,
+  14: list<LockInfo> lockedSynchronizers
+}
+
+struct ThreadDumpOptions {
+  1: bool lockedMonitors,
+  2: bool lockedSynchronizers,
+  3: i32 maxDepth
+}
+
+struct RecordingSettings {
+  1: string name,
+  2: string configuration,
+  3: map<string, string> settings,
+  4: map<string, string> options
+}
+
+struct VMOption {
+  1: string name,
+  2: string value,
+  3: string origin,
+  4: bool writeable
 }
 
 exception JMXError {
   1: string message,
   2: string causeMessage
   3: string stacktrace
+  4: optional string causeClass
 }
 
 exception JMXConnectionError {
@@ -69,9 +231,49 @@ service JMXService {
 
     list<string> getMBeanAttributeNames(1:string mBeanName) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
 
+    MBeanInfo getMBeanInfo(1:string mBeanName) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
     list<AttributeResponse> getMBeanAttributes(1:string mBeanName, 2:list<string> attributes) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
 
     list<AttributeResponse> queryMBeanAttributes(1:string mBeanNamePattern, 2:list<string> attributes) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
 
-    list<InternalStat> getInternalStats() throws (1:JMXError jmxErr)
+    list<AttributeResponse> invokeOperation(1:string mBeanName, 2:string operationName, 3:list<string> arguments, 4:list<string> signature) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    list<InternalStat> getInternalStats() throws (1:JMXError jmxErr),
+
+    InternalMetrics getInternalMetrics() throws (1:JMXError jmxErr),
+
+    list<Truncation> getTruncations() throws (1:JMXError jmxErr),
+
+    list<string> getDomains() throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    i64 getMBeanCount(1:string mBeanNamePattern) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    ServerInfo getServerInfo() throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    list<ThreadInfo> threadDump(1:ThreadDumpOptions options) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    list<ThreadInfo> findDeadlocks() throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    string diagnosticCommand(1:string command, 2:list<string> arguments) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    i64 startRecording(1:RecordingSettings settings) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    void stopRecording(1:i64 recordingId) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    void closeRecording(1:i64 recordingId) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    i64 openRecordingStream(1:i64 recordingId) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    binary readRecordingStream(1:i64 streamId) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    void closeRecordingStream(1:i64 streamId) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    list<VMOption> getVMOptions() throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    VMOption getVMOption(1:string name) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    void setVMOption(1:string name, 2:string value) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr),
+
+    void dumpHeap(1:string outputFile, 2:bool live, 3:i64 timeoutMs) throws (1:JMXConnectionError connErr, 2:JMXError jmxErr)
 }
\ No newline at end of file
@@ -0,0 +1,358 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package browser is an interactive terminal mBean browser built on gojmx.Client, similar to jconsole but
+// usable over SSH. It shows the domain and key properties tree of the mBeans and, for the selected mBean,
+// its attribute values refreshed periodically with sparklines, its MBeanInfo and its operations.
+// The tree can be searched and the current view can be exported as a collection definition.
+package browser
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/collector"
+)
+
+const (
+	// DefaultPattern selects all the mBeans.
+	DefaultPattern = "*:*"
+	// DefaultRefreshInterval is used when Options.RefreshInterval is not defined.
+	DefaultRefreshInterval = 2 * time.Second
+	// DefaultHistorySize is used when Options.HistorySize is not defined.
+	DefaultHistorySize = 60
+)
+
+// Querier is the subset of the gojmx.Client used by the browser.
+type Querier interface {
+	QueryMBeanNames(mBeanGlobPattern string) ([]string, error)
+	GetMBeanInfo(mBeanName string) (*gojmx.MBeanInfo, error)
+	GetMBeanAttributes(mBeanName string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+	InvokeOperationWithSignature(mBeanName, operationName string, args []string, signature []string) ([]*gojmx.AttributeResponse, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// Options configures the browser.
+type Options struct {
+	// Pattern selects the mBeans shown in the tree, DefaultPattern when not defined.
+	Pattern string
+	// RefreshInterval for the attribute values of the selected mBean.
+	RefreshInterval time.Duration
+	// HistorySize is the number of values kept for the sparklines.
+	HistorySize int
+}
+
+type pane int
+
+const (
+	paneTree pane = iota
+	paneDetail
+)
+
+type tab int
+
+const (
+	tabAttributes tab = iota
+	tabInfo
+	tabOperations
+)
+
+var tabNames = []string{"Attributes", "Info", "Operations"}
+
+// input is the line edited at the bottom of the screen for the search and the prompts.
+type input struct {
+	prompt string
+	value  string
+	// change is called when the value is edited, it can be nil.
+	change func(value string)
+	submit func(value string) error
+	cancel func()
+}
+
+// Browser keeps the state of the interactive browser. Use Run to start it on a terminal.
+type Browser struct {
+	querier Querier
+	options Options
+
+	root   *node
+	rows   []*node
+	cursor int
+	offset int
+	filter string
+
+	focus        pane
+	tab          tab
+	detailCursor int
+
+	selected  string
+	info      *gojmx.MBeanInfo
+	attrs     []*gojmx.AttributeResponse
+	history   map[string][]float64
+	refreshed time.Time
+	opResult  []string
+
+	input  *input
+	status string
+	quit   bool
+}
+
+// New returns a browser for the mBeans of the Querier.
+func New(q Querier, options Options) *Browser {
+	if options.Pattern == "" {
+		options.Pattern = DefaultPattern
+	}
+	if options.RefreshInterval <= 0 {
+		options.RefreshInterval = DefaultRefreshInterval
+	}
+	if options.HistorySize <= 0 {
+		options.HistorySize = DefaultHistorySize
+	}
+
+	return &Browser{
+		querier: q,
+		options: options,
+		root:    buildTree(nil),
+		history: map[string][]float64{},
+	}
+}
+
+// Load queries the mBean names and rebuilds the tree. The expanded nodes and the selection are kept.
+func (b *Browser) Load() error {
+	names, err := b.querier.QueryMBeanNames(b.options.Pattern)
+	if err != nil {
+		return b.check(err)
+	}
+
+	expanded := map[string]bool{}
+	for _, row := range b.rows {
+		if row.expanded {
+			expanded[row.pattern] = true
+		}
+	}
+	current := b.current()
+
+	b.root = buildTree(names)
+	b.markExpanded(b.root, expanded)
+	b.updateRows()
+
+	if current != nil {
+		for i, row := range b.rows {
+			if row.pattern == current.pattern {
+				b.cursor = i
+			}
+		}
+	}
+	b.status = fmt.Sprintf("%d mBeans", len(names))
+	return nil
+}
+
+func (b *Browser) markExpanded(n *node, expanded map[string]bool) {
+	for _, c := range n.children {
+		c.expanded = expanded[c.pattern]
+		b.markExpanded(c, expanded)
+	}
+}
+
+// check shows the *gojmx.JMXError in the status line, the other errors, e.g. connection errors, stop the browser.
+func (b *Browser) check(err error) error {
+	if err == nil {
+		return nil
+	}
+	if jmxErr, ok := gojmx.IsJMXError(err); ok {
+		b.status = "error: " + jmxErr.Message
+		return nil
+	}
+	return err
+}
+
+func (b *Browser) updateRows() {
+	b.rows = flatten(b.root, b.filter)
+	if b.cursor >= len(b.rows) {
+		b.cursor = len(b.rows) - 1
+	}
+	if b.cursor < 0 {
+		b.cursor = 0
+	}
+}
+
+// current returns the tree node under the cursor.
+func (b *Browser) current() *node {
+	if b.cursor < 0 || b.cursor >= len(b.rows) {
+		return nil
+	}
+	return b.rows[b.cursor]
+}
+
+// selectMBean shows the mBean in the detail pane.
+func (b *Browser) selectMBean(name string) error {
+	b.selected = name
+	b.info = nil
+	b.attrs = nil
+	b.history = map[string][]float64{}
+	b.opResult = nil
+	b.detailCursor = 0
+
+	info, err := b.querier.GetMBeanInfo(name)
+	if err != nil {
+		return b.check(err)
+	}
+	b.info = info
+	return b.refresh()
+}
+
+// refresh retrieves the attribute values of the selected mBean and records the numeric ones for the sparklines.
+func (b *Browser) refresh() error {
+	if b.selected == "" {
+		return nil
+	}
+
+	attrs, err := b.querier.GetMBeanAttributes(b.selected)
+	if err != nil {
+		return b.check(err)
+	}
+	b.attrs = attrs
+	b.refreshed = time.Now()
+
+	for _, attr := range attrs {
+		if attr.ResponseType != gojmx.ResponseTypeInt && attr.ResponseType != gojmx.ResponseTypeDouble {
+			continue
+		}
+		value, err := attr.GetValueAsFloat()
+		if err != nil {
+			continue
+		}
+		name := attrName(attr)
+		history := append(b.history[name], value)
+		if len(history) > b.options.HistorySize {
+			history = history[len(history)-b.options.HistorySize:]
+		}
+		b.history[name] = history
+	}
+	return nil
+}
+
+func attrName(attr *gojmx.AttributeResponse) string {
+	if _, name, ok := gojmx.SplitAttributeName(attr.Name); ok {
+		return name
+	}
+	return attr.Name
+}
+
+// invoke executes the operation on the selected mBean with the whitespace separated arguments.
+func (b *Browser) invoke(op *gojmx.MBeanOperationInfo, args string) error {
+	var signature []string
+	for _, param := range op.GetSignature() {
+		signature = append(signature, param.Type)
+	}
+
+	arguments := strings.Fields(args)
+	if len(arguments) != len(signature) {
+		b.status = fmt.Sprintf("error: %s expects %d arguments, got %d", op.Name, len(signature), len(arguments))
+		return nil
+	}
+
+	result, err := b.querier.InvokeOperationWithSignature(b.selected, op.Name, arguments, signature)
+	if err != nil {
+		return b.check(err)
+	}
+
+	b.opResult = nil
+	for _, attr := range result {
+		if attr.ResponseType == gojmx.ResponseTypeErr {
+			b.opResult = append(b.opResult, fmt.Sprintf("%s: error: %s", attrName(attr), attr.StatusMsg))
+			continue
+		}
+		b.opResult = append(b.opResult, fmt.Sprintf("%s = %v", attrName(attr), attr.GetValue()))
+	}
+	b.status = fmt.Sprintf("invoked %s", op.Name)
+	return nil
+}
+
+// exportDefinition builds the collection definition of the tree node under the cursor. The attributes shown
+// for the selected mBean are included, the other nodes collect all the attributes of the matching mBeans.
+func (b *Browser) exportDefinition() (*collector.Definition, error) {
+	n := b.current()
+	if n == nil {
+		return nil, fmt.Errorf("nothing to export")
+	}
+
+	bean := &collector.BeanDefinition{}
+	if n.mBean != "" {
+		bean.Query = strings.TrimPrefix(n.mBean, n.domain+":")
+	} else {
+		bean.Query = strings.TrimPrefix(n.pattern, n.domain+":")
+	}
+
+	if n.mBean != "" && n.mBean == b.selected {
+		for _, attr := range b.attrs {
+			if attr.ResponseType == gojmx.ResponseTypeErr {
+				continue
+			}
+			name := attrName(attr)
+			bean.Attributes = append(bean.Attributes, &collector.AttributeDefinition{
+				Attr:        name,
+				Description: b.attrDescription(name),
+			})
+		}
+	}
+
+	return &collector.Definition{
+		Collect: []*collector.DomainDefinition{
+			{Domain: n.domain, Beans: []*collector.BeanDefinition{bean}},
+		},
+	}, nil
+}
+
+// attrDescription returns the MBeanInfo description of the attribute or of the composite attribute of a field.
+func (b *Browser) attrDescription(name string) string {
+	if attr := b.info.GetAttribute(name); attr != nil {
+		return attr.Description
+	}
+	if i := strings.Index(name, "."); i > 0 {
+		if attr := b.info.GetAttribute(name[:i]); attr != nil {
+			return attr.Description
+		}
+	}
+	return ""
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func (b *Browser) export(path string) error {
+	definition, err := b.exportDefinition()
+	if err != nil {
+		b.status = "error: " + err.Error()
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(collector.Format(definition)), 0644); err != nil {
+		b.status = "error: " + err.Error()
+		return nil
+	}
+	b.status = "exported to " + path
+	return nil
+}
+
+func (b *Browser) defaultExportPath() string {
+	n := b.current()
+	if n == nil {
+		return "collect.yml"
+	}
+	name := n.domain
+	if n.mBean != "" {
+		name = n.mBean
+	}
+	return unsafeFileChars.ReplaceAllString(name, "_") + ".yml"
+}
+
+// operations returns the operations of the selected mBean.
+func (b *Browser) operations() []*gojmx.MBeanOperationInfo {
+	return b.info.GetOperations()
+}
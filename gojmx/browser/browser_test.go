@@ -0,0 +1,243 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package browser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/collector"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQuerier struct {
+	names   []string
+	info    map[string]*gojmx.MBeanInfo
+	values  map[string][]int64
+	calls   map[string]int
+	invoked []string
+	err     error
+}
+
+func (f *fakeQuerier) QueryMBeanNames(string) ([]string, error) {
+	return f.names, f.err
+}
+
+func (f *fakeQuerier) GetMBeanInfo(mBeanName string) (*gojmx.MBeanInfo, error) {
+	info, ok := f.info[mBeanName]
+	if !ok {
+		return nil, &gojmx.JMXError{Message: "instance not found: " + mBeanName}
+	}
+	return info, nil
+}
+
+func (f *fakeQuerier) GetMBeanAttributes(mBeanName string, _ ...string) ([]*gojmx.AttributeResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.calls == nil {
+		f.calls = map[string]int{}
+	}
+	values := f.values[mBeanName]
+	call := f.calls[mBeanName]
+	f.calls[mBeanName]++
+
+	value := values[len(values)-1]
+	if call < len(values) {
+		value = values[call]
+	}
+	return []*gojmx.AttributeResponse{
+		{Name: mBeanName + ",attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: value},
+		{Name: mBeanName + ",attr=Name", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "test"},
+		{Name: mBeanName + ",attr=Broken", ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "unsupported"},
+	}, nil
+}
+
+func (f *fakeQuerier) InvokeOperationWithSignature(mBeanName, operationName string, args []string, signature []string) ([]*gojmx.AttributeResponse, error) {
+	f.invoked = append(f.invoked, fmt.Sprintf("%s %s%v%v", mBeanName, operationName, args, signature))
+	return []*gojmx.AttributeResponse{
+		{Name: mBeanName + ",attr=" + operationName, ResponseType: nrprotocol.ResponseType_INT, IntValue: 42},
+	}, nil
+}
+
+func newFakeQuerier() *fakeQuerier {
+	return &fakeQuerier{
+		names: []string{
+			"java.lang:type=Memory",
+			"java.lang:name=G1 Young Generation,type=GarbageCollector",
+			"java.lang:type=GarbageCollector,name=G1 Old Generation",
+			"JMImplementation:type=MBeanServerDelegate",
+		},
+		info: map[string]*gojmx.MBeanInfo{
+			"java.lang:type=Memory": {
+				Name:      "java.lang:type=Memory",
+				ClassName: "sun.management.MemoryImpl",
+				Attributes: []*nrprotocol.MBeanAttributeInfo{
+					{Name: "Count", Type: "long", Description: "Objects count", Readable: true},
+				},
+				Operations: []*nrprotocol.MBeanOperationInfo{
+					{Name: "gc", ReturnType: "void", Impact: gojmx.OperationImpactAction},
+					{Name: "size", ReturnType: "long", Impact: gojmx.OperationImpactInfo, Signature: []*nrprotocol.MBeanParameterInfo{
+						{Name: "p0", Type: "int"},
+					}},
+				},
+			},
+		},
+		values: map[string][]int64{
+			"java.lang:type=Memory": {1, 5, 3},
+		},
+	}
+}
+
+func typeKeys(b *Browser, keys string) {
+	for _, k := range parseKeys([]byte(keys)) {
+		if err := b.handleKey(k); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func Test_Tree(t *testing.T) {
+	root := buildTree(newFakeQuerier().names)
+
+	var labels []string
+	var walk func(n *node)
+	walk = func(n *node) {
+		for _, c := range n.children {
+			labels = append(labels, strings.Repeat(" ", c.depth)+c.label)
+			walk(c)
+		}
+	}
+	walk(root)
+
+	// The type property goes first and the nodes are sorted.
+	assert.Equal(t, []string{
+		"JMImplementation",
+		" type=MBeanServerDelegate",
+		"java.lang",
+		" type=GarbageCollector",
+		"  name=G1 Old Generation",
+		"  name=G1 Young Generation",
+		" type=Memory",
+	}, labels)
+	assert.Equal(t, 4, root.count())
+
+	gc := root.index["java.lang"].index["type=GarbageCollector"]
+	assert.Equal(t, "java.lang:type=GarbageCollector,*", gc.pattern)
+	assert.Equal(t, "java.lang:name=G1 Young Generation,type=GarbageCollector", gc.index["name=G1 Young Generation"].mBean)
+
+	// Only the domains are visible until they are expanded.
+	assert.Len(t, flatten(root, ""), 2)
+
+	// The filter expands the matching paths and keeps the descendants of the matching nodes.
+	var rows []string
+	for _, row := range flatten(root, "garbage") {
+		rows = append(rows, row.label)
+	}
+	assert.Equal(t, []string{"java.lang", "type=GarbageCollector", "name=G1 Old Generation", "name=G1 Young Generation"}, rows)
+}
+
+func Test_ParseKeys(t *testing.T) {
+	keys := parseKeys([]byte("a\x1b[A\x1b[B\r\t\x7f\x1b\x03ñ\x1b[6~"))
+
+	assert.Equal(t, []key{
+		runeKey('a'), {code: keyUp}, {code: keyDown}, {code: keyEnter}, {code: keyTab},
+		{code: keyBackspace}, {code: keyEsc}, {code: keyCtrlC}, runeKey('ñ'), {code: keyPageDown},
+	}, keys)
+}
+
+func Test_Sparkline(t *testing.T) {
+	assert.Equal(t, "", Sparkline(nil, 10))
+	assert.Equal(t, "▁▁▁", Sparkline([]float64{2, 2, 2}, 10))
+	assert.Equal(t, "▁▄█", Sparkline([]float64{0, 5, 10}, 10))
+	// Only the last values that fit are drawn.
+	assert.Equal(t, "▁█", Sparkline([]float64{100, 0, 10}, 2))
+}
+
+func Test_Browser(t *testing.T) {
+	q := newFakeQuerier()
+	b := New(q, Options{})
+	require.NoError(t, b.Load())
+	assert.Equal(t, "4 mBeans", b.status)
+
+	// Expand java.lang and select the Memory mBean.
+	typeKeys(b, "j\r")
+	typeKeys(b, "jj\r")
+	require.Equal(t, "java.lang:type=Memory", b.selected)
+	require.NoError(t, b.refresh())
+	require.NoError(t, b.refresh())
+
+	screen := strings.Join(b.render(120, 12), "\n")
+	assert.Contains(t, screen, "gojmx browser  pattern: *:*  mBeans: 4")
+	assert.Contains(t, screen, "  - java.lang")
+	assert.Contains(t, screen, "    + type=GarbageCollector")
+	assert.Contains(t, screen, ">     type=Memory")
+	assert.Contains(t, screen, "1 [Attributes]  2 Info  3 Operations")
+	assert.Contains(t, screen, "Count   3")
+	assert.Contains(t, screen, "▁█▄")
+	assert.Contains(t, screen, "Broken  error: unsupported")
+
+	// Export the selected mBean with the shown attributes.
+	path := filepath.Join(t.TempDir(), "memory.yml")
+	typeKeys(b, "e")
+	require.NotNil(t, b.input)
+	assert.Equal(t, "java.lang_type_Memory.yml", b.input.value)
+	b.input.value = path
+	typeKeys(b, "\r")
+	assert.Equal(t, "exported to "+path, b.status)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `collect:
+  - domain: java.lang
+    beans:
+      - query: type=Memory
+        attributes:
+          # Objects count
+          - Count
+          - Name
+`, string(data))
+	_, err = collector.Parse(data)
+	assert.NoError(t, err)
+
+	// Invoke an operation with arguments from the operations tab.
+	typeKeys(b, "3\tj\r")
+	require.NotNil(t, b.input)
+	assert.Equal(t, "arguments for long size(int p0): ", b.input.prompt)
+	typeKeys(b, "10\r")
+	assert.Equal(t, []string{"java.lang:type=Memory size[10][int]"}, q.invoked)
+	assert.Equal(t, []string{"size = 42"}, b.opResult)
+
+	// Search filters the tree and escape restores it.
+	typeKeys(b, "\t/young")
+	assert.Equal(t, "young", b.filter)
+	assert.Len(t, b.rows, 3)
+	typeKeys(b, "\r\x1b")
+	assert.Equal(t, "", b.filter)
+
+	typeKeys(b, "q")
+	assert.True(t, b.quit)
+}
+
+func Test_Browser_Errors(t *testing.T) {
+	q := newFakeQuerier()
+	b := New(q, Options{})
+	require.NoError(t, b.Load())
+
+	// JMX errors are shown in the status line.
+	require.NoError(t, b.selectMBean("java.lang:type=Missing"))
+	assert.Equal(t, "error: instance not found: java.lang:type=Missing", b.status)
+
+	// Other errors stop the browser.
+	q.err = fmt.Errorf("connection lost")
+	assert.EqualError(t, b.refresh(), "connection lost")
+}
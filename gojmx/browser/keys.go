@@ -0,0 +1,289 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package browser
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
+
+type keyCode int
+
+const (
+	keyRune keyCode = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyPageUp
+	keyPageDown
+	keyEnter
+	keyTab
+	keyBackspace
+	keyEsc
+	keyCtrlC
+)
+
+type key struct {
+	code keyCode
+	r    rune
+}
+
+func runeKey(r rune) key {
+	return key{code: keyRune, r: r}
+}
+
+func (k key) is(r rune) bool {
+	return k.code == keyRune && k.r == r
+}
+
+// escapeSequences are the terminal input sequences for the special keys, in normal and application mode.
+var escapeSequences = []struct {
+	seq  []byte
+	code keyCode
+}{
+	{[]byte("\x1b[A"), keyUp},
+	{[]byte("\x1b[B"), keyDown},
+	{[]byte("\x1b[C"), keyRight},
+	{[]byte("\x1b[D"), keyLeft},
+	{[]byte("\x1bOA"), keyUp},
+	{[]byte("\x1bOB"), keyDown},
+	{[]byte("\x1bOC"), keyRight},
+	{[]byte("\x1bOD"), keyLeft},
+	{[]byte("\x1b[5~"), keyPageUp},
+	{[]byte("\x1b[6~"), keyPageDown},
+}
+
+// parseKeys decodes the keys read from a terminal in raw mode. Unknown control characters are ignored.
+func parseKeys(data []byte) []key {
+	var keys []key
+
+next:
+	for len(data) > 0 {
+		for _, s := range escapeSequences {
+			if bytes.HasPrefix(data, s.seq) {
+				keys = append(keys, key{code: s.code})
+				data = data[len(s.seq):]
+				continue next
+			}
+		}
+
+		switch data[0] {
+		case 0x1b:
+			keys = append(keys, key{code: keyEsc})
+		case '\r', '\n':
+			keys = append(keys, key{code: keyEnter})
+		case '\t':
+			keys = append(keys, key{code: keyTab})
+		case 0x7f, 0x08:
+			keys = append(keys, key{code: keyBackspace})
+		case 0x03:
+			keys = append(keys, key{code: keyCtrlC})
+		default:
+			r, size := utf8.DecodeRune(data)
+			if r >= 0x20 && r != utf8.RuneError {
+				keys = append(keys, runeKey(r))
+			}
+			data = data[size:]
+			continue
+		}
+		data = data[1:]
+	}
+	return keys
+}
+
+// handleKey updates the browser state. It only returns the errors that stop the browser.
+func (b *Browser) handleKey(k key) error {
+	if b.input != nil {
+		return b.handleInputKey(k)
+	}
+
+	switch {
+	case k.code == keyCtrlC || k.is('q'):
+		b.quit = true
+	case k.code == keyTab:
+		if b.focus == paneTree && b.selected != "" {
+			b.focus = paneDetail
+		} else {
+			b.focus = paneTree
+		}
+	case k.is('/'):
+		b.startSearch()
+	case k.code == keyEsc:
+		b.filter = ""
+		b.updateRows()
+	case k.is('1'), k.is('2'), k.is('3'):
+		b.tab = tab(k.r - '1')
+		b.detailCursor = 0
+	case k.is('e'):
+		b.input = &input{
+			prompt: "export to: ",
+			value:  b.defaultExportPath(),
+			submit: b.export,
+		}
+	case k.is('r'):
+		if err := b.Load(); err != nil {
+			return err
+		}
+		return b.refresh()
+	case b.focus == paneTree:
+		return b.handleTreeKey(k)
+	default:
+		return b.handleDetailKey(k)
+	}
+	return nil
+}
+
+func (b *Browser) handleTreeKey(k key) error {
+	n := b.current()
+
+	switch {
+	case k.code == keyUp || k.is('k'):
+		b.moveCursor(-1)
+	case k.code == keyDown || k.is('j'):
+		b.moveCursor(1)
+	case k.code == keyPageUp:
+		b.moveCursor(-10)
+	case k.code == keyPageDown:
+		b.moveCursor(10)
+	case n == nil:
+		return nil
+	case k.code == keyLeft || k.is('h'):
+		if n.expanded && len(n.children) > 0 {
+			n.expanded = false
+			b.updateRows()
+		} else if n.parent != nil && n.parent.depth >= 0 {
+			b.moveTo(n.parent)
+		}
+	case k.code == keyRight || k.is('l'):
+		if len(n.children) > 0 && !n.expanded {
+			n.expanded = true
+			b.updateRows()
+		}
+	case k.code == keyEnter:
+		if len(n.children) > 0 {
+			n.expanded = !n.expanded
+			b.updateRows()
+		}
+		if n.mBean != "" {
+			return b.selectMBean(n.mBean)
+		}
+	}
+	return nil
+}
+
+func (b *Browser) moveCursor(delta int) {
+	b.cursor += delta
+	if b.cursor >= len(b.rows) {
+		b.cursor = len(b.rows) - 1
+	}
+	if b.cursor < 0 {
+		b.cursor = 0
+	}
+}
+
+func (b *Browser) moveTo(n *node) {
+	for i, row := range b.rows {
+		if row == n {
+			b.cursor = i
+			return
+		}
+	}
+}
+
+func (b *Browser) handleDetailKey(k key) error {
+	switch {
+	case k.code == keyUp || k.is('k'):
+		if b.detailCursor > 0 {
+			b.detailCursor--
+		}
+	case k.code == keyDown || k.is('j'):
+		if b.detailCursor < b.detailRows()-1 {
+			b.detailCursor++
+		}
+	case k.code == keyEnter && b.tab == tabOperations:
+		operations := b.operations()
+		if b.detailCursor >= len(operations) {
+			return nil
+		}
+		op := operations[b.detailCursor]
+		prompt := fmt.Sprintf("invoke %s? [enter] ", op.Name)
+		if len(op.Signature) > 0 {
+			prompt = fmt.Sprintf("arguments for %s: ", op)
+		}
+		b.input = &input{
+			prompt: prompt,
+			submit: func(value string) error {
+				return b.invoke(op, value)
+			},
+		}
+	}
+	return nil
+}
+
+// detailRows returns the number of rows the detail cursor moves through.
+func (b *Browser) detailRows() int {
+	switch b.tab {
+	case tabAttributes:
+		return len(b.attrs)
+	case tabInfo:
+		return len(b.infoLines())
+	default:
+		return len(b.operations())
+	}
+}
+
+func (b *Browser) startSearch() {
+	previous := b.filter
+	b.input = &input{
+		prompt: "/",
+		value:  b.filter,
+		change: func(value string) {
+			b.filter = value
+			b.cursor = 0
+			b.updateRows()
+		},
+		submit: func(string) error {
+			return nil
+		},
+		cancel: func() {
+			b.filter = previous
+			b.updateRows()
+		},
+	}
+}
+
+func (b *Browser) handleInputKey(k key) error {
+	in := b.input
+
+	switch k.code {
+	case keyEsc, keyCtrlC:
+		b.input = nil
+		if in.cancel != nil {
+			in.cancel()
+		}
+		return nil
+	case keyEnter:
+		b.input = nil
+		return in.submit(in.value)
+	case keyBackspace:
+		if in.value == "" {
+			return nil
+		}
+		_, size := utf8.DecodeLastRuneInString(in.value)
+		in.value = in.value[:len(in.value)-size]
+	case keyRune:
+		in.value += string(k.r)
+	default:
+		return nil
+	}
+
+	if in.change != nil {
+		in.change(in.value)
+	}
+	return nil
+}
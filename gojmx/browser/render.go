@@ -0,0 +1,276 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package browser
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+const help = "↑↓ move  ←→ fold  enter select  tab pane  1-3 tabs  / search  e export  r reload  q quit"
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline draws the last width values scaled between their minimum and maximum.
+func Sparkline(values []float64, width int) string {
+	if width <= 0 || len(values) == 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+
+	out := make([]rune, len(values))
+	for i, v := range values {
+		level := 0
+		if hi > lo {
+			level = int((v - lo) / (hi - lo) * float64(len(sparks)-1))
+		}
+		out[i] = sparks[level]
+	}
+	return string(out)
+}
+
+// fit truncates or pads the text to exactly width runes.
+func fit(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	n := utf8.RuneCountInString(text)
+	if n > width {
+		runes := []rune(text)
+		return string(runes[:width-1]) + "…"
+	}
+	return text + strings.Repeat(" ", width-n)
+}
+
+// render returns the screen lines for the terminal size.
+func (b *Browser) render(width, height int) []string {
+	if width < 20 || height < 5 {
+		return []string{fit("terminal too small", width)}
+	}
+
+	lines := make([]string, 0, height)
+
+	title := fmt.Sprintf("gojmx browser  pattern: %s  mBeans: %d", b.options.Pattern, b.root.count())
+	if b.filter != "" {
+		title += fmt.Sprintf("  filter: %s (%d rows)", b.filter, len(b.rows))
+	}
+	lines = append(lines, fit(title, width))
+
+	bodyHeight := height - 3
+	treeWidth := width / 3
+	if treeWidth > 60 {
+		treeWidth = 60
+	}
+	if treeWidth < 20 {
+		treeWidth = 20
+	}
+	detailWidth := width - treeWidth - 3
+
+	tree := b.renderTree(treeWidth, bodyHeight)
+	detail := b.renderDetail(detailWidth, bodyHeight)
+	for i := 0; i < bodyHeight; i++ {
+		lines = append(lines, fit(tree[i], treeWidth)+" │ "+fit(detail[i], detailWidth))
+	}
+
+	if b.input != nil {
+		lines = append(lines, fit(b.input.prompt+b.input.value+"_", width))
+	} else {
+		lines = append(lines, fit(b.status, width))
+	}
+	lines = append(lines, fit(help, width))
+
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
+
+func (b *Browser) renderTree(width, height int) []string {
+	if b.cursor < b.offset {
+		b.offset = b.cursor
+	}
+	if b.cursor >= b.offset+height {
+		b.offset = b.cursor - height + 1
+	}
+
+	lines := make([]string, height)
+	for i := 0; i < height && b.offset+i < len(b.rows); i++ {
+		row := b.rows[b.offset+i]
+
+		prefix := "  "
+		if b.offset+i == b.cursor {
+			prefix = "* "
+			if b.focus == paneTree {
+				prefix = "> "
+			}
+		}
+
+		icon := "  "
+		if len(row.children) > 0 {
+			icon = "+ "
+			if row.expanded || b.filter != "" {
+				icon = "- "
+			}
+		}
+
+		lines[i] = fit(prefix+strings.Repeat("  ", row.depth)+icon+row.label, width)
+	}
+	return lines
+}
+
+func (b *Browser) renderDetail(width, height int) []string {
+	lines := make([]string, 0, height)
+
+	var tabs []string
+	for i, name := range tabNames {
+		if tab(i) == b.tab {
+			name = "[" + name + "]"
+		}
+		tabs = append(tabs, fmt.Sprintf("%d %s", i+1, name))
+	}
+	lines = append(lines, strings.Join(tabs, "  "))
+
+	if b.selected == "" {
+		lines = append(lines, "", "Select an mBean with enter.")
+		return pad(lines, height)
+	}
+
+	header := b.selected
+	if b.tab == tabAttributes && !b.refreshed.IsZero() {
+		header += "  (" + b.refreshed.Format("15:04:05") + ")"
+	}
+	lines = append(lines, header, "")
+
+	var content []string
+	switch b.tab {
+	case tabAttributes:
+		content = b.attributeLines(width - 2)
+	case tabInfo:
+		content = b.infoLines()
+	default:
+		for _, op := range b.operations() {
+			content = append(content, fmt.Sprintf("%s  [%s]", op, impactName(op.Impact)))
+		}
+	}
+
+	visible := height - len(lines)
+	offset := 0
+	if b.detailCursor >= visible {
+		offset = b.detailCursor - visible + 1
+	}
+	for i := offset; i < len(content) && len(lines) < height; i++ {
+		prefix := "  "
+		if i == b.detailCursor && b.focus == paneDetail {
+			prefix = "> "
+		}
+		lines = append(lines, prefix+content[i])
+	}
+
+	if b.tab == tabOperations && len(b.opResult) > 0 && len(lines) < height-1 {
+		lines = append(lines, "", "Result:")
+		for _, result := range b.opResult {
+			lines = append(lines, "  "+result)
+		}
+	}
+
+	return pad(lines, height)
+}
+
+// attributeLines formats the attribute values with the sparklines of the numeric ones.
+func (b *Browser) attributeLines(width int) []string {
+	nameWidth := 0
+	for _, attr := range b.attrs {
+		if n := utf8.RuneCountInString(attrName(attr)); n > nameWidth {
+			nameWidth = n
+		}
+	}
+	if nameWidth > 40 {
+		nameWidth = 40
+	}
+	valueWidth := 24
+	sparkWidth := width - nameWidth - valueWidth - 4
+	if sparkWidth > 30 {
+		sparkWidth = 30
+	}
+
+	lines := make([]string, 0, len(b.attrs))
+	for _, attr := range b.attrs {
+		name := attrName(attr)
+
+		value := fmt.Sprint(attr.GetValue())
+		if attr.ResponseType == gojmx.ResponseTypeErr {
+			value = "error: " + attr.StatusMsg
+		}
+
+		line := fit(name, nameWidth) + "  " + fit(value, valueWidth)
+		if history := b.history[name]; len(history) > 0 && sparkWidth > 0 {
+			line += "  " + Sparkline(history, sparkWidth)
+		}
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+	return lines
+}
+
+// infoLines formats the MBeanInfo of the selected mBean.
+func (b *Browser) infoLines() []string {
+	if b.info == nil {
+		return nil
+	}
+
+	lines := []string{
+		"Class: " + b.info.ClassName,
+		"Description: " + strings.Join(strings.Fields(b.info.Description), " "),
+		"",
+		"Attributes:",
+	}
+	for _, attr := range b.info.GetAttributes() {
+		access := ""
+		if attr.Readable {
+			access += "r"
+		}
+		if attr.Writable {
+			access += "w"
+		}
+		line := fmt.Sprintf("  %s %s (%s)", attr.Type, attr.Name, access)
+		if attr.Description != "" && attr.Description != attr.Name {
+			line += " - " + strings.Join(strings.Fields(attr.Description), " ")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func impactName(impact int32) string {
+	switch impact {
+	case gojmx.OperationImpactInfo:
+		return "info"
+	case gojmx.OperationImpactAction:
+		return "action"
+	case gojmx.OperationImpactActionInfo:
+		return "action_info"
+	default:
+		return "unknown"
+	}
+}
+
+func pad(lines []string, height int) []string {
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	return lines[:height]
+}
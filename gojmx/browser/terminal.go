@@ -0,0 +1,108 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package browser
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l"
+	exitAltScreen  = "\x1b[?25h\x1b[?1049l"
+	cursorHome     = "\x1b[H"
+	clearLine      = "\x1b[K"
+	clearBelow     = "\x1b[J"
+)
+
+// Run loads the tree and runs the browser on the terminal until it's closed by the user or the context is done.
+// The terminal is switched to raw mode and to the alternate screen, both are restored before returning.
+// *gojmx.JMXError failures are shown in the status line, the other errors stop the browser and are returned.
+func (b *Browser) Run(ctx context.Context, in *os.File, out io.Writer) error {
+	if err := b.Load(); err != nil {
+		return err
+	}
+
+	fd := int(in.Fd())
+	if !term.IsTerminal(fd) {
+		return fmt.Errorf("the browser requires a terminal")
+	}
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("cannot set the terminal raw mode: %w", err)
+	}
+	defer term.Restore(fd, state)
+
+	_, _ = io.WriteString(out, enterAltScreen)
+	defer io.WriteString(out, exitAltScreen)
+
+	// The reader is not stopped when the browser returns, it's meant to run until the process exits.
+	input := make(chan []byte)
+	go readInput(in, input)
+
+	ticker := time.NewTicker(b.options.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			width, height = 80, 24
+		}
+		if err := b.draw(out, width, height); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case data, ok := <-input:
+			if !ok {
+				return nil
+			}
+			for _, k := range parseKeys(data) {
+				if err := b.handleKey(k); err != nil {
+					return err
+				}
+				if b.quit {
+					return nil
+				}
+			}
+		case <-ticker.C:
+			if err := b.refresh(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (b *Browser) draw(out io.Writer, width, height int) error {
+	lines := b.render(width, height)
+	_, err := io.WriteString(out, cursorHome+strings.Join(lines, clearLine+"\r\n")+clearLine+clearBelow)
+	return err
+}
+
+func readInput(in io.Reader, output chan<- []byte) {
+	defer close(output)
+
+	buf := make([]byte, 256)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			output <- data
+		}
+		if err != nil {
+			return
+		}
+	}
+}
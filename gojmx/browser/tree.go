@@ -0,0 +1,136 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package browser
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// node is a domain, a key property or an mBean of the tree.
+type node struct {
+	label  string
+	domain string
+	// pattern is the query for the mBeans under the node, e.g. java.lang:type=GarbageCollector,*
+	pattern string
+	// mBean is set when the node is a registered mBean, a node can be an mBean and have children.
+	mBean    string
+	depth    int
+	expanded bool
+	parent   *node
+	children []*node
+	index    map[string]*node
+}
+
+// buildTree groups the mBean names by domain and then by key property. The type property goes first,
+// as jconsole does, followed by the others in the declared order. Names that cannot be parsed are skipped.
+func buildTree(names []string) *node {
+	root := &node{depth: -1, expanded: true}
+
+	for _, name := range names {
+		objectName, err := gojmx.ParseObjectName(name)
+		if err != nil {
+			continue
+		}
+
+		current := root.child(objectName.Domain, objectName.Domain, objectName.Domain+":*")
+		var props []string
+		for _, key := range treeKeys(objectName) {
+			prop := key + "=" + objectName.Get(key)
+			props = append(props, prop)
+			current = current.child(prop, objectName.Domain, objectName.Domain+":"+strings.Join(props, ",")+",*")
+		}
+		current.mBean = name
+	}
+
+	root.sort()
+	return root
+}
+
+func treeKeys(objectName *gojmx.ObjectName) []string {
+	keys := objectName.Keys()
+	for i, key := range keys {
+		if key == "type" && i > 0 {
+			return append([]string{key}, append(keys[:i:i], keys[i+1:]...)...)
+		}
+	}
+	return keys
+}
+
+func (n *node) child(label, domain, pattern string) *node {
+	if n.index == nil {
+		n.index = map[string]*node{}
+	}
+	if c, ok := n.index[label]; ok {
+		return c
+	}
+	c := &node{label: label, domain: domain, pattern: pattern, depth: n.depth + 1, parent: n}
+	n.index[label] = c
+	n.children = append(n.children, c)
+	return c
+}
+
+func (n *node) sort() {
+	sort.Slice(n.children, func(i, j int) bool {
+		return n.children[i].label < n.children[j].label
+	})
+	for _, c := range n.children {
+		c.sort()
+	}
+}
+
+// count returns the number of mBeans under the node, including itself.
+func (n *node) count() int {
+	total := 0
+	if n.mBean != "" {
+		total++
+	}
+	for _, c := range n.children {
+		total += c.count()
+	}
+	return total
+}
+
+func (n *node) labelMatches(filter string) bool {
+	return strings.Contains(strings.ToLower(n.label), filter)
+}
+
+// matches reports if the node or any of its descendants matches the lower case filter.
+func (n *node) matches(filter string) bool {
+	if n.labelMatches(filter) {
+		return true
+	}
+	for _, c := range n.children {
+		if c.matches(filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// flatten returns the visible rows of the tree. When filtering, the nodes leading to the matches are expanded
+// and all the descendants of a matching node are kept.
+func flatten(root *node, filter string) []*node {
+	filter = strings.ToLower(filter)
+
+	var rows []*node
+	var walk func(n *node, matched bool)
+	walk = func(n *node, matched bool) {
+		for _, c := range n.children {
+			if filter != "" && !matched && !c.matches(filter) {
+				continue
+			}
+			rows = append(rows, c)
+			if c.expanded || filter != "" {
+				walk(c, matched || (filter != "" && c.labelMatches(filter)))
+			}
+		}
+	}
+	walk(root, false)
+	return rows
+}
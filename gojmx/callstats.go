@@ -0,0 +1,359 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// defaultMaxCallStats is used when JMXConfig.MaxInternalStatsSize is not defined, as nrjmx does.
+const defaultMaxCallStats = 100000
+
+// CallStat measures a request to the nrjmx subprocess on the gojmx side. InternalStats has the stats
+// reported by nrjmx for the same request, see JoinCallStats.
+//
+// The request time is split in: Encode, the request serialization; Write, writing it to the nrjmx stdin pipe,
+// which blocks while nrjmx is not reading; Wait, from the request being written until the first bytes of the
+// response are read, which includes the time inside the JVM; and Decode, reading the rest of the response
+// and deserializing it.
+type CallStat struct {
+	Method string `json:"method"`
+	// RequestID is the thrift sequence id of the request, reported by nrjmx as InternalStat.RequestId.
+	RequestID     int32         `json:"requestId"`
+	Start         time.Time     `json:"start"`
+	Total         time.Duration `json:"total"`
+	Encode        time.Duration `json:"encode"`
+	Write         time.Duration `json:"write"`
+	Wait          time.Duration `json:"wait"`
+	Decode        time.Duration `json:"decode"`
+	BytesSent     int64         `json:"bytesSent"`
+	BytesReceived int64         `json:"bytesReceived"`
+	// Successful is false when the request returned an error or the response couldn't be read.
+	Successful    bool              `json:"successful"`
+	InternalStats InternalStatsList `json:"internalStats,omitempty"`
+}
+
+func (s *CallStat) String() string {
+	if s == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Method: '%s', RequestID: %d, Total: %.3fms, Go: %.3fms, IPC: %.3fms, JVM: %.3fms, Sent: %dB, Received: %dB, Successful: %t",
+		s.Method,
+		s.RequestID,
+		milliseconds(s.Total),
+		milliseconds(s.Go()),
+		milliseconds(s.IPC()),
+		milliseconds(s.JVM()),
+		s.BytesSent,
+		s.BytesReceived,
+		s.Successful,
+	)
+}
+
+// JVM returns the time measured by the InternalStats of the request, the overlapping stats are counted once.
+// The stats start times have millisecond precision, so it's an approximation.
+func (s *CallStat) JVM() time.Duration {
+	type interval struct{ start, end float64 }
+
+	var intervals []interval
+	for _, stat := range s.InternalStats {
+		if stat == nil {
+			continue
+		}
+		start := float64(stat.StartTimestamp)
+		intervals = append(intervals, interval{start, start + stat.Milliseconds})
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start < intervals[j].start
+	})
+
+	var total, end float64
+	for i, in := range intervals {
+		if i == 0 || in.start > end {
+			total += in.end - in.start
+			end = in.end
+		} else if in.end > end {
+			total += in.end - end
+			end = in.end
+		}
+	}
+
+	jvm := time.Duration(total * float64(time.Millisecond))
+	if roundTrip := s.Write + s.Wait; jvm > roundTrip {
+		jvm = roundTrip
+	}
+	return jvm
+}
+
+// IPC returns the round trip time outside the InternalStats: the time in the pipes, the nrjmx thrift
+// processing and the nrjmx time not covered by its stats.
+func (s *CallStat) IPC() time.Duration {
+	return s.Write + s.Wait - s.JVM()
+}
+
+// Go returns the time spent by gojmx: encoding, decoding and the client overhead.
+func (s *CallStat) Go() time.Duration {
+	return s.Total - s.Write - s.Wait
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// GetCallStats returns the gojmx stats of the requests to nrjmx since the previous call and cleans them.
+// It requires JMXConfig.EnableInternalStats, the stats are kept up to JMXConfig.MaxInternalStatsSize requests,
+// discarding the oldest ones. The nrjmx InternalStats are not retrieved, so GetInternalStats can still be used
+// by another consumer: join the stats it returns with JoinCallStats.
+func (c *Client) GetCallStats() []*CallStat {
+	return c.callStats.drain()
+}
+
+// JoinCallStats adds to each call the InternalStats of the same request. It returns the stats without a call,
+// e.g. from requests not returned yet by GetCallStats, so they can be joined with the next calls.
+// Retrieving the InternalStats before the calls, all the calls have their stats available.
+func JoinCallStats(calls []*CallStat, internalStats InternalStatsList) InternalStatsList {
+	byRequest := make(map[int32]*CallStat, len(calls))
+	for _, call := range calls {
+		byRequest[call.RequestID] = call
+	}
+
+	var unmatched InternalStatsList
+	for _, stat := range internalStats {
+		if stat == nil {
+			continue
+		}
+		if call, ok := byRequest[stat.RequestId]; ok {
+			call.InternalStats = append(call.InternalStats, stat)
+		} else {
+			unmatched = append(unmatched, stat)
+		}
+	}
+	return unmatched
+}
+
+// callStats measures the requests to nrjmx using the protocol and the pipes of the thrift client.
+type callStats struct {
+	lock    sync.Mutex
+	enabled bool
+	maxSize int
+	calls   []*CallStat
+
+	// current is the request in progress, the thrift client sends one request at a time.
+	current *callTrace
+
+	bytesSent     int64
+	bytesReceived int64
+}
+
+type callTrace struct {
+	stat      *CallStat
+	flushed   time.Time
+	received  time.Time
+	sent      int64
+	read      int64
+	depth     int
+	exception bool
+}
+
+func newCallStats() *callStats {
+	return &callStats{}
+}
+
+// enable starts keeping the stats of the requests, up to maxSize.
+func (s *callStats) enable(maxSize int64) {
+	if maxSize < 1 {
+		maxSize = defaultMaxCallStats
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.enabled = true
+	s.maxSize = int(maxSize)
+}
+
+func (s *callStats) drain() []*CallStat {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	calls := s.calls
+	s.calls = nil
+	return calls
+}
+
+// begin starts measuring a request. A previous request that didn't finish failed while reading its response.
+func (s *callStats) begin(method string, requestID int32) {
+	if trace := s.current; trace != nil {
+		s.finish(false, trace.lastActivity())
+	}
+
+	s.current = &callTrace{
+		stat: &CallStat{
+			Method:    method,
+			RequestID: requestID,
+			Start:     time.Now(),
+		},
+		sent: atomic.LoadInt64(&s.bytesSent),
+		read: atomic.LoadInt64(&s.bytesReceived),
+	}
+}
+
+// finish completes the current request at end and keeps it when the stats are enabled.
+func (s *callStats) finish(successful bool, end time.Time) {
+	trace := s.current
+	if trace == nil {
+		return
+	}
+	s.current = nil
+
+	stat := trace.stat
+	stat.Total = end.Sub(stat.Start)
+	if !trace.received.IsZero() {
+		stat.Decode = end.Sub(trace.received)
+	}
+	stat.BytesSent = atomic.LoadInt64(&s.bytesSent) - trace.sent
+	stat.BytesReceived = atomic.LoadInt64(&s.bytesReceived) - trace.read
+	stat.Successful = successful && !trace.exception
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.enabled {
+		return
+	}
+	if len(s.calls) >= s.maxSize {
+		s.calls = s.calls[1:]
+	}
+	s.calls = append(s.calls, stat)
+}
+
+// lastActivity returns the end of the last measured phase.
+func (t *callTrace) lastActivity() time.Time {
+	switch {
+	case !t.received.IsZero():
+		return t.received
+	case !t.flushed.IsZero():
+		return t.flushed
+	default:
+		return t.stat.Start.Add(t.stat.Encode)
+	}
+}
+
+// reader counts the bytes received from the nrjmx stdout pipe.
+func (s *callStats) reader(r io.ReadCloser) io.ReadCloser {
+	return &countingReader{reader: r, count: &s.bytesReceived}
+}
+
+// writer counts the bytes sent to the nrjmx stdin pipe.
+func (s *callStats) writer(w io.WriteCloser) io.WriteCloser {
+	return &countingWriter{writer: w, count: &s.bytesSent}
+}
+
+type countingReader struct {
+	reader io.ReadCloser
+	count  *int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	atomic.AddInt64(r.count, int64(n))
+	return n, err
+}
+
+func (r *countingReader) Close() error {
+	return r.reader.Close()
+}
+
+type countingWriter struct {
+	writer io.WriteCloser
+	count  *int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	atomic.AddInt64(w.count, int64(n))
+	return n, err
+}
+
+func (w *countingWriter) Close() error {
+	return w.writer.Close()
+}
+
+// instrumentedProtocol measures the phases of the requests sent and received by a thrift client.
+type instrumentedProtocol struct {
+	thrift.TProtocol
+	stats *callStats
+}
+
+func (p *instrumentedProtocol) WriteMessageBegin(ctx context.Context, name string, typeID thrift.TMessageType, seqID int32) error {
+	p.stats.begin(name, seqID)
+	return p.TProtocol.WriteMessageBegin(ctx, name, typeID, seqID)
+}
+
+func (p *instrumentedProtocol) Flush(ctx context.Context) error {
+	trace := p.stats.current
+	if trace != nil {
+		trace.stat.Encode = time.Since(trace.stat.Start)
+	}
+
+	start := time.Now()
+	err := p.TProtocol.Flush(ctx)
+	if trace != nil {
+		trace.flushed = time.Now()
+		trace.stat.Write = trace.flushed.Sub(start)
+		if err != nil {
+			p.stats.finish(false, trace.flushed)
+		}
+	}
+	return err
+}
+
+func (p *instrumentedProtocol) ReadMessageBegin(ctx context.Context) (string, thrift.TMessageType, int32, error) {
+	name, typeID, seqID, err := p.TProtocol.ReadMessageBegin(ctx)
+	if trace := p.stats.current; trace != nil && !trace.flushed.IsZero() {
+		trace.received = time.Now()
+		trace.stat.Wait = trace.received.Sub(trace.flushed)
+		trace.exception = typeID == thrift.EXCEPTION
+		if err != nil {
+			p.stats.finish(false, trace.received)
+		}
+	}
+	return name, typeID, seqID, err
+}
+
+func (p *instrumentedProtocol) ReadStructBegin(ctx context.Context) (string, error) {
+	if trace := p.stats.current; trace != nil {
+		trace.depth++
+	}
+	return p.TProtocol.ReadStructBegin(ctx)
+}
+
+func (p *instrumentedProtocol) ReadStructEnd(ctx context.Context) error {
+	if trace := p.stats.current; trace != nil {
+		trace.depth--
+	}
+	return p.TProtocol.ReadStructEnd(ctx)
+}
+
+// ReadFieldBegin detects the errors declared by the service, they are the result fields other than 0.
+func (p *instrumentedProtocol) ReadFieldBegin(ctx context.Context) (string, thrift.TType, int16, error) {
+	name, typeID, id, err := p.TProtocol.ReadFieldBegin(ctx)
+	if trace := p.stats.current; trace != nil && trace.depth == 1 && typeID != thrift.STOP && id > 0 {
+		trace.exception = true
+	}
+	return name, typeID, id, err
+}
+
+func (p *instrumentedProtocol) ReadMessageEnd(ctx context.Context) error {
+	err := p.TProtocol.ReadMessageEnd(ctx)
+	p.stats.finish(err == nil, time.Now())
+	return err
+}
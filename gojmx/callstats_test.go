@@ -0,0 +1,162 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// fakeJMXService implements the requests used by the tests, the other ones panic.
+type fakeJMXService struct {
+	nrprotocol.JMXService
+	stats []*nrprotocol.InternalStat
+}
+
+func (s *fakeJMXService) QueryMBeanNames(_ context.Context, pattern string) ([]string, error) {
+	if pattern == "missing:*" {
+		return nil, &nrprotocol.JMXError{Message: "instance not found"}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return []string{"java.lang:type=Memory", "java.lang:type=Threading"}, nil
+}
+
+func (s *fakeJMXService) GetInternalStats(_ context.Context) ([]*nrprotocol.InternalStat, error) {
+	return s.stats, nil
+}
+
+// newFakeNRJMXClient returns a Client talking to the service through pipes, as with the nrjmx subprocess.
+func newFakeNRJMXClient(t *testing.T, service nrprotocol.JMXService) *Client {
+	requestReader, requestWriter := io.Pipe()
+	responseReader, responseWriter := io.Pipe()
+
+	serverTransport, err := thrift.NewTFramedTransportFactory(thrift.NewTBufferedTransportFactory(8192)).
+		GetTransport(thrift.NewStreamTransport(requestReader, responseWriter))
+	require.NoError(t, err)
+	serverProtocol := thrift.NewTCompactProtocolFactory().GetProtocol(serverTransport)
+	processor := nrprotocol.NewJMXServiceProcessor(service)
+	go func() {
+		for {
+			// The errors declared by the service are written as responses.
+			if _, err := processor.Process(context.Background(), serverProtocol, serverProtocol); err != nil {
+				if _, ok := err.(thrift.TTransportException); ok {
+					return
+				}
+			}
+		}
+	}()
+
+	c := NewClient(context.Background())
+	c.nrJMXProcess.Stdout = responseReader
+	c.nrJMXProcess.Stdin = requestWriter
+	c.nrJMXProcess.state.Start()
+	c.jmxService, err = c.configureJMXServiceClient()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = requestWriter.Close()
+		_ = responseWriter.Close()
+	})
+	return c
+}
+
+func Test_GetCallStats(t *testing.T) {
+	start := time.Now()
+	service := &fakeJMXService{stats: []*nrprotocol.InternalStat{
+		{StatType: "queryMBeans", MBean: "java.lang:*", Milliseconds: 2, StartTimestamp: start.UnixMilli(), Successful: true, RequestId: 1},
+		// Overlapping stats are counted once.
+		{StatType: "getMBeanInfo", MBean: "java.lang:type=Memory", Milliseconds: 1, StartTimestamp: start.UnixMilli() + 1, Successful: true, RequestId: 1},
+		{StatType: "queryMBeans", MBean: "missing:*", Milliseconds: 1, StartTimestamp: start.UnixMilli() + 10, Successful: false, RequestId: 2},
+		// Requests not measured by the client are ignored.
+		{StatType: "connect", Milliseconds: 1, StartTimestamp: start.UnixMilli() - 10, Successful: true, RequestId: 0},
+	}}
+	client := newFakeNRJMXClient(t, service)
+	client.callStats.enable(0)
+
+	names, err := client.QueryMBeanNames("java.lang:*")
+	require.NoError(t, err)
+	assert.Len(t, names, 2)
+
+	_, err = client.QueryMBeanNames("missing:*")
+	_, ok := IsJMXError(err)
+	require.True(t, ok)
+
+	internalStats, err := client.GetInternalStats()
+	require.NoError(t, err)
+
+	calls := client.GetCallStats()
+	unmatched := JoinCallStats(calls, internalStats)
+
+	require.Len(t, calls, 3)
+	query := calls[0]
+	assert.Equal(t, "queryMBeanNames", query.Method)
+	assert.EqualValues(t, 1, query.RequestID)
+	assert.True(t, query.Successful)
+	assert.True(t, query.Wait >= 5*time.Millisecond, query.Wait)
+	assert.Equal(t, query.Total, query.Go()+query.Write+query.Wait)
+	assert.True(t, query.BytesSent > 0)
+	assert.True(t, query.BytesReceived > query.BytesSent)
+	require.Len(t, query.InternalStats, 2)
+	assert.Equal(t, 2*time.Millisecond, query.JVM())
+	assert.Equal(t, query.Write+query.Wait-2*time.Millisecond, query.IPC())
+
+	failed := calls[1]
+	assert.EqualValues(t, 2, failed.RequestID)
+	assert.False(t, failed.Successful)
+	require.Len(t, failed.InternalStats, 1)
+	assert.False(t, failed.InternalStats[0].Successful)
+
+	assert.Equal(t, "getInternalStats", calls[2].Method)
+	assert.True(t, calls[2].Successful)
+	assert.Empty(t, calls[2].InternalStats)
+
+	// The stats of the requests not measured by the client are returned.
+	require.Len(t, unmatched, 1)
+	assert.Equal(t, "connect", unmatched[0].StatType)
+
+	// The calls are cleaned.
+	assert.Empty(t, client.GetCallStats())
+}
+
+func Test_CallStat_Breakdown(t *testing.T) {
+	start := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC).UnixMilli()
+	stat := &CallStat{
+		Method:    "getMBeanAttributes",
+		RequestID: 7,
+		Total:     20 * time.Millisecond,
+		Encode:    time.Millisecond,
+		Write:     time.Millisecond,
+		Wait:      15 * time.Millisecond,
+		Decode:    3 * time.Millisecond,
+		InternalStats: InternalStatsList{
+			{StatType: "getAttributes", Milliseconds: 4, StartTimestamp: start},
+			{StatType: "getAttribute", Milliseconds: 2, StartTimestamp: start + 1},
+			{StatType: "getAttribute", Milliseconds: 6, StartTimestamp: start + 5},
+		},
+		BytesSent:     100,
+		BytesReceived: 2000,
+		Successful:    true,
+	}
+
+	assert.Equal(t, 10*time.Millisecond, stat.JVM())
+	assert.Equal(t, 6*time.Millisecond, stat.IPC())
+	assert.Equal(t, 4*time.Millisecond, stat.Go())
+	assert.Equal(t, "Method: 'getMBeanAttributes', RequestID: 7, Total: 20.000ms, Go: 4.000ms, IPC: 6.000ms, JVM: 10.000ms, Sent: 100B, Received: 2000B, Successful: true",
+		stat.String())
+
+	// The JVM time is limited to the round trip.
+	stat.Wait = 5 * time.Millisecond
+	assert.Equal(t, 6*time.Millisecond, stat.JVM())
+	assert.Equal(t, time.Duration(0), stat.IPC())
+}
@@ -0,0 +1,722 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/browser"
+	"github.com/newrelic/nrjmx/gojmx/encoder"
+	"github.com/newrelic/nrjmx/gojmx/watch"
+	"golang.org/x/term"
+)
+
+// runFunc executes a command with its positional arguments and the context passed to run.
+type runFunc func(ctx context.Context, client *gojmx.Client, args []string) (*result, error)
+
+// command is a gojmx subcommand.
+type command struct {
+	name        string
+	usage       string
+	description string
+	minArgs     int
+	// maxArgs is the maximum number of positional arguments, -1 for no limit.
+	maxArgs int
+	// setup registers the command flags and returns the function executing the command.
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+// report is a command that runs another one and reports about its calls instead of its output.
+type report struct {
+	name        string
+	usage       string
+	description string
+	// configure enables the reported data in the JMXConfig.
+	configure func(config *gojmx.JMXConfig)
+	run       func(client *gojmx.Client) (*result, error)
+}
+
+// parse parses the command flags and arguments, returning the function that executes it.
+func (c *command) parse(args []string, stderr io.Writer) (func(ctx context.Context, client *gojmx.Client) (*result, error), error) {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: gojmx %s %s\n\n%s\n", c.name, c.usage, c.description)
+		fs.PrintDefaults()
+	}
+	run := c.setup(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	nArgs := fs.NArg()
+	if nArgs < c.minArgs || (c.maxArgs >= 0 && nArgs > c.maxArgs) {
+		fs.Usage()
+		return nil, fmt.Errorf("invalid number of arguments for %s: %d", c.name, nArgs)
+	}
+
+	return func(ctx context.Context, client *gojmx.Client) (*result, error) {
+		return run(ctx, client, fs.Args())
+	}, nil
+}
+
+// noFlags is the setup of the commands without flags.
+func noFlags(run runFunc) func(fs *flag.FlagSet) runFunc {
+	return func(*flag.FlagSet) runFunc {
+		return run
+	}
+}
+
+var commands = map[string]*command{}
+
+var reports = map[string]*report{}
+
+func register(c *command) {
+	commands[c.name] = c
+}
+
+func registerReport(r *report) {
+	reports[r.name] = r
+}
+
+func init() {
+	register(&command{
+		name:        "domains",
+		description: "list the domains with registered mBeans",
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, _ []string) (*result, error) {
+			domains, err := client.GetDomains()
+			return stringsResult("DOMAIN", domains), err
+		}),
+	})
+
+	register(&command{
+		name:        "names",
+		usage:       "PATTERN",
+		description: "list the mBean names matching the glob pattern DOMAIN:BEAN",
+		minArgs:     1,
+		maxArgs:     1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			names, err := client.QueryMBeanNames(args[0])
+			return stringsResult("MBEAN", names), err
+		}),
+	})
+
+	register(&command{
+		name:        "count",
+		usage:       "[PATTERN]",
+		description: "count the mBeans matching the glob pattern DOMAIN:BEAN, all of them by default",
+		maxArgs:     1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			pattern := ""
+			if len(args) > 0 {
+				pattern = args[0]
+			}
+			count, err := client.GetMBeanCount(pattern)
+			return &result{
+				value:  count,
+				header: []string{"COUNT"},
+				rows:   [][]string{{strconv.FormatInt(count, 10)}},
+			}, err
+		}),
+	})
+
+	register(&command{
+		name:        "attrs",
+		usage:       "MBEAN",
+		description: "list the attribute names of an mBean",
+		minArgs:     1,
+		maxArgs:     1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			names, err := client.GetMBeanAttributeNames(args[0])
+			return stringsResult("ATTRIBUTE", names), err
+		}),
+	})
+
+	register(&command{
+		name:        "get",
+		usage:       "MBEAN [ATTRIBUTE...]",
+		description: "get the attribute values of an mBean, all of them by default",
+		minArgs:     1,
+		maxArgs:     -1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			attrs, err := client.GetMBeanAttributes(args[0], args[1:]...)
+			return attributesResult(attrs), err
+		}),
+	})
+
+	register(&command{
+		name:        "query",
+		usage:       "PATTERN [ATTRIBUTE...]",
+		description: "get the attribute values of the mBeans matching the glob pattern DOMAIN:BEAN",
+		minArgs:     1,
+		maxArgs:     -1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			attrs, err := client.QueryMBeanAttributes(args[0], args[1:]...)
+			return attributesResult(attrs), err
+		}),
+	})
+
+	register(&command{
+		name:        "info",
+		usage:       "MBEAN",
+		description: "describe the attributes and operations of an mBean",
+		minArgs:     1,
+		maxArgs:     1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			info, err := client.GetMBeanInfo(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return mBeanInfoResult(info), nil
+		}),
+	})
+
+	register(&command{
+		name:        "invoke",
+		usage:       "[-signature TYPES] MBEAN OPERATION [ARG...]",
+		description: "invoke an mBean operation, the arguments are converted to the operation parameter types",
+		minArgs:     2,
+		maxArgs:     -1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			signature := fs.String("signature", "", "comma separated parameter types for overloaded operations, e.g. long,java.lang.String")
+			return func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+				var types []string
+				if *signature != "" {
+					types = strings.Split(*signature, ",")
+				}
+				attrs, err := client.InvokeOperationWithSignature(args[0], args[1], args[2:], types)
+				return attributesResult(attrs), err
+			}
+		},
+	})
+
+	register(&command{
+		name:        "browse",
+		usage:       "[-refresh D] [PATTERN]",
+		description: "browse the mBeans interactively on the terminal",
+		maxArgs:     1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			refresh := fs.Duration("refresh", browser.DefaultRefreshInterval, "refresh interval of the selected mBean attributes")
+			return func(ctx context.Context, client *gojmx.Client, args []string) (*result, error) {
+				options := browser.Options{RefreshInterval: *refresh}
+				if len(args) > 0 {
+					options.Pattern = args[0]
+				}
+				return nil, browser.New(client, options).Run(ctx, os.Stdin, os.Stdout)
+			}
+		},
+	})
+
+	register(&command{
+		name:        "watch",
+		usage:       "[-interval D] [-count N] [-warn COND]... [-fail COND]... [-plain] PATTERN [ATTRIBUTE...]",
+		description: "poll the mBean attributes and show their values, deltas and rates. Conditions have the format\n[rate|delta](ATTRIBUTE) OPERATOR THRESHOLD[%], e.g. 'HeapMemoryUsage.Used > 90%'. The command exits\nwith code 3 when a -fail condition holds",
+		minArgs:     1,
+		maxArgs:     -1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			config := watch.Config{}
+			fs.DurationVar(&config.Interval, "interval", watch.DefaultInterval, "polling interval")
+			fs.IntVar(&config.Count, "count", 0, "number of polls, 0 polls until interrupted")
+			fs.Func("warn", "condition highlighting the rows, can be repeated", conditionFlag(&config.Warn))
+			fs.Func("fail", "condition stopping the watch with exit code 3, can be repeated", conditionFlag(&config.Fail))
+			plain := fs.Bool("plain", false, "append the tables without colors instead of refreshing the screen")
+			return func(ctx context.Context, client *gojmx.Client, args []string) (*result, error) {
+				config.Pattern = args[0]
+				config.Attributes = args[1:]
+				if !*plain && term.IsTerminal(int(os.Stdout.Fd())) {
+					config.Refresh = true
+					config.Color = true
+				}
+
+				ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+				defer stop()
+				return nil, watch.New(client, config).Run(ctx, os.Stdout)
+			}
+		},
+	})
+
+	register(&command{
+		name:        "server",
+		description: "show the JMX server metadata",
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, _ []string) (*result, error) {
+			info, err := client.ServerInfo()
+			if err != nil {
+				return nil, err
+			}
+			return &result{
+				value:  info,
+				header: []string{"FIELD", "VALUE"},
+				rows: [][]string{
+					{"MBeanServerId", info.MBeanServerId},
+					{"SpecificationVersion", info.SpecificationVersion},
+					{"ImplementationName", info.ImplementationName},
+					{"ImplementationVersion", info.ImplementationVersion},
+					{"ImplementationVendor", info.ImplementationVendor},
+					{"DefaultDomain", info.DefaultDomain},
+					{"ConnectionId", info.ConnectionId},
+				},
+			}, nil
+		}),
+	})
+
+	register(&command{
+		name:        "threads",
+		usage:       "[-locked-monitors] [-locked-synchronizers] [-depth N]",
+		description: "dump the live threads in the jstack format",
+		setup: func(fs *flag.FlagSet) runFunc {
+			opts := gojmx.ThreadDumpOptions{}
+			fs.BoolVar(&opts.LockedMonitors, "locked-monitors", false, "include the locked monitors")
+			fs.BoolVar(&opts.LockedSynchronizers, "locked-synchronizers", false, "include the locked ownable synchronizers")
+			fs.Func("depth", "maximum number of stack frames for each thread, 0 for all of them", func(s string) error {
+				depth, err := strconv.ParseInt(s, 10, 32)
+				opts.MaxDepth = int32(depth)
+				return err
+			})
+			return func(_ context.Context, client *gojmx.Client, _ []string) (*result, error) {
+				threads, err := client.ThreadDump(opts)
+				if err != nil {
+					return nil, err
+				}
+				return &result{value: threads, text: gojmx.FormatThreadDump(threads)}, nil
+			}
+		},
+	})
+
+	register(&command{
+		name:        "deadlocks",
+		description: "show the deadlocked threads",
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, _ []string) (*result, error) {
+			threads, err := client.FindDeadlocks()
+			if err != nil {
+				return nil, err
+			}
+			text := gojmx.FormatDeadlocks(threads)
+			if len(threads) == 0 {
+				text = "No deadlocks found."
+			}
+			return &result{value: threads, text: text}, nil
+		}),
+	})
+
+	register(&command{
+		name:        "dcmd",
+		usage:       "COMMAND [ARG...]",
+		description: "execute a diagnostic command as jcmd does, e.g. GC.class_histogram",
+		minArgs:     1,
+		maxArgs:     -1,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			output, err := client.DiagnosticCommand(args[0], args[1:]...)
+			if err != nil {
+				return nil, err
+			}
+			return &result{value: output, text: output}, nil
+		}),
+	})
+
+	register(&command{
+		name:        "vmoptions",
+		usage:       "[-writeable] [NAME...]",
+		description: "show the VM options, or the named ones",
+		maxArgs:     -1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			writeable := fs.Bool("writeable", false, "show only the writeable options")
+			return func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+				var options []*gojmx.VMOption
+				if len(args) == 0 {
+					all, err := client.VMOptions()
+					if err != nil {
+						return nil, err
+					}
+					options = all
+				}
+				for _, name := range args {
+					option, err := client.VMOption(name)
+					if err != nil {
+						return nil, err
+					}
+					options = append(options, option)
+				}
+
+				if *writeable {
+					var filtered []*gojmx.VMOption
+					for _, option := range options {
+						if option.Writeable {
+							filtered = append(filtered, option)
+						}
+					}
+					options = filtered
+				}
+				return vmOptionsResult(options), nil
+			}
+		},
+	})
+
+	register(&command{
+		name:        "setvmoption",
+		usage:       "NAME VALUE",
+		description: "change a writeable VM option",
+		minArgs:     2,
+		maxArgs:     2,
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+			if err := client.SetVMOption(args[0], args[1]); err != nil {
+				return nil, err
+			}
+			option, err := client.VMOption(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return vmOptionsResult([]*gojmx.VMOption{option}), nil
+		}),
+	})
+
+	register(&command{
+		name:        "heapdump",
+		usage:       "[-live] [-dump-timeout D] PATH",
+		description: "write a heap dump into the monitored JVM host filesystem, PATH must have the .hprof extension",
+		minArgs:     1,
+		maxArgs:     1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			live := fs.Bool("live", false, "dump only the reachable objects")
+			timeout := fs.Duration("dump-timeout", 0, "maximum time to wait for the dump, 0 waits until it's written")
+			return func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+				if err := client.DumpHeap(args[0], *live, *timeout); err != nil {
+					return nil, err
+				}
+				return &result{
+					value: map[string]interface{}{"path": args[0], "live": *live},
+					text:  fmt.Sprintf("Heap dump written to %s on the JVM host.", args[0]),
+				}, nil
+			}
+		},
+	})
+
+	register(&command{
+		name:        "jfr",
+		usage:       "[-duration D] [-configuration NAME] [-setting KEY=VALUE...] FILE",
+		description: "record a Java Flight Recording and download it into the local FILE",
+		minArgs:     1,
+		maxArgs:     1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			duration := fs.Duration("duration", 30*time.Second, "recording duration")
+			settings := gojmx.RecordingSettings{Name: "gojmx", Configuration: "default", Settings: map[string]string{}}
+			fs.StringVar(&settings.Configuration, "configuration", settings.Configuration, "predefined configuration, e.g. default or profile")
+			fs.Func("setting", "event setting, e.g. jdk.CPULoad#period=1s, can be repeated", func(s string) error {
+				key, value, ok := strings.Cut(s, "=")
+				if !ok {
+					return fmt.Errorf("invalid setting: '%s', valid: KEY=VALUE", s)
+				}
+				settings.Settings[key] = value
+				return nil
+			})
+			return func(_ context.Context, client *gojmx.Client, args []string) (*result, error) {
+				return recordJFR(client, settings, *duration, args[0])
+			}
+		},
+	})
+
+	register(&command{
+		name:        "version",
+		description: "show the nrjmx version",
+		setup: noFlags(func(_ context.Context, client *gojmx.Client, _ []string) (*result, error) {
+			version := client.GetClientVersion()
+			return &result{value: version, text: version}, nil
+		}),
+	})
+
+	registerReport(&report{
+		name:        "stats",
+		usage:       "COMMAND [ARGS...]",
+		description: "run a command and show the nrjmx internal stats of its calls",
+		configure: func(config *gojmx.JMXConfig) {
+			config.EnableInternalStats = true
+		},
+		run: func(client *gojmx.Client) (*result, error) {
+			stats, err := client.GetInternalStats()
+			if err != nil {
+				return nil, err
+			}
+			res := &result{
+				value:  stats,
+				header: []string{"TYPE", "MBEAN", "ATTRIBUTES", "RESPONSES", "DURATION", "SUCCESSFUL"},
+			}
+			for _, stat := range stats {
+				res.rows = append(res.rows, []string{
+					stat.StatType,
+					stat.MBean,
+					strings.Join(stat.Attrs, ","),
+					strconv.FormatInt(stat.ResponseCount, 10),
+					fmt.Sprintf("%.3fms", stat.Milliseconds),
+					strconv.FormatBool(stat.Successful),
+				})
+			}
+			return res, nil
+		},
+	})
+
+	registerReport(&report{
+		name:        "profile",
+		usage:       "COMMAND [ARGS...]",
+		description: "run a command and show the latencies of its calls by type, the slowest mBeans and the slowest attributes",
+		configure: func(config *gojmx.JMXConfig) {
+			config.EnableInternalStats = true
+		},
+		run: func(client *gojmx.Client) (*result, error) {
+			stats, err := client.GetInternalStats()
+			if err != nil {
+				return nil, err
+			}
+			analysis := stats.Analyze(gojmx.StatsAnalysisOptions{})
+			res := &result{
+				value:  analysis,
+				header: []string{"GROUP", "NAME", "COUNT", "ERRORS", "P50", "P90", "P99", "MAX", "TOTAL"},
+			}
+			addRow := func(group, name string, s gojmx.LatencySummary) {
+				res.rows = append(res.rows, []string{
+					group,
+					name,
+					strconv.Itoa(s.Count),
+					strconv.Itoa(s.Errors),
+					fmt.Sprintf("%.3fms", s.P50Ms),
+					fmt.Sprintf("%.3fms", s.P90Ms),
+					fmt.Sprintf("%.3fms", s.P99Ms),
+					fmt.Sprintf("%.3fms", s.MaxMs),
+					fmt.Sprintf("%.3fms", s.TotalMs),
+				})
+			}
+			for _, t := range analysis.ByType {
+				addRow("type", t.StatType, t.LatencySummary)
+			}
+			for _, m := range analysis.SlowestMBeans {
+				addRow("mbean", m.MBean, m.LatencySummary)
+			}
+			for _, a := range analysis.SlowestAttributes {
+				addRow("attribute", a.MBean+" "+a.Attribute, a.LatencySummary)
+			}
+			return res, nil
+		},
+	})
+
+	registerReport(&report{
+		name:        "calls",
+		usage:       "COMMAND [ARGS...]",
+		description: "run a command and show the time of its calls to nrjmx split in gojmx, IPC and JVM",
+		configure: func(config *gojmx.JMXConfig) {
+			config.EnableInternalStats = true
+		},
+		run: func(client *gojmx.Client) (*result, error) {
+			internalStats, err := client.GetInternalStats()
+			if err != nil {
+				return nil, err
+			}
+			calls := client.GetCallStats()
+			gojmx.JoinCallStats(calls, internalStats)
+			res := &result{
+				value:  calls,
+				header: []string{"METHOD", "TOTAL", "GO", "IPC", "JVM", "SENT", "RECEIVED", "SUCCESSFUL"},
+			}
+			for _, call := range calls {
+				res.rows = append(res.rows, []string{
+					call.Method,
+					formatMs(call.Total),
+					formatMs(call.Go()),
+					formatMs(call.IPC()),
+					formatMs(call.JVM()),
+					strconv.FormatInt(call.BytesSent, 10),
+					strconv.FormatInt(call.BytesReceived, 10),
+					strconv.FormatBool(call.Successful),
+				})
+			}
+			return res, nil
+		},
+	})
+
+	registerReport(&report{
+		name:        "metrics",
+		usage:       "COMMAND [ARGS...]",
+		description: "run a command and show the cumulative nrjmx metrics of its calls per type",
+		configure:   func(*gojmx.JMXConfig) {},
+		run: func(client *gojmx.Client) (*result, error) {
+			metrics, err := client.GetInternalMetrics()
+			if err != nil {
+				return nil, err
+			}
+			res := &result{
+				value:  metrics,
+				header: []string{"TYPE", "COUNT", "ERRORS", "AVG", "P99", "MAX", "TOTAL"},
+			}
+			for _, metric := range metrics.GetMetrics() {
+				res.rows = append(res.rows, []string{
+					metric.StatType,
+					strconv.FormatInt(metric.Count, 10),
+					strconv.FormatInt(metric.Errors, 10),
+					fmt.Sprintf("%.3fms", metric.AverageMs()),
+					fmt.Sprintf("%.3fms", metric.PercentileMs(99)),
+					fmt.Sprintf("%.3fms", metric.MaxMs),
+					fmt.Sprintf("%.3fms", metric.TotalMs),
+				})
+			}
+			return res, nil
+		},
+	})
+
+	registerReport(&report{
+		name:        "truncations",
+		usage:       "COMMAND [ARGS...]",
+		description: "run a command and show the queries truncated by the -max-* limits",
+		configure:   func(*gojmx.JMXConfig) {},
+		run: func(client *gojmx.Client) (*result, error) {
+			truncations, err := client.GetTruncations()
+			if err != nil {
+				return nil, err
+			}
+			res := &result{
+				value:  truncations,
+				header: []string{"LIMIT", "VALUE", "MBEAN", "DROPPED"},
+			}
+			for _, truncation := range truncations {
+				res.rows = append(res.rows, []string{
+					truncation.Limit.String(),
+					strconv.FormatInt(truncation.LimitValue, 10),
+					truncation.MBeanName,
+					strconv.FormatInt(truncation.DroppedCount, 10),
+				})
+			}
+			return res, nil
+		},
+	})
+}
+
+func stringsResult(header string, values []string) *result {
+	res := &result{value: values, header: []string{header}}
+	if values == nil {
+		res.value = []string{}
+	}
+	for _, value := range values {
+		res.rows = append(res.rows, []string{value})
+	}
+	return res
+}
+
+func attributesResult(attrs []*gojmx.AttributeResponse) *result {
+	records := encoder.Records(attrs)
+	res := &result{value: records, header: []string{"MBEAN", "ATTRIBUTE", "TYPE", "VALUE"}}
+	for _, record := range records {
+		value := record.Error
+		if !record.IsError() {
+			value = fmt.Sprint(record.Value)
+		}
+		res.rows = append(res.rows, []string{record.MBean, record.Attribute, record.Type, value})
+	}
+	return res
+}
+
+func mBeanInfoResult(info *gojmx.MBeanInfo) *result {
+	res := &result{value: info, header: []string{"KIND", "NAME", "TYPE", "ACCESS", "DESCRIPTION"}}
+	for _, attr := range info.GetAttributes() {
+		access := ""
+		if attr.Readable {
+			access += "r"
+		}
+		if attr.Writable {
+			access += "w"
+		}
+		res.rows = append(res.rows, []string{"attribute", attr.Name, attr.Type, access, attr.Description})
+	}
+	for _, op := range info.GetOperations() {
+		var params []string
+		for _, param := range op.GetSignature() {
+			params = append(params, param.Type)
+		}
+		name := fmt.Sprintf("%s(%s)", op.Name, strings.Join(params, ", "))
+		res.rows = append(res.rows, []string{"operation", name, op.ReturnType, impactName(op.Impact), op.Description})
+	}
+	return res
+}
+
+func impactName(impact int32) string {
+	switch impact {
+	case gojmx.OperationImpactInfo:
+		return "info"
+	case gojmx.OperationImpactAction:
+		return "action"
+	case gojmx.OperationImpactActionInfo:
+		return "action_info"
+	default:
+		return "unknown"
+	}
+}
+
+func vmOptionsResult(options []*gojmx.VMOption) *result {
+	res := &result{value: options, header: []string{"NAME", "VALUE", "ORIGIN", "WRITEABLE"}}
+	for _, option := range options {
+		res.rows = append(res.rows, []string{option.Name, option.Value, option.Origin, strconv.FormatBool(option.Writeable)})
+	}
+	return res
+}
+
+// recordJFR records for the duration and downloads the recording into path.
+func recordJFR(client *gojmx.Client, settings gojmx.RecordingSettings, duration time.Duration, path string) (*result, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create recording file: %w", err)
+	}
+	defer file.Close()
+
+	id, err := client.StartRecording(settings)
+	if err != nil {
+		return nil, err
+	}
+	defer client.CloseRecording(id)
+
+	time.Sleep(duration)
+
+	if err := client.StopRecording(id); err != nil {
+		return nil, err
+	}
+
+	stream, err := client.StreamRecording(id)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	size, err := io.Copy(file, stream)
+	if err != nil {
+		return nil, fmt.Errorf("cannot download recording: %w", err)
+	}
+
+	return &result{
+		value: map[string]interface{}{"id": id, "path": path, "bytes": size},
+		text:  fmt.Sprintf("Recording %d written to %s (%d bytes).", id, path, size),
+	}, nil
+}
+
+// conditionFlag appends the parsed watch conditions of a repeated flag.
+func conditionFlag(conditions *[]*watch.Condition) func(string) error {
+	return func(text string) error {
+		condition, err := watch.ParseCondition(text)
+		if err != nil {
+			return err
+		}
+		*conditions = append(*conditions, condition)
+		return nil
+	}
+}
+
+func formatMs(d time.Duration) string {
+	return fmt.Sprintf("%.3fms", float64(d)/float64(time.Millisecond))
+}
@@ -0,0 +1,276 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Command gojmx queries a JMX endpoint from the command line using the nrjmx subprocess, e.g.
+//
+//	gojmx -hostname localhost -port 9999 query 'java.lang:type=Memory'
+//	gojmx -url service:jmx:rmi:///jndi/rmi://localhost:9999/jmxrmi -output json get 'java.lang:type=Threading' ThreadCount
+//	gojmx -port 9999 stats query 'java.lang:*'
+//
+// The nrjmx tool is located as gojmx.NewClient does, NR_JMX_TOOL can be used to point to a different one.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/watch"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+	// exitCondition is returned by watch when a fail condition holds.
+	exitCondition = 3
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// globalFlags are the connection and output flags shared by all the commands.
+type globalFlags struct {
+	config  gojmx.JMXConfig
+	uriPath string
+	timeout time.Duration
+	output  string
+	record  string
+	replay  string
+}
+
+func newGlobalFlags(fs *flag.FlagSet) *globalFlags {
+	g := &globalFlags{}
+	fs.StringVar(&g.config.ConnectionURL, "url", "", "JMX connection URL, e.g. service:jmx:rmi:///jndi/rmi://localhost:9999/jmxrmi. Replaces hostname and port")
+	fs.StringVar(&g.config.Hostname, "hostname", "localhost", "JMX endpoint hostname")
+	portFlag(fs, &g.config.Port, "port", 9999, "JMX endpoint port")
+	fs.StringVar(&g.uriPath, "uri-path", "", "JMX endpoint URI path, default: jmxrmi")
+	fs.StringVar(&g.config.Username, "username", "", "JMX username")
+	fs.StringVar(&g.config.Password, "password", "", "JMX password")
+	fs.StringVar(&g.config.KeyStore, "keystore", "", "keystore file path for SSL connections")
+	fs.StringVar(&g.config.KeyStorePassword, "keystore-password", "", "keystore password")
+	fs.StringVar(&g.config.TrustStore, "truststore", "", "truststore file path for SSL connections")
+	fs.StringVar(&g.config.TrustStorePassword, "truststore-password", "", "truststore password")
+	fs.BoolVar(&g.config.UseSSL, "ssl", false, "use SSL")
+	fs.BoolVar(&g.config.IsRemote, "remote", false, "use the remoting connector, e.g. for JBoss")
+	fs.BoolVar(&g.config.IsJBossStandaloneMode, "jboss-standalone", false, "use the JBoss standalone mode remoting connector")
+	fs.DurationVar(&g.timeout, "timeout", 10*time.Second, "timeout for each request")
+	fs.Int64Var(&g.config.MaxMBeansPerPattern, "max-mbeans", 0, "maximum number of mBeans per query pattern, 0 to disable")
+	fs.Int64Var(&g.config.MaxAttributesPerMBean, "max-attributes", 0, "maximum number of attributes per mBean, 0 to disable")
+	fs.Int64Var(&g.config.MaxResponsesPerCall, "max-responses", 0, "maximum number of responses per call, 0 to disable")
+	fs.BoolVar(&g.config.Verbose, "verbose", false, "enable nrjmx verbose logging")
+	fs.StringVar(&g.output, "output", formatTable, "output format: "+strings.Join(formats, ", "))
+	fs.StringVar(&g.record, "record", "", "record the nrjmx calls into the file, see gojmx.Client.RecordTo")
+	fs.StringVar(&g.replay, "replay", "", "serve the nrjmx calls from a recording file instead of connecting")
+	return g
+}
+
+func portFlag(fs *flag.FlagSet, p *int32, name string, value int32, usage string) {
+	*p = value
+	fs.Func(name, fmt.Sprintf("%s (default %d)", usage, value), func(s string) error {
+		var port int32
+		if _, err := fmt.Sscanf(s, "%d", &port); err != nil || port <= 0 || port > 65535 {
+			return fmt.Errorf("invalid port: '%s'", s)
+		}
+		*p = port
+		return nil
+	})
+}
+
+func (g *globalFlags) jmxConfig() *gojmx.JMXConfig {
+	config := g.config
+	if g.uriPath != "" {
+		config.UriPath = &g.uriPath
+	}
+	config.RequestTimeoutMs = g.timeout.Milliseconds()
+	return &config
+}
+
+// open starts the nrjmx subprocess, or the replay, and connects to the JMX endpoint.
+func (g *globalFlags) open(ctx context.Context, config *gojmx.JMXConfig) (*gojmx.Client, func(), error) {
+	client := gojmx.NewClient(ctx)
+	var closers []io.Closer
+
+	if g.replay != "" {
+		file, err := os.Open(g.replay)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot open replay file: %w", err)
+		}
+		client, err = gojmx.NewReplayClient(ctx, file)
+		_ = file.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if g.record != "" {
+		file, err := os.Create(g.record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot create record file: %w", err)
+		}
+		client.RecordTo(file)
+		closers = append(closers, file)
+	}
+
+	closeAll := func() {
+		if client.IsRunning() {
+			_ = client.Close()
+		}
+		for _, c := range closers {
+			_ = c.Close()
+		}
+	}
+
+	client, err := client.Open(config)
+	if err != nil {
+		closeAll()
+		return nil, nil, err
+	}
+	return client, closeAll, nil
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("gojmx", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	global := newGlobalFlags(fs)
+	fs.Usage = func() { usage(fs, stderr) }
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if !isValidFormat(global.output) {
+		fmt.Fprintf(stderr, "invalid output format: '%s', valid: %s\n", global.output, strings.Join(formats, ", "))
+		return exitUsage
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	config := global.jmxConfig()
+
+	// stats, profile, metrics, calls and truncations run another command to report about its calls.
+	cmdArgs := fs.Args()
+	rep, isReport := reports[cmdArgs[0]]
+	if isReport {
+		if len(cmdArgs) < 2 {
+			fmt.Fprintf(stderr, "usage: gojmx %s %s\n", rep.name, rep.usage)
+			return exitUsage
+		}
+		rep.configure(config)
+		cmdArgs = cmdArgs[1:]
+	}
+
+	cmd, ok := commands[cmdArgs[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command: '%s'\n", cmdArgs[0])
+		fs.Usage()
+		return exitUsage
+	}
+
+	execute, err := cmd.parse(cmdArgs[1:], stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	client, closeClient, err := global.open(ctx, config)
+	if err != nil {
+		printError(stderr, err)
+		return exitError
+	}
+	defer closeClient()
+
+	res, err := execute(ctx, client)
+	if isReport {
+		// The report is written even when the command failed, as it's usually the reason to look at it.
+		if err != nil {
+			printError(stderr, err)
+		}
+		var reportErr error
+		res, reportErr = rep.run(client)
+		if reportErr != nil {
+			printError(stderr, reportErr)
+			return exitError
+		}
+	} else if err != nil {
+		printError(stderr, err)
+		var conditionErr *watch.ConditionError
+		if errors.As(err, &conditionErr) {
+			return exitCondition
+		}
+		return exitError
+	}
+
+	if err := writeResult(stdout, global.output, res); err != nil {
+		printError(stderr, err)
+		return exitError
+	}
+
+	if err != nil {
+		return exitError
+	}
+	return exitOK
+}
+
+func printError(w io.Writer, err error) {
+	if jmxErr, ok := gojmx.IsJMXError(err); ok {
+		fmt.Fprintf(w, "error: %s\n", jmxErr.Message)
+		if jmxErr.CauseMessage != "" {
+			fmt.Fprintf(w, "cause: %s\n", jmxErr.CauseMessage)
+		}
+		return
+	}
+	if connErr, ok := gojmx.IsJMXConnectionError(err); ok {
+		fmt.Fprintf(w, "connection error: %s\n", connErr.Message)
+		return
+	}
+	fmt.Fprintf(w, "error: %s\n", err)
+}
+
+func usage(fs *flag.FlagSet, w io.Writer) {
+	fmt.Fprintln(w, "usage: gojmx [FLAGS] COMMAND [ARGS...]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	for name := range reports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		description, args := "", ""
+		if cmd, ok := commands[name]; ok {
+			description, args = cmd.description, cmd.usage
+		} else {
+			description, args = reports[name].description, reports[name].usage
+		}
+		fmt.Fprintf(w, "  %-12s %s\n", name, description)
+		fmt.Fprintf(w, "  %-12s   %s\n", "", strings.TrimSpace("gojmx "+name+" "+args))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "flags:")
+	fs.PrintDefaults()
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecording = `{"time":"2026-10-01T10:00:00Z","method":"GetClientVersion","result":"2.13.0","duration_ns":0}
+{"time":"2026-10-01T10:00:00Z","method":"Connect","args":[{"hostname":"localhost","port":9999}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"GetDomains","args":[],"result":["JMImplementation","java.lang"],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"GetMBeanAttributes","args":["java.lang:type=Threading",["ThreadCount","Missing"]],"result":[{"name":"java.lang:type=Threading,attr=ThreadCount","responseType":"INT","intValue":20},{"name":"java.lang:type=Threading,attr=Missing","responseType":"ERROR","statusMsg":"attribute not found"}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"QueryMBeanAttributes","args":["java.lang:type=Memory",["Verbose"]],"result":[{"name":"java.lang:type=Memory,attr=Verbose","responseType":"BOOL","boolValue":false}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"QueryMBeanAttributes","args":["java.lang:type=Threading",["ThreadCount"]],"result":[{"name":"java.lang:type=Threading,attr=ThreadCount","responseType":"INT","intValue":20}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"InvokeOperation","args":["java.lang:type=Threading","getThreadCpuTime",["1"],["long"]],"result":[{"name":"java.lang:type=Threading,attr=getThreadCpuTime","responseType":"INT","intValue":12345}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"GetInternalMetrics","args":[],"result":{"startTimestamp":1790848800000,"metrics":[{"statType":"getAttributes","count":4,"errors":1,"totalMs":10,"maxMs":7,"bucketBoundsMs":[1,5],"bucketCounts":[2,1,1]}],"samplesEnabled":false,"maxSamples":0,"bufferedSamples":0,"evictedSamples":0},"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"GetInternalStats","args":[],"result":[{"statType":"getAttributes","mBean":"java.lang:type=Memory","attrs":["Verbose"],"responseCount":1,"milliseconds":1.5,"successful":true}],"duration_ns":1000}
+`
+
+func Test_Run(t *testing.T) {
+	replay := filepath.Join(t.TempDir(), "recording.jsonl")
+	require.NoError(t, os.WriteFile(replay, []byte(testRecording), 0600))
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedCode   int
+		expectedOutput string
+		expectedStderr string
+	}{
+		{
+			name:         "domains table",
+			args:         []string{"domains"},
+			expectedCode: exitOK,
+			expectedOutput: "DOMAIN\n" +
+				"JMImplementation\n" +
+				"java.lang\n",
+		},
+		{
+			name:         "get table with errors",
+			args:         []string{"get", "java.lang:type=Threading", "ThreadCount", "Missing"},
+			expectedCode: exitOK,
+			expectedOutput: "MBEAN                     ATTRIBUTE    TYPE   VALUE\n" +
+				"java.lang:type=Threading  ThreadCount  int    20\n" +
+				"java.lang:type=Threading  Missing      error  attribute not found\n",
+		},
+		{
+			name:         "query json",
+			args:         []string{"-output", "json", "query", "java.lang:type=Memory", "Verbose"},
+			expectedCode: exitOK,
+			expectedOutput: `[
+  {
+    "mbean": "java.lang:type=Memory",
+    "domain": "java.lang",
+    "attribute": "Verbose",
+    "type": "bool",
+    "value": false
+  }
+]
+`,
+		},
+		{
+			name:         "invoke yaml",
+			args:         []string{"-output", "yaml", "invoke", "-signature", "long", "java.lang:type=Threading", "getThreadCpuTime", "1"},
+			expectedCode: exitOK,
+			expectedOutput: "- mbean: java.lang:type=Threading\n" +
+				"  domain: java.lang\n" +
+				"  attribute: getThreadCpuTime\n" +
+				"  type: int\n" +
+				"  value: 12345\n",
+		},
+		{
+			name:         "stats",
+			args:         []string{"stats", "query", "java.lang:type=Memory", "Verbose"},
+			expectedCode: exitOK,
+			expectedOutput: "TYPE           MBEAN                  ATTRIBUTES  RESPONSES  DURATION  SUCCESSFUL\n" +
+				"getAttributes  java.lang:type=Memory  Verbose     1          1.500ms   true\n",
+		},
+		{
+			// The replay doesn't measure the calls.
+			name:           "calls",
+			args:           []string{"calls", "query", "java.lang:type=Memory", "Verbose"},
+			expectedCode:   exitOK,
+			expectedOutput: "METHOD  TOTAL  GO  IPC  JVM  SENT  RECEIVED  SUCCESSFUL\n",
+		},
+		{
+			name:         "metrics",
+			args:         []string{"metrics", "query", "java.lang:type=Memory", "Verbose"},
+			expectedCode: exitOK,
+			expectedOutput: "TYPE           COUNT  ERRORS  AVG      P99      MAX      TOTAL\n" +
+				"getAttributes  4      1       2.500ms  7.000ms  7.000ms  10.000ms\n",
+		},
+		{
+			name:         "profile",
+			args:         []string{"profile", "query", "java.lang:type=Memory", "Verbose"},
+			expectedCode: exitOK,
+			expectedOutput: "GROUP      NAME                           COUNT  ERRORS  P50      P90      P99      MAX      TOTAL\n" +
+				"type       getAttributes                  1      0       1.500ms  1.500ms  1.500ms  1.500ms  1.500ms\n" +
+				"mbean      java.lang:type=Memory          1      0       1.500ms  1.500ms  1.500ms  1.500ms  1.500ms\n" +
+				"attribute  java.lang:type=Memory Verbose  1      0       1.500ms  1.500ms  1.500ms  1.500ms  1.500ms\n",
+		},
+		{
+			name:           "watch fail condition",
+			args:           []string{"watch", "-plain", "-count", "1", "-fail", "ThreadCount >= 20", "java.lang:type=Threading", "ThreadCount"},
+			expectedCode:   exitCondition,
+			expectedStderr: "error: condition held: java.lang:type=Threading ThreadCount >= 20 (20)\n",
+		},
+		{
+			name:           "watch invalid condition",
+			args:           []string{"watch", "-fail", "ThreadCount", "java.lang:type=Threading"},
+			expectedCode:   exitUsage,
+			expectedStderr: "invalid condition: 'ThreadCount'",
+		},
+		{
+			name:           "not recorded call",
+			args:           []string{"names", "java.lang:*"},
+			expectedCode:   exitError,
+			expectedStderr: "error: no recorded call for QueryMBeanNames[\"java.lang:*\"]\n",
+		},
+		{
+			name:           "unknown command",
+			args:           []string{"unknown"},
+			expectedCode:   exitUsage,
+			expectedStderr: "unknown command: 'unknown'\n",
+		},
+		{
+			name:         "missing arguments",
+			args:         []string{"get"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:           "invalid output",
+			args:           []string{"-output", "xml", "domains"},
+			expectedCode:   exitUsage,
+			expectedStderr: "invalid output format: 'xml', valid: table, json, yaml\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			args := append([]string{"-replay", replay}, tc.args...)
+
+			code := run(context.Background(), args, stdout, stderr)
+
+			assert.Equal(t, tc.expectedCode, code, stderr.String())
+			assert.Equal(t, tc.expectedOutput, stdout.String())
+			if tc.expectedStderr != "" {
+				assert.Contains(t, stderr.String(), tc.expectedStderr)
+			}
+		})
+	}
+}
+
+func Test_Run_Record(t *testing.T) {
+	dir := t.TempDir()
+	replay := filepath.Join(dir, "recording.jsonl")
+	record := filepath.Join(dir, "record.jsonl")
+	require.NoError(t, os.WriteFile(replay, []byte(testRecording), 0600))
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := run(context.Background(), []string{"-replay", replay, "-record", record, "-password", "secret", "domains"}, stdout, stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+
+	data, err := os.ReadFile(record)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"method":"GetDomains"`)
+	assert.NotContains(t, string(data), "secret")
+}
+
+func Test_WriteResult_NonFinite(t *testing.T) {
+	res := attributesResult([]*gojmx.AttributeResponse{
+		{Name: "test:type=Cat,attr=Ratio", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.NaN()},
+	})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeResult(buf, formatJSON, res))
+	assert.Contains(t, buf.String(), `"value": "NaN"`)
+
+	buf.Reset()
+	require.NoError(t, writeResult(buf, formatYAML, res))
+	assert.Contains(t, buf.String(), `value: NaN`)
+}
+
+func Test_Run_WatchCancelled(t *testing.T) {
+	replay := filepath.Join(t.TempDir(), "recording.jsonl")
+	require.NoError(t, os.WriteFile(replay, []byte(testRecording), 0600))
+
+	// The watch stops after the first poll when the context of the run is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stderr := &bytes.Buffer{}
+	code := run(ctx, []string{"-replay", replay, "watch", "-plain", "-count", "2", "-interval", "1h", "java.lang:type=Threading", "ThreadCount"}, io.Discard, stderr)
+	assert.Equal(t, exitOK, code, stderr.String())
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+var formats = []string{formatTable, formatJSON, formatYAML}
+
+func isValidFormat(format string) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// result is the output of a command.
+type result struct {
+	// value is written by the json and yaml formats.
+	value interface{}
+	// header and rows are written by the table format.
+	header []string
+	rows   [][]string
+	// text replaces the table when it's set, e.g. for thread dumps.
+	text string
+}
+
+func writeResult(w io.Writer, format string, r *result) error {
+	if r == nil {
+		return nil
+	}
+
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(r.value, "", "  ")
+		if err != nil {
+			return fmt.Errorf("cannot encode json output: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case formatYAML:
+		data, err := toYAML(r.value)
+		if err != nil {
+			return fmt.Errorf("cannot encode yaml output: %w", err)
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		return writeTable(w, r)
+	}
+}
+
+func writeTable(w io.Writer, r *result) error {
+	if r.text != "" {
+		text := r.text
+		if !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+		_, err := io.WriteString(w, text)
+		return err
+	}
+
+	if len(r.header) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(r.header, "\t"))
+	for _, row := range r.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			// Tabs and new lines would break the table layout.
+			cells[i] = strings.Join(strings.Fields(cell), " ")
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// toYAML encodes the value using its json field names, the nrprotocol types only define json tags.
+func toYAML(value interface{}) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(data, node); err != nil {
+		return nil, err
+	}
+	blockStyle(node)
+
+	return yaml.Marshal(node)
+}
+
+// blockStyle removes the json flow style so the output is written as plain YAML.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
@@ -0,0 +1,412 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package collector runs nri-jmx compatible collection definitions against a gojmx.Client.
+package collector
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/rate"
+)
+
+// Querier is the gojmx.Client functionality required to run the collection definitions.
+type Querier interface {
+	QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// runtimeMBean and runtimeAttrs are queried to detect the JVM restarts, see rate.StartTimeAttr.
+const runtimeMBean = "java.lang:type=Runtime"
+
+var runtimeAttrs = []string{"StartTime", "Uptime"}
+
+// forgetAfter is the number of collections a counter can be missing before it's forgotten, so the counters of
+// a query or attribute that failed transiently keep their previous value.
+const forgetAfter = 3
+
+// Sample groups the metrics collected for an mBean.
+type Sample struct {
+	EventType string
+	Domain    string
+	// Query is the bean definition query that matched the mBean.
+	Query   string
+	MBean   string
+	Metrics []*Metric
+}
+
+// Metric is a collected attribute value.
+type Metric struct {
+	// Name is the metric_name or the attribute name, composite fields are separated by '.', e.g. HeapMemoryUsage.Used
+	Name      string
+	Attribute string
+	Type      MetricType
+	Unit      string
+	// Value is a float64, int64, bool or string.
+	Value interface{}
+}
+
+// Float64 returns the metric value as float64. Bool values are converted to 1 or 0.
+func (m *Metric) Float64() (float64, bool) {
+	switch v := m.Value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// Result contains the samples and the errors of a collection.
+type Result struct {
+	Samples []*Sample
+	// Errors contains the *QueryError for the queries that failed and the *AttributeFailure for the attributes
+	// that couldn't be retrieved. They don't prevent the collection of the remaining ones.
+	Errors []error
+}
+
+// QueryError is reported when an mBean query fails.
+type QueryError struct {
+	Pattern string
+	Err     error
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("query '%s' failed: %v", e.Pattern, e.Err)
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// AttributeFailure is reported when a matching attribute couldn't be retrieved.
+type AttributeFailure struct {
+	Name      string
+	StatusMsg string
+	Err       *gojmx.AttributeError
+}
+
+func (e *AttributeFailure) Error() string {
+	return fmt.Sprintf("attribute '%s' failed: %s", e.Name, e.StatusMsg)
+}
+
+// Collector runs a compiled collection definition.
+// Bean definitions with the same query share the same request to the JMX server.
+// The rate and delta metrics are computed against the previous collection, so they are
+// reported from the second collection. Use a Collector for each monitored JVM.
+type Collector struct {
+	queries []*query
+	rates   *rate.Engine
+	// hasRates is true when the definition has rate or delta metrics, the JVM Runtime is then
+	// queried on each collection to detect the restarts.
+	hasRates bool
+	// collections are the times of the last forgetAfter collections, the oldest first.
+	collections []time.Time
+}
+
+type query struct {
+	pattern string
+	// attrs are the requested attributes, nil retrieves all of them.
+	attrs []string
+	beans []*bean
+}
+
+type bean struct {
+	domain    string
+	eventType string
+	query     string
+	excludes  []*regexp.Regexp
+	attrs     []*attribute
+}
+
+type attribute struct {
+	definition *AttributeDefinition
+	regex      *regexp.Regexp
+}
+
+// New compiles the collection definition.
+func New(definition *Definition) (*Collector, error) {
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := &Collector{rates: rate.New()}
+	byPattern := map[string]*query{}
+	allAttrs := map[string]bool{}
+	requested := map[string]map[string]bool{}
+
+	for _, domain := range definition.Collect {
+		eventType := domain.EventType
+		if eventType == "" {
+			eventType = DefaultEventType
+		}
+
+		for _, beanDef := range domain.Beans {
+			pattern := domain.Pattern(beanDef)
+
+			q, exists := byPattern[pattern]
+			if !exists {
+				q = &query{pattern: pattern}
+				byPattern[pattern] = q
+				requested[pattern] = map[string]bool{}
+				c.queries = append(c.queries, q)
+			}
+
+			b := &bean{
+				domain:    domain.Domain,
+				eventType: eventType,
+				query:     beanDef.Query,
+			}
+			for _, exclude := range beanDef.ExcludeRegex {
+				b.excludes = append(b.excludes, regexp.MustCompile(exclude))
+			}
+
+			if len(beanDef.Attributes) == 0 {
+				allAttrs[pattern] = true
+			}
+			for _, attrDef := range beanDef.Attributes {
+				attr := &attribute{definition: attrDef}
+				if attrDef.MetricType == MetricTypeRate || attrDef.MetricType == MetricTypeDelta {
+					c.hasRates = true
+				}
+				if attrDef.AttrRegex != "" {
+					attr.regex = regexp.MustCompile(attrDef.AttrRegex)
+					allAttrs[pattern] = true
+				} else {
+					// Composite attributes are requested by their name and filtered later.
+					requested[pattern][strings.SplitN(attrDef.Attr, ".", 2)[0]] = true
+				}
+				b.attrs = append(b.attrs, attr)
+			}
+
+			q.beans = append(q.beans, b)
+		}
+	}
+
+	for _, q := range c.queries {
+		if allAttrs[q.pattern] {
+			continue
+		}
+		for attr := range requested[q.pattern] {
+			q.attrs = append(q.attrs, attr)
+		}
+		sort.Strings(q.attrs)
+	}
+
+	return c, nil
+}
+
+// Collect runs the collection definition. Failed queries and attributes are reported in Result.Errors,
+// the collection is only interrupted when the error is not a *gojmx.JMXError, e.g. a connection error.
+// The counters that are not returned in forgetAfter consecutive collections are forgotten, e.g. from
+// unregistered mBeans.
+func (c *Collector) Collect(q Querier) (*Result, error) {
+	result := &Result{}
+	now := time.Now()
+
+	if c.hasRates {
+		if err := c.observeRuntime(q, now); err != nil {
+			return result, err
+		}
+	}
+
+	for _, query := range c.queries {
+		response, err := q.QueryMBeanAttributes(query.pattern, query.attrs...)
+		if err != nil {
+			if _, ok := gojmx.IsJMXError(err); !ok {
+				return result, err
+			}
+			result.Errors = append(result.Errors, &QueryError{Pattern: query.pattern, Err: err})
+			continue
+		}
+
+		byMBean, mBeanNames := groupByMBean(response)
+		for _, b := range query.beans {
+			for _, mBeanName := range mBeanNames {
+				if b.isExcluded(mBeanName) {
+					continue
+				}
+
+				sample := c.newSample(b, mBeanName, byMBean[mBeanName], now, result)
+				if len(sample.Metrics) > 0 {
+					result.Samples = append(result.Samples, sample)
+				}
+			}
+		}
+	}
+
+	c.collections = append(c.collections, now)
+	if len(c.collections) > forgetAfter {
+		c.collections = c.collections[1:]
+	}
+	c.rates.Forget(c.collections[0])
+	return result, nil
+}
+
+// observeRuntime feeds the JVM Runtime StartTime and Uptime to the rates engine, so the counters of a
+// restarted JVM are reset even when their values didn't decrease. When the Runtime mBean can't be queried
+// the restarts are only detected by the decreasing counters.
+func (c *Collector) observeRuntime(q Querier, now time.Time) error {
+	response, err := q.QueryMBeanAttributes(runtimeMBean, runtimeAttrs...)
+	if err != nil {
+		if _, ok := gojmx.IsJMXError(err); !ok {
+			return err
+		}
+		return nil
+	}
+	c.rates.Observe(now, response)
+	return nil
+}
+
+// groupByMBean groups the attributes by mBean name, returning the names sorted.
+func groupByMBean(response []*gojmx.AttributeResponse) (map[string][]*gojmx.AttributeResponse, []string) {
+	byMBean := map[string][]*gojmx.AttributeResponse{}
+	var names []string
+
+	for _, attr := range response {
+		if attr == nil {
+			continue
+		}
+		mBeanName, _, ok := gojmx.SplitAttributeName(attr.Name)
+		if !ok {
+			continue
+		}
+		if _, exists := byMBean[mBeanName]; !exists {
+			names = append(names, mBeanName)
+		}
+		byMBean[mBeanName] = append(byMBean[mBeanName], attr)
+	}
+
+	sort.Strings(names)
+	return byMBean, names
+}
+
+func (b *bean) isExcluded(mBeanName string) bool {
+	for _, exclude := range b.excludes {
+		if exclude.MatchString(mBeanName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Collector) newSample(b *bean, mBeanName string, attrs []*gojmx.AttributeResponse, now time.Time, result *Result) *Sample {
+	sample := &Sample{
+		EventType: b.eventType,
+		Domain:    b.domain,
+		Query:     b.query,
+		MBean:     mBeanName,
+	}
+
+	for _, attr := range attrs {
+		_, attrName, _ := gojmx.SplitAttributeName(attr.Name)
+
+		definition, ok := b.match(attrName)
+		if !ok {
+			continue
+		}
+
+		if attr.ResponseType == gojmx.ResponseTypeErr {
+			result.Errors = append(result.Errors, &AttributeFailure{
+				Name:      attr.Name,
+				StatusMsg: attr.StatusMsg,
+				Err:       attr.GetAttributeError(),
+			})
+			continue
+		}
+
+		metric := &Metric{
+			Name:      attrName,
+			Attribute: attrName,
+			Value:     attr.GetValue(),
+		}
+
+		if definition != nil {
+			metric.Type = definition.MetricType
+			metric.Unit = definition.Unit
+			if definition.MetricName != "" && definition.Attr != "" {
+				metric.Name = definition.MetricName + strings.TrimPrefix(attrName, definition.Attr)
+			}
+		}
+
+		if metric.Type == "" {
+			metric.Type = MetricTypeGauge
+			if attr.ResponseType == gojmx.ResponseTypeString {
+				metric.Type = MetricTypeAttribute
+			}
+		}
+
+		if metric.Type == MetricTypeRate || metric.Type == MetricTypeDelta {
+			if !c.computeRate(b, attr.Name, metric, now) {
+				continue
+			}
+		}
+
+		sample.Metrics = append(sample.Metrics, metric)
+	}
+
+	return sample
+}
+
+// computeRate replaces the metric value with its rate or delta.
+// It returns false when there is no previous value or the value is not numeric.
+func (c *Collector) computeRate(b *bean, attrName string, metric *Metric, now time.Time) bool {
+	value, ok := metric.Float64()
+	if !ok {
+		return false
+	}
+
+	// The same attribute can be collected by multiple bean definitions.
+	key := b.query + "|" + attrName
+
+	sample, ok := c.rates.ObserveValue(key, now, value)
+	if !ok {
+		return false
+	}
+
+	if metric.Type == MetricTypeRate {
+		metric.Value = sample.Rate
+	} else {
+		metric.Value = sample.Delta
+	}
+	return true
+}
+
+// match returns the first attribute definition matching the attribute name.
+// When the bean has no attribute definitions all the attributes match with a nil definition.
+func (b *bean) match(attrName string) (*AttributeDefinition, bool) {
+	if len(b.attrs) == 0 {
+		return nil, true
+	}
+
+	for _, attr := range b.attrs {
+		if attr.regex != nil {
+			if attr.regex.MatchString(attrName) {
+				return attr.definition, true
+			}
+			continue
+		}
+
+		// Composite fields match their attribute, and failed composite attributes match their fields.
+		if attrName == attr.definition.Attr ||
+			strings.HasPrefix(attrName, attr.definition.Attr+".") ||
+			strings.HasPrefix(attr.definition.Attr, attrName+".") {
+			return attr.definition, true
+		}
+	}
+	return nil, false
+}
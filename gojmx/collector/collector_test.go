@@ -0,0 +1,409 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package collector
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryCall struct {
+	pattern string
+	attrs   []string
+}
+
+// fakeQuerier returns the configured responses for each mBean pattern and records the calls.
+type fakeQuerier struct {
+	responses map[string][]*gojmx.AttributeResponse
+	errors    map[string]error
+	calls     []queryCall
+}
+
+func (f *fakeQuerier) QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error) {
+	f.calls = append(f.calls, queryCall{pattern: mBeanNamePattern, attrs: mBeanAttrName})
+	if err, ok := f.errors[mBeanNamePattern]; ok {
+		return nil, err
+	}
+	return f.responses[mBeanNamePattern], nil
+}
+
+func Test_Collect(t *testing.T) {
+	definition, err := Parse([]byte(`
+collect:
+  - domain: java.lang
+    event_type: JVMSample
+    beans:
+      - query: type=GarbageCollector,name=*
+        exclude_regex:
+          - .*Old.*
+        attributes:
+          - CollectionCount
+          - attr: CollectionTime
+            metric_type: rate
+            metric_name: gcTime
+      - query: type=Memory
+        attributes:
+          - HeapMemoryUsage.Used
+          - attr: NonHeapMemoryUsage
+            metric_name: nonHeap
+      - query: type=GarbageCollector,name=*
+        attributes:
+          - Name
+  - domain: test
+    beans:
+      - query: type=Cat,*
+        attributes:
+          - attr_regex: .*Value
+`))
+	require.NoError(t, err)
+
+	c, err := New(definition)
+	require.NoError(t, err)
+
+	q := &fakeQuerier{
+		responses: map[string][]*gojmx.AttributeResponse{
+			"java.lang:type=GarbageCollector,name=*": {
+				{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 10},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 100},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=Name", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "G1 Young Generation"},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Old Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Old Generation,attr=CollectionTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 20},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Old Generation,attr=Name", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "G1 Old Generation"},
+			},
+			"java.lang:type=Memory": {
+				{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 5},
+				{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Max", ResponseType: nrprotocol.ResponseType_INT, IntValue: 50},
+				{Name: "java.lang:type=Memory,attr=NonHeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 3},
+			},
+			"test:type=Cat,*": {
+				{Name: "test:type=Cat,name=tomas,attr=DoubleValue", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: 1.2},
+				{Name: "test:type=Cat,name=tomas,attr=BoolValue", ResponseType: nrprotocol.ResponseType_BOOL, BoolValue: true},
+				{Name: "test:type=Cat,name=tomas,attr=Name", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "tomas"},
+				{
+					Name:         "test:type=Cat,name=tomas,attr=DateValue",
+					ResponseType: nrprotocol.ResponseType_ERROR,
+					StatusMsg:    "can't parse attribute",
+					Error:        &nrprotocol.AttributeError{Kind: nrprotocol.ErrorKind_NULL_VALUE},
+				},
+			},
+		},
+	}
+
+	result, err := c.Collect(q)
+	require.NoError(t, err)
+
+	// Bean definitions with the same query share the request.
+	// The Runtime is queried to detect the JVM restarts as there are rate metrics.
+	assert.Equal(t, []queryCall{
+		{pattern: "java.lang:type=Runtime", attrs: []string{"StartTime", "Uptime"}},
+		{pattern: "java.lang:type=GarbageCollector,name=*", attrs: []string{"CollectionCount", "CollectionTime", "Name"}},
+		{pattern: "java.lang:type=Memory", attrs: []string{"HeapMemoryUsage", "NonHeapMemoryUsage"}},
+		{pattern: "test:type=Cat,*", attrs: nil},
+	}, q.calls)
+
+	assert.Equal(t, []*Sample{
+		{
+			EventType: "JVMSample",
+			Domain:    "java.lang",
+			Query:     "type=GarbageCollector,name=*",
+			MBean:     "java.lang:type=GarbageCollector,name=G1 Young Generation",
+			Metrics: []*Metric{
+				// Rates are reported from the second collection.
+				{Name: "CollectionCount", Attribute: "CollectionCount", Type: MetricTypeGauge, Value: int64(10)},
+			},
+		},
+		{
+			EventType: "JVMSample",
+			Domain:    "java.lang",
+			Query:     "type=GarbageCollector,name=*",
+			MBean:     "java.lang:type=GarbageCollector,name=G1 Old Generation",
+			Metrics: []*Metric{
+				{Name: "Name", Attribute: "Name", Type: MetricTypeAttribute, Value: "G1 Old Generation"},
+			},
+		},
+		{
+			EventType: "JVMSample",
+			Domain:    "java.lang",
+			Query:     "type=GarbageCollector,name=*",
+			MBean:     "java.lang:type=GarbageCollector,name=G1 Young Generation",
+			Metrics: []*Metric{
+				{Name: "Name", Attribute: "Name", Type: MetricTypeAttribute, Value: "G1 Young Generation"},
+			},
+		},
+		{
+			EventType: "JVMSample",
+			Domain:    "java.lang",
+			Query:     "type=Memory",
+			MBean:     "java.lang:type=Memory",
+			Metrics: []*Metric{
+				{Name: "HeapMemoryUsage.Used", Attribute: "HeapMemoryUsage.Used", Type: MetricTypeGauge, Value: int64(5)},
+				{Name: "nonHeap.Used", Attribute: "NonHeapMemoryUsage.Used", Type: MetricTypeGauge, Value: int64(3)},
+			},
+		},
+		{
+			EventType: DefaultEventType,
+			Domain:    "test",
+			Query:     "type=Cat,*",
+			MBean:     "test:type=Cat,name=tomas",
+			Metrics: []*Metric{
+				{Name: "DoubleValue", Attribute: "DoubleValue", Type: MetricTypeGauge, Value: 1.2},
+				{Name: "BoolValue", Attribute: "BoolValue", Type: MetricTypeGauge, Value: true},
+			},
+		},
+	}, result.Samples)
+
+	require.Len(t, result.Errors, 1)
+	var failure *AttributeFailure
+	require.ErrorAs(t, result.Errors[0], &failure)
+	assert.Equal(t, "test:type=Cat,name=tomas,attr=DateValue", failure.Name)
+	assert.Equal(t, gojmx.ErrorKindNullValue, failure.Err.Kind)
+
+	// WHEN the counter increases
+	time.Sleep(10 * time.Millisecond)
+	q.responses["java.lang:type=GarbageCollector,name=*"][1].IntValue = 200
+
+	result, err = c.Collect(q)
+	require.NoError(t, err)
+
+	// THEN the rate is reported
+	require.Len(t, result.Samples[0].Metrics, 2)
+	gcTime := result.Samples[0].Metrics[1]
+	assert.Equal(t, "gcTime", gcTime.Name)
+	assert.Equal(t, MetricTypeRate, gcTime.Type)
+	assert.Greater(t, gcTime.Value, 0.0)
+}
+
+func Test_Collect_Delta(t *testing.T) {
+	definition, err := Parse([]byte(`
+collect:
+  - domain: test
+    beans:
+      - query: type=Cat,*
+        attributes:
+          - attr: Count
+            metric_type: delta
+`))
+	require.NoError(t, err)
+
+	c, err := New(definition)
+	require.NoError(t, err)
+
+	count := &gojmx.AttributeResponse{Name: "test:type=Cat,name=tomas,attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: 5}
+	q := &fakeQuerier{responses: map[string][]*gojmx.AttributeResponse{"test:type=Cat,*": {count}}}
+
+	result, err := c.Collect(q)
+	require.NoError(t, err)
+	assert.Empty(t, result.Samples)
+
+	count.IntValue = 8
+	result, err = c.Collect(q)
+	require.NoError(t, err)
+	require.Len(t, result.Samples, 1)
+	assert.Equal(t, []*Metric{{Name: "Count", Attribute: "Count", Type: MetricTypeDelta, Value: 3.0}}, result.Samples[0].Metrics)
+}
+
+func Test_Collect_Restart(t *testing.T) {
+	definition, err := Parse([]byte(`
+collect:
+  - domain: test
+    beans:
+      - query: type=Cat,*
+        attributes:
+          - attr: Count
+            metric_type: delta
+`))
+	require.NoError(t, err)
+
+	c, err := New(definition)
+	require.NoError(t, err)
+
+	count := &gojmx.AttributeResponse{Name: "test:type=Cat,name=tomas,attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: 5}
+	startTime := &gojmx.AttributeResponse{Name: "java.lang:type=Runtime,attr=StartTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1000}
+	uptime := &gojmx.AttributeResponse{Name: "java.lang:type=Runtime,attr=Uptime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 60000}
+	q := &fakeQuerier{responses: map[string][]*gojmx.AttributeResponse{
+		"test:type=Cat,*":        {count},
+		"java.lang:type=Runtime": {startTime, uptime},
+	}}
+
+	_, err = c.Collect(q)
+	require.NoError(t, err)
+
+	// WHEN the JVM restarts and the counter grows over its previous value
+	startTime.IntValue = 90000
+	uptime.IntValue = 1000
+	count.IntValue = 8
+
+	// THEN the counter is considered to start from 0
+	result, err := c.Collect(q)
+	require.NoError(t, err)
+	require.Len(t, result.Samples, 1)
+	assert.Equal(t, []*Metric{{Name: "Count", Attribute: "Count", Type: MetricTypeDelta, Value: 8.0}}, result.Samples[0].Metrics)
+}
+
+func Test_Collect_ForgetsUnregistered(t *testing.T) {
+	definition, err := Parse([]byte(`
+collect:
+  - domain: test
+    beans:
+      - query: type=Cat,*
+        attributes:
+          - attr: Count
+            metric_type: delta
+`))
+	require.NoError(t, err)
+
+	c, err := New(definition)
+	require.NoError(t, err)
+
+	q := &fakeQuerier{responses: map[string][]*gojmx.AttributeResponse{
+		"test:type=Cat,*": {
+			{Name: "test:type=Cat,name=tomas,attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: 5},
+			{Name: "test:type=Cat,name=garfield,attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: 2},
+		},
+	}}
+
+	_, err = c.Collect(q)
+	require.NoError(t, err)
+	assert.Equal(t, 2, c.rates.Len())
+
+	// WHEN an mBean is unregistered
+	q.responses["test:type=Cat,*"] = q.responses["test:type=Cat,*"][:1]
+
+	// THEN its counter is forgotten after forgetAfter collections
+	for i := 0; i < forgetAfter; i++ {
+		assert.Equal(t, 2, c.rates.Len())
+		time.Sleep(time.Millisecond)
+		_, err = c.Collect(q)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, c.rates.Len())
+}
+
+func Test_Collect_KeepsFailed(t *testing.T) {
+	definition, err := Parse([]byte(`
+collect:
+  - domain: test
+    beans:
+      - query: type=Cat,*
+        attributes:
+          - attr: Count
+            metric_type: delta
+      - query: type=Dog,*
+        attributes:
+          - attr: Count
+            metric_type: delta
+`))
+	require.NoError(t, err)
+
+	c, err := New(definition)
+	require.NoError(t, err)
+
+	catCount := &gojmx.AttributeResponse{Name: "test:type=Cat,name=tomas,attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: 5}
+	dogCount := &gojmx.AttributeResponse{Name: "test:type=Dog,name=rex,attr=Count", ResponseType: nrprotocol.ResponseType_INT, IntValue: 10}
+	q := &fakeQuerier{
+		responses: map[string][]*gojmx.AttributeResponse{
+			"test:type=Cat,*": {catCount},
+			"test:type=Dog,*": {dogCount},
+		},
+		errors: map[string]error{},
+	}
+
+	_, err = c.Collect(q)
+	require.NoError(t, err)
+
+	// WHEN a query fails and an attribute comes back as an error response
+	time.Sleep(time.Millisecond)
+	q.errors["test:type=Cat,*"] = &gojmx.JMXError{Message: "can't query"}
+	q.responses["test:type=Dog,*"] = []*gojmx.AttributeResponse{
+		{Name: "test:type=Dog,name=rex,attr=Count", ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "timeout"},
+	}
+	result, err := c.Collect(q)
+	require.NoError(t, err)
+	assert.Len(t, result.Errors, 2)
+
+	// THEN their counters keep the previous values
+	time.Sleep(time.Millisecond)
+	delete(q.errors, "test:type=Cat,*")
+	q.responses["test:type=Dog,*"] = []*gojmx.AttributeResponse{dogCount}
+	catCount.IntValue, dogCount.IntValue = 8, 14
+
+	result, err = c.Collect(q)
+	require.NoError(t, err)
+	require.Len(t, result.Samples, 2)
+	assert.Equal(t, []*Metric{{Name: "Count", Attribute: "Count", Type: MetricTypeDelta, Value: 3.0}}, result.Samples[0].Metrics)
+	assert.Equal(t, []*Metric{{Name: "Count", Attribute: "Count", Type: MetricTypeDelta, Value: 4.0}}, result.Samples[1].Metrics)
+}
+
+func Test_Collect_Errors(t *testing.T) {
+	definition, err := Parse([]byte(`
+collect:
+  - domain: test
+    beans:
+      - query: type=Cat,*
+      - query: type=Dog,*
+`))
+	require.NoError(t, err)
+
+	c, err := New(definition)
+	require.NoError(t, err)
+
+	// GIVEN a query that fails with a JMX error
+	q := &fakeQuerier{
+		responses: map[string][]*gojmx.AttributeResponse{
+			"test:type=Dog,*": {
+				{Name: "test:type=Dog,name=rex,attr=Age", ResponseType: nrprotocol.ResponseType_INT, IntValue: 3},
+			},
+		},
+		errors: map[string]error{
+			"test:type=Cat,*": &gojmx.JMXError{Message: "can't query"},
+		},
+	}
+
+	// THEN the remaining queries are collected
+	result, err := c.Collect(q)
+	require.NoError(t, err)
+	require.Len(t, result.Samples, 1)
+	assert.Equal(t, "test:type=Dog,name=rex", result.Samples[0].MBean)
+
+	require.Len(t, result.Errors, 1)
+	var queryErr *QueryError
+	require.ErrorAs(t, result.Errors[0], &queryErr)
+	assert.Equal(t, "test:type=Cat,*", queryErr.Pattern)
+
+	// GIVEN a connection error
+	q.errors["test:type=Cat,*"] = &gojmx.JMXConnectionError{Message: "connection lost"}
+
+	// THEN the collection is interrupted
+	_, err = c.Collect(q)
+	var connErr *gojmx.JMXConnectionError
+	assert.True(t, errors.As(err, &connErr))
+}
+
+func Test_Metric_Float64(t *testing.T) {
+	testCases := []struct {
+		value    interface{}
+		expected float64
+		ok       bool
+	}{
+		{value: 1.5, expected: 1.5, ok: true},
+		{value: int64(3), expected: 3, ok: true},
+		{value: true, expected: 1, ok: true},
+		{value: false, expected: 0, ok: true},
+		{value: "tomas", expected: 0, ok: false},
+	}
+
+	for _, testCase := range testCases {
+		value, ok := (&Metric{Value: testCase.value}).Float64()
+		assert.Equal(t, testCase.expected, value)
+		assert.Equal(t, testCase.ok, ok)
+	}
+}
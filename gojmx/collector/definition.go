@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package collector
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultEventType is used for the domains without event_type.
+const DefaultEventType = "JMXSample"
+
+// MetricType defines how an attribute value is reported.
+type MetricType string
+
+const (
+	// MetricTypeGauge reports the value as it is retrieved.
+	MetricTypeGauge MetricType = "gauge"
+	// MetricTypeRate reports the change of the value per second between collections.
+	MetricTypeRate MetricType = "rate"
+	// MetricTypeDelta reports the change of the value between collections.
+	MetricTypeDelta MetricType = "delta"
+	// MetricTypeAttribute reports the value as a string attribute, e.g. a version or a state.
+	MetricTypeAttribute MetricType = "attribute"
+)
+
+func (m MetricType) isValid() bool {
+	switch m {
+	case MetricTypeGauge, MetricTypeRate, MetricTypeDelta, MetricTypeAttribute:
+		return true
+	default:
+		return false
+	}
+}
+
+// Definition is a collection definition with the nri-jmx format, the same format generated by gojmx.FormatJMXAttributes:
+//
+//	collect:
+//	  - domain: java.lang
+//	    event_type: JVMSample
+//	    beans:
+//	      - query: type=GarbageCollector,name=*
+//	        exclude_regex:
+//	          - .*Old.*
+//	        attributes:
+//	          - CollectionCount
+//	          - attr: CollectionTime
+//	            metric_type: rate
+//	          - attr_regex: Last.*
+type Definition struct {
+	Collect []*DomainDefinition `yaml:"collect"`
+}
+
+// DomainDefinition groups the beans to collect for a domain.
+type DomainDefinition struct {
+	Domain    string            `yaml:"domain"`
+	EventType string            `yaml:"event_type"`
+	Beans     []*BeanDefinition `yaml:"beans"`
+}
+
+// BeanDefinition is an mBean query, without the domain, and the attributes to collect for the matching mBeans.
+// When no attributes are defined all of them are collected.
+type BeanDefinition struct {
+	Query string `yaml:"query"`
+	// ExcludeRegex are matched against the mBean names to skip them.
+	ExcludeRegex []string               `yaml:"exclude_regex"`
+	Attributes   []*AttributeDefinition `yaml:"attributes"`
+}
+
+// AttributeDefinition selects the attributes to collect by name or by regex.
+// It can be declared as a plain attribute name or as a mapping with attr or attr_regex.
+// Composite attributes are selected by their field, e.g. HeapMemoryUsage.Used, or all together by their name.
+type AttributeDefinition struct {
+	Attr      string `yaml:"attr"`
+	AttrRegex string `yaml:"attr_regex"`
+	// MetricType is inferred from the value type when not defined.
+	MetricType MetricType `yaml:"metric_type"`
+	// MetricName replaces the attribute name in the reported metric.
+	MetricName string `yaml:"metric_name"`
+	// Unit is reported with the metric, e.g. bytes or ms.
+	Unit string `yaml:"unit"`
+	// Description is written as a comment by Format, it's not part of the nri-jmx format.
+	Description string `yaml:"-"`
+}
+
+// UnmarshalYAML supports the plain attribute name format.
+func (a *AttributeDefinition) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		a.Attr = value.Value
+		return nil
+	}
+
+	type plain AttributeDefinition
+	return value.Decode((*plain)(a))
+}
+
+// Parse reads a collection definition in YAML format.
+func Parse(data []byte) (*Definition, error) {
+	definition := &Definition{}
+	if err := yaml.Unmarshal(data, definition); err != nil {
+		return nil, fmt.Errorf("cannot parse collection definition: %w", err)
+	}
+
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// LoadFile reads a collection definition file.
+func LoadFile(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read collection definition file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks that the definition can be collected.
+func (d *Definition) Validate() error {
+	if d == nil {
+		return fmt.Errorf("invalid collection definition: nil")
+	}
+
+	for i, domain := range d.Collect {
+		if domain == nil || domain.Domain == "" {
+			return fmt.Errorf("invalid collection definition: collect[%d] missing domain", i)
+		}
+
+		for j, bean := range domain.Beans {
+			if bean == nil || bean.Query == "" {
+				return fmt.Errorf("invalid collection definition: domain '%s' beans[%d] missing query", domain.Domain, j)
+			}
+
+			for _, exclude := range bean.ExcludeRegex {
+				if _, err := regexp.Compile(exclude); err != nil {
+					return fmt.Errorf("invalid collection definition: query '%s' exclude_regex '%s': %w", bean.Query, exclude, err)
+				}
+			}
+
+			for k, attr := range bean.Attributes {
+				if err := attr.validate(); err != nil {
+					return fmt.Errorf("invalid collection definition: query '%s' attributes[%d]: %w", bean.Query, k, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (a *AttributeDefinition) validate() error {
+	if a == nil || (a.Attr == "" && a.AttrRegex == "") {
+		return fmt.Errorf("missing attr or attr_regex")
+	}
+	if a.Attr != "" && a.AttrRegex != "" {
+		return fmt.Errorf("attr and attr_regex are exclusive")
+	}
+	if a.AttrRegex != "" {
+		if _, err := regexp.Compile(a.AttrRegex); err != nil {
+			return fmt.Errorf("attr_regex '%s': %w", a.AttrRegex, err)
+		}
+	}
+	if a.MetricType != "" && !a.MetricType.isValid() {
+		return fmt.Errorf("unknown metric_type '%s'", a.MetricType)
+	}
+	return nil
+}
+
+// Pattern returns the mBean glob pattern DOMAIN:QUERY for the bean.
+func (d *DomainDefinition) Pattern(bean *BeanDefinition) string {
+	return fmt.Sprintf("%s:%s", d.Domain, strings.TrimSpace(bean.Query))
+}
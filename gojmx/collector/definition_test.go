@@ -0,0 +1,124 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	data := `
+collect:
+  - domain: java.lang
+    event_type: JVMSample
+    beans:
+      - query: type=GarbageCollector,name=*
+        exclude_regex:
+          - .*Old.*
+        attributes:
+          - CollectionCount
+          - attr: CollectionTime
+            metric_type: rate
+            metric_name: gcTime
+          - attr_regex: Last.*
+  - domain: test
+    beans:
+      - query: type=Cat,*
+`
+	definition, err := Parse([]byte(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, &Definition{
+		Collect: []*DomainDefinition{
+			{
+				Domain:    "java.lang",
+				EventType: "JVMSample",
+				Beans: []*BeanDefinition{
+					{
+						Query:        "type=GarbageCollector,name=*",
+						ExcludeRegex: []string{".*Old.*"},
+						Attributes: []*AttributeDefinition{
+							{Attr: "CollectionCount"},
+							{Attr: "CollectionTime", MetricType: MetricTypeRate, MetricName: "gcTime"},
+							{AttrRegex: "Last.*"},
+						},
+					},
+				},
+			},
+			{
+				Domain: "test",
+				Beans: []*BeanDefinition{
+					{Query: "type=Cat,*"},
+				},
+			},
+		},
+	}, definition)
+}
+
+func Test_Parse_FormatJMXAttributes(t *testing.T) {
+	// GIVEN the output of FormatJMXAttributes
+	formatted := gojmx.FormatJMXAttributes([]*gojmx.AttributeResponse{
+		{Name: "test:type=Cat,name=tomas,attr=DoubleValue", ResponseType: gojmx.ResponseTypeDouble, DoubleValue: 1.2},
+		{Name: "test:type=Cat,name=tomas,attr=Name", ResponseType: gojmx.ResponseTypeString, StringValue: "tomas"},
+	})
+
+	// THEN it can be parsed as a collection definition
+	definition, err := Parse([]byte(formatted))
+	require.NoError(t, err)
+
+	require.Len(t, definition.Collect, 1)
+	assert.Equal(t, "test", definition.Collect[0].Domain)
+	require.Len(t, definition.Collect[0].Beans, 1)
+	assert.Equal(t, "type=Cat,name=tomas", definition.Collect[0].Beans[0].Query)
+	assert.Equal(t, []*AttributeDefinition{{Attr: "DoubleValue"}, {Attr: "Name"}}, definition.Collect[0].Beans[0].Attributes)
+}
+
+func Test_Parse_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "Missing Domain",
+			data: "collect:\n  - beans:\n      - query: type=Cat,*\n",
+		},
+		{
+			name: "Missing Query",
+			data: "collect:\n  - domain: test\n    beans:\n      - attributes: [Name]\n",
+		},
+		{
+			name: "Invalid Exclude Regex",
+			data: "collect:\n  - domain: test\n    beans:\n      - query: type=Cat,*\n        exclude_regex: ['(']\n",
+		},
+		{
+			name: "Invalid Attribute Regex",
+			data: "collect:\n  - domain: test\n    beans:\n      - query: type=Cat,*\n        attributes:\n          - attr_regex: '['\n",
+		},
+		{
+			name: "Attr And Regex",
+			data: "collect:\n  - domain: test\n    beans:\n      - query: type=Cat,*\n        attributes:\n          - attr: Name\n            attr_regex: Na.*\n",
+		},
+		{
+			name: "Unknown Metric Type",
+			data: "collect:\n  - domain: test\n    beans:\n      - query: type=Cat,*\n        attributes:\n          - attr: Name\n            metric_type: histogram\n",
+		},
+		{
+			name: "Invalid YAML",
+			data: "collect: [",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse([]byte(testCase.data))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,531 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package collector
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"gopkg.in/yaml.v3"
+)
+
+// GeneratorQuerier is the gojmx.Client functionality required to generate the collection definitions.
+type GeneratorQuerier interface {
+	Querier
+	GetDomains() ([]string, error)
+	GetMBeanInfo(mBeanName string) (*gojmx.MBeanInfo, error)
+}
+
+var _ GeneratorQuerier = (*gojmx.Client)(nil)
+
+// GenerateConfig selects the domains, mBeans and attributes included in the generated definition.
+type GenerateConfig struct {
+	// Domains limits the generated definition to the listed domains, all the domains are included when empty.
+	Domains []*DomainFilter `yaml:"domains"`
+	// ExcludeDomains are regexes matched against the domain names to skip them.
+	ExcludeDomains []string `yaml:"exclude_domains"`
+	// TypeRules infer the metric type of the numeric attributes, DefaultTypeRules are used when empty.
+	TypeRules []*TypeRule `yaml:"type_rules"`
+}
+
+// DomainFilter selects the mBeans and attributes of a domain.
+// The bean regexes are matched against the mBean names, the attribute regexes must match the whole attribute name.
+type DomainFilter struct {
+	Domain            string   `yaml:"domain"`
+	EventType         string   `yaml:"event_type"`
+	IncludeBeans      []string `yaml:"include_beans"`
+	ExcludeBeans      []string `yaml:"exclude_beans"`
+	IncludeAttributes []string `yaml:"include_attributes"`
+	ExcludeAttributes []string `yaml:"exclude_attributes"`
+}
+
+// TypeRule assigns the metric type to the numeric attributes whose name matches the regex.
+type TypeRule struct {
+	Regex      string     `yaml:"regex"`
+	MetricType MetricType `yaml:"metric_type"`
+}
+
+// DefaultTypeRules report counters as rates and accumulated times as deltas. The first matching rule is applied,
+// the numeric attributes that don't match any rule are reported as gauges.
+var DefaultTypeRules = []*TypeRule{
+	{Regex: `(StartTime|Uptime)$`, MetricType: MetricTypeGauge},
+	{Regex: `(Count|Total)$`, MetricType: MetricTypeRate},
+	{Regex: `Time$`, MetricType: MetricTypeDelta},
+}
+
+// Generate builds a collection definition from the mBeans registered in the JMX server. The metric types are
+// inferred from the MBeanInfo attribute types, the retrieved values and the TypeRules. mBeans sharing the key
+// properties and the type are grouped in the same query. The output is sorted so it can be reviewed with a diff.
+// mBeans whose MBeanInfo or values cannot be retrieved are skipped, the generation is only interrupted when the
+// error is not a *gojmx.JMXError, e.g. a connection error.
+func Generate(q GeneratorQuerier, config *GenerateConfig) (*Definition, error) {
+	g, err := newGenerator(config)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := append([]*DomainFilter(nil), config.Domains...)
+	if len(filters) == 0 {
+		domains, err := q.GetDomains()
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range domains {
+			filters = append(filters, &DomainFilter{Domain: domain})
+		}
+	}
+	sort.SliceStable(filters, func(i, j int) bool {
+		return filters[i].Domain < filters[j].Domain
+	})
+
+	definition := &Definition{}
+	for _, filter := range filters {
+		if g.isDomainExcluded(filter.Domain) {
+			continue
+		}
+
+		domain, err := g.generateDomain(q, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(domain.Beans) > 0 {
+			definition.Collect = append(definition.Collect, domain)
+		}
+	}
+	return definition, nil
+}
+
+type generator struct {
+	excludeDomains []*regexp.Regexp
+	typeRules      []*compiledTypeRule
+}
+
+type compiledTypeRule struct {
+	regex      *regexp.Regexp
+	metricType MetricType
+}
+
+type domainFilter struct {
+	includeBeans, excludeBeans []*regexp.Regexp
+	includeAttrs, excludeAttrs []*regexp.Regexp
+}
+
+// beanGroup are the mBeans collected by the same query.
+type beanGroup struct {
+	query  string
+	mBeans []string
+}
+
+func newGenerator(config *GenerateConfig) (*generator, error) {
+	if config == nil {
+		return nil, fmt.Errorf("invalid generate config: nil")
+	}
+
+	g := &generator{}
+	var err error
+	if g.excludeDomains, err = compileRegexes(config.ExcludeDomains, false); err != nil {
+		return nil, fmt.Errorf("invalid generate config: exclude_domains: %w", err)
+	}
+
+	rules := config.TypeRules
+	if len(rules) == 0 {
+		rules = DefaultTypeRules
+	}
+	for i, rule := range rules {
+		if rule == nil || !rule.MetricType.isValid() {
+			return nil, fmt.Errorf("invalid generate config: type_rules[%d] missing or unknown metric_type", i)
+		}
+		regex, err := regexp.Compile(rule.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid generate config: type_rules[%d] regex '%s': %w", i, rule.Regex, err)
+		}
+		g.typeRules = append(g.typeRules, &compiledTypeRule{regex: regex, metricType: rule.MetricType})
+	}
+
+	for i, filter := range config.Domains {
+		if filter == nil || filter.Domain == "" {
+			return nil, fmt.Errorf("invalid generate config: domains[%d] missing domain", i)
+		}
+		if _, err := filter.compile(); err != nil {
+			return nil, fmt.Errorf("invalid generate config: domain '%s': %w", filter.Domain, err)
+		}
+	}
+
+	return g, nil
+}
+
+func (f *DomainFilter) compile() (*domainFilter, error) {
+	compiled := &domainFilter{}
+	var err error
+	if compiled.includeBeans, err = compileRegexes(f.IncludeBeans, false); err != nil {
+		return nil, fmt.Errorf("include_beans: %w", err)
+	}
+	if compiled.excludeBeans, err = compileRegexes(f.ExcludeBeans, false); err != nil {
+		return nil, fmt.Errorf("exclude_beans: %w", err)
+	}
+	if compiled.includeAttrs, err = compileRegexes(f.IncludeAttributes, true); err != nil {
+		return nil, fmt.Errorf("include_attributes: %w", err)
+	}
+	if compiled.excludeAttrs, err = compileRegexes(f.ExcludeAttributes, true); err != nil {
+		return nil, fmt.Errorf("exclude_attributes: %w", err)
+	}
+	return compiled, nil
+}
+
+func compileRegexes(exprs []string, anchored bool) ([]*regexp.Regexp, error) {
+	var result []*regexp.Regexp
+	for _, expr := range exprs {
+		pattern := expr
+		if anchored {
+			pattern = "^(?:" + expr + ")$"
+		}
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("regex '%s': %w", expr, err)
+		}
+		result = append(result, regex)
+	}
+	return result, nil
+}
+
+// selected returns true when the value matches any of the includes, or there are no includes, and none of the excludes.
+func selected(value string, includes, excludes []*regexp.Regexp) bool {
+	for _, exclude := range excludes {
+		if exclude.MatchString(value) {
+			return false
+		}
+	}
+	if len(includes) == 0 {
+		return true
+	}
+	for _, include := range includes {
+		if include.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) isDomainExcluded(domain string) bool {
+	return !selected(domain, nil, g.excludeDomains)
+}
+
+func (g *generator) generateDomain(q GeneratorQuerier, filter *DomainFilter) (*DomainDefinition, error) {
+	domain := &DomainDefinition{Domain: filter.Domain, EventType: filter.EventType}
+	compiled, _ := filter.compile()
+
+	response, err := q.QueryMBeanAttributes(filter.Domain + ":*")
+	if err != nil {
+		if _, ok := gojmx.IsJMXError(err); ok {
+			return domain, nil
+		}
+		return nil, err
+	}
+
+	byMBean, mBeanNames := groupByMBean(response)
+
+	var selectedMBeans []string
+	for _, mBeanName := range mBeanNames {
+		if selected(mBeanName, compiled.includeBeans, compiled.excludeBeans) {
+			selectedMBeans = append(selectedMBeans, mBeanName)
+		}
+	}
+
+	for _, group := range groupMBeans(selectedMBeans) {
+		bean := &BeanDefinition{Query: group.query}
+		seen := map[string]bool{}
+
+		for _, mBeanName := range group.mBeans {
+			info, err := q.GetMBeanInfo(mBeanName)
+			if err != nil {
+				if _, ok := gojmx.IsJMXError(err); !ok {
+					return nil, err
+				}
+			}
+
+			for _, attr := range g.attributeDefinitions(info, byMBean[mBeanName]) {
+				if seen[attr.Attr] || !selected(attr.Attr, compiled.includeAttrs, compiled.excludeAttrs) {
+					continue
+				}
+				seen[attr.Attr] = true
+				bean.Attributes = append(bean.Attributes, attr)
+			}
+		}
+
+		if len(bean.Attributes) == 0 {
+			continue
+		}
+		sort.Slice(bean.Attributes, func(i, j int) bool {
+			return bean.Attributes[i].Attr < bean.Attributes[j].Attr
+		})
+		domain.Beans = append(domain.Beans, bean)
+	}
+
+	return domain, nil
+}
+
+// groupMBeans groups the mBeans with the same type and key properties in a query with the remaining values
+// replaced by wildcards, e.g. type=GarbageCollector,name=*. The mBeans without a group keep their own query.
+func groupMBeans(mBeanNames []string) []*beanGroup {
+	byQuery := map[string]*beanGroup{}
+	var queries []string
+
+	for _, mBeanName := range mBeanNames {
+		query := mBeanName[strings.Index(mBeanName, ":")+1:]
+
+		objectName, err := gojmx.ParseObjectName(mBeanName)
+		if err == nil && objectName.Get("type") != "" && len(objectName.Keys()) > 1 {
+			props := []string{"type=" + objectName.Properties["type"]}
+			for _, key := range objectName.Keys() {
+				if key != "type" {
+					props = append(props, key+"=*")
+				}
+			}
+			query = strings.Join(props, ",")
+		}
+
+		group, exists := byQuery[query]
+		if !exists {
+			group = &beanGroup{query: query}
+			byQuery[query] = group
+			queries = append(queries, query)
+		}
+		group.mBeans = append(group.mBeans, mBeanName)
+	}
+
+	sort.Strings(queries)
+	result := make([]*beanGroup, 0, len(queries))
+	for _, query := range queries {
+		group := byQuery[query]
+		// A single mBean is collected by its name, the wildcards would match the mBeans registered later.
+		if len(group.mBeans) == 1 {
+			mBeanName := group.mBeans[0]
+			group.query = mBeanName[strings.Index(mBeanName, ":")+1:]
+		}
+		result = append(result, group)
+	}
+	return result
+}
+
+// attributeDefinitions types the attributes of an mBean. Composite attributes are defined by their name when
+// all the fields have the same metric type and unit, otherwise each field is defined.
+// The attributes that are not readable or whose type cannot be reported are skipped.
+func (g *generator) attributeDefinitions(info *gojmx.MBeanInfo, attrs []*gojmx.AttributeResponse) []*AttributeDefinition {
+	fields := map[string][]*AttributeDefinition{}
+	responses := map[string]*gojmx.AttributeResponse{}
+	for _, attr := range attrs {
+		_, attrName, _ := gojmx.SplitAttributeName(attr.Name)
+		if attr.ResponseType == gojmx.ResponseTypeErr {
+			continue
+		}
+		responses[attrName] = attr
+		if i := strings.Index(attrName, "."); i > 0 {
+			if definition := g.typeValue(attrName, attr); definition != nil {
+				fields[attrName[:i]] = append(fields[attrName[:i]], definition)
+			}
+		}
+	}
+
+	var names []string
+	attrInfos := map[string]*gojmx.MBeanAttributeInfo{}
+	for _, attrInfo := range info.GetAttributes() {
+		if attrInfo == nil || !attrInfo.Readable {
+			continue
+		}
+		attrInfos[attrInfo.Name] = attrInfo
+		names = append(names, attrInfo.Name)
+	}
+	// Without MBeanInfo the attributes are typed from the retrieved values.
+	if info == nil {
+		for attrName := range responses {
+			names = append(names, strings.SplitN(attrName, ".", 2)[0])
+		}
+	}
+
+	var result []*AttributeDefinition
+	added := map[string]bool{}
+	for _, name := range names {
+		if added[name] {
+			continue
+		}
+		added[name] = true
+
+		description := ""
+		if attrInfo, ok := attrInfos[name]; ok && attrInfo.Description != name {
+			description = attrInfo.Description
+		}
+
+		if composite := fields[name]; len(composite) > 0 {
+			result = append(result, groupFields(name, composite, description)...)
+			continue
+		}
+
+		var definition *AttributeDefinition
+		if response, ok := responses[name]; ok {
+			definition = g.typeValue(name, response)
+		} else if attrInfo, ok := attrInfos[name]; ok {
+			definition = g.typeJavaType(name, attrInfo.Type)
+		}
+		if definition != nil {
+			definition.Description = description
+			result = append(result, definition)
+		}
+	}
+	return result
+}
+
+func groupFields(name string, fields []*AttributeDefinition, description string) []*AttributeDefinition {
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Attr < fields[j].Attr
+	})
+
+	for _, field := range fields[1:] {
+		if field.MetricType != fields[0].MetricType || field.Unit != fields[0].Unit {
+			fields[0].Description = description
+			return fields
+		}
+	}
+	return []*AttributeDefinition{{
+		Attr:        name,
+		MetricType:  fields[0].MetricType,
+		Unit:        fields[0].Unit,
+		Description: description,
+	}}
+}
+
+func (g *generator) typeValue(name string, attr *gojmx.AttributeResponse) *AttributeDefinition {
+	switch attr.ResponseType {
+	case gojmx.ResponseTypeInt, gojmx.ResponseTypeDouble:
+		return g.typeNumeric(name)
+	case gojmx.ResponseTypeBool:
+		return &AttributeDefinition{Attr: name, MetricType: MetricTypeGauge}
+	case gojmx.ResponseTypeString:
+		return &AttributeDefinition{Attr: name, MetricType: MetricTypeAttribute}
+	default:
+		return nil
+	}
+}
+
+// typeJavaType types the attributes without a retrieved value, e.g. the ones that are null at the moment.
+func (g *generator) typeJavaType(name, javaType string) *AttributeDefinition {
+	switch javaType {
+	case "int", "long", "short", "byte", "float", "double",
+		"java.lang.Integer", "java.lang.Long", "java.lang.Short", "java.lang.Byte",
+		"java.lang.Float", "java.lang.Double", "java.math.BigInteger", "java.math.BigDecimal",
+		"java.util.concurrent.atomic.AtomicInteger", "java.util.concurrent.atomic.AtomicLong":
+		return g.typeNumeric(name)
+	case "boolean", "java.lang.Boolean":
+		return &AttributeDefinition{Attr: name, MetricType: MetricTypeGauge}
+	case "java.lang.String":
+		return &AttributeDefinition{Attr: name, MetricType: MetricTypeAttribute}
+	default:
+		return nil
+	}
+}
+
+func (g *generator) typeNumeric(name string) *AttributeDefinition {
+	definition := &AttributeDefinition{Attr: name, MetricType: MetricTypeGauge, Unit: guessUnit(name)}
+	for _, rule := range g.typeRules {
+		if rule.regex.MatchString(name) {
+			definition.MetricType = rule.metricType
+			break
+		}
+	}
+	return definition
+}
+
+var (
+	nanosUnitRegex  = regexp.MustCompile(`(CpuTime|Nanos|Nanoseconds|Ns)$`)
+	millisUnitRegex = regexp.MustCompile(`([Tt]ime|Millis|Milliseconds|Ms)$`)
+	bytesUnitRegex  = regexp.MustCompile(`(Bytes|Size|Usage\.(Init|Used|Committed|Max))$`)
+)
+
+// guessUnit infers the unit from the attribute name conventions of the platform mBeans.
+func guessUnit(name string) string {
+	switch {
+	case nanosUnitRegex.MatchString(name):
+		return "ns"
+	case millisUnitRegex.MatchString(name):
+		return "ms"
+	case bytesUnitRegex.MatchString(name):
+		return "bytes"
+	default:
+		return ""
+	}
+}
+
+// Format writes the definition in YAML with the attribute descriptions as comments.
+// Attributes with only the name are written in the plain format.
+func Format(definition *Definition) string {
+	sb := strings.Builder{}
+	sb.WriteString("collect:\n")
+	if definition == nil {
+		return sb.String()
+	}
+
+	for _, domain := range definition.Collect {
+		sb.WriteString("  - domain: " + yamlScalar(domain.Domain) + "\n")
+		if domain.EventType != "" {
+			sb.WriteString("    event_type: " + yamlScalar(domain.EventType) + "\n")
+		}
+		sb.WriteString("    beans:\n")
+
+		for _, bean := range domain.Beans {
+			sb.WriteString("      - query: " + yamlScalar(bean.Query) + "\n")
+			if len(bean.ExcludeRegex) > 0 {
+				sb.WriteString("        exclude_regex:\n")
+				for _, exclude := range bean.ExcludeRegex {
+					sb.WriteString("          - " + yamlScalar(exclude) + "\n")
+				}
+			}
+			if len(bean.Attributes) == 0 {
+				continue
+			}
+
+			sb.WriteString("        attributes:\n")
+			for _, attr := range bean.Attributes {
+				if attr.Description != "" {
+					sb.WriteString("          # " + strings.Join(strings.Fields(attr.Description), " ") + "\n")
+				}
+				formatAttribute(&sb, attr)
+			}
+		}
+	}
+	return sb.String()
+}
+
+func formatAttribute(sb *strings.Builder, attr *AttributeDefinition) {
+	if attr.AttrRegex == "" && attr.MetricType == "" && attr.MetricName == "" && attr.Unit == "" {
+		sb.WriteString("          - " + yamlScalar(attr.Attr) + "\n")
+		return
+	}
+
+	prefix := "          - "
+	write := func(key, value string) {
+		if value == "" {
+			return
+		}
+		sb.WriteString(prefix + key + ": " + yamlScalar(value) + "\n")
+		prefix = "            "
+	}
+	write("attr", attr.Attr)
+	write("attr_regex", attr.AttrRegex)
+	write("metric_type", string(attr.MetricType))
+	write("metric_name", attr.MetricName)
+	write("unit", attr.Unit)
+}
+
+// yamlScalar quotes the values that would not be read back as the same string.
+func yamlScalar(value string) string {
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%q", value)
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package collector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGeneratorQuerier adds the domains and MBeanInfo to the fakeQuerier.
+type fakeGeneratorQuerier struct {
+	fakeQuerier
+	domains []string
+	infos   map[string]*gojmx.MBeanInfo
+}
+
+func (f *fakeGeneratorQuerier) GetDomains() ([]string, error) {
+	return f.domains, nil
+}
+
+func (f *fakeGeneratorQuerier) GetMBeanInfo(mBeanName string) (*gojmx.MBeanInfo, error) {
+	info, ok := f.infos[mBeanName]
+	if !ok {
+		return nil, &gojmx.JMXError{Message: "instance not found: " + mBeanName}
+	}
+	return info, nil
+}
+
+func newGeneratorQuerier() *fakeGeneratorQuerier {
+	gcInfo := &gojmx.MBeanInfo{
+		Attributes: []*nrprotocol.MBeanAttributeInfo{
+			{Name: "CollectionCount", Type: "long", Description: "CollectionCount", Readable: true},
+			{Name: "CollectionTime", Type: "long", Description: "Approximate accumulated collection elapsed time.", Readable: true},
+			{Name: "Name", Type: "java.lang.String", Description: "Name", Readable: true},
+			{Name: "Valid", Type: "boolean", Description: "Valid", Readable: true},
+			{Name: "LastGcInfo", Type: "javax.management.openmbean.CompositeData", Description: "LastGcInfo", Readable: true},
+			{Name: "Secret", Type: "long", Description: "Secret", Readable: false},
+		},
+	}
+
+	return &fakeGeneratorQuerier{
+		fakeQuerier: fakeQuerier{
+			responses: map[string][]*gojmx.AttributeResponse{
+				"java.lang:*": {
+					{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 10},
+					{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 100},
+					{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=Name", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "G1 Young Generation"},
+					{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=Valid", ResponseType: nrprotocol.ResponseType_BOOL, BoolValue: true},
+					{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=LastGcInfo", ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "null"},
+					{Name: "java.lang:type=GarbageCollector,name=G1 Old Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1},
+					{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1024},
+					{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Max", ResponseType: nrprotocol.ResponseType_INT, IntValue: 4096},
+					{Name: "java.lang:type=Memory,attr=ObjectPendingFinalizationCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 0},
+					{Name: "java.lang:type=Runtime,attr=Uptime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 5000},
+					{Name: "java.lang:type=Runtime,attr=VmName", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "OpenJDK"},
+				},
+				"test:*": {
+					{Name: "test:type=Cat,name=tomas,attr=Weight", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: 4.5},
+					{Name: "test:type=Cat,name=tomas,attr=Stats.EatCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 3},
+					{Name: "test:type=Cat,name=tomas,attr=Stats.SleepTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 3},
+				},
+			},
+			errors: map[string]error{
+				"JMImplementation:*": &gojmx.JMXError{Message: "query failed"},
+			},
+		},
+		domains: []string{"test", "java.lang", "JMImplementation"},
+		infos: map[string]*gojmx.MBeanInfo{
+			"java.lang:type=GarbageCollector,name=G1 Young Generation": gcInfo,
+			"java.lang:type=GarbageCollector,name=G1 Old Generation":   gcInfo,
+			"java.lang:type=Memory": {
+				Attributes: []*nrprotocol.MBeanAttributeInfo{
+					{Name: "HeapMemoryUsage", Type: "javax.management.openmbean.CompositeData", Readable: true},
+					{Name: "ObjectPendingFinalizationCount", Type: "int", Readable: true},
+				},
+			},
+			"java.lang:type=Runtime": {
+				Attributes: []*nrprotocol.MBeanAttributeInfo{
+					{Name: "Uptime", Type: "long", Readable: true},
+					{Name: "VmName", Type: "java.lang.String", Readable: true},
+				},
+			},
+		},
+	}
+}
+
+func Test_Generate(t *testing.T) {
+	q := newGeneratorQuerier()
+
+	definition, err := Generate(q, &GenerateConfig{})
+	require.NoError(t, err)
+	require.NoError(t, definition.Validate())
+
+	assert.Equal(t, `collect:
+  - domain: java.lang
+    beans:
+      - query: type=GarbageCollector,name=*
+        attributes:
+          - attr: CollectionCount
+            metric_type: rate
+          # Approximate accumulated collection elapsed time.
+          - attr: CollectionTime
+            metric_type: delta
+            unit: ms
+          - attr: Name
+            metric_type: attribute
+          - attr: Valid
+            metric_type: gauge
+      - query: type=Memory
+        attributes:
+          - attr: HeapMemoryUsage
+            metric_type: gauge
+            unit: bytes
+          - attr: ObjectPendingFinalizationCount
+            metric_type: rate
+      - query: type=Runtime
+        attributes:
+          - attr: Uptime
+            metric_type: gauge
+            unit: ms
+          - attr: VmName
+            metric_type: attribute
+  - domain: test
+    beans:
+      - query: type=Cat,name=tomas
+        attributes:
+          - attr: Stats.EatCount
+            metric_type: rate
+          - attr: Stats.SleepTime
+            metric_type: delta
+            unit: ms
+          - attr: Weight
+            metric_type: gauge
+`, Format(definition))
+
+	parsed, err := Parse([]byte(Format(definition)))
+	require.NoError(t, err)
+	assert.Len(t, parsed.Collect, 2)
+}
+
+func Test_Generate_Filters(t *testing.T) {
+	q := newGeneratorQuerier()
+
+	definition, err := Generate(q, &GenerateConfig{
+		Domains: []*DomainFilter{
+			{
+				Domain:            "java.lang",
+				EventType:         "JVMSample",
+				IncludeBeans:      []string{"type=GarbageCollector", "type=Memory"},
+				ExcludeBeans:      []string{"Old"},
+				ExcludeAttributes: []string{"Name|Valid"},
+			},
+			{Domain: "test"},
+		},
+		ExcludeDomains: []string{"^test$"},
+		TypeRules: []*TypeRule{
+			{Regex: "Count$", MetricType: MetricTypeDelta},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `collect:
+  - domain: java.lang
+    event_type: JVMSample
+    beans:
+      - query: type=GarbageCollector,name=G1 Young Generation
+        attributes:
+          - attr: CollectionCount
+            metric_type: delta
+          # Approximate accumulated collection elapsed time.
+          - attr: CollectionTime
+            metric_type: gauge
+            unit: ms
+      - query: type=Memory
+        attributes:
+          - attr: HeapMemoryUsage
+            metric_type: gauge
+            unit: bytes
+          - attr: ObjectPendingFinalizationCount
+            metric_type: delta
+`, Format(definition))
+	assert.Equal(t, []queryCall{{pattern: "java.lang:*"}}, q.calls)
+}
+
+func Test_Generate_Errors(t *testing.T) {
+	_, err := Generate(newGeneratorQuerier(), &GenerateConfig{Domains: []*DomainFilter{{Domain: "test", IncludeAttributes: []string{"("}}}})
+	assert.EqualError(t, err, "invalid generate config: domain 'test': include_attributes: regex '(': error parsing regexp: missing closing ): `^(?:()$`")
+
+	_, err = Generate(newGeneratorQuerier(), &GenerateConfig{TypeRules: []*TypeRule{{Regex: ".*", MetricType: "counter"}}})
+	assert.EqualError(t, err, "invalid generate config: type_rules[0] missing or unknown metric_type")
+
+	connErr := errors.New("connection lost")
+	q := newGeneratorQuerier()
+	q.errors["test:*"] = connErr
+	_, err = Generate(q, &GenerateConfig{})
+	assert.ErrorIs(t, err, connErr)
+}
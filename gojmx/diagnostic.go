@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Common jcmd commands that can be executed using Client.DiagnosticCommand.
+const (
+	DiagnosticCommandClassHistogram = "GC.class_histogram"
+	DiagnosticCommandVMFlags        = "VM.flags"
+	DiagnosticCommandThreadPrint    = "Thread.print"
+	DiagnosticCommandNativeMemory   = "VM.native_memory"
+	DiagnosticCommandSystemProps    = "VM.system_properties"
+	DiagnosticCommandVMVersion      = "VM.version"
+)
+
+// HistogramEntry is a line of the GC.class_histogram output.
+type HistogramEntry struct {
+	Rank      int
+	Instances int64
+	Bytes     int64
+	ClassName string
+	// Module is only reported by Java 9+, e.g. java.base@17.0.2
+	Module string
+}
+
+// histogramLine matches "   1:         12345         678900  [B (java.base@17.0.2)"
+var histogramLine = regexp.MustCompile(`^\s*(\d+):\s+(\d+)\s+(\d+)\s+(\S+)(?:\s+\((.*)\))?\s*$`)
+
+// ParseClassHistogram parses the GC.class_histogram command output.
+// The header and the Total lines are skipped.
+func ParseClassHistogram(output string) ([]HistogramEntry, error) {
+	var result []HistogramEntry
+
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || !startsWithDigit(trimmed) {
+			continue
+		}
+
+		matches := histogramLine.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, fmt.Errorf("cannot parse class histogram line: '%s'", trimmed)
+		}
+
+		rank, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse class histogram rank: '%s', error: %w", trimmed, err)
+		}
+		instances, err := strconv.ParseInt(matches[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse class histogram instances: '%s', error: %w", trimmed, err)
+		}
+		bytes, err := strconv.ParseInt(matches[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse class histogram bytes: '%s', error: %w", trimmed, err)
+		}
+
+		result = append(result, HistogramEntry{
+			Rank:      rank,
+			Instances: instances,
+			Bytes:     bytes,
+			ClassName: matches[4],
+			Module:    matches[5],
+		})
+	}
+
+	return result, nil
+}
+
+func startsWithDigit(s string) bool {
+	return len(s) > 0 && s[0] >= '0' && s[0] <= '9'
+}
+
+// ParseVMFlags parses the VM.flags command output into flag name and value.
+// Both the default command line format (-XX:+UseG1GC -XX:MaxHeapSize=268435456) and
+// the table format returned by "VM.flags -all" are supported. Boolean flags have "true" or "false" values.
+func ParseVMFlags(output string) map[string]string {
+	result := map[string]string{}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "[") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "-") {
+			for _, token := range strings.Fields(line) {
+				parseVMFlagOption(token, result)
+			}
+			continue
+		}
+
+		parseVMFlagRow(line, result)
+	}
+
+	return result
+}
+
+// parseVMFlagOption parses a command line flag: -XX:+Flag, -XX:-Flag or -XX:Flag=value
+func parseVMFlagOption(token string, output map[string]string) {
+	if !strings.HasPrefix(token, "-XX:") {
+		return
+	}
+	flag := strings.TrimPrefix(token, "-XX:")
+
+	switch {
+	case strings.HasPrefix(flag, "+"):
+		output[flag[1:]] = "true"
+	case strings.HasPrefix(flag, "-"):
+		output[flag[1:]] = "false"
+	default:
+		if i := strings.Index(flag, "="); i > 0 {
+			output[flag[:i]] = flag[i+1:]
+		}
+	}
+}
+
+// parseVMFlagRow parses a table row: "bool UseG1GC = true {product} {ergonomic}"
+func parseVMFlagRow(line string, output map[string]string) {
+	i := strings.Index(line, "=")
+	if i < 0 {
+		return
+	}
+
+	// Remove the flag type and the ":=" used by Java 8 for non default values.
+	nameFields := strings.Fields(strings.TrimSuffix(line[:i], ":"))
+	if len(nameFields) == 0 {
+		return
+	}
+	name := nameFields[len(nameFields)-1]
+
+	value := strings.TrimSpace(line[i+1:])
+	if j := strings.Index(value, "{"); j >= 0 {
+		value = strings.TrimSpace(value[:j])
+	}
+	output[name] = value
+}
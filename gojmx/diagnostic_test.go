@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseClassHistogram(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected []HistogramEntry
+	}{
+		{
+			name: "Java 17",
+			output: ` num     #instances         #bytes  class name (module)
+-------------------------------------------------------
+   1:         10885         928176  [B (java.base@17.0.2)
+   2:         10531         252744  java.lang.String (java.base@17.0.2)
+   3:            12            480  org.newrelic.jmx.Cat
+Total         21428        1181400
+`,
+			expected: []HistogramEntry{
+				{Rank: 1, Instances: 10885, Bytes: 928176, ClassName: "[B", Module: "java.base@17.0.2"},
+				{Rank: 2, Instances: 10531, Bytes: 252744, ClassName: "java.lang.String", Module: "java.base@17.0.2"},
+				{Rank: 3, Instances: 12, Bytes: 480, ClassName: "org.newrelic.jmx.Cat"},
+			},
+		},
+		{
+			name: "Java 8",
+			output: `
+ num     #instances         #bytes  class name
+----------------------------------------------
+   1:          4321         345680  [C
+   2:           120           2880  java.lang.Class
+Total          4441         348560
+`,
+			expected: []HistogramEntry{
+				{Rank: 1, Instances: 4321, Bytes: 345680, ClassName: "[C"},
+				{Rank: 2, Instances: 120, Bytes: 2880, ClassName: "java.lang.Class"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			entries, err := ParseClassHistogram(testCase.output)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, entries)
+		})
+	}
+
+	_, err := ParseClassHistogram("   1:  many  [B")
+	assert.Error(t, err)
+}
+
+func Test_ParseVMFlags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected map[string]string
+	}{
+		{
+			name:   "Command line format",
+			output: "-XX:CICompilerCount=3 -XX:MaxHeapSize=268435456 -XX:+UseG1GC -XX:-UseCompressedOops\n",
+			expected: map[string]string{
+				"CICompilerCount":   "3",
+				"MaxHeapSize":       "268435456",
+				"UseG1GC":           "true",
+				"UseCompressedOops": "false",
+			},
+		},
+		{
+			name: "Table format",
+			output: `[Global flags]
+     int ActiveProcessorCount                     = -1                                  {product} {default}
+    bool HeapDumpOnOutOfMemoryError               = false                            {manageable} {default}
+   ccstr HeapDumpPath                             =                                  {manageable} {default}
+   uintx MaxHeapSize                              := 268435456                          {product}
+`,
+			expected: map[string]string{
+				"ActiveProcessorCount":       "-1",
+				"HeapDumpOnOutOfMemoryError": "false",
+				"HeapDumpPath":               "",
+				"MaxHeapSize":                "268435456",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ParseVMFlags(testCase.output))
+		})
+	}
+}
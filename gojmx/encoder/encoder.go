@@ -0,0 +1,228 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package encoder serializes the attribute responses in the formats used by the CLI tools and pipelines.
+package encoder
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// Encoder writes the attribute responses in a format.
+// The structured formats (yaml, json, ndjson, csv) include the error responses, the metric formats
+// (influx, graphite, statsd) skip them together with the values they cannot represent.
+type Encoder interface {
+	Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error
+}
+
+var encoders = map[string]func() Encoder{
+	"yaml":     func() Encoder { return &YAML{} },
+	"json":     func() Encoder { return &JSON{} },
+	"ndjson":   func() Encoder { return &NDJSON{} },
+	"csv":      func() Encoder { return &CSV{} },
+	"influx":   func() Encoder { return &Influx{} },
+	"graphite": func() Encoder { return &Graphite{} },
+	"statsd":   func() Encoder { return &StatsD{} },
+}
+
+// New returns the built-in encoder with the given name, e.g. json.
+func New(name string) (Encoder, error) {
+	newEncoder, ok := encoders[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown encoder: '%s', valid: %s", name, strings.Join(Names(), ", "))
+	}
+	return newEncoder(), nil
+}
+
+// Names returns the names of the built-in encoders sorted alphabetically.
+func Names() []string {
+	names := make([]string, 0, len(encoders))
+	for name := range encoders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Record is the representation of an attribute response shared by the encoders.
+// Composite attributes keep the field in the attribute name, e.g. HeapMemoryUsage.Used
+type Record struct {
+	MBean     string `json:"mbean"`
+	Domain    string `json:"domain"`
+	Attribute string `json:"attribute"`
+	// Type is string, double, int, bool or error.
+	Type string `json:"type"`
+	// Value is encoded as a JSON string for the doubles that are not finite, "NaN", "+Inf" or "-Inf",
+	// because JSON has no representation for them.
+	Value interface{} `json:"value,omitempty"`
+	// Error and ErrorKind are set for the error responses.
+	Error     string `json:"error,omitempty"`
+	ErrorKind string `json:"error_kind,omitempty"`
+
+	objectName *gojmx.ObjectName
+}
+
+// NewRecord converts an attribute response. It returns false when the response name cannot be parsed.
+func NewRecord(attr *gojmx.AttributeResponse) (*Record, bool) {
+	if attr == nil {
+		return nil, false
+	}
+
+	mBeanName, attrName, ok := gojmx.SplitAttributeName(attr.Name)
+	if !ok {
+		return nil, false
+	}
+	objectName, err := gojmx.ParseObjectName(mBeanName)
+	if err != nil {
+		return nil, false
+	}
+
+	record := &Record{
+		MBean:      mBeanName,
+		Domain:     objectName.Domain,
+		Attribute:  attrName,
+		Type:       strings.ToLower(attr.ResponseType.String()),
+		objectName: objectName,
+	}
+
+	if attr.ResponseType == gojmx.ResponseTypeErr {
+		record.Error = attr.StatusMsg
+		if attrErr := attr.GetAttributeError(); attrErr != nil {
+			record.ErrorKind = strings.ToLower(attrErr.Kind.String())
+		}
+		return record, true
+	}
+
+	record.Value = attr.GetValue()
+	return record, true
+}
+
+// MarshalJSON encodes the non-finite double values as strings.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	type record Record
+	encoded := *(*record)(r)
+	if v, ok := r.Value.(float64); ok && (math.IsNaN(v) || math.IsInf(v, 0)) {
+		encoded.Value = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return json.Marshal(&encoded)
+}
+
+// IsError returns true for the error responses.
+func (r *Record) IsError() bool {
+	return r.Type == "error"
+}
+
+// Float64 returns the record value as float64 when it's numeric or bool.
+func (r *Record) Float64() (float64, bool) {
+	switch v := r.Value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// Records converts the attribute responses skipping the ones whose name cannot be parsed.
+func Records(attrs []*gojmx.AttributeResponse) []*Record {
+	result := make([]*Record, 0, len(attrs))
+	for _, attr := range attrs {
+		if record, ok := NewRecord(attr); ok {
+			result = append(result, record)
+		}
+	}
+	return result
+}
+
+// YAML writes the nri-jmx collection definition template of gojmx.FormatJMXAttributes.
+type YAML struct{}
+
+func (e *YAML) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	_, err := io.WriteString(w, gojmx.FormatJMXAttributes(attrs))
+	return err
+}
+
+// JSON writes a JSON array of records.
+type JSON struct {
+	// Indent pretty prints the array when not empty.
+	Indent string
+}
+
+func (e *JSON) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", e.Indent)
+	return encoder.Encode(Records(attrs))
+}
+
+// NDJSON writes a JSON record per line.
+type NDJSON struct{}
+
+func (e *NDJSON) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	encoder := json.NewEncoder(w)
+	for _, record := range Records(attrs) {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CSV writes the records with the header: mbean,attribute,type,value,error,error_kind
+type CSV struct {
+	// NoHeader skips the header line, useful for appending to an existing file.
+	NoHeader bool
+}
+
+func (e *CSV) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	writer := csv.NewWriter(w)
+	if !e.NoHeader {
+		if err := writer.Write([]string{"mbean", "attribute", "type", "value", "error", "error_kind"}); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range Records(attrs) {
+		value := ""
+		if !record.IsError() {
+			value = formatValue(record.Value)
+		}
+		if err := writer.Write([]string{record.MBean, record.Attribute, record.Type, value, record.Error, record.ErrorKind}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
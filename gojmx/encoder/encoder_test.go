@@ -0,0 +1,175 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package encoder
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAttrs = []*gojmx.AttributeResponse{
+	{
+		Name:         "java.lang:type=Memory,attr=HeapMemoryUsage.Used",
+		ResponseType: nrprotocol.ResponseType_INT,
+		IntValue:     1024,
+	},
+	{
+		Name:         "java.lang:type=Memory,attr=ObjectPendingFinalizationCount",
+		ResponseType: nrprotocol.ResponseType_DOUBLE,
+		DoubleValue:  0.5,
+	},
+	{
+		Name:         "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=Valid",
+		ResponseType: nrprotocol.ResponseType_BOOL,
+		BoolValue:    true,
+	},
+	{
+		Name:         "test:type=Cat,name=tomas,attr=Name",
+		ResponseType: nrprotocol.ResponseType_STRING,
+		StringValue:  `tomas "the cat", jr`,
+	},
+	{
+		Name:         "test:type=Cat,name=tomas,attr=Missing",
+		ResponseType: nrprotocol.ResponseType_ERROR,
+		StatusMsg:    "can't get attribute",
+		Error:        &nrprotocol.AttributeError{Kind: nrprotocol.ErrorKind_ATTRIBUTE_NOT_FOUND},
+	},
+	{
+		Name:         "invalid name",
+		ResponseType: nrprotocol.ResponseType_INT,
+		IntValue:     1,
+	},
+}
+
+var testNow = func() time.Time { return time.Unix(1700000000, 0) }
+
+func Test_Encoders(t *testing.T) {
+	testCases := []struct {
+		name     string
+		encoder  Encoder
+		expected string
+	}{
+		{
+			name:    "NDJSON",
+			encoder: &NDJSON{},
+			expected: `{"mbean":"java.lang:type=Memory","domain":"java.lang","attribute":"HeapMemoryUsage.Used","type":"int","value":1024}
+{"mbean":"java.lang:type=Memory","domain":"java.lang","attribute":"ObjectPendingFinalizationCount","type":"double","value":0.5}
+{"mbean":"java.lang:type=GarbageCollector,name=G1 Young Generation","domain":"java.lang","attribute":"Valid","type":"bool","value":true}
+{"mbean":"test:type=Cat,name=tomas","domain":"test","attribute":"Name","type":"string","value":"tomas \"the cat\", jr"}
+{"mbean":"test:type=Cat,name=tomas","domain":"test","attribute":"Missing","type":"error","error":"can't get attribute","error_kind":"attribute_not_found"}
+`,
+		},
+		{
+			name:    "CSV",
+			encoder: &CSV{},
+			expected: `mbean,attribute,type,value,error,error_kind
+java.lang:type=Memory,HeapMemoryUsage.Used,int,1024,,
+java.lang:type=Memory,ObjectPendingFinalizationCount,double,0.5,,
+"java.lang:type=GarbageCollector,name=G1 Young Generation",Valid,bool,true,,
+"test:type=Cat,name=tomas",Name,string,"tomas ""the cat"", jr",,
+"test:type=Cat,name=tomas",Missing,error,,can't get attribute,attribute_not_found
+`,
+		},
+		{
+			name:    "Influx",
+			encoder: &Influx{Now: testNow},
+			expected: `java.lang,type=Memory HeapMemoryUsage.Used=1024i,ObjectPendingFinalizationCount=0.5 1700000000000000000
+java.lang,type=GarbageCollector,name=G1\ Young\ Generation Valid=true 1700000000000000000
+test,type=Cat,name=tomas Name="tomas \"the cat\", jr" 1700000000000000000
+`,
+		},
+		{
+			name:    "Graphite",
+			encoder: &Graphite{Prefix: "jmx", Now: testNow},
+			expected: `jmx.java_lang.Memory.HeapMemoryUsage.Used 1024 1700000000
+jmx.java_lang.Memory.ObjectPendingFinalizationCount 0.5 1700000000
+jmx.java_lang.GarbageCollector.G1_Young_Generation.Valid 1 1700000000
+`,
+		},
+		{
+			name:    "StatsD",
+			encoder: &StatsD{},
+			expected: `java_lang.Memory.HeapMemoryUsage.Used:1024|g
+java_lang.Memory.ObjectPendingFinalizationCount:0.5|g
+java_lang.GarbageCollector.G1_Young_Generation.Valid:1|g
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, testCase.encoder.Encode(buf, testAttrs))
+			assert.Equal(t, testCase.expected, buf.String())
+		})
+	}
+}
+
+func Test_JSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, (&JSON{}).Encode(buf, testAttrs[:1]))
+	assert.Equal(t, `[{"mbean":"java.lang:type=Memory","domain":"java.lang","attribute":"HeapMemoryUsage.Used","type":"int","value":1024}]`+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, (&JSON{}).Encode(buf, nil))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func Test_JSON_NonFinite(t *testing.T) {
+	attrs := []*gojmx.AttributeResponse{
+		{Name: "test:type=Cat,attr=NaN", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.NaN()},
+		{Name: "test:type=Cat,attr=Inf", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.Inf(1)},
+		{Name: "test:type=Cat,attr=NegInf", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.Inf(-1)},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, (&NDJSON{}).Encode(buf, attrs))
+	assert.Equal(t, `{"mbean":"test:type=Cat","domain":"test","attribute":"NaN","type":"double","value":"NaN"}
+{"mbean":"test:type=Cat","domain":"test","attribute":"Inf","type":"double","value":"+Inf"}
+{"mbean":"test:type=Cat","domain":"test","attribute":"NegInf","type":"double","value":"-Inf"}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, (&JSON{}).Encode(buf, attrs[:1]))
+	assert.Equal(t, `[{"mbean":"test:type=Cat","domain":"test","attribute":"NaN","type":"double","value":"NaN"}]`+"\n", buf.String())
+
+	// The record keeps the double value for the metric encoders.
+	record, ok := NewRecord(attrs[1])
+	require.True(t, ok)
+	value, ok := record.Float64()
+	assert.True(t, ok)
+	assert.True(t, math.IsInf(value, 1))
+}
+
+func Test_StatsD_NegativeGauge(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, (&StatsD{}).Encode(buf, []*gojmx.AttributeResponse{
+		{
+			Name:         "test:type=Cat,attr=Delta",
+			ResponseType: nrprotocol.ResponseType_INT,
+			IntValue:     -3,
+		},
+	}))
+	assert.Equal(t, "test.Cat.Delta:0|g\ntest.Cat.Delta:-3|g\n", buf.String())
+}
+
+func Test_New(t *testing.T) {
+	assert.Equal(t, []string{"csv", "graphite", "influx", "json", "ndjson", "statsd", "yaml"}, Names())
+
+	e, err := New("JSON")
+	require.NoError(t, err)
+	assert.IsType(t, &JSON{}, e)
+
+	_, err = New("xml")
+	assert.EqualError(t, err, "unknown encoder: 'xml', valid: csv, graphite, influx, json, ndjson, statsd, yaml")
+}
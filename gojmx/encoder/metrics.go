@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package encoder
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// Influx writes the InfluxDB line protocol. Each mBean is a line with the domain as measurement,
+// the key properties as tags and the attributes as fields.
+type Influx struct {
+	// Now returns the timestamp of the lines, time.Now when not defined.
+	Now func() time.Time
+}
+
+func (e *Influx) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	timestamp := strconv.FormatInt(now(e.Now).UnixNano(), 10)
+
+	var mBeans []string
+	fields := map[string][]string{}
+	records := map[string]*Record{}
+	for _, record := range Records(attrs) {
+		if record.IsError() {
+			continue
+		}
+		if _, ok := records[record.MBean]; !ok {
+			mBeans = append(mBeans, record.MBean)
+			records[record.MBean] = record
+		}
+		fields[record.MBean] = append(fields[record.MBean], influxEscaper.Replace(record.Attribute)+"="+influxValue(record.Value))
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, mBean := range mBeans {
+		record := records[mBean]
+
+		bw.WriteString(influxMeasurementEscaper.Replace(record.Domain))
+		for _, key := range record.objectName.Keys() {
+			value := record.objectName.Get(key)
+			if value == "" {
+				continue
+			}
+			bw.WriteString("," + influxEscaper.Replace(key) + "=" + influxEscaper.Replace(value))
+		}
+		bw.WriteString(" " + strings.Join(fields[mBean], ",") + " " + timestamp + "\n")
+	}
+	return bw.Flush()
+}
+
+var (
+	influxMeasurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	influxEscaper            = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	influxStringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+func influxValue(value interface{}) string {
+	switch v := value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10) + "i"
+	case string:
+		return `"` + influxStringEscaper.Replace(v) + `"`
+	default:
+		return formatValue(v)
+	}
+}
+
+// Graphite writes the Graphite plaintext protocol: path value timestamp
+// The path is built with the domain, the key property values and the attribute, e.g.
+// java_lang.GarbageCollector.G1_Young_Generation.CollectionCount
+type Graphite struct {
+	// Prefix is prepended to the paths.
+	Prefix string
+	// Now returns the timestamp of the lines, time.Now when not defined.
+	Now func() time.Time
+}
+
+func (e *Graphite) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	timestamp := strconv.FormatInt(now(e.Now).Unix(), 10)
+
+	bw := bufio.NewWriter(w)
+	for _, record := range Records(attrs) {
+		value, ok := record.Float64()
+		if !ok || record.IsError() {
+			continue
+		}
+		bw.WriteString(metricPath(e.Prefix, record) + " " + strconv.FormatFloat(value, 'f', -1, 64) + " " + timestamp + "\n")
+	}
+	return bw.Flush()
+}
+
+// StatsD writes a StatsD gauge for each numeric attribute: path:value|g
+// The path is built like the Graphite one.
+type StatsD struct {
+	// Prefix is prepended to the paths.
+	Prefix string
+}
+
+func (e *StatsD) Encode(w io.Writer, attrs []*gojmx.AttributeResponse) error {
+	bw := bufio.NewWriter(w)
+	for _, record := range Records(attrs) {
+		value, ok := record.Float64()
+		if !ok || record.IsError() {
+			continue
+		}
+		// Negative values would be taken as a decrement of the gauge, they're reset to 0 first.
+		if value < 0 {
+			bw.WriteString(metricPath(e.Prefix, record) + ":0|g\n")
+		}
+		bw.WriteString(metricPath(e.Prefix, record) + ":" + strconv.FormatFloat(value, 'f', -1, 64) + "|g\n")
+	}
+	return bw.Flush()
+}
+
+// metricPath builds a dot separated path with the domain, the key property values and the attribute.
+// Composite attributes keep their fields as path segments.
+func metricPath(prefix string, record *Record) string {
+	var segments []string
+	if prefix != "" {
+		segments = append(segments, prefix)
+	}
+	segments = append(segments, sanitizeSegment(record.Domain))
+	for _, key := range record.objectName.Keys() {
+		segments = append(segments, sanitizeSegment(record.objectName.Get(key)))
+	}
+	for _, field := range strings.Split(record.Attribute, ".") {
+		segments = append(segments, sanitizeSegment(field))
+	}
+	return strings.Join(segments, ".")
+}
+
+// sanitizeSegment replaces the characters with special meaning for Graphite and StatsD.
+func sanitizeSegment(segment string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' {
+			return c
+		}
+		return '_'
+	}, segment)
+}
+
+func now(clock func() time.Time) time.Time {
+	if clock == nil {
+		return time.Now()
+	}
+	return clock()
+}
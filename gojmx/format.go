@@ -45,15 +45,14 @@ type attributeFormat struct {
 // FormatJMXAttributes will prettify JMXAttributes.
 func FormatJMXAttributes(attrs []*AttributeResponse) string {
 	result := map[string]queryFormat{}
-	separator := ",attr="
 
 	for _, attr := range attrs {
-		i := strings.LastIndex(attr.Name, separator)
-		if i < 0 {
+		mBeanName, attrName, ok := SplitAttributeName(attr.Name)
+		if !ok {
 			continue
 		}
 
-		split := strings.SplitN(attr.Name[:i], ":", 2)
+		split := strings.SplitN(mBeanName, ":", 2)
 		if len(split) != 2 {
 			continue
 		}
@@ -65,7 +64,7 @@ func FormatJMXAttributes(attrs []*AttributeResponse) string {
 		}
 
 		result[domain][query] = append(result[domain][query], attributeFormat{
-			Attribute: attr.Name[i+len(separator):],
+			Attribute: attrName,
 			Value:     attr.GetValue(),
 			ValueType: fmt.Sprintf("%v", attr.ResponseType),
 		})
@@ -7,6 +7,7 @@ package gojmx
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/apache/thrift/lib/go/thrift"
@@ -31,6 +32,12 @@ type Client struct {
 	nrJMXProcess *process
 	ctx          context.Context
 	version      string
+	// recordWriter receives the calls to nrjmx when defined, see RecordTo.
+	recordWriter io.Writer
+	// replay serves the recorded calls instead of the nrjmx subprocess, see NewReplayClient.
+	replay *replayService
+	// callStats measures the requests to nrjmx, see GetCallStats.
+	callStats *callStats
 }
 
 // NewClient returns a JMX client.
@@ -39,11 +46,16 @@ func NewClient(ctx context.Context) *Client {
 		ctx:          ctx,
 		version:      unknownNRJMXVersion,
 		nrJMXProcess: newProcess(ctx),
+		callStats:    newCallStats(),
 	}
 }
 
 // Open will create the connection the the JMX endpoint.
 func (c *Client) Open(config *JMXConfig) (client *Client, err error) {
+	if c.replay != nil {
+		return c.openReplay(config)
+	}
+
 	c.nrJMXProcess, err = newProcess(c.ctx).start()
 	if err != nil {
 		return c, err
@@ -61,6 +73,14 @@ func (c *Client) Open(config *JMXConfig) (client *Client, err error) {
 		return c, err
 	}
 
+	if c.recordWriter != nil {
+		c.jmxService = newRecordingService(c.jmxService, c.recordWriter, c.version)
+	}
+
+	if config != nil && config.EnableInternalStats {
+		c.callStats.enable(config.MaxInternalStatsSize)
+	}
+
 	return c, c.connect(config)
 }
 
@@ -101,6 +121,15 @@ func (c *Client) GetMBeanAttributeNames(mBeanName string) ([]string, error) {
 	return result, c.handleError(err)
 }
 
+// GetMBeanInfo returns the metadata of an mBean: its attributes, operations and their descriptions.
+func (c *Client) GetMBeanInfo(mBeanName string) (*MBeanInfo, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetMBeanInfo(c.ctx, mBeanName)
+	return (*MBeanInfo)(result), c.handleError(err)
+}
+
 // GetMBeanAttributes returns the JMX attribute values.
 func (c *Client) GetMBeanAttributes(mBeanName string, mBeanAttrName ...string) ([]*AttributeResponse, error) {
 	if err := c.checkNRJMXProccessError(); err != nil {
@@ -142,10 +171,72 @@ func (c *Client) QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...
 	return toAttributeResponseList(result), c.handleError(err)
 }
 
+// GetDomains returns the domains in which there are registered mBeans, sorted alphabetically.
+func (c *Client) GetDomains() ([]string, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetDomains(c.ctx)
+	return result, c.handleError(err)
+}
+
+// GetMBeanCount returns the number of mbeans that match the glob pattern DOMAIN:BEAN.
+// e.g *:* or java.lang:type=GarbageCollector,*
+// An empty pattern returns the total number of registered mbeans.
+func (c *Client) GetMBeanCount(mBeanGlobPattern string) (int64, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return 0, err
+	}
+	result, err := c.jmxService.GetMBeanCount(c.ctx, mBeanGlobPattern)
+	return result, c.handleError(err)
+}
+
+// ServerInfo returns the JMX server metadata reported by the MBeanServerDelegate
+// together with the JMXConnector connection id.
+func (c *Client) ServerInfo() (*ServerInfo, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetServerInfo(c.ctx)
+	return (*ServerInfo)(result), c.handleError(err)
+}
+
+// ThreadDump returns the state and stack trace of all the live threads using the ThreadMXBean.dumpAllThreads operation.
+// Use FormatThreadDump to get the jstack text format.
+func (c *Client) ThreadDump(opts ThreadDumpOptions) ([]*ThreadInfo, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.ThreadDump(c.ctx, (*nrprotocol.ThreadDumpOptions)(&opts))
+	return toThreadInfoList(result), c.handleError(err)
+}
+
+// FindDeadlocks returns the threads that are deadlocked waiting for monitors or ownable synchronizers
+// using the ThreadMXBean.findDeadlockedThreads operation. An empty result means no deadlock was found.
+func (c *Client) FindDeadlocks() ([]*ThreadInfo, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.FindDeadlocks(c.ctx)
+	return toThreadInfoList(result), c.handleError(err)
+}
+
+// DiagnosticCommand executes a jcmd command, e.g. GC.class_histogram or VM.flags, using the HotSpot
+// com.sun.management:type=DiagnosticCommand MBean and returns its text output.
+// Use ParseClassHistogram and ParseVMFlags to parse the output of the common commands.
+func (c *Client) DiagnosticCommand(name string, args ...string) (string, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return "", err
+	}
+	result, err := c.jmxService.DiagnosticCommand(c.ctx, name, args)
+	return result, c.handleError(err)
+}
+
 // GetInternalStats returns the nrjmx internal query statistics for troubleshooting.
 // Internal statistics must be enabled using JMXConfig.EnableInternalStats flag.
 // Additionally you can set a maximum size for the collected stats using JMXConfig.MaxInternalStatsSize. (default: 100000)
-// Each time you retrieve GetInternalStats, the internal stats will be cleaned.
+// Each time you retrieve GetInternalStats, the internal stats will be cleaned, so when several consumers need them
+// they must share the retrieved stats, e.g. JoinCallStats joins them with the GetCallStats of the same requests.
 func (c *Client) GetInternalStats() (InternalStatsList, error) {
 	if err := c.checkNRJMXProccessError(); err != nil {
 		return nil, err
@@ -199,7 +290,7 @@ func (c *Client) ping(timeout time.Duration) (string, error) {
 // configureJMXServiceClient will configure the thrift service to communicate via stdin/stdout.
 func (c *Client) configureJMXServiceClient() (*nrprotocol.JMXServiceClient, error) {
 	var transport thrift.TTransport
-	transport = thrift.NewStreamTransport(c.nrJMXProcess.Stdout, c.nrJMXProcess.Stdin)
+	transport = thrift.NewStreamTransport(c.callStats.reader(c.nrJMXProcess.Stdout), c.callStats.writer(c.nrJMXProcess.Stdin))
 
 	var protocolFactory thrift.TProtocolFactory
 	protocolFactory = thrift.NewTCompactProtocolFactory()
@@ -213,8 +304,8 @@ func (c *Client) configureJMXServiceClient() (*nrprotocol.JMXServiceClient, erro
 		return nil, err
 	}
 
-	inputProtocol := protocolFactory.GetProtocol(transport)
-	outputProtocol := protocolFactory.GetProtocol(transport)
+	inputProtocol := &instrumentedProtocol{TProtocol: protocolFactory.GetProtocol(transport), stats: c.callStats}
+	outputProtocol := &instrumentedProtocol{TProtocol: protocolFactory.GetProtocol(transport), stats: c.callStats}
 	jmxServiceClient := nrprotocol.NewJMXServiceClient(
 		thrift.NewTStandardClient(inputProtocol, outputProtocol),
 	)
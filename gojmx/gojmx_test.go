@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
 	"github.com/newrelic/nrjmx/gojmx/internal/testutils"
 	gopsutil "github.com/shirou/gopsutil/v3/process"
 	"github.com/stretchr/testify/assert"
@@ -259,6 +260,12 @@ func Test_Query_Exception_Success(t *testing.T) {
 			Name:         "test:type=ExceptionalCat,name=tomas,attr=NotSerializable",
 			StatusMsg:    "can't get attribute, error: 'can't get attribute: NotSerializable for bean: test:type=ExceptionalCat,name=tomas: ', cause: 'error unmarshalling return; nested exception is: \n\tjava.io.WriteAbortedException: writing aborted; java.io.NotSerializableException: org.newrelic.jmx.ExceptionalCat$NotSerializable', stacktrace: ''",
 			ResponseType: ResponseTypeErr,
+			Error: &nrprotocol.AttributeError{
+				Kind:           ErrorKindSerialization,
+				ExceptionClass: "java.rmi.UnmarshalException",
+				CauseClass:     "java.io.NotSerializableException",
+				CauseMessage:   "org.newrelic.jmx.ExceptionalCat$NotSerializable",
+			},
 		},
 	}
 
@@ -366,6 +373,9 @@ func Test_QueryMBean_Success(t *testing.T) {
 					Name:         "test:type=Cat,name=tomas,attr=DateValue",
 					ResponseType: ResponseTypeErr,
 					StatusMsg:    `can't parse attribute, error: 'found a null value for bean: test:type=Cat,name=tomas,attr=DateValue', cause: 'null', stacktrace: 'null'`,
+					Error: &nrprotocol.AttributeError{
+						Kind: ErrorKindNullValue,
+					},
 				},
 			},
 		},
@@ -450,6 +460,591 @@ func Test_Query_CompositeData(t *testing.T) {
 	assert.ElementsMatch(t, expected, actual)
 }
 
+func Test_Domains_MBeanCount_ServerInfo(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	// Populate the JMX Server with mbeans
+	resp, err := testutils.AddMBeansBatch(ctx, container, []map[string]interface{}{
+		{"name": "tomas", "doubleValue": 1.2},
+		{"name": "felix", "doubleValue": 2.2},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok!\n", string(resp))
+
+	defer testutils.CleanMBeans(ctx, container)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND domains are listed
+	domains, err := client.GetDomains()
+	require.NoError(t, err)
+	assert.Subset(t, domains, []string{"JMImplementation", "java.lang", "test"})
+
+	// AND mbeans are counted
+	count, err := client.GetMBeanCount("test:type=Cat,*")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	total, err := client.GetMBeanCount("")
+	require.NoError(t, err)
+	allNames, err := client.QueryMBeanNames("*:*")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(allNames)), total)
+
+	// AND server info is returned
+	serverInfo, err := client.ServerInfo()
+	require.NoError(t, err)
+	assert.NotEmpty(t, serverInfo.ImplementationName)
+	assert.NotEmpty(t, serverInfo.ImplementationVersion)
+	assert.NotEmpty(t, serverInfo.MBeanServerId)
+	assert.NotEmpty(t, serverInfo.ConnectionId)
+	assert.Equal(t, "DefaultDomain", serverInfo.DefaultDomain)
+}
+
+func Test_ThreadDump_FindDeadlocks(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND the threads are dumped with limited stack depth
+	threads, err := client.ThreadDump(ThreadDumpOptions{LockedMonitors: true, LockedSynchronizers: true, MaxDepth: 3})
+	require.NoError(t, err)
+	require.NotEmpty(t, threads)
+
+	var names []string
+	for _, thread := range threads {
+		names = append(names, thread.ThreadName)
+		assert.LessOrEqual(t, len(thread.GetStackTrace()), 3)
+	}
+	assert.Contains(t, names, "main")
+	assert.Contains(t, FormatThreadDump(threads), "\"main\" #1")
+
+	// AND no deadlocks are found
+	deadlocked, err := client.FindDeadlocks()
+	require.NoError(t, err)
+	assert.Empty(t, deadlocked)
+}
+
+func Test_DiagnosticCommand(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	// Populate the JMX Server with mbeans
+	resp, err := testutils.AddMBeansBatch(ctx, container, []map[string]interface{}{
+		{"name": "tomas", "doubleValue": 1.2},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok!\n", string(resp))
+
+	defer testutils.CleanMBeans(ctx, container)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND the class histogram is returned
+	output, err := client.DiagnosticCommand(DiagnosticCommandClassHistogram)
+	require.NoError(t, err)
+
+	histogram, err := ParseClassHistogram(output)
+	require.NoError(t, err)
+
+	var classes []string
+	for _, entry := range histogram {
+		classes = append(classes, entry.ClassName)
+	}
+	assert.Contains(t, classes, "org.newrelic.jmx.Cat")
+
+	// AND the vm flags are returned
+	output, err = client.DiagnosticCommand(DiagnosticCommandVMFlags, "-all")
+	require.NoError(t, err)
+	assert.Contains(t, ParseVMFlags(output), "HeapDumpOnOutOfMemoryError")
+
+	// AND unknown commands fail
+	_, err = client.DiagnosticCommand("Unknown.command")
+	jmxErr, ok := IsJMXError(err)
+	require.True(t, ok)
+	assert.Contains(t, jmxErr.Message, "diagnostic command not available")
+}
+
+func Test_FlightRecording(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND a recording can be started and stopped
+	id, err := client.StartRecording(RecordingSettings{
+		Name:          "gojmx-test",
+		Configuration: "default",
+		Settings:      map[string]string{"jdk.CPULoad#period": "1 s"},
+	})
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Second)
+
+	require.NoError(t, client.StopRecording(id))
+
+	// AND the recording is downloaded using the JFR file format
+	stream, err := client.StreamRecording(id)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+
+	require.Greater(t, len(data), 4)
+	assert.Equal(t, []byte("FLR\x00"), data[:4])
+
+	assert.NoError(t, client.CloseRecording(id))
+
+	// AND unknown recordings fail
+	_, err = client.StreamRecording(id)
+	_, ok := IsJMXError(err)
+	assert.True(t, ok)
+}
+
+func Test_VMOptions_DumpHeap(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND all the vm options are listed
+	options, err := client.VMOptions()
+	require.NoError(t, err)
+
+	writeable := map[string]bool{}
+	for _, option := range options {
+		assert.NotEmpty(t, option.Origin)
+		writeable[option.Name] = option.Writeable
+	}
+	assert.True(t, writeable["HeapDumpOnOutOfMemoryError"])
+	assert.Contains(t, writeable, "UseCompressedOops")
+	assert.False(t, writeable["UseCompressedOops"])
+
+	// AND a manageable flag can be toggled
+	require.NoError(t, client.SetVMOption("HeapDumpOnOutOfMemoryError", "true"))
+
+	option, err := client.VMOption("HeapDumpOnOutOfMemoryError")
+	require.NoError(t, err)
+	assert.Equal(t, "true", option.Value)
+	assert.Equal(t, "MANAGEMENT", option.Origin)
+
+	// AND non writeable options cannot be changed
+	err = client.SetVMOption("MaxHeapSize", "1")
+	_, ok := IsJMXError(err)
+	assert.True(t, ok)
+
+	// AND the heap is dumped
+	require.NoError(t, client.DumpHeap("/tmp/gojmx-test.hprof", true, time.Minute))
+
+	// AND dumping into an existing file fails
+	err = client.DumpHeap("/tmp/gojmx-test.hprof", true, time.Minute)
+	var heapDumpErr *HeapDumpError
+	require.ErrorAs(t, err, &heapDumpErr)
+	assert.Equal(t, HeapDumpErrorFileExists, heapDumpErr.Kind)
+
+	// AND dumping into a file without hprof extension fails
+	err = client.DumpHeap("/tmp/gojmx-test.bin", true, time.Minute)
+	require.ErrorAs(t, err, &heapDumpErr)
+	assert.Equal(t, HeapDumpErrorInvalidPath, heapDumpErr.Kind)
+}
+
+func Test_InvokeOperation(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND void operations return no responses
+	result, err := client.InvokeOperation("java.lang:type=Memory", "gc")
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	// AND the arguments are converted to the resolved signature
+	result, err = client.InvokeOperation("com.sun.management:type=HotSpotDiagnostic", "getVMOption", "HeapDumpOnOutOfMemoryError")
+	require.NoError(t, err)
+
+	values := map[string]interface{}{}
+	for _, attr := range result {
+		values[attr.Name] = attr.GetValue()
+	}
+	assert.Equal(t, "HeapDumpOnOutOfMemoryError", values["com.sun.management:type=HotSpotDiagnostic,attr=getVMOption.Name"])
+
+	// AND overloaded operations require the signature
+	_, err = client.InvokeOperation("java.lang:type=Threading", "getThreadCpuTime", "1")
+	jmxErr, ok := IsJMXError(err)
+	require.True(t, ok)
+	assert.Contains(t, jmxErr.Message, "overloaded")
+
+	result, err = client.InvokeOperationWithSignature("java.lang:type=Threading", "getThreadCpuTime", []string{"1"}, []string{"long"})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "java.lang:type=Threading,attr=getThreadCpuTime", result[0].Name)
+	assert.Equal(t, ResponseTypeInt, result[0].ResponseType)
+
+	// AND invalid arguments are reported
+	_, err = client.InvokeOperationWithSignature("java.lang:type=Threading", "getThreadCpuTime", []string{"abc"}, []string{"long"})
+	_, ok = IsJMXError(err)
+	assert.True(t, ok)
+}
+
+func Test_GetMBeanInfo(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND the mBean metadata is returned
+	info, err := client.GetMBeanInfo("java.lang:type=Memory")
+	require.NoError(t, err)
+	assert.Equal(t, "java.lang:type=Memory", info.Name)
+
+	heap := info.GetAttribute("HeapMemoryUsage")
+	require.NotNil(t, heap)
+	assert.Equal(t, "javax.management.openmbean.CompositeData", heap.Type)
+	assert.True(t, heap.Readable)
+	assert.False(t, heap.Writable)
+
+	verbose := info.GetAttribute("Verbose")
+	require.NotNil(t, verbose)
+	assert.True(t, verbose.Writable)
+
+	var operations []string
+	for _, operation := range info.GetOperations() {
+		operations = append(operations, operation.Name)
+	}
+	assert.Contains(t, operations, "gc")
+
+	// AND requesting a missing mBean fails
+	_, err = client.GetMBeanInfo("java.lang:type=Missing")
+	_, ok := IsJMXError(err)
+	assert.True(t, ok)
+}
+
+func Test_RecordReplay(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	config := &JMXConfig{
+		Hostname:         jmxHost,
+		Port:             int32(jmxPort.Int()),
+		RequestTimeoutMs: testutils.DefaultTimeoutMs,
+	}
+
+	// WHEN the client requests are recorded
+	recording := &bytes.Buffer{}
+	client := NewClient(ctx)
+	client.RecordTo(recording)
+
+	client, err = client.Open(config)
+	require.NoError(t, err)
+
+	names, err := client.QueryMBeanNames("java.lang:type=*")
+	require.NoError(t, err)
+	response, err := client.QueryMBeanAttributes("java.lang:type=Runtime", "VmName", "Missing")
+	require.NoError(t, err)
+	_, err = client.GetMBeanInfo("java.lang:type=Missing")
+	require.Error(t, err)
+
+	version := client.GetClientVersion()
+	assertCloseClientNoError(t, client)
+
+	// THEN the recording serves the same responses without the JMX Server
+	replayClient, err := NewReplayClient(ctx, bytes.NewReader(recording.Bytes()))
+	require.NoError(t, err)
+
+	replayClient, err = replayClient.Open(&JMXConfig{})
+	require.NoError(t, err)
+	defer assertCloseClientNoError(t, replayClient)
+
+	assert.Equal(t, version, replayClient.GetClientVersion())
+
+	replayNames, err := replayClient.QueryMBeanNames("java.lang:type=*")
+	require.NoError(t, err)
+	assert.Equal(t, names, replayNames)
+
+	replayResponse, err := replayClient.QueryMBeanAttributes("java.lang:type=Runtime", "VmName", "Missing")
+	require.NoError(t, err)
+	assert.Equal(t, response, replayResponse)
+
+	_, err = replayClient.GetMBeanInfo("java.lang:type=Missing")
+	_, ok := IsJMXError(err)
+	assert.True(t, ok)
+}
+
+func Test_DiscoveryCache(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	// Populate the JMX Server with mbeans
+	resp, err := testutils.AddMBeans(ctx, container, map[string]interface{}{
+		"name":        "tomas",
+		"doubleValue": 1.2,
+		"floatValue":  2.2222222,
+		"numberValue": 3,
+		"boolValue":   true,
+		"dateValue":   timeStamp,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok!\n", string(resp))
+
+	defer testutils.CleanMBeans(ctx, container)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened with the discovery cache enabled
+	config := &JMXConfig{
+		Hostname:            jmxHost,
+		Port:                int32(jmxPort.Int()),
+		RequestTimeoutMs:    testutils.DefaultTimeoutMs,
+		EnableInternalStats: true,
+		DiscoveryCacheTtlMs: 60000,
+	}
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	response, err := client.QueryMBeanAttributes("test:type=Cat,*")
+	require.NoError(t, err)
+	assert.Len(t, response, 6)
+
+	_, err = client.GetInternalStats()
+	require.NoError(t, err)
+
+	// AND the next query only requests the attribute values
+	response, err = client.QueryMBeanAttributes("test:type=Cat,*")
+	require.NoError(t, err)
+	assert.Len(t, response, 6)
+
+	internalStats, err := client.GetInternalStats()
+	require.NoError(t, err)
+	require.Len(t, internalStats, 1)
+	assert.Equal(t, "getAttributes", internalStats[0].StatType)
+
+	// AND a registered mBean invalidates the cached query
+	resp, err = testutils.AddMBeans(ctx, container, map[string]interface{}{
+		"name":        "felix",
+		"doubleValue": 1.2,
+		"floatValue":  2.2222222,
+		"numberValue": 3,
+		"boolValue":   true,
+		"dateValue":   timeStamp,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok!\n", string(resp))
+
+	assert.Eventually(t, func() bool {
+		response, err = client.QueryMBeanAttributes("test:type=Cat,*")
+		return err == nil && len(response) == 12
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func Test_QueryLimits(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	// Populate the JMX Server with mbeans
+	for _, name := range []string{"tomas", "felix", "garfield"} {
+		resp, err := testutils.AddMBeans(ctx, container, map[string]interface{}{
+			"name":        name,
+			"doubleValue": 1.2,
+			"floatValue":  2.2222222,
+			"numberValue": 3,
+			"boolValue":   true,
+			"dateValue":   timeStamp,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok!\n", string(resp))
+	}
+
+	defer testutils.CleanMBeans(ctx, container)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be opened with cardinality limits
+	config := &JMXConfig{
+		Hostname:              jmxHost,
+		Port:                  int32(jmxPort.Int()),
+		RequestTimeoutMs:      testutils.DefaultTimeoutMs,
+		MaxMBeansPerPattern:   2,
+		MaxAttributesPerMBean: 4,
+		MaxResponsesPerCall:   6,
+	}
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	// AND the first mBeans sorted by name are kept
+	names, err := client.QueryMBeanNames("test:type=Cat,*")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"test:type=Cat,name=felix", "test:type=Cat,name=garfield"}, names)
+
+	truncations, err := client.GetTruncations()
+	require.NoError(t, err)
+	require.Len(t, truncations, 1)
+	assert.Equal(t, QueryLimitMBeansPerPattern, truncations[0].Limit)
+	assert.Equal(t, "test:type=Cat,*", truncations[0].MBeanName)
+	assert.Equal(t, []string{"test:type=Cat,name=tomas"}, truncations[0].Dropped)
+
+	// AND the attributes and responses are limited
+	response, err := client.QueryMBeanAttributes("test:type=Cat,*")
+	require.NoError(t, err)
+	assert.Len(t, response, 6)
+
+	truncations, err = client.GetTruncations()
+	require.NoError(t, err)
+
+	var limits []QueryLimit
+	for _, truncation := range truncations {
+		limits = append(limits, QueryLimit(truncation.Limit))
+	}
+	assert.Equal(t, []QueryLimit{
+		QueryLimit(QueryLimitMBeansPerPattern),
+		QueryLimit(QueryLimitAttributesPerMBean),
+		QueryLimit(QueryLimitAttributesPerMBean),
+		QueryLimit(QueryLimitResponsesPerCall),
+	}, limits)
+	assert.Equal(t, "test:type=Cat,name=felix", truncations[1].MBeanName)
+	assert.Equal(t, int64(2), truncations[1].DroppedCount)
+	assert.Equal(t, int64(2), truncations[3].DroppedCount)
+
+	// AND the truncations are cleaned once retrieved
+	truncations, err = client.GetTruncations()
+	require.NoError(t, err)
+	assert.Empty(t, truncations)
+}
+
 func Test_Query_Timeout(t *testing.T) {
 	ctx := context.Background()
 
@@ -1044,6 +1639,135 @@ func TestGetEmptyInternalStats(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Equal(t, "<nil>", internalStats.String())
+
+	// AND the internal metrics are still collected.
+	internalMetrics, err := client.GetInternalMetrics()
+	require.NoError(t, err)
+	assert.False(t, internalMetrics.SamplesEnabled)
+	assert.EqualValues(t, 0, internalMetrics.BufferedSamples)
+	require.NotNil(t, internalMetrics.GetMetric("queryMBeans"))
+	assert.EqualValues(t, 1, internalMetrics.GetMetric("queryMBeans").Count)
+}
+
+func TestGetInternalMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	var data []map[string]interface{}
+	for i := 0; i < 1500; i++ {
+		data = append(data, map[string]interface{}{
+			"name":        fmt.Sprintf("metrics-%d", i),
+			"doubleValue": 1.2,
+			"floatValue":  2.2,
+			"numberValue": 3,
+			"boolValue":   true,
+			"dateValue":   timeStamp,
+		})
+	}
+
+	// Populate the JMX Server with mbeans
+	resp, err := testutils.AddMBeansBatch(ctx, container, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok!\n", string(resp))
+
+	defer testutils.CleanMBeans(ctx, container)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be oppened
+	config := &JMXConfig{
+		Hostname:             jmxHost,
+		Port:                 int32(jmxPort.Int()),
+		EnableInternalStats:  true,
+		MaxInternalStatsSize: 3000, // We expect 3002 stats, the 2 oldest ones are evicted.
+	}
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	_, err = client.QueryMBeanAttributes("test:type=Cat,*")
+	assert.NoError(t, err)
+
+	// AND the metrics include the evicted samples.
+	internalMetrics, err := client.GetInternalMetrics()
+	require.NoError(t, err)
+	assert.True(t, internalMetrics.SamplesEnabled)
+	assert.EqualValues(t, 3000, internalMetrics.MaxSamples)
+	assert.EqualValues(t, 3000, internalMetrics.BufferedSamples)
+	assert.EqualValues(t, 2, internalMetrics.EvictedSamples)
+
+	var statTypes []string
+	for _, metric := range internalMetrics.GetMetrics() {
+		statTypes = append(statTypes, metric.StatType)
+	}
+	assert.Equal(t, []string{"connect", "getAttributes", "getMBeanInfo", "queryMBeans"}, statTypes)
+
+	getAttributes := internalMetrics.GetMetric("getAttributes")
+	assert.EqualValues(t, 1500, getAttributes.Count)
+	assert.EqualValues(t, 0, getAttributes.Errors)
+	assert.True(t, getAttributes.MaxMs > 0)
+
+	// AND reading the samples doesn't reset the metrics.
+	_, err = client.GetInternalStats()
+	require.NoError(t, err)
+
+	internalMetrics, err = client.GetInternalMetrics()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, internalMetrics.BufferedSamples)
+	assert.EqualValues(t, 1500, internalMetrics.GetMetric("getAttributes").Count)
+}
+
+func TestGetCallStats(t *testing.T) {
+	ctx := context.Background()
+
+	// GIVEN a JMX Server running inside a container
+	container, err := testutils.RunJMXServiceContainer(ctx)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	jmxHost, jmxPort, err := testutils.GetContainerMappedPort(ctx, container, testutils.TestServerJMXPort)
+	require.NoError(t, err)
+
+	// THEN JMX connection can be oppened
+	config := &JMXConfig{
+		Hostname:            jmxHost,
+		Port:                int32(jmxPort.Int()),
+		EnableInternalStats: true,
+	}
+	client, err := NewClient(ctx).Open(config)
+	assert.NoError(t, err)
+	defer assertCloseClientNoError(t, client)
+
+	_, err = client.QueryMBeanAttributes("java.lang:type=Threading", "ThreadCount")
+	assert.NoError(t, err)
+
+	// AND the calls are joined with the internal stats of the same request.
+	internalStats, err := client.GetInternalStats()
+	require.NoError(t, err)
+	calls := client.GetCallStats()
+	assert.Empty(t, JoinCallStats(calls, internalStats))
+
+	var methods []string
+	for _, call := range calls {
+		methods = append(methods, call.Method)
+	}
+	assert.Equal(t, []string{"connect", "queryMBeanAttributes", "getInternalStats"}, methods)
+
+	query := calls[1]
+	assert.True(t, query.Successful)
+	assert.True(t, query.BytesSent > 0)
+	assert.True(t, query.BytesReceived > 0)
+	require.NotEmpty(t, query.InternalStats)
+	for _, stat := range query.InternalStats {
+		assert.Equal(t, query.RequestID, stat.RequestId)
+	}
+	assert.True(t, query.JVM() > 0)
+	assert.Equal(t, query.Total, query.Go()+query.IPC()+query.JVM())
 }
 
 func TestConnectionRecovers(t *testing.T) {
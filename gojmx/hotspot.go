@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// VMOption is a HotSpot VM option, e.g. HeapDumpOnOutOfMemoryError.
+// Origin reports where the value was set: DEFAULT, VM_CREATION, ENVIRON_VAR, CONFIG_FILE, MANAGEMENT, ERGONOMIC,
+// ATTACH_ON_DEMAND or OTHER. Only the writeable options (manageable flags) can be changed using Client.SetVMOption.
+type VMOption nrprotocol.VMOption
+
+func toVMOptionList(in []*nrprotocol.VMOption) []*VMOption {
+	return *(*[]*VMOption)(unsafe.Pointer(&in))
+}
+
+func (o *VMOption) String() string {
+	if o == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s=%s (origin: %s, writeable: %t)", o.Name, o.Value, o.Origin, o.Writeable)
+}
+
+// VMOptions returns all the VM options with their origin and writeable flag using the
+// com.sun.management:type=HotSpotDiagnostic MXBean, the names are listed with the VM.flags -all diagnostic command.
+// When the diagnostic command is not available only the writeable options are returned.
+func (c *Client) VMOptions() ([]*VMOption, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetVMOptions(c.ctx)
+	return toVMOptionList(result), c.handleError(err)
+}
+
+// VMOption returns a VM option, writeable or not, using the com.sun.management:type=HotSpotDiagnostic MXBean.
+func (c *Client) VMOption(name string) (*VMOption, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetVMOption(c.ctx, name)
+	return (*VMOption)(result), c.handleError(err)
+}
+
+// SetVMOption changes a writeable VM option, e.g. SetVMOption("HeapDumpOnOutOfMemoryError", "true").
+func (c *Client) SetVMOption(name, value string) error {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return err
+	}
+	err := c.jmxService.SetVMOption(c.ctx, name, value)
+	return c.handleError(err)
+}
+
+// DumpHeap writes a heap dump in the hprof format into the monitored JVM host filesystem.
+// The path must have the .hprof extension and must not exist. When live is true only the reachable objects are dumped.
+// Writing a large heap takes minutes, so the dump is bounded by the timeout instead of JMXConfig.RequestTimeoutMs,
+// 0 waits until the dump is written. On timeout the JVM keeps writing the file.
+// Failures reported by the JVM are returned as *HeapDumpError.
+func (c *Client) DumpHeap(path string, live bool, timeout time.Duration) error {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return err
+	}
+	err := c.handleError(c.jmxService.DumpHeap(c.ctx, path, live, timeout.Milliseconds()))
+
+	if jmxErr, ok := IsJMXError(err); ok {
+		return newHeapDumpError(path, jmxErr)
+	}
+	return err
+}
+
+// HeapDumpErrorKind classifies the failure of Client.DumpHeap.
+type HeapDumpErrorKind string
+
+const (
+	HeapDumpErrorUnknown HeapDumpErrorKind = "unknown"
+	// HeapDumpErrorFileExists the output file already exists.
+	HeapDumpErrorFileExists HeapDumpErrorKind = "file_exists"
+	// HeapDumpErrorInvalidPath the output file doesn't have the .hprof extension or the directory doesn't exist.
+	HeapDumpErrorInvalidPath HeapDumpErrorKind = "invalid_path"
+	// HeapDumpErrorPermissionDenied the JVM cannot write the output file or the operation is not allowed.
+	HeapDumpErrorPermissionDenied HeapDumpErrorKind = "permission_denied"
+	// HeapDumpErrorUnsupported the JVM doesn't provide the HotSpotDiagnostic MXBean.
+	HeapDumpErrorUnsupported HeapDumpErrorKind = "unsupported"
+)
+
+// HeapDumpError is returned by Client.DumpHeap when the JVM fails to write the heap dump.
+type HeapDumpError struct {
+	Kind HeapDumpErrorKind
+	Path string
+	Err  *JMXError
+}
+
+func newHeapDumpError(path string, err *JMXError) *HeapDumpError {
+	causeClass := err.GetCauseClass()
+	causeMessage := strings.ToLower(err.CauseMessage)
+
+	kind := HeapDumpErrorUnknown
+	switch {
+	case causeClass == "javax.management.InstanceNotFoundException":
+		kind = HeapDumpErrorUnsupported
+	case causeClass == "java.lang.SecurityException",
+		strings.Contains(causeMessage, "permission denied"):
+		kind = HeapDumpErrorPermissionDenied
+	case strings.Contains(causeMessage, "file exists"):
+		kind = HeapDumpErrorFileExists
+	case causeClass == "java.lang.IllegalArgumentException",
+		strings.Contains(causeMessage, "no such file or directory"):
+		kind = HeapDumpErrorInvalidPath
+	}
+
+	return &HeapDumpError{
+		Kind: kind,
+		Path: path,
+		Err:  err,
+	}
+}
+
+func (e *HeapDumpError) Error() string {
+	return fmt.Sprintf("heap dump to '%s' failed (%s): %s", e.Path, e.Kind, removeNewLines(e.Err.CauseMessage))
+}
+
+// Unwrap returns the JMXError reported by nrjmx.
+func (e *HeapDumpError) Unwrap() error {
+	return e.Err
+}
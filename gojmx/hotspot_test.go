@@ -0,0 +1,75 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HeapDumpError_Kind(t *testing.T) {
+	testCases := []struct {
+		name         string
+		causeClass   string
+		causeMessage string
+		expected     HeapDumpErrorKind
+	}{
+		{
+			name:         "File Exists",
+			causeClass:   "java.io.IOException",
+			causeMessage: "File exists",
+			expected:     HeapDumpErrorFileExists,
+		},
+		{
+			name:         "Invalid Extension",
+			causeClass:   "java.lang.IllegalArgumentException",
+			causeMessage: "heapdump file must have .hprof extention",
+			expected:     HeapDumpErrorInvalidPath,
+		},
+		{
+			name:         "Missing Directory",
+			causeClass:   "java.io.IOException",
+			causeMessage: "No such file or directory",
+			expected:     HeapDumpErrorInvalidPath,
+		},
+		{
+			name:         "Permission Denied",
+			causeClass:   "java.io.IOException",
+			causeMessage: "Permission denied",
+			expected:     HeapDumpErrorPermissionDenied,
+		},
+		{
+			name:         "Unsupported",
+			causeClass:   "javax.management.InstanceNotFoundException",
+			causeMessage: "com.sun.management:type=HotSpotDiagnostic",
+			expected:     HeapDumpErrorUnsupported,
+		},
+		{
+			name:     "Unknown",
+			expected: HeapDumpErrorUnknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			causeClass := testCase.causeClass
+			jmxErr := &JMXError{
+				Message:      "can't invoke hotspot diagnostic operation: dumpHeap",
+				CauseMessage: testCase.causeMessage,
+				CauseClass:   &causeClass,
+			}
+
+			err := newHeapDumpError("/tmp/heap.hprof", jmxErr)
+			assert.Equal(t, testCase.expected, err.Kind)
+
+			var target *JMXError
+			assert.True(t, errors.As(err, &target))
+			assert.Equal(t, jmxErr, target)
+		})
+	}
+}
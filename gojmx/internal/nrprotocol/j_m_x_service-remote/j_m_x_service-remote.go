@@ -27,9 +27,29 @@ func Usage() {
 	fmt.Fprintln(os.Stderr, "  string getClientVersion()")
 	fmt.Fprintln(os.Stderr, "   queryMBeanNames(string mBeanNamePattern)")
 	fmt.Fprintln(os.Stderr, "   getMBeanAttributeNames(string mBeanName)")
+	fmt.Fprintln(os.Stderr, "  MBeanInfo getMBeanInfo(string mBeanName)")
 	fmt.Fprintln(os.Stderr, "   getMBeanAttributes(string mBeanName,  attributes)")
 	fmt.Fprintln(os.Stderr, "   queryMBeanAttributes(string mBeanNamePattern,  attributes)")
+	fmt.Fprintln(os.Stderr, "   invokeOperation(string mBeanName, string operationName,  arguments,  signature)")
 	fmt.Fprintln(os.Stderr, "   getInternalStats()")
+	fmt.Fprintln(os.Stderr, "  InternalMetrics getInternalMetrics()")
+	fmt.Fprintln(os.Stderr, "   getTruncations()")
+	fmt.Fprintln(os.Stderr, "   getDomains()")
+	fmt.Fprintln(os.Stderr, "  i64 getMBeanCount(string mBeanNamePattern)")
+	fmt.Fprintln(os.Stderr, "  ServerInfo getServerInfo()")
+	fmt.Fprintln(os.Stderr, "   threadDump(ThreadDumpOptions options)")
+	fmt.Fprintln(os.Stderr, "   findDeadlocks()")
+	fmt.Fprintln(os.Stderr, "  string diagnosticCommand(string command,  arguments)")
+	fmt.Fprintln(os.Stderr, "  i64 startRecording(RecordingSettings settings)")
+	fmt.Fprintln(os.Stderr, "  void stopRecording(i64 recordingId)")
+	fmt.Fprintln(os.Stderr, "  void closeRecording(i64 recordingId)")
+	fmt.Fprintln(os.Stderr, "  i64 openRecordingStream(i64 recordingId)")
+	fmt.Fprintln(os.Stderr, "  string readRecordingStream(i64 streamId)")
+	fmt.Fprintln(os.Stderr, "  void closeRecordingStream(i64 streamId)")
+	fmt.Fprintln(os.Stderr, "   getVMOptions()")
+	fmt.Fprintln(os.Stderr, "  VMOption getVMOption(string name)")
+	fmt.Fprintln(os.Stderr, "  void setVMOption(string name, string value)")
+	fmt.Fprintln(os.Stderr, "  void dumpHeap(string outputFile, bool live, i64 timeoutMs)")
 	fmt.Fprintln(os.Stderr)
 	os.Exit(0)
 }
@@ -153,19 +173,19 @@ func main() {
 			fmt.Fprintln(os.Stderr, "Connect requires 1 args")
 			flag.Usage()
 		}
-		arg51 := flag.Arg(1)
-		mbTrans52 := thrift.NewTMemoryBufferLen(len(arg51))
-		defer mbTrans52.Close()
-		_, err53 := mbTrans52.WriteString(arg51)
-		if err53 != nil {
+		arg190 := flag.Arg(1)
+		mbTrans191 := thrift.NewTMemoryBufferLen(len(arg190))
+		defer mbTrans191.Close()
+		_, err192 := mbTrans191.WriteString(arg190)
+		if err192 != nil {
 			Usage()
 			return
 		}
-		factory54 := thrift.NewTJSONProtocolFactory()
-		jsProt55 := factory54.GetProtocol(mbTrans52)
+		factory193 := thrift.NewTJSONProtocolFactory()
+		jsProt194 := factory193.GetProtocol(mbTrans191)
 		argvalue0 := nrprotocol.NewJMXConfig()
-		err56 := argvalue0.Read(context.Background(), jsProt55)
-		if err56 != nil {
+		err195 := argvalue0.Read(context.Background(), jsProt194)
+		if err195 != nil {
 			Usage()
 			return
 		}
@@ -209,6 +229,16 @@ func main() {
 		fmt.Print(client.GetMBeanAttributeNames(context.Background(), value0))
 		fmt.Print("\n")
 		break
+	case "getMBeanInfo":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "GetMBeanInfo requires 1 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		fmt.Print(client.GetMBeanInfo(context.Background(), value0))
+		fmt.Print("\n")
+		break
 	case "getMBeanAttributes":
 		if flag.NArg() - 1 != 2 {
 			fmt.Fprintln(os.Stderr, "GetMBeanAttributes requires 2 args")
@@ -216,19 +246,19 @@ func main() {
 		}
 		argvalue0 := flag.Arg(1)
 		value0 := argvalue0
-		arg60 := flag.Arg(2)
-		mbTrans61 := thrift.NewTMemoryBufferLen(len(arg60))
-		defer mbTrans61.Close()
-		_, err62 := mbTrans61.WriteString(arg60)
-		if err62 != nil {
+		arg200 := flag.Arg(2)
+		mbTrans201 := thrift.NewTMemoryBufferLen(len(arg200))
+		defer mbTrans201.Close()
+		_, err202 := mbTrans201.WriteString(arg200)
+		if err202 != nil {
 			Usage()
 			return
 		}
-		factory63 := thrift.NewTJSONProtocolFactory()
-		jsProt64 := factory63.GetProtocol(mbTrans61)
+		factory203 := thrift.NewTJSONProtocolFactory()
+		jsProt204 := factory203.GetProtocol(mbTrans201)
 		containerStruct1 := nrprotocol.NewJMXServiceGetMBeanAttributesArgs()
-		err65 := containerStruct1.ReadField2(context.Background(), jsProt64)
-		if err65 != nil {
+		err205 := containerStruct1.ReadField2(context.Background(), jsProt204)
+		if err205 != nil {
 			Usage()
 			return
 		}
@@ -244,19 +274,19 @@ func main() {
 		}
 		argvalue0 := flag.Arg(1)
 		value0 := argvalue0
-		arg67 := flag.Arg(2)
-		mbTrans68 := thrift.NewTMemoryBufferLen(len(arg67))
-		defer mbTrans68.Close()
-		_, err69 := mbTrans68.WriteString(arg67)
-		if err69 != nil {
+		arg207 := flag.Arg(2)
+		mbTrans208 := thrift.NewTMemoryBufferLen(len(arg207))
+		defer mbTrans208.Close()
+		_, err209 := mbTrans208.WriteString(arg207)
+		if err209 != nil {
 			Usage()
 			return
 		}
-		factory70 := thrift.NewTJSONProtocolFactory()
-		jsProt71 := factory70.GetProtocol(mbTrans68)
+		factory210 := thrift.NewTJSONProtocolFactory()
+		jsProt211 := factory210.GetProtocol(mbTrans208)
 		containerStruct1 := nrprotocol.NewJMXServiceQueryMBeanAttributesArgs()
-		err72 := containerStruct1.ReadField2(context.Background(), jsProt71)
-		if err72 != nil {
+		err212 := containerStruct1.ReadField2(context.Background(), jsProt211)
+		if err212 != nil {
 			Usage()
 			return
 		}
@@ -265,6 +295,54 @@ func main() {
 		fmt.Print(client.QueryMBeanAttributes(context.Background(), value0, value1))
 		fmt.Print("\n")
 		break
+	case "invokeOperation":
+		if flag.NArg() - 1 != 4 {
+			fmt.Fprintln(os.Stderr, "InvokeOperation requires 4 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		argvalue1 := flag.Arg(2)
+		value1 := argvalue1
+		arg215 := flag.Arg(3)
+		mbTrans216 := thrift.NewTMemoryBufferLen(len(arg215))
+		defer mbTrans216.Close()
+		_, err217 := mbTrans216.WriteString(arg215)
+		if err217 != nil {
+			Usage()
+			return
+		}
+		factory218 := thrift.NewTJSONProtocolFactory()
+		jsProt219 := factory218.GetProtocol(mbTrans216)
+		containerStruct2 := nrprotocol.NewJMXServiceInvokeOperationArgs()
+		err220 := containerStruct2.ReadField3(context.Background(), jsProt219)
+		if err220 != nil {
+			Usage()
+			return
+		}
+		argvalue2 := containerStruct2.Arguments
+		value2 := argvalue2
+		arg221 := flag.Arg(4)
+		mbTrans222 := thrift.NewTMemoryBufferLen(len(arg221))
+		defer mbTrans222.Close()
+		_, err223 := mbTrans222.WriteString(arg221)
+		if err223 != nil {
+			Usage()
+			return
+		}
+		factory224 := thrift.NewTJSONProtocolFactory()
+		jsProt225 := factory224.GetProtocol(mbTrans222)
+		containerStruct3 := nrprotocol.NewJMXServiceInvokeOperationArgs()
+		err226 := containerStruct3.ReadField4(context.Background(), jsProt225)
+		if err226 != nil {
+			Usage()
+			return
+		}
+		argvalue3 := containerStruct3.Signature
+		value3 := argvalue3
+		fmt.Print(client.InvokeOperation(context.Background(), value0, value1, value2, value3))
+		fmt.Print("\n")
+		break
 	case "getInternalStats":
 		if flag.NArg() - 1 != 0 {
 			fmt.Fprintln(os.Stderr, "GetInternalStats requires 0 args")
@@ -273,6 +351,252 @@ func main() {
 		fmt.Print(client.GetInternalStats(context.Background()))
 		fmt.Print("\n")
 		break
+	case "getInternalMetrics":
+		if flag.NArg() - 1 != 0 {
+			fmt.Fprintln(os.Stderr, "GetInternalMetrics requires 0 args")
+			flag.Usage()
+		}
+		fmt.Print(client.GetInternalMetrics(context.Background()))
+		fmt.Print("\n")
+		break
+	case "getTruncations":
+		if flag.NArg() - 1 != 0 {
+			fmt.Fprintln(os.Stderr, "GetTruncations requires 0 args")
+			flag.Usage()
+		}
+		fmt.Print(client.GetTruncations(context.Background()))
+		fmt.Print("\n")
+		break
+	case "getDomains":
+		if flag.NArg() - 1 != 0 {
+			fmt.Fprintln(os.Stderr, "GetDomains requires 0 args")
+			flag.Usage()
+		}
+		fmt.Print(client.GetDomains(context.Background()))
+		fmt.Print("\n")
+		break
+	case "getMBeanCount":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "GetMBeanCount requires 1 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		fmt.Print(client.GetMBeanCount(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "getServerInfo":
+		if flag.NArg() - 1 != 0 {
+			fmt.Fprintln(os.Stderr, "GetServerInfo requires 0 args")
+			flag.Usage()
+		}
+		fmt.Print(client.GetServerInfo(context.Background()))
+		fmt.Print("\n")
+		break
+	case "threadDump":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "ThreadDump requires 1 args")
+			flag.Usage()
+		}
+		arg228 := flag.Arg(1)
+		mbTrans229 := thrift.NewTMemoryBufferLen(len(arg228))
+		defer mbTrans229.Close()
+		_, err230 := mbTrans229.WriteString(arg228)
+		if err230 != nil {
+			Usage()
+			return
+		}
+		factory231 := thrift.NewTJSONProtocolFactory()
+		jsProt232 := factory231.GetProtocol(mbTrans229)
+		argvalue0 := nrprotocol.NewThreadDumpOptions()
+		err233 := argvalue0.Read(context.Background(), jsProt232)
+		if err233 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.ThreadDump(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "findDeadlocks":
+		if flag.NArg() - 1 != 0 {
+			fmt.Fprintln(os.Stderr, "FindDeadlocks requires 0 args")
+			flag.Usage()
+		}
+		fmt.Print(client.FindDeadlocks(context.Background()))
+		fmt.Print("\n")
+		break
+	case "diagnosticCommand":
+		if flag.NArg() - 1 != 2 {
+			fmt.Fprintln(os.Stderr, "DiagnosticCommand requires 2 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		arg235 := flag.Arg(2)
+		mbTrans236 := thrift.NewTMemoryBufferLen(len(arg235))
+		defer mbTrans236.Close()
+		_, err237 := mbTrans236.WriteString(arg235)
+		if err237 != nil {
+			Usage()
+			return
+		}
+		factory238 := thrift.NewTJSONProtocolFactory()
+		jsProt239 := factory238.GetProtocol(mbTrans236)
+		containerStruct1 := nrprotocol.NewJMXServiceDiagnosticCommandArgs()
+		err240 := containerStruct1.ReadField2(context.Background(), jsProt239)
+		if err240 != nil {
+			Usage()
+			return
+		}
+		argvalue1 := containerStruct1.Arguments
+		value1 := argvalue1
+		fmt.Print(client.DiagnosticCommand(context.Background(), value0, value1))
+		fmt.Print("\n")
+		break
+	case "startRecording":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "StartRecording requires 1 args")
+			flag.Usage()
+		}
+		arg241 := flag.Arg(1)
+		mbTrans242 := thrift.NewTMemoryBufferLen(len(arg241))
+		defer mbTrans242.Close()
+		_, err243 := mbTrans242.WriteString(arg241)
+		if err243 != nil {
+			Usage()
+			return
+		}
+		factory244 := thrift.NewTJSONProtocolFactory()
+		jsProt245 := factory244.GetProtocol(mbTrans242)
+		argvalue0 := nrprotocol.NewRecordingSettings()
+		err246 := argvalue0.Read(context.Background(), jsProt245)
+		if err246 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.StartRecording(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "stopRecording":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "StopRecording requires 1 args")
+			flag.Usage()
+		}
+		argvalue0, err247 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+		if err247 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.StopRecording(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "closeRecording":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "CloseRecording requires 1 args")
+			flag.Usage()
+		}
+		argvalue0, err248 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+		if err248 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.CloseRecording(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "openRecordingStream":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "OpenRecordingStream requires 1 args")
+			flag.Usage()
+		}
+		argvalue0, err249 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+		if err249 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.OpenRecordingStream(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "readRecordingStream":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "ReadRecordingStream requires 1 args")
+			flag.Usage()
+		}
+		argvalue0, err250 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+		if err250 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.ReadRecordingStream(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "closeRecordingStream":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "CloseRecordingStream requires 1 args")
+			flag.Usage()
+		}
+		argvalue0, err251 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+		if err251 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.CloseRecordingStream(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "getVMOptions":
+		if flag.NArg() - 1 != 0 {
+			fmt.Fprintln(os.Stderr, "GetVMOptions requires 0 args")
+			flag.Usage()
+		}
+		fmt.Print(client.GetVMOptions(context.Background()))
+		fmt.Print("\n")
+		break
+	case "getVMOption":
+		if flag.NArg() - 1 != 1 {
+			fmt.Fprintln(os.Stderr, "GetVMOption requires 1 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		fmt.Print(client.GetVMOption(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "setVMOption":
+		if flag.NArg() - 1 != 2 {
+			fmt.Fprintln(os.Stderr, "SetVMOption requires 2 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		argvalue1 := flag.Arg(2)
+		value1 := argvalue1
+		fmt.Print(client.SetVMOption(context.Background(), value0, value1))
+		fmt.Print("\n")
+		break
+	case "dumpHeap":
+		if flag.NArg() - 1 != 3 {
+			fmt.Fprintln(os.Stderr, "DumpHeap requires 3 args")
+			flag.Usage()
+		}
+		argvalue0 := flag.Arg(1)
+		value0 := argvalue0
+		argvalue1 := flag.Arg(2) == "true"
+		value1 := argvalue1
+		argvalue2, err257 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+		if err257 != nil {
+			Usage()
+			return
+		}
+		value2 := argvalue2
+		fmt.Print(client.DumpHeap(context.Background(), value0, value1, value2))
+		fmt.Print("\n")
+		break
 	case "":
 		Usage()
 	default:
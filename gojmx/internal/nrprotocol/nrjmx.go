@@ -90,6 +90,207 @@ func (p *ResponseType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type ErrorKind int64
+const (
+	ErrorKind_UNKNOWN ErrorKind = 1
+	ErrorKind_ATTRIBUTE_NOT_FOUND ErrorKind = 2
+	ErrorKind_MBEAN_NOT_FOUND ErrorKind = 3
+	ErrorKind_UNSUPPORTED_OPERATION ErrorKind = 4
+	ErrorKind_UNSUPPORTED_TYPE ErrorKind = 5
+	ErrorKind_SERIALIZATION ErrorKind = 6
+	ErrorKind_SECURITY ErrorKind = 7
+	ErrorKind_NULL_VALUE ErrorKind = 8
+	ErrorKind_GETTER_EXCEPTION ErrorKind = 9
+	ErrorKind_NOT_RETURNED ErrorKind = 10
+)
+
+func (p ErrorKind) String() string {
+	switch p {
+	case ErrorKind_UNKNOWN: return "UNKNOWN"
+	case ErrorKind_ATTRIBUTE_NOT_FOUND: return "ATTRIBUTE_NOT_FOUND"
+	case ErrorKind_MBEAN_NOT_FOUND: return "MBEAN_NOT_FOUND"
+	case ErrorKind_UNSUPPORTED_OPERATION: return "UNSUPPORTED_OPERATION"
+	case ErrorKind_UNSUPPORTED_TYPE: return "UNSUPPORTED_TYPE"
+	case ErrorKind_SERIALIZATION: return "SERIALIZATION"
+	case ErrorKind_SECURITY: return "SECURITY"
+	case ErrorKind_NULL_VALUE: return "NULL_VALUE"
+	case ErrorKind_GETTER_EXCEPTION: return "GETTER_EXCEPTION"
+	case ErrorKind_NOT_RETURNED: return "NOT_RETURNED"
+	}
+	return "<UNSET>"
+}
+
+func ErrorKindFromString(s string) (ErrorKind, error) {
+	switch s {
+	case "UNKNOWN": return ErrorKind_UNKNOWN, nil
+	case "ATTRIBUTE_NOT_FOUND": return ErrorKind_ATTRIBUTE_NOT_FOUND, nil
+	case "MBEAN_NOT_FOUND": return ErrorKind_MBEAN_NOT_FOUND, nil
+	case "UNSUPPORTED_OPERATION": return ErrorKind_UNSUPPORTED_OPERATION, nil
+	case "UNSUPPORTED_TYPE": return ErrorKind_UNSUPPORTED_TYPE, nil
+	case "SERIALIZATION": return ErrorKind_SERIALIZATION, nil
+	case "SECURITY": return ErrorKind_SECURITY, nil
+	case "NULL_VALUE": return ErrorKind_NULL_VALUE, nil
+	case "GETTER_EXCEPTION": return ErrorKind_GETTER_EXCEPTION, nil
+	case "NOT_RETURNED": return ErrorKind_NOT_RETURNED, nil
+	}
+	return ErrorKind(0), fmt.Errorf("not a valid ErrorKind string")
+}
+
+
+func ErrorKindPtr(v ErrorKind) *ErrorKind { return &v }
+
+func (p ErrorKind) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ErrorKind) UnmarshalText(text []byte) error {
+	q, err := ErrorKindFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ErrorKind) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ErrorKind(v)
+	return nil
+}
+
+func (p *ErrorKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type QueryLimit int64
+const (
+	QueryLimit_MBEANS_PER_PATTERN QueryLimit = 0
+	QueryLimit_ATTRIBUTES_PER_MBEAN QueryLimit = 1
+	QueryLimit_RESPONSES_PER_CALL QueryLimit = 2
+)
+
+func (p QueryLimit) String() string {
+	switch p {
+	case QueryLimit_MBEANS_PER_PATTERN: return "MBEANS_PER_PATTERN"
+	case QueryLimit_ATTRIBUTES_PER_MBEAN: return "ATTRIBUTES_PER_MBEAN"
+	case QueryLimit_RESPONSES_PER_CALL: return "RESPONSES_PER_CALL"
+	}
+	return "<UNSET>"
+}
+
+func QueryLimitFromString(s string) (QueryLimit, error) {
+	switch s {
+	case "MBEANS_PER_PATTERN": return QueryLimit_MBEANS_PER_PATTERN, nil
+	case "ATTRIBUTES_PER_MBEAN": return QueryLimit_ATTRIBUTES_PER_MBEAN, nil
+	case "RESPONSES_PER_CALL": return QueryLimit_RESPONSES_PER_CALL, nil
+	}
+	return QueryLimit(0), fmt.Errorf("not a valid QueryLimit string")
+}
+
+
+func QueryLimitPtr(v QueryLimit) *QueryLimit { return &v }
+
+func (p QueryLimit) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *QueryLimit) UnmarshalText(text []byte) error {
+	q, err := QueryLimitFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *QueryLimit) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = QueryLimit(v)
+	return nil
+}
+
+func (p *QueryLimit) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ThreadState int64
+const (
+	ThreadState_NEW ThreadState = 1
+	ThreadState_RUNNABLE ThreadState = 2
+	ThreadState_BLOCKED ThreadState = 3
+	ThreadState_WAITING ThreadState = 4
+	ThreadState_TIMED_WAITING ThreadState = 5
+	ThreadState_TERMINATED ThreadState = 6
+)
+
+func (p ThreadState) String() string {
+	switch p {
+	case ThreadState_NEW: return "NEW"
+	case ThreadState_RUNNABLE: return "RUNNABLE"
+	case ThreadState_BLOCKED: return "BLOCKED"
+	case ThreadState_WAITING: return "WAITING"
+	case ThreadState_TIMED_WAITING: return "TIMED_WAITING"
+	case ThreadState_TERMINATED: return "TERMINATED"
+	}
+	return "<UNSET>"
+}
+
+func ThreadStateFromString(s string) (ThreadState, error) {
+	switch s {
+	case "NEW": return ThreadState_NEW, nil
+	case "RUNNABLE": return ThreadState_RUNNABLE, nil
+	case "BLOCKED": return ThreadState_BLOCKED, nil
+	case "WAITING": return ThreadState_WAITING, nil
+	case "TIMED_WAITING": return ThreadState_TIMED_WAITING, nil
+	case "TERMINATED": return ThreadState_TERMINATED, nil
+	}
+	return ThreadState(0), fmt.Errorf("not a valid ThreadState string")
+}
+
+
+func ThreadStatePtr(v ThreadState) *ThreadState { return &v }
+
+func (p ThreadState) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ThreadState) UnmarshalText(text []byte) error {
+	q, err := ThreadStateFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ThreadState) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ThreadState(v)
+	return nil
+}
+
+func (p *ThreadState) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 // Attributes:
 //  - ConnectionURL
 //  - Hostname
@@ -108,6 +309,10 @@ func (p *ResponseType) Value() (driver.Value, error) {
 //  - Verbose
 //  - EnableInternalStats
 //  - MaxInternalStatsSize
+//  - DiscoveryCacheTtlMs
+//  - MaxMBeansPerPattern
+//  - MaxAttributesPerMBean
+//  - MaxResponsesPerCall
 // 
 type JMXConfig struct {
 	ConnectionURL string `thrift:"connectionURL,1" db:"connectionURL" json:"connectionURL"`
@@ -127,6 +332,10 @@ type JMXConfig struct {
 	Verbose bool `thrift:"verbose,15" db:"verbose" json:"verbose"`
 	EnableInternalStats bool `thrift:"enableInternalStats,16" db:"enableInternalStats" json:"enableInternalStats"`
 	MaxInternalStatsSize int64 `thrift:"maxInternalStatsSize,17" db:"maxInternalStatsSize" json:"maxInternalStatsSize"`
+	DiscoveryCacheTtlMs int64 `thrift:"discoveryCacheTtlMs,18" db:"discoveryCacheTtlMs" json:"discoveryCacheTtlMs"`
+	MaxMBeansPerPattern int64 `thrift:"maxMBeansPerPattern,19" db:"maxMBeansPerPattern" json:"maxMBeansPerPattern"`
+	MaxAttributesPerMBean int64 `thrift:"maxAttributesPerMBean,20" db:"maxAttributesPerMBean" json:"maxAttributesPerMBean"`
+	MaxResponsesPerCall int64 `thrift:"maxResponsesPerCall,21" db:"maxResponsesPerCall" json:"maxResponsesPerCall"`
 }
 
 func NewJMXConfig() *JMXConfig {
@@ -238,6 +447,30 @@ func (p *JMXConfig) GetMaxInternalStatsSize() int64 {
 	return p.MaxInternalStatsSize
 }
 
+
+
+func (p *JMXConfig) GetDiscoveryCacheTtlMs() int64 {
+	return p.DiscoveryCacheTtlMs
+}
+
+
+
+func (p *JMXConfig) GetMaxMBeansPerPattern() int64 {
+	return p.MaxMBeansPerPattern
+}
+
+
+
+func (p *JMXConfig) GetMaxAttributesPerMBean() int64 {
+	return p.MaxAttributesPerMBean
+}
+
+
+
+func (p *JMXConfig) GetMaxResponsesPerCall() int64 {
+	return p.MaxResponsesPerCall
+}
+
 func (p *JMXConfig) IsSetUriPath() bool {
 	return p.UriPath != nil
 }
@@ -427,6 +660,46 @@ func (p *JMXConfig) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 18:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField18(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 19:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField19(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField20(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 21:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField21(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -595,6 +868,42 @@ func (p *JMXConfig) ReadField17(ctx context.Context, iprot thrift.TProtocol) err
 	return nil
 }
 
+func (p *JMXConfig) ReadField18(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 18: ", err)
+	} else {
+		p.DiscoveryCacheTtlMs = v
+	}
+	return nil
+}
+
+func (p *JMXConfig) ReadField19(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 19: ", err)
+	} else {
+		p.MaxMBeansPerPattern = v
+	}
+	return nil
+}
+
+func (p *JMXConfig) ReadField20(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 20: ", err)
+	} else {
+		p.MaxAttributesPerMBean = v
+	}
+	return nil
+}
+
+func (p *JMXConfig) ReadField21(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 21: ", err)
+	} else {
+		p.MaxResponsesPerCall = v
+	}
+	return nil
+}
+
 func (p *JMXConfig) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "JMXConfig"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -617,6 +926,10 @@ func (p *JMXConfig) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField15(ctx, oprot); err != nil { return err }
 		if err := p.writeField16(ctx, oprot); err != nil { return err }
 		if err := p.writeField17(ctx, oprot); err != nil { return err }
+		if err := p.writeField18(ctx, oprot); err != nil { return err }
+		if err := p.writeField19(ctx, oprot); err != nil { return err }
+		if err := p.writeField20(ctx, oprot); err != nil { return err }
+		if err := p.writeField21(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -850,6 +1163,58 @@ func (p *JMXConfig) writeField17(ctx context.Context, oprot thrift.TProtocol) (e
 	return err
 }
 
+func (p *JMXConfig) writeField18(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "discoveryCacheTtlMs", thrift.I64, 18); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 18:discoveryCacheTtlMs: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.DiscoveryCacheTtlMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.discoveryCacheTtlMs (18) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 18:discoveryCacheTtlMs: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXConfig) writeField19(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "maxMBeansPerPattern", thrift.I64, 19); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 19:maxMBeansPerPattern: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.MaxMBeansPerPattern)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxMBeansPerPattern (19) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 19:maxMBeansPerPattern: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXConfig) writeField20(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "maxAttributesPerMBean", thrift.I64, 20); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maxAttributesPerMBean: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.MaxAttributesPerMBean)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxAttributesPerMBean (20) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maxAttributesPerMBean: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXConfig) writeField21(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "maxResponsesPerCall", thrift.I64, 21); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 21:maxResponsesPerCall: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.MaxResponsesPerCall)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxResponsesPerCall (21) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 21:maxResponsesPerCall: ", p), err)
+	}
+	return err
+}
+
 func (p *JMXConfig) Equals(other *JMXConfig) bool {
 	if p == other {
 		return true
@@ -878,6 +1243,10 @@ func (p *JMXConfig) Equals(other *JMXConfig) bool {
 	if p.Verbose != other.Verbose { return false }
 	if p.EnableInternalStats != other.EnableInternalStats { return false }
 	if p.MaxInternalStatsSize != other.MaxInternalStatsSize { return false }
+	if p.DiscoveryCacheTtlMs != other.DiscoveryCacheTtlMs { return false }
+	if p.MaxMBeansPerPattern != other.MaxMBeansPerPattern { return false }
+	if p.MaxAttributesPerMBean != other.MaxAttributesPerMBean { return false }
+	if p.MaxResponsesPerCall != other.MaxResponsesPerCall { return false }
 	return true
 }
 
@@ -906,74 +1275,50 @@ func (p *JMXConfig) Validate() error {
 }
 
 // Attributes:
-//  - StatusMsg
-//  - Name
-//  - ResponseType
-//  - StringValue
-//  - DoubleValue
-//  - IntValue
-//  - BoolValue
+//  - Kind
+//  - ExceptionClass
+//  - CauseClass
+//  - CauseMessage
 // 
-type AttributeResponse struct {
-	StatusMsg string `thrift:"statusMsg,1" db:"statusMsg" json:"statusMsg"`
-	Name string `thrift:"name,2" db:"name" json:"name"`
-	ResponseType ResponseType `thrift:"responseType,3" db:"responseType" json:"responseType"`
-	StringValue string `thrift:"stringValue,4" db:"stringValue" json:"stringValue"`
-	DoubleValue float64 `thrift:"doubleValue,5" db:"doubleValue" json:"doubleValue"`
-	IntValue int64 `thrift:"intValue,6" db:"intValue" json:"intValue"`
-	BoolValue bool `thrift:"boolValue,7" db:"boolValue" json:"boolValue"`
-}
-
-func NewAttributeResponse() *AttributeResponse {
-	return &AttributeResponse{}
-}
-
-
-
-func (p *AttributeResponse) GetStatusMsg() string {
-	return p.StatusMsg
+type AttributeError struct {
+	Kind ErrorKind `thrift:"kind,1" db:"kind" json:"kind"`
+	ExceptionClass string `thrift:"exceptionClass,2" db:"exceptionClass" json:"exceptionClass"`
+	CauseClass string `thrift:"causeClass,3" db:"causeClass" json:"causeClass"`
+	CauseMessage string `thrift:"causeMessage,4" db:"causeMessage" json:"causeMessage"`
 }
 
-
-
-func (p *AttributeResponse) GetName() string {
-	return p.Name
+func NewAttributeError() *AttributeError {
+	return &AttributeError{}
 }
 
 
 
-func (p *AttributeResponse) GetResponseType() ResponseType {
-	return p.ResponseType
+func (p *AttributeError) GetKind() ErrorKind {
+	return p.Kind
 }
 
 
 
-func (p *AttributeResponse) GetStringValue() string {
-	return p.StringValue
+func (p *AttributeError) GetExceptionClass() string {
+	return p.ExceptionClass
 }
 
 
 
-func (p *AttributeResponse) GetDoubleValue() float64 {
-	return p.DoubleValue
+func (p *AttributeError) GetCauseClass() string {
+	return p.CauseClass
 }
 
 
 
-func (p *AttributeResponse) GetIntValue() int64 {
-	return p.IntValue
+func (p *AttributeError) GetCauseMessage() string {
+	return p.CauseMessage
 }
 
-
-
-func (p *AttributeResponse) GetBoolValue() bool {
-	return p.BoolValue
-}
-
-func (p *AttributeResponse) Read(ctx context.Context, iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
+func (p *AttributeError) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
 
 	for {
@@ -986,7 +1331,7 @@ func (p *AttributeResponse) Read(ctx context.Context, iprot thrift.TProtocol) er
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -1006,7 +1351,7 @@ func (p *AttributeResponse) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
@@ -1025,36 +1370,6 @@ func (p *AttributeResponse) Read(ctx context.Context, iprot thrift.TProtocol) er
 					return err
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.DOUBLE {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.BOOL {
-				if err := p.ReadField7(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -1070,72 +1385,45 @@ func (p *AttributeResponse) Read(ctx context.Context, iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *AttributeResponse) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *AttributeError) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.StatusMsg = v
+		temp := ErrorKind(v)
+		p.Kind = temp
 	}
 	return nil
 }
 
-func (p *AttributeResponse) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeError) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.Name = v
+		p.ExceptionClass = v
 	}
 	return nil
 }
 
-func (p *AttributeResponse) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AttributeError) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		temp := ResponseType(v)
-		p.ResponseType = temp
+		p.CauseClass = v
 	}
 	return nil
 }
 
-func (p *AttributeResponse) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeError) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
-		p.StringValue = v
-	}
-	return nil
-}
-
-func (p *AttributeResponse) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadDouble(ctx); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.DoubleValue = v
-	}
-	return nil
-}
-
-func (p *AttributeResponse) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.IntValue = v
-	}
-	return nil
-}
-
-func (p *AttributeResponse) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(ctx); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.BoolValue = v
+		p.CauseMessage = v
 	}
 	return nil
 }
 
-func (p *AttributeResponse) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "AttributeResponse"); err != nil {
+func (p *AttributeError) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AttributeError"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -1143,9 +1431,6 @@ func (p *AttributeResponse) Write(ctx context.Context, oprot thrift.TProtocol) e
 		if err := p.writeField2(ctx, oprot); err != nil { return err }
 		if err := p.writeField3(ctx, oprot); err != nil { return err }
 		if err := p.writeField4(ctx, oprot); err != nil { return err }
-		if err := p.writeField5(ctx, oprot); err != nil { return err }
-		if err := p.writeField6(ctx, oprot); err != nil { return err }
-		if err := p.writeField7(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1156,203 +1441,176 @@ func (p *AttributeResponse) Write(ctx context.Context, oprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *AttributeResponse) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "statusMsg", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:statusMsg: ", p), err)
-	}
-	if err := oprot.WriteString(ctx, string(p.StatusMsg)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.statusMsg (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:statusMsg: ", p), err)
-	}
-	return err
-}
-
-func (p *AttributeResponse) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:name: ", p), err)
-	}
-	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.name (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:name: ", p), err)
-	}
-	return err
-}
-
-func (p *AttributeResponse) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "responseType", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:responseType: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.ResponseType)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.responseType (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:responseType: ", p), err)
-	}
-	return err
-}
-
-func (p *AttributeResponse) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "stringValue", thrift.STRING, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:stringValue: ", p), err)
+func (p *AttributeError) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "kind", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:kind: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.StringValue)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.stringValue (4) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.Kind)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.kind (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:stringValue: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:kind: ", p), err)
 	}
 	return err
 }
 
-func (p *AttributeResponse) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "doubleValue", thrift.DOUBLE, 5); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:doubleValue: ", p), err)
+func (p *AttributeError) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "exceptionClass", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:exceptionClass: ", p), err)
 	}
-	if err := oprot.WriteDouble(ctx, float64(p.DoubleValue)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.doubleValue (5) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.ExceptionClass)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.exceptionClass (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:doubleValue: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:exceptionClass: ", p), err)
 	}
 	return err
 }
 
-func (p *AttributeResponse) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "intValue", thrift.I64, 6); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:intValue: ", p), err)
+func (p *AttributeError) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "causeClass", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:causeClass: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.IntValue)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.intValue (6) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.CauseClass)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.causeClass (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:intValue: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:causeClass: ", p), err)
 	}
 	return err
 }
 
-func (p *AttributeResponse) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "boolValue", thrift.BOOL, 7); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:boolValue: ", p), err)
+func (p *AttributeError) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "causeMessage", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:causeMessage: ", p), err)
 	}
-	if err := oprot.WriteBool(ctx, bool(p.BoolValue)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.boolValue (7) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.CauseMessage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.causeMessage (4) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:boolValue: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:causeMessage: ", p), err)
 	}
 	return err
 }
 
-func (p *AttributeResponse) Equals(other *AttributeResponse) bool {
+func (p *AttributeError) Equals(other *AttributeError) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.StatusMsg != other.StatusMsg { return false }
-	if p.Name != other.Name { return false }
-	if p.ResponseType != other.ResponseType { return false }
-	if p.StringValue != other.StringValue { return false }
-	if p.DoubleValue != other.DoubleValue { return false }
-	if p.IntValue != other.IntValue { return false }
-	if p.BoolValue != other.BoolValue { return false }
+	if p.Kind != other.Kind { return false }
+	if p.ExceptionClass != other.ExceptionClass { return false }
+	if p.CauseClass != other.CauseClass { return false }
+	if p.CauseMessage != other.CauseMessage { return false }
 	return true
 }
 
-func (p *AttributeResponse) String() string {
+func (p *AttributeError) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttributeResponse(%+v)", *p)
+	return fmt.Sprintf("AttributeError(%+v)", *p)
 }
 
-func (p *AttributeResponse) LogValue() slog.Value {
+func (p *AttributeError) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.AttributeResponse",
+		Type: "*nrprotocol.AttributeError",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*AttributeResponse)(nil)
+var _ slog.LogValuer = (*AttributeError)(nil)
 
-func (p *AttributeResponse) Validate() error {
+func (p *AttributeError) Validate() error {
 	return nil
 }
 
 // Attributes:
-//  - StatType
-//  - MBean
-//  - Attrs
-//  - ResponseCount
-//  - Milliseconds
-//  - StartTimestamp
-//  - Successful
+//  - StatusMsg
+//  - Name
+//  - ResponseType
+//  - StringValue
+//  - DoubleValue
+//  - IntValue
+//  - BoolValue
+//  - Error
 // 
-type InternalStat struct {
-	StatType string `thrift:"statType,1" db:"statType" json:"statType"`
-	MBean string `thrift:"mBean,2" db:"mBean" json:"mBean"`
-	Attrs []string `thrift:"attrs,3" db:"attrs" json:"attrs"`
-	ResponseCount int64 `thrift:"responseCount,4" db:"responseCount" json:"responseCount"`
-	Milliseconds float64 `thrift:"milliseconds,5" db:"milliseconds" json:"milliseconds"`
-	StartTimestamp int64 `thrift:"startTimestamp,6" db:"startTimestamp" json:"startTimestamp"`
-	Successful bool `thrift:"successful,7" db:"successful" json:"successful"`
+type AttributeResponse struct {
+	StatusMsg string `thrift:"statusMsg,1" db:"statusMsg" json:"statusMsg"`
+	Name string `thrift:"name,2" db:"name" json:"name"`
+	ResponseType ResponseType `thrift:"responseType,3" db:"responseType" json:"responseType"`
+	StringValue string `thrift:"stringValue,4" db:"stringValue" json:"stringValue"`
+	DoubleValue float64 `thrift:"doubleValue,5" db:"doubleValue" json:"doubleValue"`
+	IntValue int64 `thrift:"intValue,6" db:"intValue" json:"intValue"`
+	BoolValue bool `thrift:"boolValue,7" db:"boolValue" json:"boolValue"`
+	Error *AttributeError `thrift:"error,8" db:"error" json:"error,omitempty"`
 }
 
-func NewInternalStat() *InternalStat {
-	return &InternalStat{}
+func NewAttributeResponse() *AttributeResponse {
+	return &AttributeResponse{}
 }
 
 
 
-func (p *InternalStat) GetStatType() string {
-	return p.StatType
+func (p *AttributeResponse) GetStatusMsg() string {
+	return p.StatusMsg
 }
 
 
 
-func (p *InternalStat) GetMBean() string {
-	return p.MBean
+func (p *AttributeResponse) GetName() string {
+	return p.Name
 }
 
 
 
-func (p *InternalStat) GetAttrs() []string {
-	return p.Attrs
+func (p *AttributeResponse) GetResponseType() ResponseType {
+	return p.ResponseType
 }
 
 
 
-func (p *InternalStat) GetResponseCount() int64 {
-	return p.ResponseCount
+func (p *AttributeResponse) GetStringValue() string {
+	return p.StringValue
 }
 
 
 
-func (p *InternalStat) GetMilliseconds() float64 {
-	return p.Milliseconds
+func (p *AttributeResponse) GetDoubleValue() float64 {
+	return p.DoubleValue
 }
 
 
 
-func (p *InternalStat) GetStartTimestamp() int64 {
-	return p.StartTimestamp
+func (p *AttributeResponse) GetIntValue() int64 {
+	return p.IntValue
 }
 
 
 
-func (p *InternalStat) GetSuccessful() bool {
-	return p.Successful
+func (p *AttributeResponse) GetBoolValue() bool {
+	return p.BoolValue
 }
 
-func (p *InternalStat) Read(ctx context.Context, iprot thrift.TProtocol) error {
+var AttributeResponse_Error_DEFAULT *AttributeError
+
+func (p *AttributeResponse) GetError() *AttributeError {
+	if !p.IsSetError() {
+		return AttributeResponse_Error_DEFAULT
+	}
+	return p.Error
+}
+
+func (p *AttributeResponse) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *AttributeResponse) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -1388,7 +1646,7 @@ func (p *InternalStat) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
@@ -1398,7 +1656,7 @@ func (p *InternalStat) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField4(ctx, iprot); err != nil {
 					return err
 				}
@@ -1437,6 +1695,16 @@ func (p *InternalStat) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -1452,84 +1720,80 @@ func (p *InternalStat) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *InternalStat) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeResponse) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.StatType = v
+		p.StatusMsg = v
 	}
 	return nil
 }
 
-func (p *InternalStat) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeResponse) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.MBean = v
+		p.Name = v
 	}
 	return nil
 }
 
-func (p *InternalStat) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]string, 0, size)
-	p.Attrs = tSlice
-	for i := 0; i < size; i++ {
-		var _elem0 string
-		if v, err := iprot.ReadString(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem0 = v
-		}
-		p.Attrs = append(p.Attrs, _elem0)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *AttributeResponse) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := ResponseType(v)
+		p.ResponseType = temp
 	}
 	return nil
 }
 
-func (p *InternalStat) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *AttributeResponse) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
-		p.ResponseCount = v
+		p.StringValue = v
 	}
 	return nil
 }
 
-func (p *InternalStat) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeResponse) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadDouble(ctx); err != nil {
 		return thrift.PrependError("error reading field 5: ", err)
 	} else {
-		p.Milliseconds = v
+		p.DoubleValue = v
 	}
 	return nil
 }
 
-func (p *InternalStat) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeResponse) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 6: ", err)
 	} else {
-		p.StartTimestamp = v
+		p.IntValue = v
 	}
 	return nil
 }
 
-func (p *InternalStat) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttributeResponse) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBool(ctx); err != nil {
 		return thrift.PrependError("error reading field 7: ", err)
 	} else {
-		p.Successful = v
+		p.BoolValue = v
 	}
 	return nil
 }
 
-func (p *InternalStat) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "InternalStat"); err != nil {
+func (p *AttributeResponse) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Error = &AttributeError{}
+	if err := p.Error.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
+	}
+	return nil
+}
+
+func (p *AttributeResponse) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AttributeResponse"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -1540,6 +1804,7 @@ func (p *InternalStat) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField5(ctx, oprot); err != nil { return err }
 		if err := p.writeField6(ctx, oprot); err != nil { return err }
 		if err := p.writeField7(ctx, oprot); err != nil { return err }
+		if err := p.writeField8(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1550,183 +1815,219 @@ func (p *InternalStat) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *InternalStat) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "statType", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:statType: ", p), err)
+func (p *AttributeResponse) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "statusMsg", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:statusMsg: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.StatType)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.statType (1) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.StatusMsg)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.statusMsg (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:statType: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:statusMsg: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "mBean", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:mBean: ", p), err)
+func (p *AttributeResponse) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:name: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.MBean)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.mBean (2) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:mBean: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:name: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "attrs", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:attrs: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Attrs)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Attrs {
-		if err := oprot.WriteString(ctx, string(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *AttributeResponse) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "responseType", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:responseType: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteI32(ctx, int32(p.ResponseType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.responseType (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:attrs: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:responseType: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "responseCount", thrift.I64, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:responseCount: ", p), err)
+func (p *AttributeResponse) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "stringValue", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:stringValue: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.ResponseCount)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.responseCount (4) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.StringValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.stringValue (4) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:responseCount: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:stringValue: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "milliseconds", thrift.DOUBLE, 5); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:milliseconds: ", p), err)
+func (p *AttributeResponse) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "doubleValue", thrift.DOUBLE, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:doubleValue: ", p), err)
 	}
-	if err := oprot.WriteDouble(ctx, float64(p.Milliseconds)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.milliseconds (5) field write error: ", p), err)
+	if err := oprot.WriteDouble(ctx, float64(p.DoubleValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.doubleValue (5) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:milliseconds: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:doubleValue: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "startTimestamp", thrift.I64, 6); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:startTimestamp: ", p), err)
+func (p *AttributeResponse) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "intValue", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:intValue: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.StartTimestamp)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.startTimestamp (6) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.IntValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.intValue (6) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:startTimestamp: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:intValue: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "successful", thrift.BOOL, 7); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:successful: ", p), err)
+func (p *AttributeResponse) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "boolValue", thrift.BOOL, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:boolValue: ", p), err)
 	}
-	if err := oprot.WriteBool(ctx, bool(p.Successful)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.successful (7) field write error: ", p), err)
+	if err := oprot.WriteBool(ctx, bool(p.BoolValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.boolValue (7) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:successful: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:boolValue: ", p), err)
 	}
 	return err
 }
 
-func (p *InternalStat) Equals(other *InternalStat) bool {
+func (p *AttributeResponse) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err := oprot.WriteFieldBegin(ctx, "error", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:error: ", p), err)
+		}
+		if err := p.Error.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:error: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttributeResponse) Equals(other *AttributeResponse) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.StatType != other.StatType { return false }
-	if p.MBean != other.MBean { return false }
-	if len(p.Attrs) != len(other.Attrs) { return false }
-	for i, _tgt := range p.Attrs {
-		_src1 := other.Attrs[i]
-		if _tgt != _src1 { return false }
-	}
-	if p.ResponseCount != other.ResponseCount { return false }
-	if p.Milliseconds != other.Milliseconds { return false }
-	if p.StartTimestamp != other.StartTimestamp { return false }
-	if p.Successful != other.Successful { return false }
+	if p.StatusMsg != other.StatusMsg { return false }
+	if p.Name != other.Name { return false }
+	if p.ResponseType != other.ResponseType { return false }
+	if p.StringValue != other.StringValue { return false }
+	if p.DoubleValue != other.DoubleValue { return false }
+	if p.IntValue != other.IntValue { return false }
+	if p.BoolValue != other.BoolValue { return false }
+	if !p.Error.Equals(other.Error) { return false }
 	return true
 }
 
-func (p *InternalStat) String() string {
+func (p *AttributeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InternalStat(%+v)", *p)
+	return fmt.Sprintf("AttributeResponse(%+v)", *p)
 }
 
-func (p *InternalStat) LogValue() slog.Value {
+func (p *AttributeResponse) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.InternalStat",
+		Type: "*nrprotocol.AttributeResponse",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*InternalStat)(nil)
+var _ slog.LogValuer = (*AttributeResponse)(nil)
 
-func (p *InternalStat) Validate() error {
+func (p *AttributeResponse) Validate() error {
 	return nil
 }
 
 // Attributes:
-//  - Message
-//  - CauseMessage
-//  - Stacktrace
+//  - Limit
+//  - LimitValue
+//  - MBeanName
+//  - DroppedCount
+//  - Dropped
+//  - Timestamp
+//  - EvictedBefore
 // 
-type JMXError struct {
-	Message string `thrift:"message,1" db:"message" json:"message"`
-	CauseMessage string `thrift:"causeMessage,2" db:"causeMessage" json:"causeMessage"`
-	Stacktrace string `thrift:"stacktrace,3" db:"stacktrace" json:"stacktrace"`
+type Truncation struct {
+	Limit QueryLimit `thrift:"limit,1" db:"limit" json:"limit"`
+	LimitValue int64 `thrift:"limitValue,2" db:"limitValue" json:"limitValue"`
+	MBeanName string `thrift:"mBeanName,3" db:"mBeanName" json:"mBeanName"`
+	DroppedCount int64 `thrift:"droppedCount,4" db:"droppedCount" json:"droppedCount"`
+	Dropped []string `thrift:"dropped,5" db:"dropped" json:"dropped"`
+	Timestamp int64 `thrift:"timestamp,6" db:"timestamp" json:"timestamp"`
+	EvictedBefore int64 `thrift:"evictedBefore,7" db:"evictedBefore" json:"evictedBefore"`
 }
 
-func NewJMXError() *JMXError {
-	return &JMXError{}
+func NewTruncation() *Truncation {
+	return &Truncation{}
 }
 
 
 
-func (p *JMXError) GetMessage() string {
-	return p.Message
+func (p *Truncation) GetLimit() QueryLimit {
+	return p.Limit
 }
 
 
 
-func (p *JMXError) GetCauseMessage() string {
-	return p.CauseMessage
+func (p *Truncation) GetLimitValue() int64 {
+	return p.LimitValue
 }
 
 
 
-func (p *JMXError) GetStacktrace() string {
-	return p.Stacktrace
+func (p *Truncation) GetMBeanName() string {
+	return p.MBeanName
 }
 
-func (p *JMXError) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+
+func (p *Truncation) GetDroppedCount() int64 {
+	return p.DroppedCount
+}
+
+
+
+func (p *Truncation) GetDropped() []string {
+	return p.Dropped
+}
+
+
+
+func (p *Truncation) GetTimestamp() int64 {
+	return p.Timestamp
+}
+
+
+
+func (p *Truncation) GetEvictedBefore() int64 {
+	return p.EvictedBefore
+}
+
+func (p *Truncation) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -1742,7 +2043,7 @@ func (p *JMXError) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -1752,7 +2053,7 @@ func (p *JMXError) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -1771,56 +2072,150 @@ func (p *JMXError) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *JMXError) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Message = v
-	}
-	return nil
-}
-
-func (p *JMXError) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Truncation) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.CauseMessage = v
+		temp := QueryLimit(v)
+		p.Limit = temp
 	}
 	return nil
 }
 
-func (p *JMXError) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *Truncation) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.LimitValue = v
+	}
+	return nil
+}
+
+func (p *Truncation) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.Stacktrace = v
+		p.MBeanName = v
 	}
 	return nil
 }
 
-func (p *JMXError) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "JMXError"); err != nil {
+func (p *Truncation) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.DroppedCount = v
+	}
+	return nil
+}
+
+func (p *Truncation) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Dropped = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem0 = v
+		}
+		p.Dropped = append(p.Dropped, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Truncation) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Timestamp = v
+	}
+	return nil
+}
+
+func (p *Truncation) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.EvictedBefore = v
+	}
+	return nil
+}
+
+func (p *Truncation) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Truncation"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
 		if err := p.writeField2(ctx, oprot); err != nil { return err }
 		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+		if err := p.writeField7(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1831,109 +2226,199 @@ func (p *JMXError) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JMXError) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "message", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:message: ", p), err)
+func (p *Truncation) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "limit", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:limit: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.Message)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.message (1) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.Limit)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.limit (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:message: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:limit: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXError) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "causeMessage", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:causeMessage: ", p), err)
+func (p *Truncation) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "limitValue", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:limitValue: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.CauseMessage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.causeMessage (2) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.LimitValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.limitValue (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:causeMessage: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:limitValue: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXError) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "stacktrace", thrift.STRING, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:stacktrace: ", p), err)
+func (p *Truncation) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:mBeanName: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.Stacktrace)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.stacktrace (3) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:stacktrace: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:mBeanName: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXError) Equals(other *JMXError) bool {
+func (p *Truncation) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "droppedCount", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:droppedCount: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.DroppedCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.droppedCount (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:droppedCount: ", p), err)
+	}
+	return err
+}
+
+func (p *Truncation) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "dropped", thrift.LIST, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:dropped: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Dropped)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Dropped {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:dropped: ", p), err)
+	}
+	return err
+}
+
+func (p *Truncation) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "timestamp", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:timestamp: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Timestamp)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.timestamp (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:timestamp: ", p), err)
+	}
+	return err
+}
+
+func (p *Truncation) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "evictedBefore", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:evictedBefore: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.EvictedBefore)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.evictedBefore (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:evictedBefore: ", p), err)
+	}
+	return err
+}
+
+func (p *Truncation) Equals(other *Truncation) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Message != other.Message { return false }
-	if p.CauseMessage != other.CauseMessage { return false }
-	if p.Stacktrace != other.Stacktrace { return false }
+	if p.Limit != other.Limit { return false }
+	if p.LimitValue != other.LimitValue { return false }
+	if p.MBeanName != other.MBeanName { return false }
+	if p.DroppedCount != other.DroppedCount { return false }
+	if len(p.Dropped) != len(other.Dropped) { return false }
+	for i, _tgt := range p.Dropped {
+		_src1 := other.Dropped[i]
+		if _tgt != _src1 { return false }
+	}
+	if p.Timestamp != other.Timestamp { return false }
+	if p.EvictedBefore != other.EvictedBefore { return false }
 	return true
 }
 
-func (p *JMXError) String() string {
+func (p *Truncation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXError(%+v)", *p)
-}
-
-func (p *JMXError) Error() string {
-	return p.String()
-}
-
-func (JMXError) TExceptionType() thrift.TExceptionType {
-	return thrift.TExceptionTypeCompiled
+	return fmt.Sprintf("Truncation(%+v)", *p)
 }
 
-var _ thrift.TException = (*JMXError)(nil)
-
-func (p *JMXError) LogValue() slog.Value {
+func (p *Truncation) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXError",
+		Type: "*nrprotocol.Truncation",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXError)(nil)
+var _ slog.LogValuer = (*Truncation)(nil)
 
-func (p *JMXError) Validate() error {
+func (p *Truncation) Validate() error {
 	return nil
 }
 
 // Attributes:
-//  - Message
+//  - Name
+//  - Type
+//  - Description
+//  - Readable
+//  - Writable
 // 
-type JMXConnectionError struct {
-	Message string `thrift:"message,1" db:"message" json:"message"`
+type MBeanAttributeInfo struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	Type string `thrift:"type,2" db:"type" json:"type"`
+	Description string `thrift:"description,3" db:"description" json:"description"`
+	Readable bool `thrift:"readable,4" db:"readable" json:"readable"`
+	Writable bool `thrift:"writable,5" db:"writable" json:"writable"`
 }
 
-func NewJMXConnectionError() *JMXConnectionError {
-	return &JMXConnectionError{}
+func NewMBeanAttributeInfo() *MBeanAttributeInfo {
+	return &MBeanAttributeInfo{}
 }
 
 
 
-func (p *JMXConnectionError) GetMessage() string {
-	return p.Message
+func (p *MBeanAttributeInfo) GetName() string {
+	return p.Name
 }
 
-func (p *JMXConnectionError) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+
+func (p *MBeanAttributeInfo) GetType() string {
+	return p.Type
+}
+
+
+
+func (p *MBeanAttributeInfo) GetDescription() string {
+	return p.Description
+}
+
+
+
+func (p *MBeanAttributeInfo) GetReadable() bool {
+	return p.Readable
+}
+
+
+
+func (p *MBeanAttributeInfo) GetWritable() bool {
+	return p.Writable
+}
+
+func (p *MBeanAttributeInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -1958,6 +2443,46 @@ func (p *JMXConnectionError) Read(ctx context.Context, iprot thrift.TProtocol) e
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -1973,21 +2498,61 @@ func (p *JMXConnectionError) Read(ctx context.Context, iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *JMXConnectionError) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *MBeanAttributeInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Message = v
+		p.Name = v
 	}
 	return nil
 }
 
-func (p *JMXConnectionError) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "JMXConnectionError"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *MBeanAttributeInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Type = v
+	}
+	return nil
+}
+
+func (p *MBeanAttributeInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *MBeanAttributeInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Readable = v
+	}
+	return nil
+}
+
+func (p *MBeanAttributeInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Writable = v
+	}
+	return nil
+}
+
+func (p *MBeanAttributeInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MBeanAttributeInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1998,765 +2563,8660 @@ func (p *JMXConnectionError) Write(ctx context.Context, oprot thrift.TProtocol)
 	return nil
 }
 
-func (p *JMXConnectionError) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "message", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:message: ", p), err)
+func (p *MBeanAttributeInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.Message)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.message (1) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:message: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXConnectionError) Equals(other *JMXConnectionError) bool {
+func (p *MBeanAttributeInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "type", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:type: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.type (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:type: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanAttributeInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "description", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.description (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:description: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanAttributeInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "readable", thrift.BOOL, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:readable: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Readable)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.readable (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:readable: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanAttributeInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "writable", thrift.BOOL, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:writable: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Writable)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.writable (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:writable: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanAttributeInfo) Equals(other *MBeanAttributeInfo) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Message != other.Message { return false }
+	if p.Name != other.Name { return false }
+	if p.Type != other.Type { return false }
+	if p.Description != other.Description { return false }
+	if p.Readable != other.Readable { return false }
+	if p.Writable != other.Writable { return false }
 	return true
 }
 
-func (p *JMXConnectionError) String() string {
+func (p *MBeanAttributeInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXConnectionError(%+v)", *p)
-}
-
-func (p *JMXConnectionError) Error() string {
-	return p.String()
-}
-
-func (JMXConnectionError) TExceptionType() thrift.TExceptionType {
-	return thrift.TExceptionTypeCompiled
+	return fmt.Sprintf("MBeanAttributeInfo(%+v)", *p)
 }
 
-var _ thrift.TException = (*JMXConnectionError)(nil)
-
-func (p *JMXConnectionError) LogValue() slog.Value {
+func (p *MBeanAttributeInfo) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXConnectionError",
+		Type: "*nrprotocol.MBeanAttributeInfo",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXConnectionError)(nil)
+var _ slog.LogValuer = (*MBeanAttributeInfo)(nil)
 
-func (p *JMXConnectionError) Validate() error {
+func (p *MBeanAttributeInfo) Validate() error {
 	return nil
 }
 
-type JMXService interface {
-	// Parameters:
-	//  - Config
-	// 
-	Connect(ctx context.Context, config *JMXConfig) (_err error)
-	Disconnect(ctx context.Context) (_err error)
-	GetClientVersion(ctx context.Context) (_r string, _err error)
-	// Parameters:
-	//  - MBeanNamePattern
-	// 
-	QueryMBeanNames(ctx context.Context, mBeanNamePattern string) (_r []string, _err error)
-	// Parameters:
-	//  - MBeanName
-	// 
-	GetMBeanAttributeNames(ctx context.Context, mBeanName string) (_r []string, _err error)
-	// Parameters:
-	//  - MBeanName
-	//  - Attributes
-	// 
-	GetMBeanAttributes(ctx context.Context, mBeanName string, attributes []string) (_r []*AttributeResponse, _err error)
-	// Parameters:
-	//  - MBeanNamePattern
-	//  - Attributes
-	// 
-	QueryMBeanAttributes(ctx context.Context, mBeanNamePattern string, attributes []string) (_r []*AttributeResponse, _err error)
-	GetInternalStats(ctx context.Context) (_r []*InternalStat, _err error)
+// Attributes:
+//  - Name
+//  - Type
+//  - Description
+// 
+type MBeanParameterInfo struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	Type string `thrift:"type,2" db:"type" json:"type"`
+	Description string `thrift:"description,3" db:"description" json:"description"`
 }
 
-type JMXServiceClient struct {
-	c thrift.TClient
-	meta thrift.ResponseMeta
+func NewMBeanParameterInfo() *MBeanParameterInfo {
+	return &MBeanParameterInfo{}
 }
 
-func NewJMXServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *JMXServiceClient {
-	return &JMXServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
-}
 
-func NewJMXServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *JMXServiceClient {
-	return &JMXServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
-}
 
-func NewJMXServiceClient(c thrift.TClient) *JMXServiceClient {
-	return &JMXServiceClient{
-		c: c,
-	}
+func (p *MBeanParameterInfo) GetName() string {
+	return p.Name
 }
 
-func (p *JMXServiceClient) Client_() thrift.TClient {
-	return p.c
-}
 
-func (p *JMXServiceClient) LastResponseMeta_() thrift.ResponseMeta {
-	return p.meta
+
+func (p *MBeanParameterInfo) GetType() string {
+	return p.Type
 }
 
-func (p *JMXServiceClient) SetLastResponseMeta_(meta thrift.ResponseMeta) {
-	p.meta = meta
+
+
+func (p *MBeanParameterInfo) GetDescription() string {
+	return p.Description
 }
 
-// Parameters:
-//  - Config
-// 
-func (p *JMXServiceClient) Connect(ctx context.Context, config *JMXConfig) (_err error) {
-	var _args2 JMXServiceConnectArgs
-	_args2.Config = config
-	var _result4 JMXServiceConnectResult
-	var _meta3 thrift.ResponseMeta
-	_meta3, _err = p.Client_().Call(ctx, "connect", &_args2, &_result4)
-	p.SetLastResponseMeta_(_meta3)
-	if _err != nil {
-		return
-	}
-	switch {
-	case _result4.ConnErr!= nil:
-		return _result4.ConnErr
-	case _result4.JmxErr!= nil:
-		return _result4.JmxErr
+func (p *MBeanParameterInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	return nil
-}
 
-func (p *JMXServiceClient) Disconnect(ctx context.Context) (_err error) {
-	var _args5 JMXServiceDisconnectArgs
-	var _result7 JMXServiceDisconnectResult
-	var _meta6 thrift.ResponseMeta
-	_meta6, _err = p.Client_().Call(ctx, "disconnect", &_args5, &_result7)
-	p.SetLastResponseMeta_(_meta6)
-	if _err != nil {
-		return
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	switch {
-	case _result7.Err!= nil:
-		return _result7.Err
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-
 	return nil
 }
 
-func (p *JMXServiceClient) GetClientVersion(ctx context.Context) (_r string, _err error) {
-	var _args8 JMXServiceGetClientVersionArgs
-	var _result10 JMXServiceGetClientVersionResult
-	var _meta9 thrift.ResponseMeta
-	_meta9, _err = p.Client_().Call(ctx, "getClientVersion", &_args8, &_result10)
-	p.SetLastResponseMeta_(_meta9)
-	if _err != nil {
-		return
-	}
-	switch {
-	case _result10.Err!= nil:
-		return _r, _result10.Err
+func (p *MBeanParameterInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
 	}
-
-	return _result10.GetSuccess(), nil
+	return nil
 }
 
-// Parameters:
-//  - MBeanNamePattern
-// 
-func (p *JMXServiceClient) QueryMBeanNames(ctx context.Context, mBeanNamePattern string) (_r []string, _err error) {
-	var _args11 JMXServiceQueryMBeanNamesArgs
-	_args11.MBeanNamePattern = mBeanNamePattern
-	var _result13 JMXServiceQueryMBeanNamesResult
-	var _meta12 thrift.ResponseMeta
-	_meta12, _err = p.Client_().Call(ctx, "queryMBeanNames", &_args11, &_result13)
-	p.SetLastResponseMeta_(_meta12)
-	if _err != nil {
-		return
-	}
-	switch {
-	case _result13.ConnErr!= nil:
-		return _r, _result13.ConnErr
-	case _result13.JmxErr!= nil:
-		return _r, _result13.JmxErr
+func (p *MBeanParameterInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Type = v
 	}
+	return nil
+}
 
-	return _result13.GetSuccess(), nil
+func (p *MBeanParameterInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
 }
 
-// Parameters:
-//  - MBeanName
-// 
-func (p *JMXServiceClient) GetMBeanAttributeNames(ctx context.Context, mBeanName string) (_r []string, _err error) {
-	var _args14 JMXServiceGetMBeanAttributeNamesArgs
-	_args14.MBeanName = mBeanName
-	var _result16 JMXServiceGetMBeanAttributeNamesResult
-	var _meta15 thrift.ResponseMeta
-	_meta15, _err = p.Client_().Call(ctx, "getMBeanAttributeNames", &_args14, &_result16)
-	p.SetLastResponseMeta_(_meta15)
-	if _err != nil {
-		return
+func (p *MBeanParameterInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MBeanParameterInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	switch {
-	case _result16.ConnErr!= nil:
-		return _r, _result16.ConnErr
-	case _result16.JmxErr!= nil:
-		return _r, _result16.JmxErr
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
 	}
-
-	return _result16.GetSuccess(), nil
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-// Parameters:
-//  - MBeanName
-//  - Attributes
-// 
-func (p *JMXServiceClient) GetMBeanAttributes(ctx context.Context, mBeanName string, attributes []string) (_r []*AttributeResponse, _err error) {
-	var _args17 JMXServiceGetMBeanAttributesArgs
-	_args17.MBeanName = mBeanName
-	_args17.Attributes = attributes
-	var _result19 JMXServiceGetMBeanAttributesResult
-	var _meta18 thrift.ResponseMeta
-	_meta18, _err = p.Client_().Call(ctx, "getMBeanAttributes", &_args17, &_result19)
-	p.SetLastResponseMeta_(_meta18)
-	if _err != nil {
-		return
+func (p *MBeanParameterInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
 	}
-	switch {
-	case _result19.ConnErr!= nil:
-		return _r, _result19.ConnErr
-	case _result19.JmxErr!= nil:
-		return _r, _result19.JmxErr
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
 	}
+	return err
+}
 
-	return _result19.GetSuccess(), nil
+func (p *MBeanParameterInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "type", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:type: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.type (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:type: ", p), err)
+	}
+	return err
 }
 
-// Parameters:
-//  - MBeanNamePattern
-//  - Attributes
-// 
-func (p *JMXServiceClient) QueryMBeanAttributes(ctx context.Context, mBeanNamePattern string, attributes []string) (_r []*AttributeResponse, _err error) {
-	var _args20 JMXServiceQueryMBeanAttributesArgs
-	_args20.MBeanNamePattern = mBeanNamePattern
-	_args20.Attributes = attributes
-	var _result22 JMXServiceQueryMBeanAttributesResult
-	var _meta21 thrift.ResponseMeta
-	_meta21, _err = p.Client_().Call(ctx, "queryMBeanAttributes", &_args20, &_result22)
-	p.SetLastResponseMeta_(_meta21)
-	if _err != nil {
-		return
+func (p *MBeanParameterInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "description", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:description: ", p), err)
 	}
-	switch {
-	case _result22.ConnErr!= nil:
-		return _r, _result22.ConnErr
-	case _result22.JmxErr!= nil:
-		return _r, _result22.JmxErr
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.description (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:description: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanParameterInfo) Equals(other *MBeanParameterInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
+	if p.Name != other.Name { return false }
+	if p.Type != other.Type { return false }
+	if p.Description != other.Description { return false }
+	return true
+}
 
-	return _result22.GetSuccess(), nil
+func (p *MBeanParameterInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MBeanParameterInfo(%+v)", *p)
 }
 
-func (p *JMXServiceClient) GetInternalStats(ctx context.Context) (_r []*InternalStat, _err error) {
-	var _args23 JMXServiceGetInternalStatsArgs
-	var _result25 JMXServiceGetInternalStatsResult
-	var _meta24 thrift.ResponseMeta
-	_meta24, _err = p.Client_().Call(ctx, "getInternalStats", &_args23, &_result25)
-	p.SetLastResponseMeta_(_meta24)
-	if _err != nil {
-		return
+func (p *MBeanParameterInfo) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
 	}
-	switch {
-	case _result25.JmxErr!= nil:
-		return _r, _result25.JmxErr
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.MBeanParameterInfo",
+		Value: p,
 	}
+	return slog.AnyValue(v)
+}
 
-	return _result25.GetSuccess(), nil
+var _ slog.LogValuer = (*MBeanParameterInfo)(nil)
+
+func (p *MBeanParameterInfo) Validate() error {
+	return nil
 }
 
-type JMXServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler JMXService
+// Attributes:
+//  - Name
+//  - ReturnType
+//  - Description
+//  - Signature
+//  - Impact
+// 
+type MBeanOperationInfo struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	ReturnType string `thrift:"returnType,2" db:"returnType" json:"returnType"`
+	Description string `thrift:"description,3" db:"description" json:"description"`
+	Signature []*MBeanParameterInfo `thrift:"signature,4" db:"signature" json:"signature"`
+	Impact int32 `thrift:"impact,5" db:"impact" json:"impact"`
 }
 
-func (p *JMXServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewMBeanOperationInfo() *MBeanOperationInfo {
+	return &MBeanOperationInfo{}
 }
 
-func (p *JMXServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+
+
+func (p *MBeanOperationInfo) GetName() string {
+	return p.Name
 }
 
-func (p *JMXServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+
+
+func (p *MBeanOperationInfo) GetReturnType() string {
+	return p.ReturnType
 }
 
-func NewJMXServiceProcessor(handler JMXService) *JMXServiceProcessor {
 
-	self26 := &JMXServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-	self26.processorMap["connect"] = &jMXServiceProcessorConnect{handler:handler}
-	self26.processorMap["disconnect"] = &jMXServiceProcessorDisconnect{handler:handler}
-	self26.processorMap["getClientVersion"] = &jMXServiceProcessorGetClientVersion{handler:handler}
-	self26.processorMap["queryMBeanNames"] = &jMXServiceProcessorQueryMBeanNames{handler:handler}
-	self26.processorMap["getMBeanAttributeNames"] = &jMXServiceProcessorGetMBeanAttributeNames{handler:handler}
-	self26.processorMap["getMBeanAttributes"] = &jMXServiceProcessorGetMBeanAttributes{handler:handler}
-	self26.processorMap["queryMBeanAttributes"] = &jMXServiceProcessorQueryMBeanAttributes{handler:handler}
-	self26.processorMap["getInternalStats"] = &jMXServiceProcessorGetInternalStats{handler:handler}
-	return self26
+
+func (p *MBeanOperationInfo) GetDescription() string {
+	return p.Description
 }
 
-func (p *JMXServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err2 := iprot.ReadMessageBegin(ctx)
-	if err2 != nil { return false, thrift.WrapTException(err2) }
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(ctx, thrift.STRUCT)
-	iprot.ReadMessageEnd(ctx)
-	x27 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-	oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-	x27.Write(ctx, oprot)
-	oprot.WriteMessageEnd(ctx)
-	oprot.Flush(ctx)
-	return false, x27
+
+
+func (p *MBeanOperationInfo) GetSignature() []*MBeanParameterInfo {
+	return p.Signature
 }
 
-type jMXServiceProcessorConnect struct {
-	handler JMXService
+
+
+func (p *MBeanOperationInfo) GetImpact() int32 {
+	return p.Impact
 }
 
-func (p *jMXServiceProcessorConnect) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err28 error
-	args := JMXServiceConnectArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "connect", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *MBeanOperationInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	iprot.ReadMessageEnd(ctx)
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
-	}
 
-	result := JMXServiceConnectResult{}
-	if err2 := p.handler.Connect(ctx, args.Config); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXConnectionError:
-			result.ConnErr = v
-		case *JMXError:
-			result.JmxErr = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-			_exc29 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing connect: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "connect", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err28 = thrift.WrapTException(err2)
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if err2 := _exc29.Write(ctx, oprot); _write_err28 == nil && err2 != nil {
-				_write_err28 = thrift.WrapTException(err2)
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err28 == nil && err2 != nil {
-				_write_err28 = thrift.WrapTException(err2)
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if err2 := oprot.Flush(ctx); _write_err28 == nil && err2 != nil {
-				_write_err28 = thrift.WrapTException(err2)
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if _write_err28 != nil {
-				return false, thrift.WrapTException(_write_err28)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
-			return true, err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
 		}
 	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "connect", thrift.REPLY, seqId); err2 != nil {
-		_write_err28 = thrift.WrapTException(err2)
-	}
-	if err2 := result.Write(ctx, oprot); _write_err28 == nil && err2 != nil {
-		_write_err28 = thrift.WrapTException(err2)
-	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err28 == nil && err2 != nil {
-		_write_err28 = thrift.WrapTException(err2)
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if err2 := oprot.Flush(ctx); _write_err28 == nil && err2 != nil {
-		_write_err28 = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *MBeanOperationInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
 	}
-	if _write_err28 != nil {
-		return false, thrift.WrapTException(_write_err28)
+	return nil
+}
+
+func (p *MBeanOperationInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.ReturnType = v
 	}
-	return true, err
+	return nil
 }
 
-type jMXServiceProcessorDisconnect struct {
-	handler JMXService
+func (p *MBeanOperationInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
 }
 
-func (p *jMXServiceProcessorDisconnect) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err30 error
-	args := JMXServiceDisconnectArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "disconnect", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *MBeanOperationInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	iprot.ReadMessageEnd(ctx)
+	tSlice := make([]*MBeanParameterInfo, 0, size)
+	p.Signature = tSlice
+	for i := 0; i < size; i++ {
+		_elem2 := &MBeanParameterInfo{}
+		if err := _elem2.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		}
+		p.Signature = append(p.Signature, _elem2)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
+func (p *MBeanOperationInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Impact = v
 	}
+	return nil
+}
 
-	result := JMXServiceDisconnectResult{}
-	if err2 := p.handler.Disconnect(ctx); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXError:
-			result.Err = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
-				}
-			}
-			_exc31 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing disconnect: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "disconnect", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err30 = thrift.WrapTException(err2)
-			}
-			if err2 := _exc31.Write(ctx, oprot); _write_err30 == nil && err2 != nil {
-				_write_err30 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err30 == nil && err2 != nil {
-				_write_err30 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.Flush(ctx); _write_err30 == nil && err2 != nil {
-				_write_err30 = thrift.WrapTException(err2)
-			}
-			if _write_err30 != nil {
-				return false, thrift.WrapTException(_write_err30)
-			}
-			return true, err
-		}
-	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "disconnect", thrift.REPLY, seqId); err2 != nil {
-		_write_err30 = thrift.WrapTException(err2)
-	}
-	if err2 := result.Write(ctx, oprot); _write_err30 == nil && err2 != nil {
-		_write_err30 = thrift.WrapTException(err2)
+func (p *MBeanOperationInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MBeanOperationInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err30 == nil && err2 != nil {
-		_write_err30 = thrift.WrapTException(err2)
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
 	}
-	if err2 := oprot.Flush(ctx); _write_err30 == nil && err2 != nil {
-		_write_err30 = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if _write_err30 != nil {
-		return false, thrift.WrapTException(_write_err30)
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return true, err
-}
-
-type jMXServiceProcessorGetClientVersion struct {
-	handler JMXService
+	return nil
 }
 
-func (p *jMXServiceProcessorGetClientVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err32 error
-	args := JMXServiceGetClientVersionArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "getClientVersion", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *MBeanOperationInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
 	}
-	iprot.ReadMessageEnd(ctx)
-
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
 	}
+	return err
+}
 
-	result := JMXServiceGetClientVersionResult{}
-	if retval, err2 := p.handler.GetClientVersion(ctx); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXError:
-			result.Err = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
-				}
-			}
-			_exc33 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getClientVersion: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "getClientVersion", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err32 = thrift.WrapTException(err2)
-			}
-			if err2 := _exc33.Write(ctx, oprot); _write_err32 == nil && err2 != nil {
-				_write_err32 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err32 == nil && err2 != nil {
-				_write_err32 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.Flush(ctx); _write_err32 == nil && err2 != nil {
-				_write_err32 = thrift.WrapTException(err2)
-			}
-			if _write_err32 != nil {
-				return false, thrift.WrapTException(_write_err32)
-			}
-			return true, err
-		}
-	} else {
-		result.Success = &retval
+func (p *MBeanOperationInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "returnType", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:returnType: ", p), err)
 	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "getClientVersion", thrift.REPLY, seqId); err2 != nil {
-		_write_err32 = thrift.WrapTException(err2)
+	if err := oprot.WriteString(ctx, string(p.ReturnType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.returnType (2) field write error: ", p), err)
 	}
-	if err2 := result.Write(ctx, oprot); _write_err32 == nil && err2 != nil {
-		_write_err32 = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:returnType: ", p), err)
 	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err32 == nil && err2 != nil {
-		_write_err32 = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *MBeanOperationInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "description", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:description: ", p), err)
 	}
-	if err2 := oprot.Flush(ctx); _write_err32 == nil && err2 != nil {
-		_write_err32 = thrift.WrapTException(err2)
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.description (3) field write error: ", p), err)
 	}
-	if _write_err32 != nil {
-		return false, thrift.WrapTException(_write_err32)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:description: ", p), err)
 	}
-	return true, err
-}
-
-type jMXServiceProcessorQueryMBeanNames struct {
-	handler JMXService
+	return err
 }
 
-func (p *jMXServiceProcessorQueryMBeanNames) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err34 error
-	args := JMXServiceQueryMBeanNamesArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "queryMBeanNames", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *MBeanOperationInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "signature", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:signature: ", p), err)
 	}
-	iprot.ReadMessageEnd(ctx)
-
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Signature)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-
-	result := JMXServiceQueryMBeanNamesResult{}
-	if retval, err2 := p.handler.QueryMBeanNames(ctx, args.MBeanNamePattern); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXConnectionError:
-			result.ConnErr = v
-		case *JMXError:
-			result.JmxErr = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
-				}
-			}
-			_exc35 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing queryMBeanNames: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanNames", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err34 = thrift.WrapTException(err2)
-			}
-			if err2 := _exc35.Write(ctx, oprot); _write_err34 == nil && err2 != nil {
-				_write_err34 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err34 == nil && err2 != nil {
-				_write_err34 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.Flush(ctx); _write_err34 == nil && err2 != nil {
-				_write_err34 = thrift.WrapTException(err2)
-			}
-			if _write_err34 != nil {
-				return false, thrift.WrapTException(_write_err34)
-			}
-			return true, err
+	for _, v := range p.Signature {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
-	} else {
-		result.Success = retval
 	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanNames", thrift.REPLY, seqId); err2 != nil {
-		_write_err34 = thrift.WrapTException(err2)
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
-	if err2 := result.Write(ctx, oprot); _write_err34 == nil && err2 != nil {
-		_write_err34 = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:signature: ", p), err)
 	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err34 == nil && err2 != nil {
-		_write_err34 = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *MBeanOperationInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "impact", thrift.I32, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:impact: ", p), err)
 	}
-	if err2 := oprot.Flush(ctx); _write_err34 == nil && err2 != nil {
-		_write_err34 = thrift.WrapTException(err2)
+	if err := oprot.WriteI32(ctx, int32(p.Impact)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.impact (5) field write error: ", p), err)
 	}
-	if _write_err34 != nil {
-		return false, thrift.WrapTException(_write_err34)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:impact: ", p), err)
 	}
-	return true, err
+	return err
 }
 
-type jMXServiceProcessorGetMBeanAttributeNames struct {
-	handler JMXService
+func (p *MBeanOperationInfo) Equals(other *MBeanOperationInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name { return false }
+	if p.ReturnType != other.ReturnType { return false }
+	if p.Description != other.Description { return false }
+	if len(p.Signature) != len(other.Signature) { return false }
+	for i, _tgt := range p.Signature {
+		_src3 := other.Signature[i]
+		if !_tgt.Equals(_src3) { return false }
+	}
+	if p.Impact != other.Impact { return false }
+	return true
 }
 
-func (p *jMXServiceProcessorGetMBeanAttributeNames) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err36 error
-	args := JMXServiceGetMBeanAttributeNamesArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "getMBeanAttributeNames", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *MBeanOperationInfo) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	iprot.ReadMessageEnd(ctx)
+	return fmt.Sprintf("MBeanOperationInfo(%+v)", *p)
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
+func (p *MBeanOperationInfo) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.MBeanOperationInfo",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*MBeanOperationInfo)(nil)
+
+func (p *MBeanOperationInfo) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - Name
+//  - ClassName
+//  - Description
+//  - Attributes
+//  - Operations
+// 
+type MBeanInfo struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	ClassName string `thrift:"className,2" db:"className" json:"className"`
+	Description string `thrift:"description,3" db:"description" json:"description"`
+	Attributes []*MBeanAttributeInfo `thrift:"attributes,4" db:"attributes" json:"attributes"`
+	Operations []*MBeanOperationInfo `thrift:"operations,5" db:"operations" json:"operations"`
+}
+
+func NewMBeanInfo() *MBeanInfo {
+	return &MBeanInfo{}
+}
+
+
+
+func (p *MBeanInfo) GetName() string {
+	return p.Name
+}
+
+
+
+func (p *MBeanInfo) GetClassName() string {
+	return p.ClassName
+}
+
+
+
+func (p *MBeanInfo) GetDescription() string {
+	return p.Description
+}
+
+
+
+func (p *MBeanInfo) GetAttributes() []*MBeanAttributeInfo {
+	return p.Attributes
+}
+
+
+
+func (p *MBeanInfo) GetOperations() []*MBeanOperationInfo {
+	return p.Operations
+}
+
+func (p *MBeanInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *MBeanInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *MBeanInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.ClassName = v
+	}
+	return nil
+}
+
+func (p *MBeanInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *MBeanInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*MBeanAttributeInfo, 0, size)
+	p.Attributes = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &MBeanAttributeInfo{}
+		if err := _elem4.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.Attributes = append(p.Attributes, _elem4)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *MBeanInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*MBeanOperationInfo, 0, size)
+	p.Operations = tSlice
+	for i := 0; i < size; i++ {
+		_elem5 := &MBeanOperationInfo{}
+		if err := _elem5.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
+		}
+		p.Operations = append(p.Operations, _elem5)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *MBeanInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MBeanInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *MBeanInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "className", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:className: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ClassName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.className (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:className: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "description", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.description (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:description: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "attributes", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:attributes: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Attributes)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Attributes {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:attributes: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "operations", thrift.LIST, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:operations: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Operations)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Operations {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:operations: ", p), err)
+	}
+	return err
+}
+
+func (p *MBeanInfo) Equals(other *MBeanInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name { return false }
+	if p.ClassName != other.ClassName { return false }
+	if p.Description != other.Description { return false }
+	if len(p.Attributes) != len(other.Attributes) { return false }
+	for i, _tgt := range p.Attributes {
+		_src6 := other.Attributes[i]
+		if !_tgt.Equals(_src6) { return false }
+	}
+	if len(p.Operations) != len(other.Operations) { return false }
+	for i, _tgt := range p.Operations {
+		_src7 := other.Operations[i]
+		if !_tgt.Equals(_src7) { return false }
+	}
+	return true
+}
+
+func (p *MBeanInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MBeanInfo(%+v)", *p)
+}
+
+func (p *MBeanInfo) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.MBeanInfo",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*MBeanInfo)(nil)
+
+func (p *MBeanInfo) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - StatType
+//  - MBean
+//  - Attrs
+//  - ResponseCount
+//  - Milliseconds
+//  - StartTimestamp
+//  - Successful
+//  - RequestId
+// 
+type InternalStat struct {
+	StatType string `thrift:"statType,1" db:"statType" json:"statType"`
+	MBean string `thrift:"mBean,2" db:"mBean" json:"mBean"`
+	Attrs []string `thrift:"attrs,3" db:"attrs" json:"attrs"`
+	ResponseCount int64 `thrift:"responseCount,4" db:"responseCount" json:"responseCount"`
+	Milliseconds float64 `thrift:"milliseconds,5" db:"milliseconds" json:"milliseconds"`
+	StartTimestamp int64 `thrift:"startTimestamp,6" db:"startTimestamp" json:"startTimestamp"`
+	Successful bool `thrift:"successful,7" db:"successful" json:"successful"`
+	RequestId int32 `thrift:"requestId,8" db:"requestId" json:"requestId"`
+}
+
+func NewInternalStat() *InternalStat {
+	return &InternalStat{}
+}
+
+
+
+func (p *InternalStat) GetStatType() string {
+	return p.StatType
+}
+
+
+
+func (p *InternalStat) GetMBean() string {
+	return p.MBean
+}
+
+
+
+func (p *InternalStat) GetAttrs() []string {
+	return p.Attrs
+}
+
+
+
+func (p *InternalStat) GetResponseCount() int64 {
+	return p.ResponseCount
+}
+
+
+
+func (p *InternalStat) GetMilliseconds() float64 {
+	return p.Milliseconds
+}
+
+
+
+func (p *InternalStat) GetStartTimestamp() int64 {
+	return p.StartTimestamp
+}
+
+
+
+func (p *InternalStat) GetSuccessful() bool {
+	return p.Successful
+}
+
+
+
+func (p *InternalStat) GetRequestId() int32 {
+	return p.RequestId
+}
+
+func (p *InternalStat) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.StatType = v
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.MBean = v
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Attrs = tSlice
+	for i := 0; i < size; i++ {
+		var _elem8 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem8 = v
+		}
+		p.Attrs = append(p.Attrs, _elem8)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.ResponseCount = v
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Milliseconds = v
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.StartTimestamp = v
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Successful = v
+	}
+	return nil
+}
+
+func (p *InternalStat) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.RequestId = v
+	}
+	return nil
+}
+
+func (p *InternalStat) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InternalStat"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+		if err := p.writeField7(ctx, oprot); err != nil { return err }
+		if err := p.writeField8(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InternalStat) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "statType", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:statType: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.StatType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.statType (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:statType: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBean", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:mBean: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBean)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBean (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:mBean: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "attrs", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:attrs: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Attrs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Attrs {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:attrs: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "responseCount", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:responseCount: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ResponseCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.responseCount (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:responseCount: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "milliseconds", thrift.DOUBLE, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:milliseconds: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.Milliseconds)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.milliseconds (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:milliseconds: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "startTimestamp", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:startTimestamp: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.StartTimestamp)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.startTimestamp (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:startTimestamp: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "successful", thrift.BOOL, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:successful: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Successful)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.successful (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:successful: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "requestId", thrift.I32, 8); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:requestId: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.RequestId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestId (8) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:requestId: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalStat) Equals(other *InternalStat) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.StatType != other.StatType { return false }
+	if p.MBean != other.MBean { return false }
+	if len(p.Attrs) != len(other.Attrs) { return false }
+	for i, _tgt := range p.Attrs {
+		_src9 := other.Attrs[i]
+		if _tgt != _src9 { return false }
+	}
+	if p.ResponseCount != other.ResponseCount { return false }
+	if p.Milliseconds != other.Milliseconds { return false }
+	if p.StartTimestamp != other.StartTimestamp { return false }
+	if p.Successful != other.Successful { return false }
+	if p.RequestId != other.RequestId { return false }
+	return true
+}
+
+func (p *InternalStat) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InternalStat(%+v)", *p)
+}
+
+func (p *InternalStat) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.InternalStat",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*InternalStat)(nil)
+
+func (p *InternalStat) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - StatType
+//  - Count
+//  - Errors
+//  - TotalMs
+//  - MaxMs
+//  - BucketBoundsMs
+//  - BucketCounts
+// 
+type InternalMetric struct {
+	StatType string `thrift:"statType,1" db:"statType" json:"statType"`
+	Count int64 `thrift:"count,2" db:"count" json:"count"`
+	Errors int64 `thrift:"errors,3" db:"errors" json:"errors"`
+	TotalMs float64 `thrift:"totalMs,4" db:"totalMs" json:"totalMs"`
+	MaxMs float64 `thrift:"maxMs,5" db:"maxMs" json:"maxMs"`
+	BucketBoundsMs []float64 `thrift:"bucketBoundsMs,6" db:"bucketBoundsMs" json:"bucketBoundsMs"`
+	BucketCounts []int64 `thrift:"bucketCounts,7" db:"bucketCounts" json:"bucketCounts"`
+}
+
+func NewInternalMetric() *InternalMetric {
+	return &InternalMetric{}
+}
+
+
+
+func (p *InternalMetric) GetStatType() string {
+	return p.StatType
+}
+
+
+
+func (p *InternalMetric) GetCount() int64 {
+	return p.Count
+}
+
+
+
+func (p *InternalMetric) GetErrors() int64 {
+	return p.Errors
+}
+
+
+
+func (p *InternalMetric) GetTotalMs() float64 {
+	return p.TotalMs
+}
+
+
+
+func (p *InternalMetric) GetMaxMs() float64 {
+	return p.MaxMs
+}
+
+
+
+func (p *InternalMetric) GetBucketBoundsMs() []float64 {
+	return p.BucketBoundsMs
+}
+
+
+
+func (p *InternalMetric) GetBucketCounts() []int64 {
+	return p.BucketCounts
+}
+
+func (p *InternalMetric) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.StatType = v
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Count = v
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Errors = v
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.TotalMs = v
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.MaxMs = v
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]float64, 0, size)
+	p.BucketBoundsMs = tSlice
+	for i := 0; i < size; i++ {
+		var _elem10 float64
+		if v, err := iprot.ReadDouble(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem10 = v
+		}
+		p.BucketBoundsMs = append(p.BucketBoundsMs, _elem10)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *InternalMetric) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int64, 0, size)
+	p.BucketCounts = tSlice
+	for i := 0; i < size; i++ {
+		var _elem11 int64
+		if v, err := iprot.ReadI64(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem11 = v
+		}
+		p.BucketCounts = append(p.BucketCounts, _elem11)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *InternalMetric) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InternalMetric"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+		if err := p.writeField7(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InternalMetric) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "statType", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:statType: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.StatType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.statType (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:statType: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "count", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:count: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Count)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.count (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:count: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "errors", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:errors: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Errors)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.errors (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:errors: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "totalMs", thrift.DOUBLE, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:totalMs: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.TotalMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.totalMs (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:totalMs: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "maxMs", thrift.DOUBLE, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:maxMs: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.MaxMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxMs (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:maxMs: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "bucketBoundsMs", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:bucketBoundsMs: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.DOUBLE, len(p.BucketBoundsMs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.BucketBoundsMs {
+		if err := oprot.WriteDouble(ctx, float64(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:bucketBoundsMs: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "bucketCounts", thrift.LIST, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:bucketCounts: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.BucketCounts)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.BucketCounts {
+		if err := oprot.WriteI64(ctx, int64(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:bucketCounts: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetric) Equals(other *InternalMetric) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.StatType != other.StatType { return false }
+	if p.Count != other.Count { return false }
+	if p.Errors != other.Errors { return false }
+	if p.TotalMs != other.TotalMs { return false }
+	if p.MaxMs != other.MaxMs { return false }
+	if len(p.BucketBoundsMs) != len(other.BucketBoundsMs) { return false }
+	for i, _tgt := range p.BucketBoundsMs {
+		_src12 := other.BucketBoundsMs[i]
+		if _tgt != _src12 { return false }
+	}
+	if len(p.BucketCounts) != len(other.BucketCounts) { return false }
+	for i, _tgt := range p.BucketCounts {
+		_src13 := other.BucketCounts[i]
+		if _tgt != _src13 { return false }
+	}
+	return true
+}
+
+func (p *InternalMetric) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InternalMetric(%+v)", *p)
+}
+
+func (p *InternalMetric) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.InternalMetric",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*InternalMetric)(nil)
+
+func (p *InternalMetric) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - StartTimestamp
+//  - Metrics
+//  - SamplesEnabled
+//  - MaxSamples
+//  - BufferedSamples
+//  - EvictedSamples
+// 
+type InternalMetrics struct {
+	StartTimestamp int64 `thrift:"startTimestamp,1" db:"startTimestamp" json:"startTimestamp"`
+	Metrics []*InternalMetric `thrift:"metrics,2" db:"metrics" json:"metrics"`
+	SamplesEnabled bool `thrift:"samplesEnabled,3" db:"samplesEnabled" json:"samplesEnabled"`
+	MaxSamples int64 `thrift:"maxSamples,4" db:"maxSamples" json:"maxSamples"`
+	BufferedSamples int64 `thrift:"bufferedSamples,5" db:"bufferedSamples" json:"bufferedSamples"`
+	EvictedSamples int64 `thrift:"evictedSamples,6" db:"evictedSamples" json:"evictedSamples"`
+}
+
+func NewInternalMetrics() *InternalMetrics {
+	return &InternalMetrics{}
+}
+
+
+
+func (p *InternalMetrics) GetStartTimestamp() int64 {
+	return p.StartTimestamp
+}
+
+
+
+func (p *InternalMetrics) GetMetrics() []*InternalMetric {
+	return p.Metrics
+}
+
+
+
+func (p *InternalMetrics) GetSamplesEnabled() bool {
+	return p.SamplesEnabled
+}
+
+
+
+func (p *InternalMetrics) GetMaxSamples() int64 {
+	return p.MaxSamples
+}
+
+
+
+func (p *InternalMetrics) GetBufferedSamples() int64 {
+	return p.BufferedSamples
+}
+
+
+
+func (p *InternalMetrics) GetEvictedSamples() int64 {
+	return p.EvictedSamples
+}
+
+func (p *InternalMetrics) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InternalMetrics) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.StartTimestamp = v
+	}
+	return nil
+}
+
+func (p *InternalMetrics) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*InternalMetric, 0, size)
+	p.Metrics = tSlice
+	for i := 0; i < size; i++ {
+		_elem14 := &InternalMetric{}
+		if err := _elem14.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem14), err)
+		}
+		p.Metrics = append(p.Metrics, _elem14)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *InternalMetrics) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.SamplesEnabled = v
+	}
+	return nil
+}
+
+func (p *InternalMetrics) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.MaxSamples = v
+	}
+	return nil
+}
+
+func (p *InternalMetrics) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.BufferedSamples = v
+	}
+	return nil
+}
+
+func (p *InternalMetrics) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.EvictedSamples = v
+	}
+	return nil
+}
+
+func (p *InternalMetrics) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InternalMetrics"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InternalMetrics) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "startTimestamp", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startTimestamp: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.StartTimestamp)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.startTimestamp (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startTimestamp: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetrics) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "metrics", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:metrics: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Metrics)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Metrics {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:metrics: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetrics) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "samplesEnabled", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:samplesEnabled: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.SamplesEnabled)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.samplesEnabled (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:samplesEnabled: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetrics) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "maxSamples", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:maxSamples: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.MaxSamples)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxSamples (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:maxSamples: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetrics) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "bufferedSamples", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:bufferedSamples: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.BufferedSamples)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.bufferedSamples (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:bufferedSamples: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetrics) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "evictedSamples", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:evictedSamples: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.EvictedSamples)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.evictedSamples (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:evictedSamples: ", p), err)
+	}
+	return err
+}
+
+func (p *InternalMetrics) Equals(other *InternalMetrics) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.StartTimestamp != other.StartTimestamp { return false }
+	if len(p.Metrics) != len(other.Metrics) { return false }
+	for i, _tgt := range p.Metrics {
+		_src15 := other.Metrics[i]
+		if !_tgt.Equals(_src15) { return false }
+	}
+	if p.SamplesEnabled != other.SamplesEnabled { return false }
+	if p.MaxSamples != other.MaxSamples { return false }
+	if p.BufferedSamples != other.BufferedSamples { return false }
+	if p.EvictedSamples != other.EvictedSamples { return false }
+	return true
+}
+
+func (p *InternalMetrics) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InternalMetrics(%+v)", *p)
+}
+
+func (p *InternalMetrics) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.InternalMetrics",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*InternalMetrics)(nil)
+
+func (p *InternalMetrics) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - MBeanServerId
+//  - SpecificationVersion
+//  - ImplementationName
+//  - ImplementationVersion
+//  - ImplementationVendor
+//  - DefaultDomain
+//  - ConnectionId
+// 
+type ServerInfo struct {
+	MBeanServerId string `thrift:"mBeanServerId,1" db:"mBeanServerId" json:"mBeanServerId"`
+	SpecificationVersion string `thrift:"specificationVersion,2" db:"specificationVersion" json:"specificationVersion"`
+	ImplementationName string `thrift:"implementationName,3" db:"implementationName" json:"implementationName"`
+	ImplementationVersion string `thrift:"implementationVersion,4" db:"implementationVersion" json:"implementationVersion"`
+	ImplementationVendor string `thrift:"implementationVendor,5" db:"implementationVendor" json:"implementationVendor"`
+	DefaultDomain string `thrift:"defaultDomain,6" db:"defaultDomain" json:"defaultDomain"`
+	ConnectionId string `thrift:"connectionId,7" db:"connectionId" json:"connectionId"`
+}
+
+func NewServerInfo() *ServerInfo {
+	return &ServerInfo{}
+}
+
+
+
+func (p *ServerInfo) GetMBeanServerId() string {
+	return p.MBeanServerId
+}
+
+
+
+func (p *ServerInfo) GetSpecificationVersion() string {
+	return p.SpecificationVersion
+}
+
+
+
+func (p *ServerInfo) GetImplementationName() string {
+	return p.ImplementationName
+}
+
+
+
+func (p *ServerInfo) GetImplementationVersion() string {
+	return p.ImplementationVersion
+}
+
+
+
+func (p *ServerInfo) GetImplementationVendor() string {
+	return p.ImplementationVendor
+}
+
+
+
+func (p *ServerInfo) GetDefaultDomain() string {
+	return p.DefaultDomain
+}
+
+
+
+func (p *ServerInfo) GetConnectionId() string {
+	return p.ConnectionId
+}
+
+func (p *ServerInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanServerId = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.SpecificationVersion = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ImplementationName = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.ImplementationVersion = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ImplementationVendor = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.DefaultDomain = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ConnectionId = v
+	}
+	return nil
+}
+
+func (p *ServerInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ServerInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+		if err := p.writeField7(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ServerInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanServerId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanServerId: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanServerId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanServerId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanServerId: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "specificationVersion", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:specificationVersion: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.SpecificationVersion)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.specificationVersion (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:specificationVersion: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "implementationName", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:implementationName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ImplementationName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.implementationName (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:implementationName: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "implementationVersion", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:implementationVersion: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ImplementationVersion)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.implementationVersion (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:implementationVersion: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "implementationVendor", thrift.STRING, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:implementationVendor: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ImplementationVendor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.implementationVendor (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:implementationVendor: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "defaultDomain", thrift.STRING, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:defaultDomain: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.DefaultDomain)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.defaultDomain (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:defaultDomain: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "connectionId", thrift.STRING, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:connectionId: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ConnectionId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.connectionId (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:connectionId: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) Equals(other *ServerInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.MBeanServerId != other.MBeanServerId { return false }
+	if p.SpecificationVersion != other.SpecificationVersion { return false }
+	if p.ImplementationName != other.ImplementationName { return false }
+	if p.ImplementationVersion != other.ImplementationVersion { return false }
+	if p.ImplementationVendor != other.ImplementationVendor { return false }
+	if p.DefaultDomain != other.DefaultDomain { return false }
+	if p.ConnectionId != other.ConnectionId { return false }
+	return true
+}
+
+func (p *ServerInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ServerInfo(%+v)", *p)
+}
+
+func (p *ServerInfo) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.ServerInfo",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*ServerInfo)(nil)
+
+func (p *ServerInfo) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - ClassName
+//  - IdentityHashCode
+// 
+type LockInfo struct {
+	ClassName string `thrift:"className,1" db:"className" json:"className"`
+	IdentityHashCode int32 `thrift:"identityHashCode,2" db:"identityHashCode" json:"identityHashCode"`
+}
+
+func NewLockInfo() *LockInfo {
+	return &LockInfo{}
+}
+
+
+
+func (p *LockInfo) GetClassName() string {
+	return p.ClassName
+}
+
+
+
+func (p *LockInfo) GetIdentityHashCode() int32 {
+	return p.IdentityHashCode
+}
+
+func (p *LockInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *LockInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ClassName = v
+	}
+	return nil
+}
+
+func (p *LockInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.IdentityHashCode = v
+	}
+	return nil
+}
+
+func (p *LockInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "LockInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *LockInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "className", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:className: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ClassName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.className (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:className: ", p), err)
+	}
+	return err
+}
+
+func (p *LockInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "identityHashCode", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:identityHashCode: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.IdentityHashCode)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.identityHashCode (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:identityHashCode: ", p), err)
+	}
+	return err
+}
+
+func (p *LockInfo) Equals(other *LockInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ClassName != other.ClassName { return false }
+	if p.IdentityHashCode != other.IdentityHashCode { return false }
+	return true
+}
+
+func (p *LockInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LockInfo(%+v)", *p)
+}
+
+func (p *LockInfo) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.LockInfo",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*LockInfo)(nil)
+
+func (p *LockInfo) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - ClassName
+//  - MethodName
+//  - FileName
+//  - LineNumber
+//  - NativeMethod
+//  - LockedMonitors
+// 
+type StackFrame struct {
+	ClassName string `thrift:"className,1" db:"className" json:"className"`
+	MethodName string `thrift:"methodName,2" db:"methodName" json:"methodName"`
+	FileName string `thrift:"fileName,3" db:"fileName" json:"fileName"`
+	LineNumber int32 `thrift:"lineNumber,4" db:"lineNumber" json:"lineNumber"`
+	NativeMethod bool `thrift:"nativeMethod,5" db:"nativeMethod" json:"nativeMethod"`
+	LockedMonitors []*LockInfo `thrift:"lockedMonitors,6" db:"lockedMonitors" json:"lockedMonitors"`
+}
+
+func NewStackFrame() *StackFrame {
+	return &StackFrame{}
+}
+
+
+
+func (p *StackFrame) GetClassName() string {
+	return p.ClassName
+}
+
+
+
+func (p *StackFrame) GetMethodName() string {
+	return p.MethodName
+}
+
+
+
+func (p *StackFrame) GetFileName() string {
+	return p.FileName
+}
+
+
+
+func (p *StackFrame) GetLineNumber() int32 {
+	return p.LineNumber
+}
+
+
+
+func (p *StackFrame) GetNativeMethod() bool {
+	return p.NativeMethod
+}
+
+
+
+func (p *StackFrame) GetLockedMonitors() []*LockInfo {
+	return p.LockedMonitors
+}
+
+func (p *StackFrame) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *StackFrame) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ClassName = v
+	}
+	return nil
+}
+
+func (p *StackFrame) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.MethodName = v
+	}
+	return nil
+}
+
+func (p *StackFrame) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.FileName = v
+	}
+	return nil
+}
+
+func (p *StackFrame) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.LineNumber = v
+	}
+	return nil
+}
+
+func (p *StackFrame) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.NativeMethod = v
+	}
+	return nil
+}
+
+func (p *StackFrame) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*LockInfo, 0, size)
+	p.LockedMonitors = tSlice
+	for i := 0; i < size; i++ {
+		_elem16 := &LockInfo{}
+		if err := _elem16.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
+		}
+		p.LockedMonitors = append(p.LockedMonitors, _elem16)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *StackFrame) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "StackFrame"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *StackFrame) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "className", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:className: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ClassName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.className (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:className: ", p), err)
+	}
+	return err
+}
+
+func (p *StackFrame) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "methodName", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:methodName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MethodName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.methodName (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:methodName: ", p), err)
+	}
+	return err
+}
+
+func (p *StackFrame) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "fileName", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:fileName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.FileName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.fileName (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:fileName: ", p), err)
+	}
+	return err
+}
+
+func (p *StackFrame) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lineNumber", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:lineNumber: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.LineNumber)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.lineNumber (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:lineNumber: ", p), err)
+	}
+	return err
+}
+
+func (p *StackFrame) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "nativeMethod", thrift.BOOL, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:nativeMethod: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.NativeMethod)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nativeMethod (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:nativeMethod: ", p), err)
+	}
+	return err
+}
+
+func (p *StackFrame) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lockedMonitors", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:lockedMonitors: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.LockedMonitors)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.LockedMonitors {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:lockedMonitors: ", p), err)
+	}
+	return err
+}
+
+func (p *StackFrame) Equals(other *StackFrame) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ClassName != other.ClassName { return false }
+	if p.MethodName != other.MethodName { return false }
+	if p.FileName != other.FileName { return false }
+	if p.LineNumber != other.LineNumber { return false }
+	if p.NativeMethod != other.NativeMethod { return false }
+	if len(p.LockedMonitors) != len(other.LockedMonitors) { return false }
+	for i, _tgt := range p.LockedMonitors {
+		_src17 := other.LockedMonitors[i]
+		if !_tgt.Equals(_src17) { return false }
+	}
+	return true
+}
+
+func (p *StackFrame) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StackFrame(%+v)", *p)
+}
+
+func (p *StackFrame) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.StackFrame",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*StackFrame)(nil)
+
+func (p *StackFrame) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - ThreadId
+//  - ThreadName
+//  - ThreadState
+//  - BlockedCount
+//  - BlockedTimeMs
+//  - WaitedCount
+//  - WaitedTimeMs
+//  - LockInfo
+//  - LockOwnerId
+//  - LockOwnerName
+//  - InNative
+//  - Suspended
+//  - StackTrace
+//  - LockedSynchronizers
+// 
+type ThreadInfo struct {
+	ThreadId int64 `thrift:"threadId,1" db:"threadId" json:"threadId"`
+	ThreadName string `thrift:"threadName,2" db:"threadName" json:"threadName"`
+	ThreadState ThreadState `thrift:"threadState,3" db:"threadState" json:"threadState"`
+	BlockedCount int64 `thrift:"blockedCount,4" db:"blockedCount" json:"blockedCount"`
+	BlockedTimeMs int64 `thrift:"blockedTimeMs,5" db:"blockedTimeMs" json:"blockedTimeMs"`
+	WaitedCount int64 `thrift:"waitedCount,6" db:"waitedCount" json:"waitedCount"`
+	WaitedTimeMs int64 `thrift:"waitedTimeMs,7" db:"waitedTimeMs" json:"waitedTimeMs"`
+	LockInfo *LockInfo `thrift:"lockInfo,8" db:"lockInfo" json:"lockInfo,omitempty"`
+	LockOwnerId int64 `thrift:"lockOwnerId,9" db:"lockOwnerId" json:"lockOwnerId"`
+	LockOwnerName string `thrift:"lockOwnerName,10" db:"lockOwnerName" json:"lockOwnerName"`
+	InNative bool `thrift:"inNative,11" db:"inNative" json:"inNative"`
+	Suspended bool `thrift:"suspended,12" db:"suspended" json:"suspended"`
+	StackTrace []*StackFrame `thrift:"stackTrace,13" db:"stackTrace" json:"stackTrace"`
+	LockedSynchronizers []*LockInfo `thrift:"lockedSynchronizers,14" db:"lockedSynchronizers" json:"lockedSynchronizers"`
+}
+
+func NewThreadInfo() *ThreadInfo {
+	return &ThreadInfo{}
+}
+
+
+
+func (p *ThreadInfo) GetThreadId() int64 {
+	return p.ThreadId
+}
+
+
+
+func (p *ThreadInfo) GetThreadName() string {
+	return p.ThreadName
+}
+
+
+
+func (p *ThreadInfo) GetThreadState() ThreadState {
+	return p.ThreadState
+}
+
+
+
+func (p *ThreadInfo) GetBlockedCount() int64 {
+	return p.BlockedCount
+}
+
+
+
+func (p *ThreadInfo) GetBlockedTimeMs() int64 {
+	return p.BlockedTimeMs
+}
+
+
+
+func (p *ThreadInfo) GetWaitedCount() int64 {
+	return p.WaitedCount
+}
+
+
+
+func (p *ThreadInfo) GetWaitedTimeMs() int64 {
+	return p.WaitedTimeMs
+}
+
+var ThreadInfo_LockInfo_DEFAULT *LockInfo
+
+func (p *ThreadInfo) GetLockInfo() *LockInfo {
+	if !p.IsSetLockInfo() {
+		return ThreadInfo_LockInfo_DEFAULT
+	}
+	return p.LockInfo
+}
+
+
+
+func (p *ThreadInfo) GetLockOwnerId() int64 {
+	return p.LockOwnerId
+}
+
+
+
+func (p *ThreadInfo) GetLockOwnerName() string {
+	return p.LockOwnerName
+}
+
+
+
+func (p *ThreadInfo) GetInNative() bool {
+	return p.InNative
+}
+
+
+
+func (p *ThreadInfo) GetSuspended() bool {
+	return p.Suspended
+}
+
+
+
+func (p *ThreadInfo) GetStackTrace() []*StackFrame {
+	return p.StackTrace
+}
+
+
+
+func (p *ThreadInfo) GetLockedSynchronizers() []*LockInfo {
+	return p.LockedSynchronizers
+}
+
+func (p *ThreadInfo) IsSetLockInfo() bool {
+	return p.LockInfo != nil
+}
+
+func (p *ThreadInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField10(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField11(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField12(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField13(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField14(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ThreadId = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.ThreadName = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := ThreadState(v)
+		p.ThreadState = temp
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.BlockedCount = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.BlockedTimeMs = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.WaitedCount = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.WaitedTimeMs = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.LockInfo = &LockInfo{}
+	if err := p.LockInfo.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.LockInfo), err)
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.LockOwnerId = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		p.LockOwnerName = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField11(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 11: ", err)
+	} else {
+		p.InNative = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField12(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 12: ", err)
+	} else {
+		p.Suspended = v
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField13(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*StackFrame, 0, size)
+	p.StackTrace = tSlice
+	for i := 0; i < size; i++ {
+		_elem18 := &StackFrame{}
+		if err := _elem18.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem18), err)
+		}
+		p.StackTrace = append(p.StackTrace, _elem18)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ThreadInfo) ReadField14(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*LockInfo, 0, size)
+	p.LockedSynchronizers = tSlice
+	for i := 0; i < size; i++ {
+		_elem19 := &LockInfo{}
+		if err := _elem19.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem19), err)
+		}
+		p.LockedSynchronizers = append(p.LockedSynchronizers, _elem19)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ThreadInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ThreadInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+		if err := p.writeField5(ctx, oprot); err != nil { return err }
+		if err := p.writeField6(ctx, oprot); err != nil { return err }
+		if err := p.writeField7(ctx, oprot); err != nil { return err }
+		if err := p.writeField8(ctx, oprot); err != nil { return err }
+		if err := p.writeField9(ctx, oprot); err != nil { return err }
+		if err := p.writeField10(ctx, oprot); err != nil { return err }
+		if err := p.writeField11(ctx, oprot); err != nil { return err }
+		if err := p.writeField12(ctx, oprot); err != nil { return err }
+		if err := p.writeField13(ctx, oprot); err != nil { return err }
+		if err := p.writeField14(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ThreadInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "threadId", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:threadId: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ThreadId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.threadId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:threadId: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "threadName", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:threadName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ThreadName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.threadName (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:threadName: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "threadState", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:threadState: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ThreadState)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.threadState (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:threadState: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "blockedCount", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:blockedCount: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.BlockedCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.blockedCount (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:blockedCount: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "blockedTimeMs", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:blockedTimeMs: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.BlockedTimeMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.blockedTimeMs (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:blockedTimeMs: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "waitedCount", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:waitedCount: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.WaitedCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.waitedCount (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:waitedCount: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "waitedTimeMs", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:waitedTimeMs: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.WaitedTimeMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.waitedTimeMs (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:waitedTimeMs: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetLockInfo() {
+		if err := oprot.WriteFieldBegin(ctx, "lockInfo", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:lockInfo: ", p), err)
+		}
+		if err := p.LockInfo.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.LockInfo), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:lockInfo: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lockOwnerId", thrift.I64, 9); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:lockOwnerId: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.LockOwnerId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.lockOwnerId (9) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:lockOwnerId: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lockOwnerName", thrift.STRING, 10); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:lockOwnerName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.LockOwnerName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.lockOwnerName (10) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 10:lockOwnerName: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField11(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "inNative", thrift.BOOL, 11); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:inNative: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.InNative)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.inNative (11) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 11:inNative: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField12(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "suspended", thrift.BOOL, 12); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:suspended: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Suspended)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.suspended (12) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 12:suspended: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField13(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "stackTrace", thrift.LIST, 13); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:stackTrace: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.StackTrace)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.StackTrace {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 13:stackTrace: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) writeField14(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lockedSynchronizers", thrift.LIST, 14); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 14:lockedSynchronizers: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.LockedSynchronizers)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.LockedSynchronizers {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 14:lockedSynchronizers: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadInfo) Equals(other *ThreadInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ThreadId != other.ThreadId { return false }
+	if p.ThreadName != other.ThreadName { return false }
+	if p.ThreadState != other.ThreadState { return false }
+	if p.BlockedCount != other.BlockedCount { return false }
+	if p.BlockedTimeMs != other.BlockedTimeMs { return false }
+	if p.WaitedCount != other.WaitedCount { return false }
+	if p.WaitedTimeMs != other.WaitedTimeMs { return false }
+	if !p.LockInfo.Equals(other.LockInfo) { return false }
+	if p.LockOwnerId != other.LockOwnerId { return false }
+	if p.LockOwnerName != other.LockOwnerName { return false }
+	if p.InNative != other.InNative { return false }
+	if p.Suspended != other.Suspended { return false }
+	if len(p.StackTrace) != len(other.StackTrace) { return false }
+	for i, _tgt := range p.StackTrace {
+		_src20 := other.StackTrace[i]
+		if !_tgt.Equals(_src20) { return false }
+	}
+	if len(p.LockedSynchronizers) != len(other.LockedSynchronizers) { return false }
+	for i, _tgt := range p.LockedSynchronizers {
+		_src21 := other.LockedSynchronizers[i]
+		if !_tgt.Equals(_src21) { return false }
+	}
+	return true
+}
+
+func (p *ThreadInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ThreadInfo(%+v)", *p)
+}
+
+func (p *ThreadInfo) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.ThreadInfo",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*ThreadInfo)(nil)
+
+func (p *ThreadInfo) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - LockedMonitors
+//  - LockedSynchronizers
+//  - MaxDepth
+// 
+type ThreadDumpOptions struct {
+	LockedMonitors bool `thrift:"lockedMonitors,1" db:"lockedMonitors" json:"lockedMonitors"`
+	LockedSynchronizers bool `thrift:"lockedSynchronizers,2" db:"lockedSynchronizers" json:"lockedSynchronizers"`
+	MaxDepth int32 `thrift:"maxDepth,3" db:"maxDepth" json:"maxDepth"`
+}
+
+func NewThreadDumpOptions() *ThreadDumpOptions {
+	return &ThreadDumpOptions{}
+}
+
+
+
+func (p *ThreadDumpOptions) GetLockedMonitors() bool {
+	return p.LockedMonitors
+}
+
+
+
+func (p *ThreadDumpOptions) GetLockedSynchronizers() bool {
+	return p.LockedSynchronizers
+}
+
+
+
+func (p *ThreadDumpOptions) GetMaxDepth() int32 {
+	return p.MaxDepth
+}
+
+func (p *ThreadDumpOptions) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ThreadDumpOptions) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.LockedMonitors = v
+	}
+	return nil
+}
+
+func (p *ThreadDumpOptions) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.LockedSynchronizers = v
+	}
+	return nil
+}
+
+func (p *ThreadDumpOptions) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.MaxDepth = v
+	}
+	return nil
+}
+
+func (p *ThreadDumpOptions) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ThreadDumpOptions"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ThreadDumpOptions) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lockedMonitors", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:lockedMonitors: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.LockedMonitors)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.lockedMonitors (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:lockedMonitors: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadDumpOptions) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "lockedSynchronizers", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:lockedSynchronizers: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.LockedSynchronizers)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.lockedSynchronizers (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:lockedSynchronizers: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadDumpOptions) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "maxDepth", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:maxDepth: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.MaxDepth)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxDepth (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:maxDepth: ", p), err)
+	}
+	return err
+}
+
+func (p *ThreadDumpOptions) Equals(other *ThreadDumpOptions) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.LockedMonitors != other.LockedMonitors { return false }
+	if p.LockedSynchronizers != other.LockedSynchronizers { return false }
+	if p.MaxDepth != other.MaxDepth { return false }
+	return true
+}
+
+func (p *ThreadDumpOptions) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ThreadDumpOptions(%+v)", *p)
+}
+
+func (p *ThreadDumpOptions) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.ThreadDumpOptions",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*ThreadDumpOptions)(nil)
+
+func (p *ThreadDumpOptions) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - Name
+//  - Configuration
+//  - Settings
+//  - Options
+// 
+type RecordingSettings struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	Configuration string `thrift:"configuration,2" db:"configuration" json:"configuration"`
+	Settings map[string]string `thrift:"settings,3" db:"settings" json:"settings"`
+	Options map[string]string `thrift:"options,4" db:"options" json:"options"`
+}
+
+func NewRecordingSettings() *RecordingSettings {
+	return &RecordingSettings{}
+}
+
+
+
+func (p *RecordingSettings) GetName() string {
+	return p.Name
+}
+
+
+
+func (p *RecordingSettings) GetConfiguration() string {
+	return p.Configuration
+}
+
+
+
+func (p *RecordingSettings) GetSettings() map[string]string {
+	return p.Settings
+}
+
+
+
+func (p *RecordingSettings) GetOptions() map[string]string {
+	return p.Options
+}
+
+func (p *RecordingSettings) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *RecordingSettings) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *RecordingSettings) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Configuration = v
+	}
+	return nil
+}
+
+func (p *RecordingSettings) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.Settings = tMap
+	for i := 0; i < size; i++ {
+		var _key22 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key22 = v
+		}
+		var _val23 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val23 = v
+		}
+		p.Settings[_key22] = _val23
+	}
+	if err := iprot.ReadMapEnd(ctx); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *RecordingSettings) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.Options = tMap
+	for i := 0; i < size; i++ {
+		var _key24 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key24 = v
+		}
+		var _val25 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val25 = v
+		}
+		p.Options[_key24] = _val25
+	}
+	if err := iprot.ReadMapEnd(ctx); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *RecordingSettings) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "RecordingSettings"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *RecordingSettings) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+	}
+	return err
+}
+
+func (p *RecordingSettings) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "configuration", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:configuration: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Configuration)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.configuration (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:configuration: ", p), err)
+	}
+	return err
+}
+
+func (p *RecordingSettings) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "settings", thrift.MAP, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:settings: ", p), err)
+	}
+	if err := oprot.WriteMapBegin(ctx, thrift.STRING, thrift.STRING, len(p.Settings)); err != nil {
+		return thrift.PrependError("error writing map begin: ", err)
+	}
+	for k, v := range p.Settings {
+		if err := oprot.WriteString(ctx, string(k)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteMapEnd(ctx); err != nil {
+		return thrift.PrependError("error writing map end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:settings: ", p), err)
+	}
+	return err
+}
+
+func (p *RecordingSettings) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "options", thrift.MAP, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:options: ", p), err)
+	}
+	if err := oprot.WriteMapBegin(ctx, thrift.STRING, thrift.STRING, len(p.Options)); err != nil {
+		return thrift.PrependError("error writing map begin: ", err)
+	}
+	for k, v := range p.Options {
+		if err := oprot.WriteString(ctx, string(k)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteMapEnd(ctx); err != nil {
+		return thrift.PrependError("error writing map end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:options: ", p), err)
+	}
+	return err
+}
+
+func (p *RecordingSettings) Equals(other *RecordingSettings) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name { return false }
+	if p.Configuration != other.Configuration { return false }
+	if len(p.Settings) != len(other.Settings) { return false }
+	for k, _tgt := range p.Settings {
+		_src26 := other.Settings[k]
+		if _tgt != _src26 { return false }
+	}
+	if len(p.Options) != len(other.Options) { return false }
+	for k, _tgt := range p.Options {
+		_src27 := other.Options[k]
+		if _tgt != _src27 { return false }
+	}
+	return true
+}
+
+func (p *RecordingSettings) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecordingSettings(%+v)", *p)
+}
+
+func (p *RecordingSettings) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.RecordingSettings",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*RecordingSettings)(nil)
+
+func (p *RecordingSettings) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - Name
+//  - Value
+//  - Origin
+//  - Writeable
+// 
+type VMOption struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	Value string `thrift:"value,2" db:"value" json:"value"`
+	Origin string `thrift:"origin,3" db:"origin" json:"origin"`
+	Writeable bool `thrift:"writeable,4" db:"writeable" json:"writeable"`
+}
+
+func NewVMOption() *VMOption {
+	return &VMOption{}
+}
+
+
+
+func (p *VMOption) GetName() string {
+	return p.Name
+}
+
+
+
+func (p *VMOption) GetValue() string {
+	return p.Value
+}
+
+
+
+func (p *VMOption) GetOrigin() string {
+	return p.Origin
+}
+
+
+
+func (p *VMOption) GetWriteable() bool {
+	return p.Writeable
+}
+
+func (p *VMOption) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *VMOption) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *VMOption) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Value = v
+	}
+	return nil
+}
+
+func (p *VMOption) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Origin = v
+	}
+	return nil
+}
+
+func (p *VMOption) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Writeable = v
+	}
+	return nil
+}
+
+func (p *VMOption) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "VMOption"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *VMOption) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+	}
+	return err
+}
+
+func (p *VMOption) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "value", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Value)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err)
+	}
+	return err
+}
+
+func (p *VMOption) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "origin", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:origin: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Origin)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.origin (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:origin: ", p), err)
+	}
+	return err
+}
+
+func (p *VMOption) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "writeable", thrift.BOOL, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:writeable: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Writeable)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.writeable (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:writeable: ", p), err)
+	}
+	return err
+}
+
+func (p *VMOption) Equals(other *VMOption) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name { return false }
+	if p.Value != other.Value { return false }
+	if p.Origin != other.Origin { return false }
+	if p.Writeable != other.Writeable { return false }
+	return true
+}
+
+func (p *VMOption) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VMOption(%+v)", *p)
+}
+
+func (p *VMOption) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.VMOption",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*VMOption)(nil)
+
+func (p *VMOption) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - Message
+//  - CauseMessage
+//  - Stacktrace
+//  - CauseClass
+// 
+type JMXError struct {
+	Message string `thrift:"message,1" db:"message" json:"message"`
+	CauseMessage string `thrift:"causeMessage,2" db:"causeMessage" json:"causeMessage"`
+	Stacktrace string `thrift:"stacktrace,3" db:"stacktrace" json:"stacktrace"`
+	CauseClass *string `thrift:"causeClass,4" db:"causeClass" json:"causeClass,omitempty"`
+}
+
+func NewJMXError() *JMXError {
+	return &JMXError{}
+}
+
+
+
+func (p *JMXError) GetMessage() string {
+	return p.Message
+}
+
+
+
+func (p *JMXError) GetCauseMessage() string {
+	return p.CauseMessage
+}
+
+
+
+func (p *JMXError) GetStacktrace() string {
+	return p.Stacktrace
+}
+
+var JMXError_CauseClass_DEFAULT string
+
+func (p *JMXError) GetCauseClass() string {
+	if !p.IsSetCauseClass() {
+		return JMXError_CauseClass_DEFAULT
+	}
+	return *p.CauseClass
+}
+
+func (p *JMXError) IsSetCauseClass() bool {
+	return p.CauseClass != nil
+}
+
+func (p *JMXError) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXError) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *JMXError) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.CauseMessage = v
+	}
+	return nil
+}
+
+func (p *JMXError) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Stacktrace = v
+	}
+	return nil
+}
+
+func (p *JMXError) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.CauseClass = &v
+	}
+	return nil
+}
+
+func (p *JMXError) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "JMXError"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXError) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "message", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:message: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Message)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.message (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:message: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXError) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "causeMessage", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:causeMessage: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.CauseMessage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.causeMessage (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:causeMessage: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXError) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "stacktrace", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:stacktrace: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Stacktrace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.stacktrace (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:stacktrace: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXError) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetCauseClass() {
+		if err := oprot.WriteFieldBegin(ctx, "causeClass", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:causeClass: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.CauseClass)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.causeClass (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:causeClass: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXError) Equals(other *JMXError) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Message != other.Message { return false }
+	if p.CauseMessage != other.CauseMessage { return false }
+	if p.Stacktrace != other.Stacktrace { return false }
+	if p.CauseClass != other.CauseClass {
+		if p.CauseClass == nil || other.CauseClass == nil {
+			return false
+		}
+		if (*p.CauseClass) != (*other.CauseClass) { return false }
+	}
+	return true
+}
+
+func (p *JMXError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXError(%+v)", *p)
+}
+
+func (p *JMXError) Error() string {
+	return p.String()
+}
+
+func (JMXError) TExceptionType() thrift.TExceptionType {
+	return thrift.TExceptionTypeCompiled
+}
+
+var _ thrift.TException = (*JMXError)(nil)
+
+func (p *JMXError) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXError",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXError)(nil)
+
+func (p *JMXError) Validate() error {
+	return nil
+}
+
+// Attributes:
+//  - Message
+// 
+type JMXConnectionError struct {
+	Message string `thrift:"message,1" db:"message" json:"message"`
+}
+
+func NewJMXConnectionError() *JMXConnectionError {
+	return &JMXConnectionError{}
+}
+
+
+
+func (p *JMXConnectionError) GetMessage() string {
+	return p.Message
+}
+
+func (p *JMXConnectionError) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXConnectionError) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *JMXConnectionError) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "JMXConnectionError"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXConnectionError) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "message", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:message: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Message)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.message (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:message: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXConnectionError) Equals(other *JMXConnectionError) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Message != other.Message { return false }
+	return true
+}
+
+func (p *JMXConnectionError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXConnectionError(%+v)", *p)
+}
+
+func (p *JMXConnectionError) Error() string {
+	return p.String()
+}
+
+func (JMXConnectionError) TExceptionType() thrift.TExceptionType {
+	return thrift.TExceptionTypeCompiled
+}
+
+var _ thrift.TException = (*JMXConnectionError)(nil)
+
+func (p *JMXConnectionError) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXConnectionError",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXConnectionError)(nil)
+
+func (p *JMXConnectionError) Validate() error {
+	return nil
+}
+
+type JMXService interface {
+	// Parameters:
+	//  - Config
+	// 
+	Connect(ctx context.Context, config *JMXConfig) (_err error)
+	Disconnect(ctx context.Context) (_err error)
+	GetClientVersion(ctx context.Context) (_r string, _err error)
+	// Parameters:
+	//  - MBeanNamePattern
+	// 
+	QueryMBeanNames(ctx context.Context, mBeanNamePattern string) (_r []string, _err error)
+	// Parameters:
+	//  - MBeanName
+	// 
+	GetMBeanAttributeNames(ctx context.Context, mBeanName string) (_r []string, _err error)
+	// Parameters:
+	//  - MBeanName
+	// 
+	GetMBeanInfo(ctx context.Context, mBeanName string) (_r *MBeanInfo, _err error)
+	// Parameters:
+	//  - MBeanName
+	//  - Attributes
+	// 
+	GetMBeanAttributes(ctx context.Context, mBeanName string, attributes []string) (_r []*AttributeResponse, _err error)
+	// Parameters:
+	//  - MBeanNamePattern
+	//  - Attributes
+	// 
+	QueryMBeanAttributes(ctx context.Context, mBeanNamePattern string, attributes []string) (_r []*AttributeResponse, _err error)
+	// Parameters:
+	//  - MBeanName
+	//  - OperationName
+	//  - Arguments
+	//  - Signature
+	// 
+	InvokeOperation(ctx context.Context, mBeanName string, operationName string, arguments []string, signature []string) (_r []*AttributeResponse, _err error)
+	GetInternalStats(ctx context.Context) (_r []*InternalStat, _err error)
+	GetInternalMetrics(ctx context.Context) (_r *InternalMetrics, _err error)
+	GetTruncations(ctx context.Context) (_r []*Truncation, _err error)
+	GetDomains(ctx context.Context) (_r []string, _err error)
+	// Parameters:
+	//  - MBeanNamePattern
+	// 
+	GetMBeanCount(ctx context.Context, mBeanNamePattern string) (_r int64, _err error)
+	GetServerInfo(ctx context.Context) (_r *ServerInfo, _err error)
+	// Parameters:
+	//  - Options
+	// 
+	ThreadDump(ctx context.Context, options *ThreadDumpOptions) (_r []*ThreadInfo, _err error)
+	FindDeadlocks(ctx context.Context) (_r []*ThreadInfo, _err error)
+	// Parameters:
+	//  - Command
+	//  - Arguments
+	// 
+	DiagnosticCommand(ctx context.Context, command string, arguments []string) (_r string, _err error)
+	// Parameters:
+	//  - Settings
+	// 
+	StartRecording(ctx context.Context, settings *RecordingSettings) (_r int64, _err error)
+	// Parameters:
+	//  - RecordingId
+	// 
+	StopRecording(ctx context.Context, recordingId int64) (_err error)
+	// Parameters:
+	//  - RecordingId
+	// 
+	CloseRecording(ctx context.Context, recordingId int64) (_err error)
+	// Parameters:
+	//  - RecordingId
+	// 
+	OpenRecordingStream(ctx context.Context, recordingId int64) (_r int64, _err error)
+	// Parameters:
+	//  - StreamId
+	// 
+	ReadRecordingStream(ctx context.Context, streamId int64) (_r []byte, _err error)
+	// Parameters:
+	//  - StreamId
+	// 
+	CloseRecordingStream(ctx context.Context, streamId int64) (_err error)
+	GetVMOptions(ctx context.Context) (_r []*VMOption, _err error)
+	// Parameters:
+	//  - Name
+	// 
+	GetVMOption(ctx context.Context, name string) (_r *VMOption, _err error)
+	// Parameters:
+	//  - Name
+	//  - Value
+	// 
+	SetVMOption(ctx context.Context, name string, value string) (_err error)
+	// Parameters:
+	//  - OutputFile
+	//  - Live
+	//  - TimeoutMs
+	// 
+	DumpHeap(ctx context.Context, outputFile string, live bool, timeoutMs int64) (_err error)
+}
+
+type JMXServiceClient struct {
+	c thrift.TClient
+	meta thrift.ResponseMeta
+}
+
+func NewJMXServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *JMXServiceClient {
+	return &JMXServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewJMXServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *JMXServiceClient {
+	return &JMXServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewJMXServiceClient(c thrift.TClient) *JMXServiceClient {
+	return &JMXServiceClient{
+		c: c,
+	}
+}
+
+func (p *JMXServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *JMXServiceClient) LastResponseMeta_() thrift.ResponseMeta {
+	return p.meta
+}
+
+func (p *JMXServiceClient) SetLastResponseMeta_(meta thrift.ResponseMeta) {
+	p.meta = meta
+}
+
+// Parameters:
+//  - Config
+// 
+func (p *JMXServiceClient) Connect(ctx context.Context, config *JMXConfig) (_err error) {
+	var _args28 JMXServiceConnectArgs
+	_args28.Config = config
+	var _result30 JMXServiceConnectResult
+	var _meta29 thrift.ResponseMeta
+	_meta29, _err = p.Client_().Call(ctx, "connect", &_args28, &_result30)
+	p.SetLastResponseMeta_(_meta29)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result30.ConnErr!= nil:
+		return _result30.ConnErr
+	case _result30.JmxErr!= nil:
+		return _result30.JmxErr
+	}
+
+	return nil
+}
+
+func (p *JMXServiceClient) Disconnect(ctx context.Context) (_err error) {
+	var _args31 JMXServiceDisconnectArgs
+	var _result33 JMXServiceDisconnectResult
+	var _meta32 thrift.ResponseMeta
+	_meta32, _err = p.Client_().Call(ctx, "disconnect", &_args31, &_result33)
+	p.SetLastResponseMeta_(_meta32)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result33.Err!= nil:
+		return _result33.Err
+	}
+
+	return nil
+}
+
+func (p *JMXServiceClient) GetClientVersion(ctx context.Context) (_r string, _err error) {
+	var _args34 JMXServiceGetClientVersionArgs
+	var _result36 JMXServiceGetClientVersionResult
+	var _meta35 thrift.ResponseMeta
+	_meta35, _err = p.Client_().Call(ctx, "getClientVersion", &_args34, &_result36)
+	p.SetLastResponseMeta_(_meta35)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result36.Err!= nil:
+		return _r, _result36.Err
+	}
+
+	return _result36.GetSuccess(), nil
+}
+
+// Parameters:
+//  - MBeanNamePattern
+// 
+func (p *JMXServiceClient) QueryMBeanNames(ctx context.Context, mBeanNamePattern string) (_r []string, _err error) {
+	var _args37 JMXServiceQueryMBeanNamesArgs
+	_args37.MBeanNamePattern = mBeanNamePattern
+	var _result39 JMXServiceQueryMBeanNamesResult
+	var _meta38 thrift.ResponseMeta
+	_meta38, _err = p.Client_().Call(ctx, "queryMBeanNames", &_args37, &_result39)
+	p.SetLastResponseMeta_(_meta38)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result39.ConnErr!= nil:
+		return _r, _result39.ConnErr
+	case _result39.JmxErr!= nil:
+		return _r, _result39.JmxErr
+	}
+
+	return _result39.GetSuccess(), nil
+}
+
+// Parameters:
+//  - MBeanName
+// 
+func (p *JMXServiceClient) GetMBeanAttributeNames(ctx context.Context, mBeanName string) (_r []string, _err error) {
+	var _args40 JMXServiceGetMBeanAttributeNamesArgs
+	_args40.MBeanName = mBeanName
+	var _result42 JMXServiceGetMBeanAttributeNamesResult
+	var _meta41 thrift.ResponseMeta
+	_meta41, _err = p.Client_().Call(ctx, "getMBeanAttributeNames", &_args40, &_result42)
+	p.SetLastResponseMeta_(_meta41)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result42.ConnErr!= nil:
+		return _r, _result42.ConnErr
+	case _result42.JmxErr!= nil:
+		return _r, _result42.JmxErr
+	}
+
+	return _result42.GetSuccess(), nil
+}
+
+// Parameters:
+//  - MBeanName
+// 
+func (p *JMXServiceClient) GetMBeanInfo(ctx context.Context, mBeanName string) (_r *MBeanInfo, _err error) {
+	var _args43 JMXServiceGetMBeanInfoArgs
+	_args43.MBeanName = mBeanName
+	var _result45 JMXServiceGetMBeanInfoResult
+	var _meta44 thrift.ResponseMeta
+	_meta44, _err = p.Client_().Call(ctx, "getMBeanInfo", &_args43, &_result45)
+	p.SetLastResponseMeta_(_meta44)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result45.ConnErr!= nil:
+		return _r, _result45.ConnErr
+	case _result45.JmxErr!= nil:
+		return _r, _result45.JmxErr
+	}
+
+	if _ret46 := _result45.GetSuccess(); _ret46 != nil {
+		return _ret46, nil
+	}
+	return nil, thrift.NewTApplicationException(thrift.MISSING_RESULT, "getMBeanInfo failed: unknown result")
+}
+
+// Parameters:
+//  - MBeanName
+//  - Attributes
+// 
+func (p *JMXServiceClient) GetMBeanAttributes(ctx context.Context, mBeanName string, attributes []string) (_r []*AttributeResponse, _err error) {
+	var _args47 JMXServiceGetMBeanAttributesArgs
+	_args47.MBeanName = mBeanName
+	_args47.Attributes = attributes
+	var _result49 JMXServiceGetMBeanAttributesResult
+	var _meta48 thrift.ResponseMeta
+	_meta48, _err = p.Client_().Call(ctx, "getMBeanAttributes", &_args47, &_result49)
+	p.SetLastResponseMeta_(_meta48)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result49.ConnErr!= nil:
+		return _r, _result49.ConnErr
+	case _result49.JmxErr!= nil:
+		return _r, _result49.JmxErr
+	}
+
+	return _result49.GetSuccess(), nil
+}
+
+// Parameters:
+//  - MBeanNamePattern
+//  - Attributes
+// 
+func (p *JMXServiceClient) QueryMBeanAttributes(ctx context.Context, mBeanNamePattern string, attributes []string) (_r []*AttributeResponse, _err error) {
+	var _args50 JMXServiceQueryMBeanAttributesArgs
+	_args50.MBeanNamePattern = mBeanNamePattern
+	_args50.Attributes = attributes
+	var _result52 JMXServiceQueryMBeanAttributesResult
+	var _meta51 thrift.ResponseMeta
+	_meta51, _err = p.Client_().Call(ctx, "queryMBeanAttributes", &_args50, &_result52)
+	p.SetLastResponseMeta_(_meta51)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result52.ConnErr!= nil:
+		return _r, _result52.ConnErr
+	case _result52.JmxErr!= nil:
+		return _r, _result52.JmxErr
+	}
+
+	return _result52.GetSuccess(), nil
+}
+
+// Parameters:
+//  - MBeanName
+//  - OperationName
+//  - Arguments
+//  - Signature
+// 
+func (p *JMXServiceClient) InvokeOperation(ctx context.Context, mBeanName string, operationName string, arguments []string, signature []string) (_r []*AttributeResponse, _err error) {
+	var _args53 JMXServiceInvokeOperationArgs
+	_args53.MBeanName = mBeanName
+	_args53.OperationName = operationName
+	_args53.Arguments = arguments
+	_args53.Signature = signature
+	var _result55 JMXServiceInvokeOperationResult
+	var _meta54 thrift.ResponseMeta
+	_meta54, _err = p.Client_().Call(ctx, "invokeOperation", &_args53, &_result55)
+	p.SetLastResponseMeta_(_meta54)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result55.ConnErr!= nil:
+		return _r, _result55.ConnErr
+	case _result55.JmxErr!= nil:
+		return _r, _result55.JmxErr
+	}
+
+	return _result55.GetSuccess(), nil
+}
+
+func (p *JMXServiceClient) GetInternalStats(ctx context.Context) (_r []*InternalStat, _err error) {
+	var _args56 JMXServiceGetInternalStatsArgs
+	var _result58 JMXServiceGetInternalStatsResult
+	var _meta57 thrift.ResponseMeta
+	_meta57, _err = p.Client_().Call(ctx, "getInternalStats", &_args56, &_result58)
+	p.SetLastResponseMeta_(_meta57)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result58.JmxErr!= nil:
+		return _r, _result58.JmxErr
+	}
+
+	return _result58.GetSuccess(), nil
+}
+
+func (p *JMXServiceClient) GetInternalMetrics(ctx context.Context) (_r *InternalMetrics, _err error) {
+	var _args59 JMXServiceGetInternalMetricsArgs
+	var _result61 JMXServiceGetInternalMetricsResult
+	var _meta60 thrift.ResponseMeta
+	_meta60, _err = p.Client_().Call(ctx, "getInternalMetrics", &_args59, &_result61)
+	p.SetLastResponseMeta_(_meta60)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result61.JmxErr!= nil:
+		return _r, _result61.JmxErr
+	}
+
+	if _ret62 := _result61.GetSuccess(); _ret62 != nil {
+		return _ret62, nil
+	}
+	return nil, thrift.NewTApplicationException(thrift.MISSING_RESULT, "getInternalMetrics failed: unknown result")
+}
+
+func (p *JMXServiceClient) GetTruncations(ctx context.Context) (_r []*Truncation, _err error) {
+	var _args63 JMXServiceGetTruncationsArgs
+	var _result65 JMXServiceGetTruncationsResult
+	var _meta64 thrift.ResponseMeta
+	_meta64, _err = p.Client_().Call(ctx, "getTruncations", &_args63, &_result65)
+	p.SetLastResponseMeta_(_meta64)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result65.JmxErr!= nil:
+		return _r, _result65.JmxErr
+	}
+
+	return _result65.GetSuccess(), nil
+}
+
+func (p *JMXServiceClient) GetDomains(ctx context.Context) (_r []string, _err error) {
+	var _args66 JMXServiceGetDomainsArgs
+	var _result68 JMXServiceGetDomainsResult
+	var _meta67 thrift.ResponseMeta
+	_meta67, _err = p.Client_().Call(ctx, "getDomains", &_args66, &_result68)
+	p.SetLastResponseMeta_(_meta67)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result68.ConnErr!= nil:
+		return _r, _result68.ConnErr
+	case _result68.JmxErr!= nil:
+		return _r, _result68.JmxErr
+	}
+
+	return _result68.GetSuccess(), nil
+}
+
+// Parameters:
+//  - MBeanNamePattern
+// 
+func (p *JMXServiceClient) GetMBeanCount(ctx context.Context, mBeanNamePattern string) (_r int64, _err error) {
+	var _args69 JMXServiceGetMBeanCountArgs
+	_args69.MBeanNamePattern = mBeanNamePattern
+	var _result71 JMXServiceGetMBeanCountResult
+	var _meta70 thrift.ResponseMeta
+	_meta70, _err = p.Client_().Call(ctx, "getMBeanCount", &_args69, &_result71)
+	p.SetLastResponseMeta_(_meta70)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result71.ConnErr!= nil:
+		return _r, _result71.ConnErr
+	case _result71.JmxErr!= nil:
+		return _r, _result71.JmxErr
+	}
+
+	return _result71.GetSuccess(), nil
+}
+
+func (p *JMXServiceClient) GetServerInfo(ctx context.Context) (_r *ServerInfo, _err error) {
+	var _args72 JMXServiceGetServerInfoArgs
+	var _result74 JMXServiceGetServerInfoResult
+	var _meta73 thrift.ResponseMeta
+	_meta73, _err = p.Client_().Call(ctx, "getServerInfo", &_args72, &_result74)
+	p.SetLastResponseMeta_(_meta73)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result74.ConnErr!= nil:
+		return _r, _result74.ConnErr
+	case _result74.JmxErr!= nil:
+		return _r, _result74.JmxErr
+	}
+
+	if _ret75 := _result74.GetSuccess(); _ret75 != nil {
+		return _ret75, nil
+	}
+	return nil, thrift.NewTApplicationException(thrift.MISSING_RESULT, "getServerInfo failed: unknown result")
+}
+
+// Parameters:
+//  - Options
+// 
+func (p *JMXServiceClient) ThreadDump(ctx context.Context, options *ThreadDumpOptions) (_r []*ThreadInfo, _err error) {
+	var _args76 JMXServiceThreadDumpArgs
+	_args76.Options = options
+	var _result78 JMXServiceThreadDumpResult
+	var _meta77 thrift.ResponseMeta
+	_meta77, _err = p.Client_().Call(ctx, "threadDump", &_args76, &_result78)
+	p.SetLastResponseMeta_(_meta77)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result78.ConnErr!= nil:
+		return _r, _result78.ConnErr
+	case _result78.JmxErr!= nil:
+		return _r, _result78.JmxErr
+	}
+
+	return _result78.GetSuccess(), nil
+}
+
+func (p *JMXServiceClient) FindDeadlocks(ctx context.Context) (_r []*ThreadInfo, _err error) {
+	var _args79 JMXServiceFindDeadlocksArgs
+	var _result81 JMXServiceFindDeadlocksResult
+	var _meta80 thrift.ResponseMeta
+	_meta80, _err = p.Client_().Call(ctx, "findDeadlocks", &_args79, &_result81)
+	p.SetLastResponseMeta_(_meta80)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result81.ConnErr!= nil:
+		return _r, _result81.ConnErr
+	case _result81.JmxErr!= nil:
+		return _r, _result81.JmxErr
+	}
+
+	return _result81.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Command
+//  - Arguments
+// 
+func (p *JMXServiceClient) DiagnosticCommand(ctx context.Context, command string, arguments []string) (_r string, _err error) {
+	var _args82 JMXServiceDiagnosticCommandArgs
+	_args82.Command = command
+	_args82.Arguments = arguments
+	var _result84 JMXServiceDiagnosticCommandResult
+	var _meta83 thrift.ResponseMeta
+	_meta83, _err = p.Client_().Call(ctx, "diagnosticCommand", &_args82, &_result84)
+	p.SetLastResponseMeta_(_meta83)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result84.ConnErr!= nil:
+		return _r, _result84.ConnErr
+	case _result84.JmxErr!= nil:
+		return _r, _result84.JmxErr
+	}
+
+	return _result84.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Settings
+// 
+func (p *JMXServiceClient) StartRecording(ctx context.Context, settings *RecordingSettings) (_r int64, _err error) {
+	var _args85 JMXServiceStartRecordingArgs
+	_args85.Settings = settings
+	var _result87 JMXServiceStartRecordingResult
+	var _meta86 thrift.ResponseMeta
+	_meta86, _err = p.Client_().Call(ctx, "startRecording", &_args85, &_result87)
+	p.SetLastResponseMeta_(_meta86)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result87.ConnErr!= nil:
+		return _r, _result87.ConnErr
+	case _result87.JmxErr!= nil:
+		return _r, _result87.JmxErr
+	}
+
+	return _result87.GetSuccess(), nil
+}
+
+// Parameters:
+//  - RecordingId
+// 
+func (p *JMXServiceClient) StopRecording(ctx context.Context, recordingId int64) (_err error) {
+	var _args88 JMXServiceStopRecordingArgs
+	_args88.RecordingId = recordingId
+	var _result90 JMXServiceStopRecordingResult
+	var _meta89 thrift.ResponseMeta
+	_meta89, _err = p.Client_().Call(ctx, "stopRecording", &_args88, &_result90)
+	p.SetLastResponseMeta_(_meta89)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result90.ConnErr!= nil:
+		return _result90.ConnErr
+	case _result90.JmxErr!= nil:
+		return _result90.JmxErr
+	}
+
+	return nil
+}
+
+// Parameters:
+//  - RecordingId
+// 
+func (p *JMXServiceClient) CloseRecording(ctx context.Context, recordingId int64) (_err error) {
+	var _args91 JMXServiceCloseRecordingArgs
+	_args91.RecordingId = recordingId
+	var _result93 JMXServiceCloseRecordingResult
+	var _meta92 thrift.ResponseMeta
+	_meta92, _err = p.Client_().Call(ctx, "closeRecording", &_args91, &_result93)
+	p.SetLastResponseMeta_(_meta92)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result93.ConnErr!= nil:
+		return _result93.ConnErr
+	case _result93.JmxErr!= nil:
+		return _result93.JmxErr
+	}
+
+	return nil
+}
+
+// Parameters:
+//  - RecordingId
+// 
+func (p *JMXServiceClient) OpenRecordingStream(ctx context.Context, recordingId int64) (_r int64, _err error) {
+	var _args94 JMXServiceOpenRecordingStreamArgs
+	_args94.RecordingId = recordingId
+	var _result96 JMXServiceOpenRecordingStreamResult
+	var _meta95 thrift.ResponseMeta
+	_meta95, _err = p.Client_().Call(ctx, "openRecordingStream", &_args94, &_result96)
+	p.SetLastResponseMeta_(_meta95)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result96.ConnErr!= nil:
+		return _r, _result96.ConnErr
+	case _result96.JmxErr!= nil:
+		return _r, _result96.JmxErr
+	}
+
+	return _result96.GetSuccess(), nil
+}
+
+// Parameters:
+//  - StreamId
+// 
+func (p *JMXServiceClient) ReadRecordingStream(ctx context.Context, streamId int64) (_r []byte, _err error) {
+	var _args97 JMXServiceReadRecordingStreamArgs
+	_args97.StreamId = streamId
+	var _result99 JMXServiceReadRecordingStreamResult
+	var _meta98 thrift.ResponseMeta
+	_meta98, _err = p.Client_().Call(ctx, "readRecordingStream", &_args97, &_result99)
+	p.SetLastResponseMeta_(_meta98)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result99.ConnErr!= nil:
+		return _r, _result99.ConnErr
+	case _result99.JmxErr!= nil:
+		return _r, _result99.JmxErr
+	}
+
+	return _result99.GetSuccess(), nil
+}
+
+// Parameters:
+//  - StreamId
+// 
+func (p *JMXServiceClient) CloseRecordingStream(ctx context.Context, streamId int64) (_err error) {
+	var _args100 JMXServiceCloseRecordingStreamArgs
+	_args100.StreamId = streamId
+	var _result102 JMXServiceCloseRecordingStreamResult
+	var _meta101 thrift.ResponseMeta
+	_meta101, _err = p.Client_().Call(ctx, "closeRecordingStream", &_args100, &_result102)
+	p.SetLastResponseMeta_(_meta101)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result102.ConnErr!= nil:
+		return _result102.ConnErr
+	case _result102.JmxErr!= nil:
+		return _result102.JmxErr
+	}
+
+	return nil
+}
+
+func (p *JMXServiceClient) GetVMOptions(ctx context.Context) (_r []*VMOption, _err error) {
+	var _args103 JMXServiceGetVMOptionsArgs
+	var _result105 JMXServiceGetVMOptionsResult
+	var _meta104 thrift.ResponseMeta
+	_meta104, _err = p.Client_().Call(ctx, "getVMOptions", &_args103, &_result105)
+	p.SetLastResponseMeta_(_meta104)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result105.ConnErr!= nil:
+		return _r, _result105.ConnErr
+	case _result105.JmxErr!= nil:
+		return _r, _result105.JmxErr
+	}
+
+	return _result105.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Name
+// 
+func (p *JMXServiceClient) GetVMOption(ctx context.Context, name string) (_r *VMOption, _err error) {
+	var _args106 JMXServiceGetVMOptionArgs
+	_args106.Name = name
+	var _result108 JMXServiceGetVMOptionResult
+	var _meta107 thrift.ResponseMeta
+	_meta107, _err = p.Client_().Call(ctx, "getVMOption", &_args106, &_result108)
+	p.SetLastResponseMeta_(_meta107)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result108.ConnErr!= nil:
+		return _r, _result108.ConnErr
+	case _result108.JmxErr!= nil:
+		return _r, _result108.JmxErr
+	}
+
+	if _ret109 := _result108.GetSuccess(); _ret109 != nil {
+		return _ret109, nil
+	}
+	return nil, thrift.NewTApplicationException(thrift.MISSING_RESULT, "getVMOption failed: unknown result")
+}
+
+// Parameters:
+//  - Name
+//  - Value
+// 
+func (p *JMXServiceClient) SetVMOption(ctx context.Context, name string, value string) (_err error) {
+	var _args110 JMXServiceSetVMOptionArgs
+	_args110.Name = name
+	_args110.Value = value
+	var _result112 JMXServiceSetVMOptionResult
+	var _meta111 thrift.ResponseMeta
+	_meta111, _err = p.Client_().Call(ctx, "setVMOption", &_args110, &_result112)
+	p.SetLastResponseMeta_(_meta111)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result112.ConnErr!= nil:
+		return _result112.ConnErr
+	case _result112.JmxErr!= nil:
+		return _result112.JmxErr
+	}
+
+	return nil
+}
+
+// Parameters:
+//  - OutputFile
+//  - Live
+//  - TimeoutMs
+// 
+func (p *JMXServiceClient) DumpHeap(ctx context.Context, outputFile string, live bool, timeoutMs int64) (_err error) {
+	var _args113 JMXServiceDumpHeapArgs
+	_args113.OutputFile = outputFile
+	_args113.Live = live
+	_args113.TimeoutMs = timeoutMs
+	var _result115 JMXServiceDumpHeapResult
+	var _meta114 thrift.ResponseMeta
+	_meta114, _err = p.Client_().Call(ctx, "dumpHeap", &_args113, &_result115)
+	p.SetLastResponseMeta_(_meta114)
+	if _err != nil {
+		return
+	}
+	switch {
+	case _result115.ConnErr!= nil:
+		return _result115.ConnErr
+	case _result115.JmxErr!= nil:
+		return _result115.JmxErr
+	}
+
+	return nil
+}
+
+type JMXServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler JMXService
+}
+
+func (p *JMXServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *JMXServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *JMXServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewJMXServiceProcessor(handler JMXService) *JMXServiceProcessor {
+
+	self116 := &JMXServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+	self116.processorMap["connect"] = &jMXServiceProcessorConnect{handler:handler}
+	self116.processorMap["disconnect"] = &jMXServiceProcessorDisconnect{handler:handler}
+	self116.processorMap["getClientVersion"] = &jMXServiceProcessorGetClientVersion{handler:handler}
+	self116.processorMap["queryMBeanNames"] = &jMXServiceProcessorQueryMBeanNames{handler:handler}
+	self116.processorMap["getMBeanAttributeNames"] = &jMXServiceProcessorGetMBeanAttributeNames{handler:handler}
+	self116.processorMap["getMBeanInfo"] = &jMXServiceProcessorGetMBeanInfo{handler:handler}
+	self116.processorMap["getMBeanAttributes"] = &jMXServiceProcessorGetMBeanAttributes{handler:handler}
+	self116.processorMap["queryMBeanAttributes"] = &jMXServiceProcessorQueryMBeanAttributes{handler:handler}
+	self116.processorMap["invokeOperation"] = &jMXServiceProcessorInvokeOperation{handler:handler}
+	self116.processorMap["getInternalStats"] = &jMXServiceProcessorGetInternalStats{handler:handler}
+	self116.processorMap["getInternalMetrics"] = &jMXServiceProcessorGetInternalMetrics{handler:handler}
+	self116.processorMap["getTruncations"] = &jMXServiceProcessorGetTruncations{handler:handler}
+	self116.processorMap["getDomains"] = &jMXServiceProcessorGetDomains{handler:handler}
+	self116.processorMap["getMBeanCount"] = &jMXServiceProcessorGetMBeanCount{handler:handler}
+	self116.processorMap["getServerInfo"] = &jMXServiceProcessorGetServerInfo{handler:handler}
+	self116.processorMap["threadDump"] = &jMXServiceProcessorThreadDump{handler:handler}
+	self116.processorMap["findDeadlocks"] = &jMXServiceProcessorFindDeadlocks{handler:handler}
+	self116.processorMap["diagnosticCommand"] = &jMXServiceProcessorDiagnosticCommand{handler:handler}
+	self116.processorMap["startRecording"] = &jMXServiceProcessorStartRecording{handler:handler}
+	self116.processorMap["stopRecording"] = &jMXServiceProcessorStopRecording{handler:handler}
+	self116.processorMap["closeRecording"] = &jMXServiceProcessorCloseRecording{handler:handler}
+	self116.processorMap["openRecordingStream"] = &jMXServiceProcessorOpenRecordingStream{handler:handler}
+	self116.processorMap["readRecordingStream"] = &jMXServiceProcessorReadRecordingStream{handler:handler}
+	self116.processorMap["closeRecordingStream"] = &jMXServiceProcessorCloseRecordingStream{handler:handler}
+	self116.processorMap["getVMOptions"] = &jMXServiceProcessorGetVMOptions{handler:handler}
+	self116.processorMap["getVMOption"] = &jMXServiceProcessorGetVMOption{handler:handler}
+	self116.processorMap["setVMOption"] = &jMXServiceProcessorSetVMOption{handler:handler}
+	self116.processorMap["dumpHeap"] = &jMXServiceProcessorDumpHeap{handler:handler}
+	return self116
+}
+
+func (p *JMXServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err2 := iprot.ReadMessageBegin(ctx)
+	if err2 != nil { return false, thrift.WrapTException(err2) }
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(ctx, thrift.STRUCT)
+	iprot.ReadMessageEnd(ctx)
+	x117 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+	oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
+	x117.Write(ctx, oprot)
+	oprot.WriteMessageEnd(ctx)
+	oprot.Flush(ctx)
+	return false, x117
+}
+
+type jMXServiceProcessorConnect struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorConnect) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err118 error
+	args := JMXServiceConnectArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "connect", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceConnectResult{}
+	if err2 := p.handler.Connect(ctx, args.Config); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc119 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing connect: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "connect", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err118 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc119.Write(ctx, oprot); _write_err118 == nil && err2 != nil {
+				_write_err118 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err118 == nil && err2 != nil {
+				_write_err118 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err118 == nil && err2 != nil {
+				_write_err118 = thrift.WrapTException(err2)
+			}
+			if _write_err118 != nil {
+				return false, thrift.WrapTException(_write_err118)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "connect", thrift.REPLY, seqId); err2 != nil {
+		_write_err118 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err118 == nil && err2 != nil {
+		_write_err118 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err118 == nil && err2 != nil {
+		_write_err118 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err118 == nil && err2 != nil {
+		_write_err118 = thrift.WrapTException(err2)
+	}
+	if _write_err118 != nil {
+		return false, thrift.WrapTException(_write_err118)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorDisconnect struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorDisconnect) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err120 error
+	args := JMXServiceDisconnectArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "disconnect", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceDisconnectResult{}
+	if err2 := p.handler.Disconnect(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXError:
+			result.Err = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc121 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing disconnect: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "disconnect", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err120 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc121.Write(ctx, oprot); _write_err120 == nil && err2 != nil {
+				_write_err120 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err120 == nil && err2 != nil {
+				_write_err120 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err120 == nil && err2 != nil {
+				_write_err120 = thrift.WrapTException(err2)
+			}
+			if _write_err120 != nil {
+				return false, thrift.WrapTException(_write_err120)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "disconnect", thrift.REPLY, seqId); err2 != nil {
+		_write_err120 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err120 == nil && err2 != nil {
+		_write_err120 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err120 == nil && err2 != nil {
+		_write_err120 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err120 == nil && err2 != nil {
+		_write_err120 = thrift.WrapTException(err2)
+	}
+	if _write_err120 != nil {
+		return false, thrift.WrapTException(_write_err120)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetClientVersion struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetClientVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err122 error
+	args := JMXServiceGetClientVersionArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getClientVersion", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetClientVersionResult{}
+	if retval, err2 := p.handler.GetClientVersion(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXError:
+			result.Err = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc123 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getClientVersion: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getClientVersion", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err122 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc123.Write(ctx, oprot); _write_err122 == nil && err2 != nil {
+				_write_err122 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err122 == nil && err2 != nil {
+				_write_err122 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err122 == nil && err2 != nil {
+				_write_err122 = thrift.WrapTException(err2)
+			}
+			if _write_err122 != nil {
+				return false, thrift.WrapTException(_write_err122)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = &retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getClientVersion", thrift.REPLY, seqId); err2 != nil {
+		_write_err122 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err122 == nil && err2 != nil {
+		_write_err122 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err122 == nil && err2 != nil {
+		_write_err122 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err122 == nil && err2 != nil {
+		_write_err122 = thrift.WrapTException(err2)
+	}
+	if _write_err122 != nil {
+		return false, thrift.WrapTException(_write_err122)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorQueryMBeanNames struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorQueryMBeanNames) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err124 error
+	args := JMXServiceQueryMBeanNamesArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "queryMBeanNames", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceQueryMBeanNamesResult{}
+	if retval, err2 := p.handler.QueryMBeanNames(ctx, args.MBeanNamePattern); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc125 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing queryMBeanNames: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanNames", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err124 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc125.Write(ctx, oprot); _write_err124 == nil && err2 != nil {
+				_write_err124 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err124 == nil && err2 != nil {
+				_write_err124 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err124 == nil && err2 != nil {
+				_write_err124 = thrift.WrapTException(err2)
+			}
+			if _write_err124 != nil {
+				return false, thrift.WrapTException(_write_err124)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanNames", thrift.REPLY, seqId); err2 != nil {
+		_write_err124 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err124 == nil && err2 != nil {
+		_write_err124 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err124 == nil && err2 != nil {
+		_write_err124 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err124 == nil && err2 != nil {
+		_write_err124 = thrift.WrapTException(err2)
+	}
+	if _write_err124 != nil {
+		return false, thrift.WrapTException(_write_err124)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetMBeanAttributeNames struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetMBeanAttributeNames) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err126 error
+	args := JMXServiceGetMBeanAttributeNamesArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getMBeanAttributeNames", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetMBeanAttributeNamesResult{}
+	if retval, err2 := p.handler.GetMBeanAttributeNames(ctx, args.MBeanName); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc127 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMBeanAttributeNames: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributeNames", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err126 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc127.Write(ctx, oprot); _write_err126 == nil && err2 != nil {
+				_write_err126 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err126 == nil && err2 != nil {
+				_write_err126 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err126 == nil && err2 != nil {
+				_write_err126 = thrift.WrapTException(err2)
+			}
+			if _write_err126 != nil {
+				return false, thrift.WrapTException(_write_err126)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributeNames", thrift.REPLY, seqId); err2 != nil {
+		_write_err126 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err126 == nil && err2 != nil {
+		_write_err126 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err126 == nil && err2 != nil {
+		_write_err126 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err126 == nil && err2 != nil {
+		_write_err126 = thrift.WrapTException(err2)
+	}
+	if _write_err126 != nil {
+		return false, thrift.WrapTException(_write_err126)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetMBeanInfo struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetMBeanInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err128 error
+	args := JMXServiceGetMBeanInfoArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getMBeanInfo", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetMBeanInfoResult{}
+	if retval, err2 := p.handler.GetMBeanInfo(ctx, args.MBeanName); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc129 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMBeanInfo: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getMBeanInfo", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err128 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc129.Write(ctx, oprot); _write_err128 == nil && err2 != nil {
+				_write_err128 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err128 == nil && err2 != nil {
+				_write_err128 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err128 == nil && err2 != nil {
+				_write_err128 = thrift.WrapTException(err2)
+			}
+			if _write_err128 != nil {
+				return false, thrift.WrapTException(_write_err128)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getMBeanInfo", thrift.REPLY, seqId); err2 != nil {
+		_write_err128 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err128 == nil && err2 != nil {
+		_write_err128 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err128 == nil && err2 != nil {
+		_write_err128 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err128 == nil && err2 != nil {
+		_write_err128 = thrift.WrapTException(err2)
+	}
+	if _write_err128 != nil {
+		return false, thrift.WrapTException(_write_err128)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetMBeanAttributes struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetMBeanAttributes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err130 error
+	args := JMXServiceGetMBeanAttributesArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getMBeanAttributes", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetMBeanAttributesResult{}
+	if retval, err2 := p.handler.GetMBeanAttributes(ctx, args.MBeanName, args.Attributes); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc131 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMBeanAttributes: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributes", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err130 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc131.Write(ctx, oprot); _write_err130 == nil && err2 != nil {
+				_write_err130 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err130 == nil && err2 != nil {
+				_write_err130 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err130 == nil && err2 != nil {
+				_write_err130 = thrift.WrapTException(err2)
+			}
+			if _write_err130 != nil {
+				return false, thrift.WrapTException(_write_err130)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributes", thrift.REPLY, seqId); err2 != nil {
+		_write_err130 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err130 == nil && err2 != nil {
+		_write_err130 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err130 == nil && err2 != nil {
+		_write_err130 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err130 == nil && err2 != nil {
+		_write_err130 = thrift.WrapTException(err2)
+	}
+	if _write_err130 != nil {
+		return false, thrift.WrapTException(_write_err130)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorQueryMBeanAttributes struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorQueryMBeanAttributes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err132 error
+	args := JMXServiceQueryMBeanAttributesArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "queryMBeanAttributes", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceQueryMBeanAttributesResult{}
+	if retval, err2 := p.handler.QueryMBeanAttributes(ctx, args.MBeanNamePattern, args.Attributes); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc133 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing queryMBeanAttributes: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanAttributes", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err132 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc133.Write(ctx, oprot); _write_err132 == nil && err2 != nil {
+				_write_err132 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err132 == nil && err2 != nil {
+				_write_err132 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err132 == nil && err2 != nil {
+				_write_err132 = thrift.WrapTException(err2)
+			}
+			if _write_err132 != nil {
+				return false, thrift.WrapTException(_write_err132)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanAttributes", thrift.REPLY, seqId); err2 != nil {
+		_write_err132 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err132 == nil && err2 != nil {
+		_write_err132 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err132 == nil && err2 != nil {
+		_write_err132 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err132 == nil && err2 != nil {
+		_write_err132 = thrift.WrapTException(err2)
+	}
+	if _write_err132 != nil {
+		return false, thrift.WrapTException(_write_err132)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorInvokeOperation struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorInvokeOperation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err134 error
+	args := JMXServiceInvokeOperationArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "invokeOperation", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceInvokeOperationResult{}
+	if retval, err2 := p.handler.InvokeOperation(ctx, args.MBeanName, args.OperationName, args.Arguments, args.Signature); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc135 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing invokeOperation: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "invokeOperation", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err134 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc135.Write(ctx, oprot); _write_err134 == nil && err2 != nil {
+				_write_err134 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err134 == nil && err2 != nil {
+				_write_err134 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err134 == nil && err2 != nil {
+				_write_err134 = thrift.WrapTException(err2)
+			}
+			if _write_err134 != nil {
+				return false, thrift.WrapTException(_write_err134)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "invokeOperation", thrift.REPLY, seqId); err2 != nil {
+		_write_err134 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err134 == nil && err2 != nil {
+		_write_err134 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err134 == nil && err2 != nil {
+		_write_err134 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err134 == nil && err2 != nil {
+		_write_err134 = thrift.WrapTException(err2)
+	}
+	if _write_err134 != nil {
+		return false, thrift.WrapTException(_write_err134)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetInternalStats struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetInternalStats) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err136 error
+	args := JMXServiceGetInternalStatsArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getInternalStats", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetInternalStatsResult{}
+	if retval, err2 := p.handler.GetInternalStats(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc137 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getInternalStats: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getInternalStats", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err136 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc137.Write(ctx, oprot); _write_err136 == nil && err2 != nil {
+				_write_err136 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err136 == nil && err2 != nil {
+				_write_err136 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err136 == nil && err2 != nil {
+				_write_err136 = thrift.WrapTException(err2)
+			}
+			if _write_err136 != nil {
+				return false, thrift.WrapTException(_write_err136)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getInternalStats", thrift.REPLY, seqId); err2 != nil {
+		_write_err136 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err136 == nil && err2 != nil {
+		_write_err136 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err136 == nil && err2 != nil {
+		_write_err136 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err136 == nil && err2 != nil {
+		_write_err136 = thrift.WrapTException(err2)
+	}
+	if _write_err136 != nil {
+		return false, thrift.WrapTException(_write_err136)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetInternalMetrics struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetInternalMetrics) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err138 error
+	args := JMXServiceGetInternalMetricsArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getInternalMetrics", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetInternalMetricsResult{}
+	if retval, err2 := p.handler.GetInternalMetrics(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc139 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getInternalMetrics: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getInternalMetrics", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err138 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc139.Write(ctx, oprot); _write_err138 == nil && err2 != nil {
+				_write_err138 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err138 == nil && err2 != nil {
+				_write_err138 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err138 == nil && err2 != nil {
+				_write_err138 = thrift.WrapTException(err2)
+			}
+			if _write_err138 != nil {
+				return false, thrift.WrapTException(_write_err138)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getInternalMetrics", thrift.REPLY, seqId); err2 != nil {
+		_write_err138 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err138 == nil && err2 != nil {
+		_write_err138 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err138 == nil && err2 != nil {
+		_write_err138 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err138 == nil && err2 != nil {
+		_write_err138 = thrift.WrapTException(err2)
+	}
+	if _write_err138 != nil {
+		return false, thrift.WrapTException(_write_err138)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetTruncations struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetTruncations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err140 error
+	args := JMXServiceGetTruncationsArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getTruncations", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetTruncationsResult{}
+	if retval, err2 := p.handler.GetTruncations(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc141 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getTruncations: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getTruncations", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err140 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc141.Write(ctx, oprot); _write_err140 == nil && err2 != nil {
+				_write_err140 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err140 == nil && err2 != nil {
+				_write_err140 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err140 == nil && err2 != nil {
+				_write_err140 = thrift.WrapTException(err2)
+			}
+			if _write_err140 != nil {
+				return false, thrift.WrapTException(_write_err140)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getTruncations", thrift.REPLY, seqId); err2 != nil {
+		_write_err140 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err140 == nil && err2 != nil {
+		_write_err140 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err140 == nil && err2 != nil {
+		_write_err140 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err140 == nil && err2 != nil {
+		_write_err140 = thrift.WrapTException(err2)
+	}
+	if _write_err140 != nil {
+		return false, thrift.WrapTException(_write_err140)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetDomains struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetDomains) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err142 error
+	args := JMXServiceGetDomainsArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getDomains", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetDomainsResult{}
+	if retval, err2 := p.handler.GetDomains(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc143 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getDomains: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getDomains", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err142 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc143.Write(ctx, oprot); _write_err142 == nil && err2 != nil {
+				_write_err142 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err142 == nil && err2 != nil {
+				_write_err142 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err142 == nil && err2 != nil {
+				_write_err142 = thrift.WrapTException(err2)
+			}
+			if _write_err142 != nil {
+				return false, thrift.WrapTException(_write_err142)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getDomains", thrift.REPLY, seqId); err2 != nil {
+		_write_err142 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err142 == nil && err2 != nil {
+		_write_err142 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err142 == nil && err2 != nil {
+		_write_err142 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err142 == nil && err2 != nil {
+		_write_err142 = thrift.WrapTException(err2)
+	}
+	if _write_err142 != nil {
+		return false, thrift.WrapTException(_write_err142)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetMBeanCount struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetMBeanCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err144 error
+	args := JMXServiceGetMBeanCountArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getMBeanCount", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetMBeanCountResult{}
+	if retval, err2 := p.handler.GetMBeanCount(ctx, args.MBeanNamePattern); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc145 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMBeanCount: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getMBeanCount", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err144 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc145.Write(ctx, oprot); _write_err144 == nil && err2 != nil {
+				_write_err144 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err144 == nil && err2 != nil {
+				_write_err144 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err144 == nil && err2 != nil {
+				_write_err144 = thrift.WrapTException(err2)
+			}
+			if _write_err144 != nil {
+				return false, thrift.WrapTException(_write_err144)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = &retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getMBeanCount", thrift.REPLY, seqId); err2 != nil {
+		_write_err144 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err144 == nil && err2 != nil {
+		_write_err144 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err144 == nil && err2 != nil {
+		_write_err144 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err144 == nil && err2 != nil {
+		_write_err144 = thrift.WrapTException(err2)
+	}
+	if _write_err144 != nil {
+		return false, thrift.WrapTException(_write_err144)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetServerInfo struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetServerInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err146 error
+	args := JMXServiceGetServerInfoArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getServerInfo", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetServerInfoResult{}
+	if retval, err2 := p.handler.GetServerInfo(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc147 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getServerInfo: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getServerInfo", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err146 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc147.Write(ctx, oprot); _write_err146 == nil && err2 != nil {
+				_write_err146 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err146 == nil && err2 != nil {
+				_write_err146 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err146 == nil && err2 != nil {
+				_write_err146 = thrift.WrapTException(err2)
+			}
+			if _write_err146 != nil {
+				return false, thrift.WrapTException(_write_err146)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getServerInfo", thrift.REPLY, seqId); err2 != nil {
+		_write_err146 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err146 == nil && err2 != nil {
+		_write_err146 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err146 == nil && err2 != nil {
+		_write_err146 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err146 == nil && err2 != nil {
+		_write_err146 = thrift.WrapTException(err2)
+	}
+	if _write_err146 != nil {
+		return false, thrift.WrapTException(_write_err146)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorThreadDump struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorThreadDump) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err148 error
+	args := JMXServiceThreadDumpArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "threadDump", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceThreadDumpResult{}
+	if retval, err2 := p.handler.ThreadDump(ctx, args.Options); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc149 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing threadDump: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "threadDump", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err148 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc149.Write(ctx, oprot); _write_err148 == nil && err2 != nil {
+				_write_err148 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err148 == nil && err2 != nil {
+				_write_err148 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err148 == nil && err2 != nil {
+				_write_err148 = thrift.WrapTException(err2)
+			}
+			if _write_err148 != nil {
+				return false, thrift.WrapTException(_write_err148)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "threadDump", thrift.REPLY, seqId); err2 != nil {
+		_write_err148 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err148 == nil && err2 != nil {
+		_write_err148 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err148 == nil && err2 != nil {
+		_write_err148 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err148 == nil && err2 != nil {
+		_write_err148 = thrift.WrapTException(err2)
+	}
+	if _write_err148 != nil {
+		return false, thrift.WrapTException(_write_err148)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorFindDeadlocks struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorFindDeadlocks) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err150 error
+	args := JMXServiceFindDeadlocksArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "findDeadlocks", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceFindDeadlocksResult{}
+	if retval, err2 := p.handler.FindDeadlocks(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc151 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing findDeadlocks: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "findDeadlocks", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err150 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc151.Write(ctx, oprot); _write_err150 == nil && err2 != nil {
+				_write_err150 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err150 == nil && err2 != nil {
+				_write_err150 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err150 == nil && err2 != nil {
+				_write_err150 = thrift.WrapTException(err2)
+			}
+			if _write_err150 != nil {
+				return false, thrift.WrapTException(_write_err150)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "findDeadlocks", thrift.REPLY, seqId); err2 != nil {
+		_write_err150 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err150 == nil && err2 != nil {
+		_write_err150 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err150 == nil && err2 != nil {
+		_write_err150 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err150 == nil && err2 != nil {
+		_write_err150 = thrift.WrapTException(err2)
+	}
+	if _write_err150 != nil {
+		return false, thrift.WrapTException(_write_err150)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorDiagnosticCommand struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorDiagnosticCommand) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err152 error
+	args := JMXServiceDiagnosticCommandArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "diagnosticCommand", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceDiagnosticCommandResult{}
+	if retval, err2 := p.handler.DiagnosticCommand(ctx, args.Command, args.Arguments); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc153 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing diagnosticCommand: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "diagnosticCommand", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err152 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc153.Write(ctx, oprot); _write_err152 == nil && err2 != nil {
+				_write_err152 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err152 == nil && err2 != nil {
+				_write_err152 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err152 == nil && err2 != nil {
+				_write_err152 = thrift.WrapTException(err2)
+			}
+			if _write_err152 != nil {
+				return false, thrift.WrapTException(_write_err152)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = &retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "diagnosticCommand", thrift.REPLY, seqId); err2 != nil {
+		_write_err152 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err152 == nil && err2 != nil {
+		_write_err152 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err152 == nil && err2 != nil {
+		_write_err152 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err152 == nil && err2 != nil {
+		_write_err152 = thrift.WrapTException(err2)
+	}
+	if _write_err152 != nil {
+		return false, thrift.WrapTException(_write_err152)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorStartRecording struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorStartRecording) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err154 error
+	args := JMXServiceStartRecordingArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "startRecording", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceStartRecordingResult{}
+	if retval, err2 := p.handler.StartRecording(ctx, args.Settings); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc155 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing startRecording: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "startRecording", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err154 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc155.Write(ctx, oprot); _write_err154 == nil && err2 != nil {
+				_write_err154 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err154 == nil && err2 != nil {
+				_write_err154 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err154 == nil && err2 != nil {
+				_write_err154 = thrift.WrapTException(err2)
+			}
+			if _write_err154 != nil {
+				return false, thrift.WrapTException(_write_err154)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = &retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "startRecording", thrift.REPLY, seqId); err2 != nil {
+		_write_err154 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err154 == nil && err2 != nil {
+		_write_err154 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err154 == nil && err2 != nil {
+		_write_err154 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err154 == nil && err2 != nil {
+		_write_err154 = thrift.WrapTException(err2)
+	}
+	if _write_err154 != nil {
+		return false, thrift.WrapTException(_write_err154)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorStopRecording struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorStopRecording) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err156 error
+	args := JMXServiceStopRecordingArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "stopRecording", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceStopRecordingResult{}
+	if err2 := p.handler.StopRecording(ctx, args.RecordingId); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc157 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing stopRecording: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "stopRecording", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err156 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc157.Write(ctx, oprot); _write_err156 == nil && err2 != nil {
+				_write_err156 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err156 == nil && err2 != nil {
+				_write_err156 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err156 == nil && err2 != nil {
+				_write_err156 = thrift.WrapTException(err2)
+			}
+			if _write_err156 != nil {
+				return false, thrift.WrapTException(_write_err156)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "stopRecording", thrift.REPLY, seqId); err2 != nil {
+		_write_err156 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err156 == nil && err2 != nil {
+		_write_err156 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err156 == nil && err2 != nil {
+		_write_err156 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err156 == nil && err2 != nil {
+		_write_err156 = thrift.WrapTException(err2)
+	}
+	if _write_err156 != nil {
+		return false, thrift.WrapTException(_write_err156)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorCloseRecording struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorCloseRecording) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err158 error
+	args := JMXServiceCloseRecordingArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "closeRecording", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceCloseRecordingResult{}
+	if err2 := p.handler.CloseRecording(ctx, args.RecordingId); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc159 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing closeRecording: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "closeRecording", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err158 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc159.Write(ctx, oprot); _write_err158 == nil && err2 != nil {
+				_write_err158 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err158 == nil && err2 != nil {
+				_write_err158 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err158 == nil && err2 != nil {
+				_write_err158 = thrift.WrapTException(err2)
+			}
+			if _write_err158 != nil {
+				return false, thrift.WrapTException(_write_err158)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "closeRecording", thrift.REPLY, seqId); err2 != nil {
+		_write_err158 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err158 == nil && err2 != nil {
+		_write_err158 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err158 == nil && err2 != nil {
+		_write_err158 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err158 == nil && err2 != nil {
+		_write_err158 = thrift.WrapTException(err2)
+	}
+	if _write_err158 != nil {
+		return false, thrift.WrapTException(_write_err158)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorOpenRecordingStream struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorOpenRecordingStream) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err160 error
+	args := JMXServiceOpenRecordingStreamArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "openRecordingStream", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceOpenRecordingStreamResult{}
+	if retval, err2 := p.handler.OpenRecordingStream(ctx, args.RecordingId); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc161 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing openRecordingStream: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "openRecordingStream", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err160 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc161.Write(ctx, oprot); _write_err160 == nil && err2 != nil {
+				_write_err160 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err160 == nil && err2 != nil {
+				_write_err160 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err160 == nil && err2 != nil {
+				_write_err160 = thrift.WrapTException(err2)
+			}
+			if _write_err160 != nil {
+				return false, thrift.WrapTException(_write_err160)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = &retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "openRecordingStream", thrift.REPLY, seqId); err2 != nil {
+		_write_err160 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err160 == nil && err2 != nil {
+		_write_err160 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err160 == nil && err2 != nil {
+		_write_err160 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err160 == nil && err2 != nil {
+		_write_err160 = thrift.WrapTException(err2)
+	}
+	if _write_err160 != nil {
+		return false, thrift.WrapTException(_write_err160)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorReadRecordingStream struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorReadRecordingStream) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err162 error
+	args := JMXServiceReadRecordingStreamArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "readRecordingStream", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceReadRecordingStreamResult{}
+	if retval, err2 := p.handler.ReadRecordingStream(ctx, args.StreamId); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc163 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing readRecordingStream: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "readRecordingStream", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err162 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc163.Write(ctx, oprot); _write_err162 == nil && err2 != nil {
+				_write_err162 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err162 == nil && err2 != nil {
+				_write_err162 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err162 == nil && err2 != nil {
+				_write_err162 = thrift.WrapTException(err2)
+			}
+			if _write_err162 != nil {
+				return false, thrift.WrapTException(_write_err162)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "readRecordingStream", thrift.REPLY, seqId); err2 != nil {
+		_write_err162 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err162 == nil && err2 != nil {
+		_write_err162 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err162 == nil && err2 != nil {
+		_write_err162 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err162 == nil && err2 != nil {
+		_write_err162 = thrift.WrapTException(err2)
+	}
+	if _write_err162 != nil {
+		return false, thrift.WrapTException(_write_err162)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorCloseRecordingStream struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorCloseRecordingStream) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err164 error
+	args := JMXServiceCloseRecordingStreamArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "closeRecordingStream", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceCloseRecordingStreamResult{}
+	if err2 := p.handler.CloseRecordingStream(ctx, args.StreamId); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc165 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing closeRecordingStream: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "closeRecordingStream", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err164 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc165.Write(ctx, oprot); _write_err164 == nil && err2 != nil {
+				_write_err164 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err164 == nil && err2 != nil {
+				_write_err164 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err164 == nil && err2 != nil {
+				_write_err164 = thrift.WrapTException(err2)
+			}
+			if _write_err164 != nil {
+				return false, thrift.WrapTException(_write_err164)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "closeRecordingStream", thrift.REPLY, seqId); err2 != nil {
+		_write_err164 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err164 == nil && err2 != nil {
+		_write_err164 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err164 == nil && err2 != nil {
+		_write_err164 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err164 == nil && err2 != nil {
+		_write_err164 = thrift.WrapTException(err2)
+	}
+	if _write_err164 != nil {
+		return false, thrift.WrapTException(_write_err164)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetVMOptions struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetVMOptions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err166 error
+	args := JMXServiceGetVMOptionsArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getVMOptions", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetVMOptionsResult{}
+	if retval, err2 := p.handler.GetVMOptions(ctx); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc167 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getVMOptions: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getVMOptions", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err166 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc167.Write(ctx, oprot); _write_err166 == nil && err2 != nil {
+				_write_err166 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err166 == nil && err2 != nil {
+				_write_err166 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err166 == nil && err2 != nil {
+				_write_err166 = thrift.WrapTException(err2)
+			}
+			if _write_err166 != nil {
+				return false, thrift.WrapTException(_write_err166)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getVMOptions", thrift.REPLY, seqId); err2 != nil {
+		_write_err166 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err166 == nil && err2 != nil {
+		_write_err166 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err166 == nil && err2 != nil {
+		_write_err166 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err166 == nil && err2 != nil {
+		_write_err166 = thrift.WrapTException(err2)
+	}
+	if _write_err166 != nil {
+		return false, thrift.WrapTException(_write_err166)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorGetVMOption struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorGetVMOption) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err168 error
+	args := JMXServiceGetVMOptionArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "getVMOption", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceGetVMOptionResult{}
+	if retval, err2 := p.handler.GetVMOption(ctx, args.Name); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc169 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getVMOption: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "getVMOption", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err168 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc169.Write(ctx, oprot); _write_err168 == nil && err2 != nil {
+				_write_err168 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err168 == nil && err2 != nil {
+				_write_err168 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err168 == nil && err2 != nil {
+				_write_err168 = thrift.WrapTException(err2)
+			}
+			if _write_err168 != nil {
+				return false, thrift.WrapTException(_write_err168)
+			}
+			return true, err
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "getVMOption", thrift.REPLY, seqId); err2 != nil {
+		_write_err168 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err168 == nil && err2 != nil {
+		_write_err168 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err168 == nil && err2 != nil {
+		_write_err168 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err168 == nil && err2 != nil {
+		_write_err168 = thrift.WrapTException(err2)
+	}
+	if _write_err168 != nil {
+		return false, thrift.WrapTException(_write_err168)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorSetVMOption struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorSetVMOption) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err170 error
+	args := JMXServiceSetVMOptionArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "setVMOption", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelCauseFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel(thrift.ErrAbandonRequest)
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceSetVMOptionResult{}
+	if err2 := p.handler.SetVMOption(ctx, args.Name, args.Value); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc171 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setVMOption: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "setVMOption", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err170 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc171.Write(ctx, oprot); _write_err170 == nil && err2 != nil {
+				_write_err170 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err170 == nil && err2 != nil {
+				_write_err170 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err170 == nil && err2 != nil {
+				_write_err170 = thrift.WrapTException(err2)
+			}
+			if _write_err170 != nil {
+				return false, thrift.WrapTException(_write_err170)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "setVMOption", thrift.REPLY, seqId); err2 != nil {
+		_write_err170 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err170 == nil && err2 != nil {
+		_write_err170 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err170 == nil && err2 != nil {
+		_write_err170 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err170 == nil && err2 != nil {
+		_write_err170 = thrift.WrapTException(err2)
+	}
+	if _write_err170 != nil {
+		return false, thrift.WrapTException(_write_err170)
+	}
+	return true, err
+}
+
+type jMXServiceProcessorDumpHeap struct {
+	handler JMXService
+}
+
+func (p *jMXServiceProcessorDumpHeap) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	var _write_err172 error
+	args := JMXServiceDumpHeapArgs{}
+	if err2 := args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "dumpHeap", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
 		var cancel context.CancelCauseFunc
 		ctx, cancel = context.WithCancelCause(ctx)
 		defer cancel(nil)
@@ -2777,399 +11237,6605 @@ func (p *jMXServiceProcessorGetMBeanAttributeNames) Process(ctx context.Context,
 					}
 				}
 			}
-		}(tickerCtx, cancel)
+		}(tickerCtx, cancel)
+	}
+
+	result := JMXServiceDumpHeapResult{}
+	if err2 := p.handler.DumpHeap(ctx, args.OutputFile, args.Live, args.TimeoutMs); err2 != nil {
+		tickerCancel()
+		err = thrift.WrapTException(err2)
+		switch v := err2.(type) {
+		case *JMXConnectionError:
+			result.ConnErr = v
+		case *JMXError:
+			result.JmxErr = v
+		default:
+			if errors.Is(err2, thrift.ErrAbandonRequest) {
+				return false, thrift.WrapTException(err2)
+			}
+			if errors.Is(err2, context.Canceled) {
+				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
+					return false, thrift.WrapTException(err)
+				}
+			}
+			_exc173 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing dumpHeap: " + err2.Error())
+			if err2 := oprot.WriteMessageBegin(ctx, "dumpHeap", thrift.EXCEPTION, seqId); err2 != nil {
+				_write_err172 = thrift.WrapTException(err2)
+			}
+			if err2 := _exc173.Write(ctx, oprot); _write_err172 == nil && err2 != nil {
+				_write_err172 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.WriteMessageEnd(ctx); _write_err172 == nil && err2 != nil {
+				_write_err172 = thrift.WrapTException(err2)
+			}
+			if err2 := oprot.Flush(ctx); _write_err172 == nil && err2 != nil {
+				_write_err172 = thrift.WrapTException(err2)
+			}
+			if _write_err172 != nil {
+				return false, thrift.WrapTException(_write_err172)
+			}
+			return true, err
+		}
+	}
+	tickerCancel()
+	if err2 := oprot.WriteMessageBegin(ctx, "dumpHeap", thrift.REPLY, seqId); err2 != nil {
+		_write_err172 = thrift.WrapTException(err2)
+	}
+	if err2 := result.Write(ctx, oprot); _write_err172 == nil && err2 != nil {
+		_write_err172 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.WriteMessageEnd(ctx); _write_err172 == nil && err2 != nil {
+		_write_err172 = thrift.WrapTException(err2)
+	}
+	if err2 := oprot.Flush(ctx); _write_err172 == nil && err2 != nil {
+		_write_err172 = thrift.WrapTException(err2)
+	}
+	if _write_err172 != nil {
+		return false, thrift.WrapTException(_write_err172)
+	}
+	return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - Config
+// 
+type JMXServiceConnectArgs struct {
+	Config *JMXConfig `thrift:"config,1" db:"config" json:"config"`
+}
+
+func NewJMXServiceConnectArgs() *JMXServiceConnectArgs {
+	return &JMXServiceConnectArgs{}
+}
+
+var JMXServiceConnectArgs_Config_DEFAULT *JMXConfig
+
+func (p *JMXServiceConnectArgs) GetConfig() *JMXConfig {
+	if !p.IsSetConfig() {
+		return JMXServiceConnectArgs_Config_DEFAULT
+	}
+	return p.Config
+}
+
+func (p *JMXServiceConnectArgs) IsSetConfig() bool {
+	return p.Config != nil
+}
+
+func (p *JMXServiceConnectArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Config = &JMXConfig{}
+	if err := p.Config.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Config), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "connect_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "config", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:config: ", p), err)
+	}
+	if err := p.Config.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Config), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:config: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceConnectArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceConnectArgs(%+v)", *p)
+}
+
+func (p *JMXServiceConnectArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceConnectArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceConnectArgs)(nil)
+
+// Attributes:
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceConnectResult struct {
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceConnectResult() *JMXServiceConnectResult {
+	return &JMXServiceConnectResult{}
+}
+
+var JMXServiceConnectResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceConnectResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceConnectResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceConnectResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceConnectResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceConnectResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceConnectResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceConnectResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceConnectResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "connect_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceConnectResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceConnectResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceConnectResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceConnectResult(%+v)", *p)
+}
+
+func (p *JMXServiceConnectResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceConnectResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceConnectResult)(nil)
+
+type JMXServiceDisconnectArgs struct {
+}
+
+func NewJMXServiceDisconnectArgs() *JMXServiceDisconnectArgs {
+	return &JMXServiceDisconnectArgs{}
+}
+
+func (p *JMXServiceDisconnectArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDisconnectArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "disconnect_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDisconnectArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceDisconnectArgs(%+v)", *p)
+}
+
+func (p *JMXServiceDisconnectArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceDisconnectArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceDisconnectArgs)(nil)
+
+// Attributes:
+//  - Err
+// 
+type JMXServiceDisconnectResult struct {
+	Err *JMXError `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewJMXServiceDisconnectResult() *JMXServiceDisconnectResult {
+	return &JMXServiceDisconnectResult{}
+}
+
+var JMXServiceDisconnectResult_Err_DEFAULT *JMXError
+
+func (p *JMXServiceDisconnectResult) GetErr() *JMXError {
+	if !p.IsSetErr() {
+		return JMXServiceDisconnectResult_Err_DEFAULT
+	}
+	return p.Err
+}
+
+func (p *JMXServiceDisconnectResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *JMXServiceDisconnectResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDisconnectResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Err = &JMXError{}
+	if err := p.Err.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDisconnectResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "disconnect_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDisconnectResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin(ctx, "err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceDisconnectResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceDisconnectResult(%+v)", *p)
+}
+
+func (p *JMXServiceDisconnectResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceDisconnectResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceDisconnectResult)(nil)
+
+type JMXServiceGetClientVersionArgs struct {
+}
+
+func NewJMXServiceGetClientVersionArgs() *JMXServiceGetClientVersionArgs {
+	return &JMXServiceGetClientVersionArgs{}
+}
+
+func (p *JMXServiceGetClientVersionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetClientVersionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getClientVersion_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetClientVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetClientVersionArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetClientVersionArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetClientVersionArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetClientVersionArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - Err
+// 
+type JMXServiceGetClientVersionResult struct {
+	Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err *JMXError `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewJMXServiceGetClientVersionResult() *JMXServiceGetClientVersionResult {
+	return &JMXServiceGetClientVersionResult{}
+}
+
+var JMXServiceGetClientVersionResult_Success_DEFAULT string
+
+func (p *JMXServiceGetClientVersionResult) GetSuccess() string {
+	if !p.IsSetSuccess() {
+		return JMXServiceGetClientVersionResult_Success_DEFAULT
+	}
+	return *p.Success
+}
+
+var JMXServiceGetClientVersionResult_Err_DEFAULT *JMXError
+
+func (p *JMXServiceGetClientVersionResult) GetErr() *JMXError {
+	if !p.IsSetErr() {
+		return JMXServiceGetClientVersionResult_Err_DEFAULT
+	}
+	return p.Err
+}
+
+func (p *JMXServiceGetClientVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetClientVersionResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *JMXServiceGetClientVersionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetClientVersionResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 0: ", err)
+	} else {
+		p.Success = &v
+	}
+	return nil
+}
+
+func (p *JMXServiceGetClientVersionResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Err = &JMXError{}
+	if err := p.Err.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetClientVersionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getClientVersion_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetClientVersionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRING, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Success)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetClientVersionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin(ctx, "err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetClientVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetClientVersionResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetClientVersionResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetClientVersionResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetClientVersionResult)(nil)
+
+// Attributes:
+//  - MBeanNamePattern
+// 
+type JMXServiceQueryMBeanNamesArgs struct {
+	MBeanNamePattern string `thrift:"mBeanNamePattern,1" db:"mBeanNamePattern" json:"mBeanNamePattern"`
+}
+
+func NewJMXServiceQueryMBeanNamesArgs() *JMXServiceQueryMBeanNamesArgs {
+	return &JMXServiceQueryMBeanNamesArgs{}
+}
+
+
+
+func (p *JMXServiceQueryMBeanNamesArgs) GetMBeanNamePattern() string {
+	return p.MBeanNamePattern
+}
+
+func (p *JMXServiceQueryMBeanNamesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanNamePattern = v
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "queryMBeanNames_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanNamePattern", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanNamePattern: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanNamePattern)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanNamePattern (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanNamePattern: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanNamesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceQueryMBeanNamesArgs(%+v)", *p)
+}
+
+func (p *JMXServiceQueryMBeanNamesArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceQueryMBeanNamesArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceQueryMBeanNamesArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceQueryMBeanNamesResult struct {
+	Success []string `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceQueryMBeanNamesResult() *JMXServiceQueryMBeanNamesResult {
+	return &JMXServiceQueryMBeanNamesResult{}
+}
+
+var JMXServiceQueryMBeanNamesResult_Success_DEFAULT []string
+
+
+func (p *JMXServiceQueryMBeanNamesResult) GetSuccess() []string {
+	return p.Success
+}
+
+var JMXServiceQueryMBeanNamesResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceQueryMBeanNamesResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceQueryMBeanNamesResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceQueryMBeanNamesResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceQueryMBeanNamesResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceQueryMBeanNamesResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		var _elem174 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem174 = v
+		}
+		p.Success = append(p.Success, _elem174)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "queryMBeanNames_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := oprot.WriteString(ctx, string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceQueryMBeanNamesResult(%+v)", *p)
+}
+
+func (p *JMXServiceQueryMBeanNamesResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceQueryMBeanNamesResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceQueryMBeanNamesResult)(nil)
+
+// Attributes:
+//  - MBeanName
+// 
+type JMXServiceGetMBeanAttributeNamesArgs struct {
+	MBeanName string `thrift:"mBeanName,1" db:"mBeanName" json:"mBeanName"`
+}
+
+func NewJMXServiceGetMBeanAttributeNamesArgs() *JMXServiceGetMBeanAttributeNamesArgs {
+	return &JMXServiceGetMBeanAttributeNamesArgs{}
+}
+
+
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) GetMBeanName() string {
+	return p.MBeanName
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanName = v
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributeNames_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanName: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanAttributeNamesArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanAttributeNamesArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanAttributeNamesArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceGetMBeanAttributeNamesResult struct {
+	Success []string `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetMBeanAttributeNamesResult() *JMXServiceGetMBeanAttributeNamesResult {
+	return &JMXServiceGetMBeanAttributeNamesResult{}
+}
+
+var JMXServiceGetMBeanAttributeNamesResult_Success_DEFAULT []string
+
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) GetSuccess() []string {
+	return p.Success
+}
+
+var JMXServiceGetMBeanAttributeNamesResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceGetMBeanAttributeNamesResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceGetMBeanAttributeNamesResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetMBeanAttributeNamesResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		var _elem175 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem175 = v
+		}
+		p.Success = append(p.Success, _elem175)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributeNames_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := oprot.WriteString(ctx, string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanAttributeNamesResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanAttributeNamesResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanAttributeNamesResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanAttributeNamesResult)(nil)
+
+// Attributes:
+//  - MBeanName
+// 
+type JMXServiceGetMBeanInfoArgs struct {
+	MBeanName string `thrift:"mBeanName,1" db:"mBeanName" json:"mBeanName"`
+}
+
+func NewJMXServiceGetMBeanInfoArgs() *JMXServiceGetMBeanInfoArgs {
+	return &JMXServiceGetMBeanInfoArgs{}
+}
+
+
+
+func (p *JMXServiceGetMBeanInfoArgs) GetMBeanName() string {
+	return p.MBeanName
+}
+
+func (p *JMXServiceGetMBeanInfoArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanName = v
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanInfo_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanName: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanInfoArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanInfoArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanInfoArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanInfoArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceGetMBeanInfoResult struct {
+	Success *MBeanInfo `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetMBeanInfoResult() *JMXServiceGetMBeanInfoResult {
+	return &JMXServiceGetMBeanInfoResult{}
+}
+
+var JMXServiceGetMBeanInfoResult_Success_DEFAULT *MBeanInfo
+
+func (p *JMXServiceGetMBeanInfoResult) GetSuccess() *MBeanInfo {
+	if !p.IsSetSuccess() {
+		return JMXServiceGetMBeanInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var JMXServiceGetMBeanInfoResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceGetMBeanInfoResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceGetMBeanInfoResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceGetMBeanInfoResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetMBeanInfoResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetMBeanInfoResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetMBeanInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &MBeanInfo{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanInfo_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanInfoResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanInfoResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanInfoResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanInfoResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanInfoResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanInfoResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanInfoResult)(nil)
+
+// Attributes:
+//  - MBeanName
+//  - Attributes
+// 
+type JMXServiceGetMBeanAttributesArgs struct {
+	MBeanName string `thrift:"mBeanName,1" db:"mBeanName" json:"mBeanName"`
+	Attributes []string `thrift:"attributes,2" db:"attributes" json:"attributes"`
+}
+
+func NewJMXServiceGetMBeanAttributesArgs() *JMXServiceGetMBeanAttributesArgs {
+	return &JMXServiceGetMBeanAttributesArgs{}
+}
+
+
+
+func (p *JMXServiceGetMBeanAttributesArgs) GetMBeanName() string {
+	return p.MBeanName
+}
+
+
+
+func (p *JMXServiceGetMBeanAttributesArgs) GetAttributes() []string {
+	return p.Attributes
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanName = v
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Attributes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem176 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem176 = v
+		}
+		p.Attributes = append(p.Attributes, _elem176)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributes_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanName: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "attributes", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:attributes: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Attributes)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Attributes {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:attributes: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanAttributesArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanAttributesArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanAttributesArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanAttributesArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceGetMBeanAttributesResult struct {
+	Success []*AttributeResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetMBeanAttributesResult() *JMXServiceGetMBeanAttributesResult {
+	return &JMXServiceGetMBeanAttributesResult{}
+}
+
+var JMXServiceGetMBeanAttributesResult_Success_DEFAULT []*AttributeResponse
+
+
+func (p *JMXServiceGetMBeanAttributesResult) GetSuccess() []*AttributeResponse {
+	return p.Success
+}
+
+var JMXServiceGetMBeanAttributesResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceGetMBeanAttributesResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceGetMBeanAttributesResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceGetMBeanAttributesResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetMBeanAttributesResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetMBeanAttributesResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AttributeResponse, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem177 := &AttributeResponse{}
+		if err := _elem177.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem177), err)
+		}
+		p.Success = append(p.Success, _elem177)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributes_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanAttributesResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanAttributesResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanAttributesResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanAttributesResult)(nil)
+
+// Attributes:
+//  - MBeanNamePattern
+//  - Attributes
+// 
+type JMXServiceQueryMBeanAttributesArgs struct {
+	MBeanNamePattern string `thrift:"mBeanNamePattern,1" db:"mBeanNamePattern" json:"mBeanNamePattern"`
+	Attributes []string `thrift:"attributes,2" db:"attributes" json:"attributes"`
+}
+
+func NewJMXServiceQueryMBeanAttributesArgs() *JMXServiceQueryMBeanAttributesArgs {
+	return &JMXServiceQueryMBeanAttributesArgs{}
+}
+
+
+
+func (p *JMXServiceQueryMBeanAttributesArgs) GetMBeanNamePattern() string {
+	return p.MBeanNamePattern
+}
+
+
+
+func (p *JMXServiceQueryMBeanAttributesArgs) GetAttributes() []string {
+	return p.Attributes
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanNamePattern = v
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Attributes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem178 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem178 = v
+		}
+		p.Attributes = append(p.Attributes, _elem178)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "queryMBeanAttributes_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanNamePattern", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanNamePattern: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanNamePattern)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanNamePattern (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanNamePattern: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "attributes", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:attributes: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Attributes)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Attributes {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:attributes: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceQueryMBeanAttributesArgs(%+v)", *p)
+}
+
+func (p *JMXServiceQueryMBeanAttributesArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceQueryMBeanAttributesArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceQueryMBeanAttributesArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceQueryMBeanAttributesResult struct {
+	Success []*AttributeResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceQueryMBeanAttributesResult() *JMXServiceQueryMBeanAttributesResult {
+	return &JMXServiceQueryMBeanAttributesResult{}
+}
+
+var JMXServiceQueryMBeanAttributesResult_Success_DEFAULT []*AttributeResponse
+
+
+func (p *JMXServiceQueryMBeanAttributesResult) GetSuccess() []*AttributeResponse {
+	return p.Success
+}
+
+var JMXServiceQueryMBeanAttributesResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceQueryMBeanAttributesResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceQueryMBeanAttributesResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceQueryMBeanAttributesResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceQueryMBeanAttributesResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceQueryMBeanAttributesResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AttributeResponse, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem179 := &AttributeResponse{}
+		if err := _elem179.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem179), err)
+		}
+		p.Success = append(p.Success, _elem179)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "queryMBeanAttributes_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceQueryMBeanAttributesResult(%+v)", *p)
+}
+
+func (p *JMXServiceQueryMBeanAttributesResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceQueryMBeanAttributesResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceQueryMBeanAttributesResult)(nil)
+
+// Attributes:
+//  - MBeanName
+//  - OperationName
+//  - Arguments
+//  - Signature
+// 
+type JMXServiceInvokeOperationArgs struct {
+	MBeanName string `thrift:"mBeanName,1" db:"mBeanName" json:"mBeanName"`
+	OperationName string `thrift:"operationName,2" db:"operationName" json:"operationName"`
+	Arguments []string `thrift:"arguments,3" db:"arguments" json:"arguments"`
+	Signature []string `thrift:"signature,4" db:"signature" json:"signature"`
+}
+
+func NewJMXServiceInvokeOperationArgs() *JMXServiceInvokeOperationArgs {
+	return &JMXServiceInvokeOperationArgs{}
+}
+
+
+
+func (p *JMXServiceInvokeOperationArgs) GetMBeanName() string {
+	return p.MBeanName
+}
+
+
+
+func (p *JMXServiceInvokeOperationArgs) GetOperationName() string {
+	return p.OperationName
+}
+
+
+
+func (p *JMXServiceInvokeOperationArgs) GetArguments() []string {
+	return p.Arguments
+}
+
+
+
+func (p *JMXServiceInvokeOperationArgs) GetSignature() []string {
+	return p.Signature
+}
+
+func (p *JMXServiceInvokeOperationArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanName = v
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OperationName = v
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationArgs) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Arguments = tSlice
+	for i := 0; i < size; i++ {
+		var _elem180 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem180 = v
+		}
+		p.Arguments = append(p.Arguments, _elem180)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationArgs) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Signature = tSlice
+	for i := 0; i < size; i++ {
+		var _elem181 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem181 = v
+		}
+		p.Signature = append(p.Signature, _elem181)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "invokeOperation_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
+		if err := p.writeField4(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanName: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "operationName", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:operationName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.OperationName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.operationName (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:operationName: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "arguments", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:arguments: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Arguments)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Arguments {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:arguments: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "signature", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:signature: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Signature)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Signature {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:signature: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceInvokeOperationArgs(%+v)", *p)
+}
+
+func (p *JMXServiceInvokeOperationArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceInvokeOperationArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceInvokeOperationArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceInvokeOperationResult struct {
+	Success []*AttributeResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceInvokeOperationResult() *JMXServiceInvokeOperationResult {
+	return &JMXServiceInvokeOperationResult{}
+}
+
+var JMXServiceInvokeOperationResult_Success_DEFAULT []*AttributeResponse
+
+
+func (p *JMXServiceInvokeOperationResult) GetSuccess() []*AttributeResponse {
+	return p.Success
+}
+
+var JMXServiceInvokeOperationResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceInvokeOperationResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceInvokeOperationResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceInvokeOperationResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceInvokeOperationResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceInvokeOperationResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceInvokeOperationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceInvokeOperationResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceInvokeOperationResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceInvokeOperationResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AttributeResponse, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem182 := &AttributeResponse{}
+		if err := _elem182.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem182), err)
+		}
+		p.Success = append(p.Success, _elem182)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "invokeOperation_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceInvokeOperationResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceInvokeOperationResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceInvokeOperationResult(%+v)", *p)
+}
+
+func (p *JMXServiceInvokeOperationResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceInvokeOperationResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceInvokeOperationResult)(nil)
+
+type JMXServiceGetInternalStatsArgs struct {
+}
+
+func NewJMXServiceGetInternalStatsArgs() *JMXServiceGetInternalStatsArgs {
+	return &JMXServiceGetInternalStatsArgs{}
+}
+
+func (p *JMXServiceGetInternalStatsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalStatsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getInternalStats_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalStatsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetInternalStatsArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetInternalStatsArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetInternalStatsArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetInternalStatsArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - JmxErr
+// 
+type JMXServiceGetInternalStatsResult struct {
+	Success []*InternalStat `thrift:"success,0" db:"success" json:"success,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,1" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetInternalStatsResult() *JMXServiceGetInternalStatsResult {
+	return &JMXServiceGetInternalStatsResult{}
+}
+
+var JMXServiceGetInternalStatsResult_Success_DEFAULT []*InternalStat
+
+
+func (p *JMXServiceGetInternalStatsResult) GetSuccess() []*InternalStat {
+	return p.Success
+}
+
+var JMXServiceGetInternalStatsResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetInternalStatsResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetInternalStatsResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetInternalStatsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetInternalStatsResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetInternalStatsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalStatsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*InternalStat, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem183 := &InternalStat{}
+		if err := _elem183.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem183), err)
+		}
+		p.Success = append(p.Success, _elem183)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalStatsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalStatsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getInternalStats_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalStatsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetInternalStatsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetInternalStatsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetInternalStatsResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetInternalStatsResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetInternalStatsResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetInternalStatsResult)(nil)
+
+type JMXServiceGetInternalMetricsArgs struct {
+}
+
+func NewJMXServiceGetInternalMetricsArgs() *JMXServiceGetInternalMetricsArgs {
+	return &JMXServiceGetInternalMetricsArgs{}
+}
+
+func (p *JMXServiceGetInternalMetricsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalMetricsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getInternalMetrics_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalMetricsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetInternalMetricsArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetInternalMetricsArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetInternalMetricsArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetInternalMetricsArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - JmxErr
+// 
+type JMXServiceGetInternalMetricsResult struct {
+	Success *InternalMetrics `thrift:"success,0" db:"success" json:"success,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,1" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetInternalMetricsResult() *JMXServiceGetInternalMetricsResult {
+	return &JMXServiceGetInternalMetricsResult{}
+}
+
+var JMXServiceGetInternalMetricsResult_Success_DEFAULT *InternalMetrics
+
+func (p *JMXServiceGetInternalMetricsResult) GetSuccess() *InternalMetrics {
+	if !p.IsSetSuccess() {
+		return JMXServiceGetInternalMetricsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var JMXServiceGetInternalMetricsResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetInternalMetricsResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetInternalMetricsResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetInternalMetricsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetInternalMetricsResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetInternalMetricsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalMetricsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &InternalMetrics{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalMetricsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalMetricsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getInternalMetrics_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetInternalMetricsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetInternalMetricsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetInternalMetricsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetInternalMetricsResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetInternalMetricsResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetInternalMetricsResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetInternalMetricsResult)(nil)
+
+type JMXServiceGetTruncationsArgs struct {
+}
+
+func NewJMXServiceGetTruncationsArgs() *JMXServiceGetTruncationsArgs {
+	return &JMXServiceGetTruncationsArgs{}
+}
+
+func (p *JMXServiceGetTruncationsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetTruncationsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getTruncations_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetTruncationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetTruncationsArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetTruncationsArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetTruncationsArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetTruncationsArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - JmxErr
+// 
+type JMXServiceGetTruncationsResult struct {
+	Success []*Truncation `thrift:"success,0" db:"success" json:"success,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,1" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetTruncationsResult() *JMXServiceGetTruncationsResult {
+	return &JMXServiceGetTruncationsResult{}
+}
+
+var JMXServiceGetTruncationsResult_Success_DEFAULT []*Truncation
+
+
+func (p *JMXServiceGetTruncationsResult) GetSuccess() []*Truncation {
+	return p.Success
+}
+
+var JMXServiceGetTruncationsResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetTruncationsResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetTruncationsResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetTruncationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetTruncationsResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetTruncationsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetTruncationsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Truncation, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem184 := &Truncation{}
+		if err := _elem184.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem184), err)
+		}
+		p.Success = append(p.Success, _elem184)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetTruncationsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetTruncationsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getTruncations_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetTruncationsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetTruncationsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetTruncationsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetTruncationsResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetTruncationsResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetTruncationsResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetTruncationsResult)(nil)
+
+type JMXServiceGetDomainsArgs struct {
+}
+
+func NewJMXServiceGetDomainsArgs() *JMXServiceGetDomainsArgs {
+	return &JMXServiceGetDomainsArgs{}
+}
+
+func (p *JMXServiceGetDomainsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getDomains_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetDomainsArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetDomainsArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetDomainsArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetDomainsArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceGetDomainsResult struct {
+	Success []string `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetDomainsResult() *JMXServiceGetDomainsResult {
+	return &JMXServiceGetDomainsResult{}
+}
+
+var JMXServiceGetDomainsResult_Success_DEFAULT []string
+
+
+func (p *JMXServiceGetDomainsResult) GetSuccess() []string {
+	return p.Success
+}
+
+var JMXServiceGetDomainsResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceGetDomainsResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceGetDomainsResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceGetDomainsResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetDomainsResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetDomainsResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetDomainsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetDomainsResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceGetDomainsResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetDomainsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		var _elem185 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem185 = v
+		}
+		p.Success = append(p.Success, _elem185)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getDomains_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetDomainsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := oprot.WriteString(ctx, string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetDomainsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetDomainsResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetDomainsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetDomainsResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetDomainsResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetDomainsResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetDomainsResult)(nil)
+
+// Attributes:
+//  - MBeanNamePattern
+// 
+type JMXServiceGetMBeanCountArgs struct {
+	MBeanNamePattern string `thrift:"mBeanNamePattern,1" db:"mBeanNamePattern" json:"mBeanNamePattern"`
+}
+
+func NewJMXServiceGetMBeanCountArgs() *JMXServiceGetMBeanCountArgs {
+	return &JMXServiceGetMBeanCountArgs{}
+}
+
+
+
+func (p *JMXServiceGetMBeanCountArgs) GetMBeanNamePattern() string {
+	return p.MBeanNamePattern
+}
+
+func (p *JMXServiceGetMBeanCountArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MBeanNamePattern = v
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanCount_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "mBeanNamePattern", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanNamePattern: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.MBeanNamePattern)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.mBeanNamePattern (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanNamePattern: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanCountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanCountArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanCountArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanCountArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanCountArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceGetMBeanCountResult struct {
+	Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetMBeanCountResult() *JMXServiceGetMBeanCountResult {
+	return &JMXServiceGetMBeanCountResult{}
+}
+
+var JMXServiceGetMBeanCountResult_Success_DEFAULT int64
+
+func (p *JMXServiceGetMBeanCountResult) GetSuccess() int64 {
+	if !p.IsSetSuccess() {
+		return JMXServiceGetMBeanCountResult_Success_DEFAULT
+	}
+	return *p.Success
+}
+
+var JMXServiceGetMBeanCountResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceGetMBeanCountResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceGetMBeanCountResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceGetMBeanCountResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetMBeanCountResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetMBeanCountResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetMBeanCountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 0: ", err)
+	} else {
+		p.Success = &v
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getMBeanCount_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetMBeanCountResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanCountResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanCountResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetMBeanCountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetMBeanCountResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetMBeanCountResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetMBeanCountResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetMBeanCountResult)(nil)
+
+type JMXServiceGetServerInfoArgs struct {
+}
+
+func NewJMXServiceGetServerInfoArgs() *JMXServiceGetServerInfoArgs {
+	return &JMXServiceGetServerInfoArgs{}
+}
+
+func (p *JMXServiceGetServerInfoArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getServerInfo_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetServerInfoArgs(%+v)", *p)
+}
+
+func (p *JMXServiceGetServerInfoArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetServerInfoArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetServerInfoArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceGetServerInfoResult struct {
+	Success *ServerInfo `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceGetServerInfoResult() *JMXServiceGetServerInfoResult {
+	return &JMXServiceGetServerInfoResult{}
+}
+
+var JMXServiceGetServerInfoResult_Success_DEFAULT *ServerInfo
+
+func (p *JMXServiceGetServerInfoResult) GetSuccess() *ServerInfo {
+	if !p.IsSetSuccess() {
+		return JMXServiceGetServerInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var JMXServiceGetServerInfoResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceGetServerInfoResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceGetServerInfoResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceGetServerInfoResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceGetServerInfoResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceGetServerInfoResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceGetServerInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceGetServerInfoResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceGetServerInfoResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceGetServerInfoResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &ServerInfo{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getServerInfo_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceGetServerInfoResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetServerInfoResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetServerInfoResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceGetServerInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceGetServerInfoResult(%+v)", *p)
+}
+
+func (p *JMXServiceGetServerInfoResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceGetServerInfoResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceGetServerInfoResult)(nil)
+
+// Attributes:
+//  - Options
+// 
+type JMXServiceThreadDumpArgs struct {
+	Options *ThreadDumpOptions `thrift:"options,1" db:"options" json:"options"`
+}
+
+func NewJMXServiceThreadDumpArgs() *JMXServiceThreadDumpArgs {
+	return &JMXServiceThreadDumpArgs{}
+}
+
+var JMXServiceThreadDumpArgs_Options_DEFAULT *ThreadDumpOptions
+
+func (p *JMXServiceThreadDumpArgs) GetOptions() *ThreadDumpOptions {
+	if !p.IsSetOptions() {
+		return JMXServiceThreadDumpArgs_Options_DEFAULT
+	}
+	return p.Options
+}
+
+func (p *JMXServiceThreadDumpArgs) IsSetOptions() bool {
+	return p.Options != nil
+}
+
+func (p *JMXServiceThreadDumpArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Options = &ThreadDumpOptions{}
+	if err := p.Options.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Options), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "threadDump_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "options", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:options: ", p), err)
+	}
+	if err := p.Options.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Options), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:options: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceThreadDumpArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceThreadDumpArgs(%+v)", *p)
+}
+
+func (p *JMXServiceThreadDumpArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceThreadDumpArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceThreadDumpArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceThreadDumpResult struct {
+	Success []*ThreadInfo `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceThreadDumpResult() *JMXServiceThreadDumpResult {
+	return &JMXServiceThreadDumpResult{}
+}
+
+var JMXServiceThreadDumpResult_Success_DEFAULT []*ThreadInfo
+
+
+func (p *JMXServiceThreadDumpResult) GetSuccess() []*ThreadInfo {
+	return p.Success
+}
+
+var JMXServiceThreadDumpResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceThreadDumpResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceThreadDumpResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceThreadDumpResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceThreadDumpResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceThreadDumpResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceThreadDumpResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceThreadDumpResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceThreadDumpResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceThreadDumpResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ThreadInfo, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem186 := &ThreadInfo{}
+		if err := _elem186.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem186), err)
+		}
+		p.Success = append(p.Success, _elem186)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "threadDump_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceThreadDumpResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceThreadDumpResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceThreadDumpResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceThreadDumpResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceThreadDumpResult(%+v)", *p)
+}
+
+func (p *JMXServiceThreadDumpResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceThreadDumpResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceThreadDumpResult)(nil)
+
+type JMXServiceFindDeadlocksArgs struct {
+}
+
+func NewJMXServiceFindDeadlocksArgs() *JMXServiceFindDeadlocksArgs {
+	return &JMXServiceFindDeadlocksArgs{}
+}
+
+func (p *JMXServiceFindDeadlocksArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "findDeadlocks_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceFindDeadlocksArgs(%+v)", *p)
+}
+
+func (p *JMXServiceFindDeadlocksArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceFindDeadlocksArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceFindDeadlocksArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceFindDeadlocksResult struct {
+	Success []*ThreadInfo `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceFindDeadlocksResult() *JMXServiceFindDeadlocksResult {
+	return &JMXServiceFindDeadlocksResult{}
+}
+
+var JMXServiceFindDeadlocksResult_Success_DEFAULT []*ThreadInfo
+
+
+func (p *JMXServiceFindDeadlocksResult) GetSuccess() []*ThreadInfo {
+	return p.Success
+}
+
+var JMXServiceFindDeadlocksResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceFindDeadlocksResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceFindDeadlocksResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceFindDeadlocksResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceFindDeadlocksResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceFindDeadlocksResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceFindDeadlocksResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ThreadInfo, 0, size)
+	p.Success = tSlice
+	for i := 0; i < size; i++ {
+		_elem187 := &ThreadInfo{}
+		if err := _elem187.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem187), err)
+		}
+		p.Success = append(p.Success, _elem187)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "findDeadlocks_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceFindDeadlocksResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Success {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceFindDeadlocksResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceFindDeadlocksResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceFindDeadlocksResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceFindDeadlocksResult(%+v)", *p)
+}
+
+func (p *JMXServiceFindDeadlocksResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceFindDeadlocksResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceFindDeadlocksResult)(nil)
+
+// Attributes:
+//  - Command
+//  - Arguments
+// 
+type JMXServiceDiagnosticCommandArgs struct {
+	Command string `thrift:"command,1" db:"command" json:"command"`
+	Arguments []string `thrift:"arguments,2" db:"arguments" json:"arguments"`
+}
+
+func NewJMXServiceDiagnosticCommandArgs() *JMXServiceDiagnosticCommandArgs {
+	return &JMXServiceDiagnosticCommandArgs{}
+}
+
+
+
+func (p *JMXServiceDiagnosticCommandArgs) GetCommand() string {
+	return p.Command
+}
+
+
+
+func (p *JMXServiceDiagnosticCommandArgs) GetArguments() []string {
+	return p.Arguments
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Command = v
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Arguments = tSlice
+	for i := 0; i < size; i++ {
+		var _elem188 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem188 = v
+		}
+		p.Arguments = append(p.Arguments, _elem188)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "diagnosticCommand_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "command", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:command: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Command)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.command (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:command: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "arguments", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:arguments: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Arguments)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Arguments {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:arguments: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceDiagnosticCommandArgs(%+v)", *p)
+}
+
+func (p *JMXServiceDiagnosticCommandArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceDiagnosticCommandArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceDiagnosticCommandArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceDiagnosticCommandResult struct {
+	Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceDiagnosticCommandResult() *JMXServiceDiagnosticCommandResult {
+	return &JMXServiceDiagnosticCommandResult{}
+}
+
+var JMXServiceDiagnosticCommandResult_Success_DEFAULT string
+
+func (p *JMXServiceDiagnosticCommandResult) GetSuccess() string {
+	if !p.IsSetSuccess() {
+		return JMXServiceDiagnosticCommandResult_Success_DEFAULT
+	}
+	return *p.Success
+}
+
+var JMXServiceDiagnosticCommandResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceDiagnosticCommandResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceDiagnosticCommandResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceDiagnosticCommandResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceDiagnosticCommandResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceDiagnosticCommandResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceDiagnosticCommandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 0: ", err)
+	} else {
+		p.Success = &v
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "diagnosticCommand_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceDiagnosticCommandResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRING, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Success)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceDiagnosticCommandResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceDiagnosticCommandResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
 	}
+	return err
+}
 
-	result := JMXServiceGetMBeanAttributeNamesResult{}
-	if retval, err2 := p.handler.GetMBeanAttributeNames(ctx, args.MBeanName); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXConnectionError:
-			result.ConnErr = v
-		case *JMXError:
-			result.JmxErr = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
+func (p *JMXServiceDiagnosticCommandResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceDiagnosticCommandResult(%+v)", *p)
+}
+
+func (p *JMXServiceDiagnosticCommandResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceDiagnosticCommandResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceDiagnosticCommandResult)(nil)
+
+// Attributes:
+//  - Settings
+// 
+type JMXServiceStartRecordingArgs struct {
+	Settings *RecordingSettings `thrift:"settings,1" db:"settings" json:"settings"`
+}
+
+func NewJMXServiceStartRecordingArgs() *JMXServiceStartRecordingArgs {
+	return &JMXServiceStartRecordingArgs{}
+}
+
+var JMXServiceStartRecordingArgs_Settings_DEFAULT *RecordingSettings
+
+func (p *JMXServiceStartRecordingArgs) GetSettings() *RecordingSettings {
+	if !p.IsSetSettings() {
+		return JMXServiceStartRecordingArgs_Settings_DEFAULT
+	}
+	return p.Settings
+}
+
+func (p *JMXServiceStartRecordingArgs) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *JMXServiceStartRecordingArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-			_exc37 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMBeanAttributeNames: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributeNames", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err36 = thrift.WrapTException(err2)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
-			if err2 := _exc37.Write(ctx, oprot); _write_err36 == nil && err2 != nil {
-				_write_err36 = thrift.WrapTException(err2)
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Settings = &RecordingSettings{}
+	if err := p.Settings.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Settings), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "startRecording_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "settings", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:settings: ", p), err)
+	}
+	if err := p.Settings.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Settings), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:settings: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceStartRecordingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceStartRecordingArgs(%+v)", *p)
+}
+
+func (p *JMXServiceStartRecordingArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceStartRecordingArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
+
+var _ slog.LogValuer = (*JMXServiceStartRecordingArgs)(nil)
+
+// Attributes:
+//  - Success
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceStartRecordingResult struct {
+	Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceStartRecordingResult() *JMXServiceStartRecordingResult {
+	return &JMXServiceStartRecordingResult{}
+}
+
+var JMXServiceStartRecordingResult_Success_DEFAULT int64
+
+func (p *JMXServiceStartRecordingResult) GetSuccess() int64 {
+	if !p.IsSetSuccess() {
+		return JMXServiceStartRecordingResult_Success_DEFAULT
+	}
+	return *p.Success
+}
+
+var JMXServiceStartRecordingResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceStartRecordingResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceStartRecordingResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceStartRecordingResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceStartRecordingResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceStartRecordingResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceStartRecordingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceStartRecordingResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceStartRecordingResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceStartRecordingResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err36 == nil && err2 != nil {
-				_write_err36 = thrift.WrapTException(err2)
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if err2 := oprot.Flush(ctx); _write_err36 == nil && err2 != nil {
-				_write_err36 = thrift.WrapTException(err2)
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if _write_err36 != nil {
-				return false, thrift.WrapTException(_write_err36)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
-			return true, err
 		}
-	} else {
-		result.Success = retval
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 0: ", err)
+	} else {
+		p.Success = &v
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "startRecording_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStartRecordingResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
 	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributeNames", thrift.REPLY, seqId); err2 != nil {
-		_write_err36 = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *JMXServiceStartRecordingResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
 	}
-	if err2 := result.Write(ctx, oprot); _write_err36 == nil && err2 != nil {
-		_write_err36 = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *JMXServiceStartRecordingResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
 	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err36 == nil && err2 != nil {
-		_write_err36 = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *JMXServiceStartRecordingResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 := oprot.Flush(ctx); _write_err36 == nil && err2 != nil {
-		_write_err36 = thrift.WrapTException(err2)
+	return fmt.Sprintf("JMXServiceStartRecordingResult(%+v)", *p)
+}
+
+func (p *JMXServiceStartRecordingResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
 	}
-	if _write_err36 != nil {
-		return false, thrift.WrapTException(_write_err36)
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceStartRecordingResult",
+		Value: p,
 	}
-	return true, err
+	return slog.AnyValue(v)
 }
 
-type jMXServiceProcessorGetMBeanAttributes struct {
-	handler JMXService
+var _ slog.LogValuer = (*JMXServiceStartRecordingResult)(nil)
+
+// Attributes:
+//  - RecordingId
+// 
+type JMXServiceStopRecordingArgs struct {
+	RecordingId int64 `thrift:"recordingId,1" db:"recordingId" json:"recordingId"`
 }
 
-func (p *jMXServiceProcessorGetMBeanAttributes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err38 error
-	args := JMXServiceGetMBeanAttributesArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "getMBeanAttributes", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
-	}
-	iprot.ReadMessageEnd(ctx)
+func NewJMXServiceStopRecordingArgs() *JMXServiceStopRecordingArgs {
+	return &JMXServiceStopRecordingArgs{}
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
+
+
+func (p *JMXServiceStopRecordingArgs) GetRecordingId() int64 {
+	return p.RecordingId
+}
+
+func (p *JMXServiceStopRecordingArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	result := JMXServiceGetMBeanAttributesResult{}
-	if retval, err2 := p.handler.GetMBeanAttributes(ctx, args.MBeanName, args.Attributes); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXConnectionError:
-			result.ConnErr = v
-		case *JMXError:
-			result.JmxErr = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-			_exc39 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMBeanAttributes: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributes", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err38 = thrift.WrapTException(err2)
-			}
-			if err2 := _exc39.Write(ctx, oprot); _write_err38 == nil && err2 != nil {
-				_write_err38 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err38 == nil && err2 != nil {
-				_write_err38 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.Flush(ctx); _write_err38 == nil && err2 != nil {
-				_write_err38 = thrift.WrapTException(err2)
-			}
-			if _write_err38 != nil {
-				return false, thrift.WrapTException(_write_err38)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
-			return true, err
 		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceStopRecordingArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		result.Success = retval
+		p.RecordingId = v
 	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "getMBeanAttributes", thrift.REPLY, seqId); err2 != nil {
-		_write_err38 = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *JMXServiceStopRecordingArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "stopRecording_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err2 := result.Write(ctx, oprot); _write_err38 == nil && err2 != nil {
-		_write_err38 = thrift.WrapTException(err2)
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err38 == nil && err2 != nil {
-		_write_err38 = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *JMXServiceStopRecordingArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "recordingId", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:recordingId: ", p), err)
 	}
-	if err2 := oprot.Flush(ctx); _write_err38 == nil && err2 != nil {
-		_write_err38 = thrift.WrapTException(err2)
+	if err := oprot.WriteI64(ctx, int64(p.RecordingId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.recordingId (1) field write error: ", p), err)
 	}
-	if _write_err38 != nil {
-		return false, thrift.WrapTException(_write_err38)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:recordingId: ", p), err)
 	}
-	return true, err
+	return err
 }
 
-type jMXServiceProcessorQueryMBeanAttributes struct {
-	handler JMXService
+func (p *JMXServiceStopRecordingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JMXServiceStopRecordingArgs(%+v)", *p)
 }
 
-func (p *jMXServiceProcessorQueryMBeanAttributes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err40 error
-	args := JMXServiceQueryMBeanAttributesArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "queryMBeanAttributes", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *JMXServiceStopRecordingArgs) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
 	}
-	iprot.ReadMessageEnd(ctx)
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceStopRecordingArgs",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
+var _ slog.LogValuer = (*JMXServiceStopRecordingArgs)(nil)
+
+// Attributes:
+//  - ConnErr
+//  - JmxErr
+// 
+type JMXServiceStopRecordingResult struct {
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
+}
+
+func NewJMXServiceStopRecordingResult() *JMXServiceStopRecordingResult {
+	return &JMXServiceStopRecordingResult{}
+}
+
+var JMXServiceStopRecordingResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceStopRecordingResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceStopRecordingResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
+}
+
+var JMXServiceStopRecordingResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceStopRecordingResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceStopRecordingResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceStopRecordingResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceStopRecordingResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceStopRecordingResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
 				}
-			}
-		}(tickerCtx, cancel)
-	}
-
-	result := JMXServiceQueryMBeanAttributesResult{}
-	if retval, err2 := p.handler.QueryMBeanAttributes(ctx, args.MBeanNamePattern, args.Attributes); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXConnectionError:
-			result.ConnErr = v
-		case *JMXError:
-			result.JmxErr = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-			_exc41 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing queryMBeanAttributes: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanAttributes", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err40 = thrift.WrapTException(err2)
-			}
-			if err2 := _exc41.Write(ctx, oprot); _write_err40 == nil && err2 != nil {
-				_write_err40 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err40 == nil && err2 != nil {
-				_write_err40 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.Flush(ctx); _write_err40 == nil && err2 != nil {
-				_write_err40 = thrift.WrapTException(err2)
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-			if _write_err40 != nil {
-				return false, thrift.WrapTException(_write_err40)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
-			return true, err
 		}
-	} else {
-		result.Success = retval
-	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "queryMBeanAttributes", thrift.REPLY, seqId); err2 != nil {
-		_write_err40 = thrift.WrapTException(err2)
-	}
-	if err2 := result.Write(ctx, oprot); _write_err40 == nil && err2 != nil {
-		_write_err40 = thrift.WrapTException(err2)
-	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err40 == nil && err2 != nil {
-		_write_err40 = thrift.WrapTException(err2)
-	}
-	if err2 := oprot.Flush(ctx); _write_err40 == nil && err2 != nil {
-		_write_err40 = thrift.WrapTException(err2)
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	if _write_err40 != nil {
-		return false, thrift.WrapTException(_write_err40)
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return true, err
+	return nil
 }
 
-type jMXServiceProcessorGetInternalStats struct {
-	handler JMXService
+func (p *JMXServiceStopRecordingResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
 }
 
-func (p *jMXServiceProcessorGetInternalStats) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	var _write_err42 error
-	args := JMXServiceGetInternalStatsArgs{}
-	if err2 := args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "getInternalStats", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *JMXServiceStopRecordingResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
 	}
-	iprot.ReadMessageEnd(ctx)
+	return nil
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelCauseFunc
-		ctx, cancel = context.WithCancelCause(ctx)
-		defer cancel(nil)
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelCauseFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel(thrift.ErrAbandonRequest)
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
+func (p *JMXServiceStopRecordingResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "stopRecording_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-
-	result := JMXServiceGetInternalStatsResult{}
-	if retval, err2 := p.handler.GetInternalStats(ctx); err2 != nil {
-		tickerCancel()
-		err = thrift.WrapTException(err2)
-		switch v := err2.(type) {
-		case *JMXError:
-			result.JmxErr = v
-		default:
-			if errors.Is(err2, thrift.ErrAbandonRequest) {
-				return false, thrift.WrapTException(err2)
-			}
-			if errors.Is(err2, context.Canceled) {
-				if err := context.Cause(ctx); errors.Is(err, thrift.ErrAbandonRequest) {
-					return false, thrift.WrapTException(err)
-				}
-			}
-			_exc43 := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getInternalStats: " + err2.Error())
-			if err2 := oprot.WriteMessageBegin(ctx, "getInternalStats", thrift.EXCEPTION, seqId); err2 != nil {
-				_write_err42 = thrift.WrapTException(err2)
-			}
-			if err2 := _exc43.Write(ctx, oprot); _write_err42 == nil && err2 != nil {
-				_write_err42 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.WriteMessageEnd(ctx); _write_err42 == nil && err2 != nil {
-				_write_err42 = thrift.WrapTException(err2)
-			}
-			if err2 := oprot.Flush(ctx); _write_err42 == nil && err2 != nil {
-				_write_err42 = thrift.WrapTException(err2)
-			}
-			if _write_err42 != nil {
-				return false, thrift.WrapTException(_write_err42)
-			}
-			return true, err
-		}
-	} else {
-		result.Success = retval
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
-	tickerCancel()
-	if err2 := oprot.WriteMessageBegin(ctx, "getInternalStats", thrift.REPLY, seqId); err2 != nil {
-		_write_err42 = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 := result.Write(ctx, oprot); _write_err42 == nil && err2 != nil {
-		_write_err42 = thrift.WrapTException(err2)
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 := oprot.WriteMessageEnd(ctx); _write_err42 == nil && err2 != nil {
-		_write_err42 = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *JMXServiceStopRecordingResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
 	}
-	if err2 := oprot.Flush(ctx); _write_err42 == nil && err2 != nil {
-		_write_err42 = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *JMXServiceStopRecordingResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
 	}
-	if _write_err42 != nil {
-		return false, thrift.WrapTException(_write_err42)
+	return err
+}
+
+func (p *JMXServiceStopRecordingResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JMXServiceStopRecordingResult(%+v)", *p)
 }
 
+func (p *JMXServiceStopRecordingResult) LogValue() slog.Value {
+	if p == nil {
+		return slog.AnyValue(nil)
+	}
+	v := thrift.SlogTStructWrapper{
+		Type: "*nrprotocol.JMXServiceStopRecordingResult",
+		Value: p,
+	}
+	return slog.AnyValue(v)
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+var _ slog.LogValuer = (*JMXServiceStopRecordingResult)(nil)
 
 // Attributes:
-//  - Config
+//  - RecordingId
 // 
-type JMXServiceConnectArgs struct {
-	Config *JMXConfig `thrift:"config,1" db:"config" json:"config"`
+type JMXServiceCloseRecordingArgs struct {
+	RecordingId int64 `thrift:"recordingId,1" db:"recordingId" json:"recordingId"`
 }
 
-func NewJMXServiceConnectArgs() *JMXServiceConnectArgs {
-	return &JMXServiceConnectArgs{}
+func NewJMXServiceCloseRecordingArgs() *JMXServiceCloseRecordingArgs {
+	return &JMXServiceCloseRecordingArgs{}
 }
 
-var JMXServiceConnectArgs_Config_DEFAULT *JMXConfig
 
-func (p *JMXServiceConnectArgs) GetConfig() *JMXConfig {
-	if !p.IsSetConfig() {
-		return JMXServiceConnectArgs_Config_DEFAULT
-	}
-	return p.Config
-}
 
-func (p *JMXServiceConnectArgs) IsSetConfig() bool {
-	return p.Config != nil
+func (p *JMXServiceCloseRecordingArgs) GetRecordingId() int64 {
+	return p.RecordingId
 }
 
-func (p *JMXServiceConnectArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3185,7 +17851,7 @@ func (p *JMXServiceConnectArgs) Read(ctx context.Context, iprot thrift.TProtocol
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -3209,16 +17875,17 @@ func (p *JMXServiceConnectArgs) Read(ctx context.Context, iprot thrift.TProtocol
 	return nil
 }
 
-func (p *JMXServiceConnectArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Config = &JMXConfig{}
-	if err := p.Config.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Config), err)
+func (p *JMXServiceCloseRecordingArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.RecordingId = v
 	}
 	return nil
 }
 
-func (p *JMXServiceConnectArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "connect_args"); err != nil {
+func (p *JMXServiceCloseRecordingArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "closeRecording_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -3233,79 +17900,79 @@ func (p *JMXServiceConnectArgs) Write(ctx context.Context, oprot thrift.TProtoco
 	return nil
 }
 
-func (p *JMXServiceConnectArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "config", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:config: ", p), err)
+func (p *JMXServiceCloseRecordingArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "recordingId", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:recordingId: ", p), err)
 	}
-	if err := p.Config.Write(ctx, oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Config), err)
+	if err := oprot.WriteI64(ctx, int64(p.RecordingId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.recordingId (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:config: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:recordingId: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXServiceConnectArgs) String() string {
+func (p *JMXServiceCloseRecordingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceConnectArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceCloseRecordingArgs(%+v)", *p)
 }
 
-func (p *JMXServiceConnectArgs) LogValue() slog.Value {
+func (p *JMXServiceCloseRecordingArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceConnectArgs",
+		Type: "*nrprotocol.JMXServiceCloseRecordingArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceConnectArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceCloseRecordingArgs)(nil)
 
 // Attributes:
 //  - ConnErr
 //  - JmxErr
 // 
-type JMXServiceConnectResult struct {
+type JMXServiceCloseRecordingResult struct {
 	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
 	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceConnectResult() *JMXServiceConnectResult {
-	return &JMXServiceConnectResult{}
+func NewJMXServiceCloseRecordingResult() *JMXServiceCloseRecordingResult {
+	return &JMXServiceCloseRecordingResult{}
 }
 
-var JMXServiceConnectResult_ConnErr_DEFAULT *JMXConnectionError
+var JMXServiceCloseRecordingResult_ConnErr_DEFAULT *JMXConnectionError
 
-func (p *JMXServiceConnectResult) GetConnErr() *JMXConnectionError {
+func (p *JMXServiceCloseRecordingResult) GetConnErr() *JMXConnectionError {
 	if !p.IsSetConnErr() {
-		return JMXServiceConnectResult_ConnErr_DEFAULT
+		return JMXServiceCloseRecordingResult_ConnErr_DEFAULT
 	}
 	return p.ConnErr
 }
 
-var JMXServiceConnectResult_JmxErr_DEFAULT *JMXError
+var JMXServiceCloseRecordingResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceConnectResult) GetJmxErr() *JMXError {
+func (p *JMXServiceCloseRecordingResult) GetJmxErr() *JMXError {
 	if !p.IsSetJmxErr() {
-		return JMXServiceConnectResult_JmxErr_DEFAULT
+		return JMXServiceCloseRecordingResult_JmxErr_DEFAULT
 	}
 	return p.JmxErr
 }
 
-func (p *JMXServiceConnectResult) IsSetConnErr() bool {
+func (p *JMXServiceCloseRecordingResult) IsSetConnErr() bool {
 	return p.ConnErr != nil
 }
 
-func (p *JMXServiceConnectResult) IsSetJmxErr() bool {
+func (p *JMXServiceCloseRecordingResult) IsSetJmxErr() bool {
 	return p.JmxErr != nil
 }
 
-func (p *JMXServiceConnectResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3355,7 +18022,7 @@ func (p *JMXServiceConnectResult) Read(ctx context.Context, iprot thrift.TProtoc
 	return nil
 }
 
-func (p *JMXServiceConnectResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	p.ConnErr = &JMXConnectionError{}
 	if err := p.ConnErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
@@ -3363,7 +18030,7 @@ func (p *JMXServiceConnectResult) ReadField1(ctx context.Context, iprot thrift.T
 	return nil
 }
 
-func (p *JMXServiceConnectResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	p.JmxErr = &JMXError{}
 	if err := p.JmxErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
@@ -3371,8 +18038,8 @@ func (p *JMXServiceConnectResult) ReadField2(ctx context.Context, iprot thrift.T
 	return nil
 }
 
-func (p *JMXServiceConnectResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "connect_result"); err != nil {
+func (p *JMXServiceCloseRecordingResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "closeRecording_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -3388,7 +18055,7 @@ func (p *JMXServiceConnectResult) Write(ctx context.Context, oprot thrift.TProto
 	return nil
 }
 
-func (p *JMXServiceConnectResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceCloseRecordingResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetConnErr() {
 		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
@@ -3403,7 +18070,7 @@ func (p *JMXServiceConnectResult) writeField1(ctx context.Context, oprot thrift.
 	return err
 }
 
-func (p *JMXServiceConnectResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceCloseRecordingResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetJmxErr() {
 		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
@@ -3418,34 +18085,44 @@ func (p *JMXServiceConnectResult) writeField2(ctx context.Context, oprot thrift.
 	return err
 }
 
-func (p *JMXServiceConnectResult) String() string {
+func (p *JMXServiceCloseRecordingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceConnectResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceCloseRecordingResult(%+v)", *p)
 }
 
-func (p *JMXServiceConnectResult) LogValue() slog.Value {
+func (p *JMXServiceCloseRecordingResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceConnectResult",
+		Type: "*nrprotocol.JMXServiceCloseRecordingResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceConnectResult)(nil)
+var _ slog.LogValuer = (*JMXServiceCloseRecordingResult)(nil)
 
-type JMXServiceDisconnectArgs struct {
+// Attributes:
+//  - RecordingId
+// 
+type JMXServiceOpenRecordingStreamArgs struct {
+	RecordingId int64 `thrift:"recordingId,1" db:"recordingId" json:"recordingId"`
 }
 
-func NewJMXServiceDisconnectArgs() *JMXServiceDisconnectArgs {
-	return &JMXServiceDisconnectArgs{}
+func NewJMXServiceOpenRecordingStreamArgs() *JMXServiceOpenRecordingStreamArgs {
+	return &JMXServiceOpenRecordingStreamArgs{}
+}
+
+
+
+func (p *JMXServiceOpenRecordingStreamArgs) GetRecordingId() int64 {
+	return p.RecordingId
 }
 
-func (p *JMXServiceDisconnectArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceOpenRecordingStreamArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3459,8 +18136,21 @@ func (p *JMXServiceDisconnectArgs) Read(ctx context.Context, iprot thrift.TProto
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
 			return err
@@ -3472,11 +18162,21 @@ func (p *JMXServiceDisconnectArgs) Read(ctx context.Context, iprot thrift.TProto
 	return nil
 }
 
-func (p *JMXServiceDisconnectArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "disconnect_args"); err != nil {
+func (p *JMXServiceOpenRecordingStreamArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.RecordingId = v
+	}
+	return nil
+}
+
+func (p *JMXServiceOpenRecordingStreamArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "openRecordingStream_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3487,51 +18187,94 @@ func (p *JMXServiceDisconnectArgs) Write(ctx context.Context, oprot thrift.TProt
 	return nil
 }
 
-func (p *JMXServiceDisconnectArgs) String() string {
+func (p *JMXServiceOpenRecordingStreamArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "recordingId", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:recordingId: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.RecordingId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.recordingId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:recordingId: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceOpenRecordingStreamArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceDisconnectArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceOpenRecordingStreamArgs(%+v)", *p)
 }
 
-func (p *JMXServiceDisconnectArgs) LogValue() slog.Value {
+func (p *JMXServiceOpenRecordingStreamArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceDisconnectArgs",
+		Type: "*nrprotocol.JMXServiceOpenRecordingStreamArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceDisconnectArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceOpenRecordingStreamArgs)(nil)
 
 // Attributes:
-//  - Err
+//  - Success
+//  - ConnErr
+//  - JmxErr
 // 
-type JMXServiceDisconnectResult struct {
-	Err *JMXError `thrift:"err,1" db:"err" json:"err,omitempty"`
+type JMXServiceOpenRecordingStreamResult struct {
+	Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceDisconnectResult() *JMXServiceDisconnectResult {
-	return &JMXServiceDisconnectResult{}
+func NewJMXServiceOpenRecordingStreamResult() *JMXServiceOpenRecordingStreamResult {
+	return &JMXServiceOpenRecordingStreamResult{}
 }
 
-var JMXServiceDisconnectResult_Err_DEFAULT *JMXError
+var JMXServiceOpenRecordingStreamResult_Success_DEFAULT int64
 
-func (p *JMXServiceDisconnectResult) GetErr() *JMXError {
-	if !p.IsSetErr() {
-		return JMXServiceDisconnectResult_Err_DEFAULT
+func (p *JMXServiceOpenRecordingStreamResult) GetSuccess() int64 {
+	if !p.IsSetSuccess() {
+		return JMXServiceOpenRecordingStreamResult_Success_DEFAULT
 	}
-	return p.Err
+	return *p.Success
 }
 
-func (p *JMXServiceDisconnectResult) IsSetErr() bool {
-	return p.Err != nil
+var JMXServiceOpenRecordingStreamResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceOpenRecordingStreamResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceOpenRecordingStreamResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
 }
 
-func (p *JMXServiceDisconnectResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+var JMXServiceOpenRecordingStreamResult_JmxErr_DEFAULT *JMXError
+
+func (p *JMXServiceOpenRecordingStreamResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceOpenRecordingStreamResult_JmxErr_DEFAULT
+	}
+	return p.JmxErr
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3546,6 +18289,16 @@ func (p *JMXServiceDisconnectResult) Read(ctx context.Context, iprot thrift.TPro
 			break
 		}
 		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		case 1:
 			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
@@ -3556,6 +18309,16 @@ func (p *JMXServiceDisconnectResult) Read(ctx context.Context, iprot thrift.TPro
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -3571,20 +18334,39 @@ func (p *JMXServiceDisconnectResult) Read(ctx context.Context, iprot thrift.TPro
 	return nil
 }
 
-func (p *JMXServiceDisconnectResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Err = &JMXError{}
-	if err := p.Err.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+func (p *JMXServiceOpenRecordingStreamResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 0: ", err)
+	} else {
+		p.Success = &v
 	}
 	return nil
 }
 
-func (p *JMXServiceDisconnectResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "disconnect_result"); err != nil {
+func (p *JMXServiceOpenRecordingStreamResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "openRecordingStream_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil { return err }
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3595,49 +18377,89 @@ func (p *JMXServiceDisconnectResult) Write(ctx context.Context, oprot thrift.TPr
 	return nil
 }
 
-func (p *JMXServiceDisconnectResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin(ctx, "err", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+func (p *JMXServiceOpenRecordingStreamResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := p.Err.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JMXServiceDisconnectResult) String() string {
+func (p *JMXServiceOpenRecordingStreamResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
+		}
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceOpenRecordingStreamResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceDisconnectResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceOpenRecordingStreamResult(%+v)", *p)
 }
 
-func (p *JMXServiceDisconnectResult) LogValue() slog.Value {
+func (p *JMXServiceOpenRecordingStreamResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceDisconnectResult",
+		Type: "*nrprotocol.JMXServiceOpenRecordingStreamResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceDisconnectResult)(nil)
+var _ slog.LogValuer = (*JMXServiceOpenRecordingStreamResult)(nil)
 
-type JMXServiceGetClientVersionArgs struct {
+// Attributes:
+//  - StreamId
+// 
+type JMXServiceReadRecordingStreamArgs struct {
+	StreamId int64 `thrift:"streamId,1" db:"streamId" json:"streamId"`
 }
 
-func NewJMXServiceGetClientVersionArgs() *JMXServiceGetClientVersionArgs {
-	return &JMXServiceGetClientVersionArgs{}
+func NewJMXServiceReadRecordingStreamArgs() *JMXServiceReadRecordingStreamArgs {
+	return &JMXServiceReadRecordingStreamArgs{}
 }
 
-func (p *JMXServiceGetClientVersionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+
+func (p *JMXServiceReadRecordingStreamArgs) GetStreamId() int64 {
+	return p.StreamId
+}
+
+func (p *JMXServiceReadRecordingStreamArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3651,8 +18473,21 @@ func (p *JMXServiceGetClientVersionArgs) Read(ctx context.Context, iprot thrift.
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
 			return err
@@ -3664,11 +18499,21 @@ func (p *JMXServiceGetClientVersionArgs) Read(ctx context.Context, iprot thrift.
 	return nil
 }
 
-func (p *JMXServiceGetClientVersionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getClientVersion_args"); err != nil {
+func (p *JMXServiceReadRecordingStreamArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.StreamId = v
+	}
+	return nil
+}
+
+func (p *JMXServiceReadRecordingStreamArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "readRecordingStream_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3679,66 +18524,92 @@ func (p *JMXServiceGetClientVersionArgs) Write(ctx context.Context, oprot thrift
 	return nil
 }
 
-func (p *JMXServiceGetClientVersionArgs) String() string {
+func (p *JMXServiceReadRecordingStreamArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "streamId", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:streamId: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.StreamId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.streamId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:streamId: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceReadRecordingStreamArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetClientVersionArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceReadRecordingStreamArgs(%+v)", *p)
 }
 
-func (p *JMXServiceGetClientVersionArgs) LogValue() slog.Value {
+func (p *JMXServiceReadRecordingStreamArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetClientVersionArgs",
+		Type: "*nrprotocol.JMXServiceReadRecordingStreamArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetClientVersionArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceReadRecordingStreamArgs)(nil)
 
 // Attributes:
 //  - Success
-//  - Err
+//  - ConnErr
+//  - JmxErr
 // 
-type JMXServiceGetClientVersionResult struct {
-	Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err *JMXError `thrift:"err,1" db:"err" json:"err,omitempty"`
+type JMXServiceReadRecordingStreamResult struct {
+	Success []byte `thrift:"success,0" db:"success" json:"success,omitempty"`
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceGetClientVersionResult() *JMXServiceGetClientVersionResult {
-	return &JMXServiceGetClientVersionResult{}
+func NewJMXServiceReadRecordingStreamResult() *JMXServiceReadRecordingStreamResult {
+	return &JMXServiceReadRecordingStreamResult{}
 }
 
-var JMXServiceGetClientVersionResult_Success_DEFAULT string
+var JMXServiceReadRecordingStreamResult_Success_DEFAULT []byte
 
-func (p *JMXServiceGetClientVersionResult) GetSuccess() string {
-	if !p.IsSetSuccess() {
-		return JMXServiceGetClientVersionResult_Success_DEFAULT
+
+func (p *JMXServiceReadRecordingStreamResult) GetSuccess() []byte {
+	return p.Success
+}
+
+var JMXServiceReadRecordingStreamResult_ConnErr_DEFAULT *JMXConnectionError
+
+func (p *JMXServiceReadRecordingStreamResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceReadRecordingStreamResult_ConnErr_DEFAULT
 	}
-	return *p.Success
+	return p.ConnErr
 }
 
-var JMXServiceGetClientVersionResult_Err_DEFAULT *JMXError
+var JMXServiceReadRecordingStreamResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceGetClientVersionResult) GetErr() *JMXError {
-	if !p.IsSetErr() {
-		return JMXServiceGetClientVersionResult_Err_DEFAULT
+func (p *JMXServiceReadRecordingStreamResult) GetJmxErr() *JMXError {
+	if !p.IsSetJmxErr() {
+		return JMXServiceReadRecordingStreamResult_JmxErr_DEFAULT
 	}
-	return p.Err
+	return p.JmxErr
 }
 
-func (p *JMXServiceGetClientVersionResult) IsSetSuccess() bool {
+func (p *JMXServiceReadRecordingStreamResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JMXServiceGetClientVersionResult) IsSetErr() bool {
-	return p.Err != nil
+func (p *JMXServiceReadRecordingStreamResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
+}
+
+func (p *JMXServiceReadRecordingStreamResult) IsSetJmxErr() bool {
+	return p.JmxErr != nil
 }
 
-func (p *JMXServiceGetClientVersionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceReadRecordingStreamResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3773,6 +18644,16 @@ func (p *JMXServiceGetClientVersionResult) Read(ctx context.Context, iprot thrif
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -3788,30 +18669,39 @@ func (p *JMXServiceGetClientVersionResult) Read(ctx context.Context, iprot thrif
 	return nil
 }
 
-func (p *JMXServiceGetClientVersionResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *JMXServiceReadRecordingStreamResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 0: ", err)
 	} else {
-		p.Success = &v
+		p.Success = v
 	}
 	return nil
 }
 
-func (p *JMXServiceGetClientVersionResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Err = &JMXError{}
-	if err := p.Err.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+func (p *JMXServiceReadRecordingStreamResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
 	}
 	return nil
 }
 
-func (p *JMXServiceGetClientVersionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getClientVersion_result"); err != nil {
+func (p *JMXServiceReadRecordingStreamResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.JmxErr = &JMXError{}
+	if err := p.JmxErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
+	}
+	return nil
+}
+
+func (p *JMXServiceReadRecordingStreamResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "readRecordingStream_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField0(ctx, oprot); err != nil { return err }
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3822,12 +18712,12 @@ func (p *JMXServiceGetClientVersionResult) Write(ctx context.Context, oprot thri
 	return nil
 }
 
-func (p *JMXServiceGetClientVersionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceReadRecordingStreamResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRING, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := oprot.WriteString(ctx, string(*p.Success)); err != nil {
+		if err := oprot.WriteBinary(ctx, p.Success); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
@@ -3837,59 +18727,74 @@ func (p *JMXServiceGetClientVersionResult) writeField0(ctx context.Context, opro
 	return err
 }
 
-func (p *JMXServiceGetClientVersionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin(ctx, "err", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+func (p *JMXServiceReadRecordingStreamResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
 		}
-		if err := p.Err.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JMXServiceGetClientVersionResult) String() string {
+func (p *JMXServiceReadRecordingStreamResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJmxErr() {
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
+		}
+		if err := p.JmxErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JMXServiceReadRecordingStreamResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetClientVersionResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceReadRecordingStreamResult(%+v)", *p)
 }
 
-func (p *JMXServiceGetClientVersionResult) LogValue() slog.Value {
+func (p *JMXServiceReadRecordingStreamResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetClientVersionResult",
+		Type: "*nrprotocol.JMXServiceReadRecordingStreamResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetClientVersionResult)(nil)
+var _ slog.LogValuer = (*JMXServiceReadRecordingStreamResult)(nil)
 
 // Attributes:
-//  - MBeanNamePattern
+//  - StreamId
 // 
-type JMXServiceQueryMBeanNamesArgs struct {
-	MBeanNamePattern string `thrift:"mBeanNamePattern,1" db:"mBeanNamePattern" json:"mBeanNamePattern"`
+type JMXServiceCloseRecordingStreamArgs struct {
+	StreamId int64 `thrift:"streamId,1" db:"streamId" json:"streamId"`
 }
 
-func NewJMXServiceQueryMBeanNamesArgs() *JMXServiceQueryMBeanNamesArgs {
-	return &JMXServiceQueryMBeanNamesArgs{}
+func NewJMXServiceCloseRecordingStreamArgs() *JMXServiceCloseRecordingStreamArgs {
+	return &JMXServiceCloseRecordingStreamArgs{}
 }
 
 
 
-func (p *JMXServiceQueryMBeanNamesArgs) GetMBeanNamePattern() string {
-	return p.MBeanNamePattern
+func (p *JMXServiceCloseRecordingStreamArgs) GetStreamId() int64 {
+	return p.StreamId
 }
 
-func (p *JMXServiceQueryMBeanNamesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingStreamArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3905,7 +18810,7 @@ func (p *JMXServiceQueryMBeanNamesArgs) Read(ctx context.Context, iprot thrift.T
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -3929,17 +18834,17 @@ func (p *JMXServiceQueryMBeanNamesArgs) Read(ctx context.Context, iprot thrift.T
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *JMXServiceCloseRecordingStreamArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.MBeanNamePattern = v
+		p.StreamId = v
 	}
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "queryMBeanNames_args"); err != nil {
+func (p *JMXServiceCloseRecordingStreamArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "closeRecordingStream_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -3954,92 +18859,79 @@ func (p *JMXServiceQueryMBeanNamesArgs) Write(ctx context.Context, oprot thrift.
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "mBeanNamePattern", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanNamePattern: ", p), err)
+func (p *JMXServiceCloseRecordingStreamArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "streamId", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:streamId: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.MBeanNamePattern)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.mBeanNamePattern (1) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.StreamId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.streamId (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanNamePattern: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:streamId: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXServiceQueryMBeanNamesArgs) String() string {
+func (p *JMXServiceCloseRecordingStreamArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceQueryMBeanNamesArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceCloseRecordingStreamArgs(%+v)", *p)
 }
 
-func (p *JMXServiceQueryMBeanNamesArgs) LogValue() slog.Value {
+func (p *JMXServiceCloseRecordingStreamArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceQueryMBeanNamesArgs",
+		Type: "*nrprotocol.JMXServiceCloseRecordingStreamArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceQueryMBeanNamesArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceCloseRecordingStreamArgs)(nil)
 
 // Attributes:
-//  - Success
 //  - ConnErr
 //  - JmxErr
 // 
-type JMXServiceQueryMBeanNamesResult struct {
-	Success []string `thrift:"success,0" db:"success" json:"success,omitempty"`
+type JMXServiceCloseRecordingStreamResult struct {
 	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
 	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceQueryMBeanNamesResult() *JMXServiceQueryMBeanNamesResult {
-	return &JMXServiceQueryMBeanNamesResult{}
-}
-
-var JMXServiceQueryMBeanNamesResult_Success_DEFAULT []string
-
-
-func (p *JMXServiceQueryMBeanNamesResult) GetSuccess() []string {
-	return p.Success
+func NewJMXServiceCloseRecordingStreamResult() *JMXServiceCloseRecordingStreamResult {
+	return &JMXServiceCloseRecordingStreamResult{}
 }
 
-var JMXServiceQueryMBeanNamesResult_ConnErr_DEFAULT *JMXConnectionError
+var JMXServiceCloseRecordingStreamResult_ConnErr_DEFAULT *JMXConnectionError
 
-func (p *JMXServiceQueryMBeanNamesResult) GetConnErr() *JMXConnectionError {
+func (p *JMXServiceCloseRecordingStreamResult) GetConnErr() *JMXConnectionError {
 	if !p.IsSetConnErr() {
-		return JMXServiceQueryMBeanNamesResult_ConnErr_DEFAULT
+		return JMXServiceCloseRecordingStreamResult_ConnErr_DEFAULT
 	}
 	return p.ConnErr
 }
 
-var JMXServiceQueryMBeanNamesResult_JmxErr_DEFAULT *JMXError
+var JMXServiceCloseRecordingStreamResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceQueryMBeanNamesResult) GetJmxErr() *JMXError {
+func (p *JMXServiceCloseRecordingStreamResult) GetJmxErr() *JMXError {
 	if !p.IsSetJmxErr() {
-		return JMXServiceQueryMBeanNamesResult_JmxErr_DEFAULT
+		return JMXServiceCloseRecordingStreamResult_JmxErr_DEFAULT
 	}
 	return p.JmxErr
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) IsSetSuccess() bool {
-	return p.Success != nil
-}
-
-func (p *JMXServiceQueryMBeanNamesResult) IsSetConnErr() bool {
+func (p *JMXServiceCloseRecordingStreamResult) IsSetConnErr() bool {
 	return p.ConnErr != nil
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) IsSetJmxErr() bool {
+func (p *JMXServiceCloseRecordingStreamResult) IsSetJmxErr() bool {
 	return p.JmxErr != nil
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingStreamResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4054,16 +18946,6 @@ func (p *JMXServiceQueryMBeanNamesResult) Read(ctx context.Context, iprot thrift
 			break
 		}
 		switch fieldId {
-		case 0:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField0(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		case 1:
 			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
@@ -4099,29 +18981,7 @@ func (p *JMXServiceQueryMBeanNamesResult) Read(ctx context.Context, iprot thrift
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]string, 0, size)
-	p.Success = tSlice
-	for i := 0; i < size; i++ {
-		var _elem44 string
-		if v, err := iprot.ReadString(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem44 = v
-		}
-		p.Success = append(p.Success, _elem44)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *JMXServiceQueryMBeanNamesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingStreamResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	p.ConnErr = &JMXConnectionError{}
 	if err := p.ConnErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
@@ -4129,7 +18989,7 @@ func (p *JMXServiceQueryMBeanNamesResult) ReadField1(ctx context.Context, iprot
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceCloseRecordingStreamResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	p.JmxErr = &JMXError{}
 	if err := p.JmxErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
@@ -4137,12 +18997,11 @@ func (p *JMXServiceQueryMBeanNamesResult) ReadField2(ctx context.Context, iprot
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "queryMBeanNames_result"); err != nil {
+func (p *JMXServiceCloseRecordingStreamResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "closeRecordingStream_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(ctx, oprot); err != nil { return err }
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
 		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
@@ -4155,30 +19014,7 @@ func (p *JMXServiceQueryMBeanNamesResult) Write(ctx context.Context, oprot thrif
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Success)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.Success {
-			if err := oprot.WriteString(ctx, string(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
-		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *JMXServiceQueryMBeanNamesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceCloseRecordingStreamResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetConnErr() {
 		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
@@ -4193,7 +19029,7 @@ func (p *JMXServiceQueryMBeanNamesResult) writeField1(ctx context.Context, oprot
 	return err
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceCloseRecordingStreamResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetJmxErr() {
 		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
@@ -4208,44 +19044,34 @@ func (p *JMXServiceQueryMBeanNamesResult) writeField2(ctx context.Context, oprot
 	return err
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) String() string {
+func (p *JMXServiceCloseRecordingStreamResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceQueryMBeanNamesResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceCloseRecordingStreamResult(%+v)", *p)
 }
 
-func (p *JMXServiceQueryMBeanNamesResult) LogValue() slog.Value {
+func (p *JMXServiceCloseRecordingStreamResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceQueryMBeanNamesResult",
+		Type: "*nrprotocol.JMXServiceCloseRecordingStreamResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceQueryMBeanNamesResult)(nil)
-
-// Attributes:
-//  - MBeanName
-// 
-type JMXServiceGetMBeanAttributeNamesArgs struct {
-	MBeanName string `thrift:"mBeanName,1" db:"mBeanName" json:"mBeanName"`
-}
+var _ slog.LogValuer = (*JMXServiceCloseRecordingStreamResult)(nil)
 
-func NewJMXServiceGetMBeanAttributeNamesArgs() *JMXServiceGetMBeanAttributeNamesArgs {
-	return &JMXServiceGetMBeanAttributeNamesArgs{}
+type JMXServiceGetVMOptionsArgs struct {
 }
 
-
-
-func (p *JMXServiceGetMBeanAttributeNamesArgs) GetMBeanName() string {
-	return p.MBeanName
+func NewJMXServiceGetVMOptionsArgs() *JMXServiceGetVMOptionsArgs {
+	return &JMXServiceGetVMOptionsArgs{}
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4259,21 +19085,8 @@ func (p *JMXServiceGetMBeanAttributeNamesArgs) Read(ctx context.Context, iprot t
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
-			}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
 			return err
@@ -4285,21 +19098,11 @@ func (p *JMXServiceGetMBeanAttributeNamesArgs) Read(ctx context.Context, iprot t
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.MBeanName = v
-	}
-	return nil
-}
-
-func (p *JMXServiceGetMBeanAttributeNamesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributeNames_args"); err != nil {
+func (p *JMXServiceGetVMOptionsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getVMOptions_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4310,92 +19113,79 @@ func (p *JMXServiceGetMBeanAttributeNamesArgs) Write(ctx context.Context, oprot
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanName: ", p), err)
-	}
-	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanName: ", p), err)
-	}
-	return err
-}
-
-func (p *JMXServiceGetMBeanAttributeNamesArgs) String() string {
+func (p *JMXServiceGetVMOptionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetMBeanAttributeNamesArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceGetVMOptionsArgs(%+v)", *p)
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesArgs) LogValue() slog.Value {
+func (p *JMXServiceGetVMOptionsArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetMBeanAttributeNamesArgs",
+		Type: "*nrprotocol.JMXServiceGetVMOptionsArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetMBeanAttributeNamesArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceGetVMOptionsArgs)(nil)
 
 // Attributes:
 //  - Success
 //  - ConnErr
 //  - JmxErr
 // 
-type JMXServiceGetMBeanAttributeNamesResult struct {
-	Success []string `thrift:"success,0" db:"success" json:"success,omitempty"`
+type JMXServiceGetVMOptionsResult struct {
+	Success []*VMOption `thrift:"success,0" db:"success" json:"success,omitempty"`
 	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
 	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceGetMBeanAttributeNamesResult() *JMXServiceGetMBeanAttributeNamesResult {
-	return &JMXServiceGetMBeanAttributeNamesResult{}
+func NewJMXServiceGetVMOptionsResult() *JMXServiceGetVMOptionsResult {
+	return &JMXServiceGetVMOptionsResult{}
 }
 
-var JMXServiceGetMBeanAttributeNamesResult_Success_DEFAULT []string
+var JMXServiceGetVMOptionsResult_Success_DEFAULT []*VMOption
 
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) GetSuccess() []string {
+func (p *JMXServiceGetVMOptionsResult) GetSuccess() []*VMOption {
 	return p.Success
 }
 
-var JMXServiceGetMBeanAttributeNamesResult_ConnErr_DEFAULT *JMXConnectionError
+var JMXServiceGetVMOptionsResult_ConnErr_DEFAULT *JMXConnectionError
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) GetConnErr() *JMXConnectionError {
+func (p *JMXServiceGetVMOptionsResult) GetConnErr() *JMXConnectionError {
 	if !p.IsSetConnErr() {
-		return JMXServiceGetMBeanAttributeNamesResult_ConnErr_DEFAULT
+		return JMXServiceGetVMOptionsResult_ConnErr_DEFAULT
 	}
 	return p.ConnErr
 }
 
-var JMXServiceGetMBeanAttributeNamesResult_JmxErr_DEFAULT *JMXError
+var JMXServiceGetVMOptionsResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) GetJmxErr() *JMXError {
+func (p *JMXServiceGetVMOptionsResult) GetJmxErr() *JMXError {
 	if !p.IsSetJmxErr() {
-		return JMXServiceGetMBeanAttributeNamesResult_JmxErr_DEFAULT
+		return JMXServiceGetVMOptionsResult_JmxErr_DEFAULT
 	}
 	return p.JmxErr
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) IsSetSuccess() bool {
+func (p *JMXServiceGetVMOptionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) IsSetConnErr() bool {
+func (p *JMXServiceGetVMOptionsResult) IsSetConnErr() bool {
 	return p.ConnErr != nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) IsSetJmxErr() bool {
+func (p *JMXServiceGetVMOptionsResult) IsSetJmxErr() bool {
 	return p.JmxErr != nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4455,21 +19245,19 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) Read(ctx context.Context, iprot
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin(ctx)
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]string, 0, size)
+	tSlice := make([]*VMOption, 0, size)
 	p.Success = tSlice
 	for i := 0; i < size; i++ {
-		var _elem45 string
-		if v, err := iprot.ReadString(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem45 = v
+		_elem189 := &VMOption{}
+		if err := _elem189.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem189), err)
 		}
-		p.Success = append(p.Success, _elem45)
+		p.Success = append(p.Success, _elem189)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -4477,7 +19265,7 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField0(ctx context.Context,
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	p.ConnErr = &JMXConnectionError{}
 	if err := p.ConnErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
@@ -4485,7 +19273,7 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField1(ctx context.Context,
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionsResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	p.JmxErr = &JMXError{}
 	if err := p.JmxErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
@@ -4493,8 +19281,8 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) ReadField2(ctx context.Context,
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributeNames_result"); err != nil {
+func (p *JMXServiceGetVMOptionsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getVMOptions_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4511,17 +19299,17 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) Write(ctx context.Context, opro
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceGetVMOptionsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Success)); err != nil {
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
 			return thrift.PrependError("error writing list begin: ", err)
 		}
 		for _, v := range p.Success {
-			if err := oprot.WriteString(ctx, string(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 			}
 		}
 		if err := oprot.WriteListEnd(ctx); err != nil {
@@ -4534,7 +19322,7 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) writeField0(ctx context.Context
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceGetVMOptionsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetConnErr() {
 		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
@@ -4549,7 +19337,7 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) writeField1(ctx context.Context
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceGetVMOptionsResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetJmxErr() {
 		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
@@ -4564,52 +19352,44 @@ func (p *JMXServiceGetMBeanAttributeNamesResult) writeField2(ctx context.Context
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) String() string {
+func (p *JMXServiceGetVMOptionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetMBeanAttributeNamesResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceGetVMOptionsResult(%+v)", *p)
 }
 
-func (p *JMXServiceGetMBeanAttributeNamesResult) LogValue() slog.Value {
+func (p *JMXServiceGetVMOptionsResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetMBeanAttributeNamesResult",
+		Type: "*nrprotocol.JMXServiceGetVMOptionsResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetMBeanAttributeNamesResult)(nil)
+var _ slog.LogValuer = (*JMXServiceGetVMOptionsResult)(nil)
 
 // Attributes:
-//  - MBeanName
-//  - Attributes
+//  - Name
 // 
-type JMXServiceGetMBeanAttributesArgs struct {
-	MBeanName string `thrift:"mBeanName,1" db:"mBeanName" json:"mBeanName"`
-	Attributes []string `thrift:"attributes,2" db:"attributes" json:"attributes"`
-}
-
-func NewJMXServiceGetMBeanAttributesArgs() *JMXServiceGetMBeanAttributesArgs {
-	return &JMXServiceGetMBeanAttributesArgs{}
+type JMXServiceGetVMOptionArgs struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
 }
 
-
-
-func (p *JMXServiceGetMBeanAttributesArgs) GetMBeanName() string {
-	return p.MBeanName
+func NewJMXServiceGetVMOptionArgs() *JMXServiceGetVMOptionArgs {
+	return &JMXServiceGetVMOptionArgs{}
 }
 
 
 
-func (p *JMXServiceGetMBeanAttributesArgs) GetAttributes() []string {
-	return p.Attributes
+func (p *JMXServiceGetVMOptionArgs) GetName() string {
+	return p.Name
 }
 
-func (p *JMXServiceGetMBeanAttributesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4634,16 +19414,6 @@ func (p *JMXServiceGetMBeanAttributesArgs) Read(ctx context.Context, iprot thrif
 					return err
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -4659,44 +19429,21 @@ func (p *JMXServiceGetMBeanAttributesArgs) Read(ctx context.Context, iprot thrif
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.MBeanName = v
-	}
-	return nil
-}
-
-func (p *JMXServiceGetMBeanAttributesArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]string, 0, size)
-	p.Attributes = tSlice
-	for i := 0; i < size; i++ {
-		var _elem46 string
-		if v, err := iprot.ReadString(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem46 = v
-		}
-		p.Attributes = append(p.Attributes, _elem46)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+		p.Name = v
 	}
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributes_args"); err != nil {
+func (p *JMXServiceGetVMOptionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getVMOption_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
-		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4707,113 +19454,94 @@ func (p *JMXServiceGetMBeanAttributesArgs) Write(ctx context.Context, oprot thri
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "mBeanName", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanName: ", p), err)
-	}
-	if err := oprot.WriteString(ctx, string(p.MBeanName)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.mBeanName (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanName: ", p), err)
-	}
-	return err
-}
-
-func (p *JMXServiceGetMBeanAttributesArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "attributes", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:attributes: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Attributes)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Attributes {
-		if err := oprot.WriteString(ctx, string(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *JMXServiceGetVMOptionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:attributes: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributesArgs) String() string {
+func (p *JMXServiceGetVMOptionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetMBeanAttributesArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceGetVMOptionArgs(%+v)", *p)
 }
 
-func (p *JMXServiceGetMBeanAttributesArgs) LogValue() slog.Value {
+func (p *JMXServiceGetVMOptionArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetMBeanAttributesArgs",
+		Type: "*nrprotocol.JMXServiceGetVMOptionArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetMBeanAttributesArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceGetVMOptionArgs)(nil)
 
 // Attributes:
 //  - Success
 //  - ConnErr
 //  - JmxErr
 // 
-type JMXServiceGetMBeanAttributesResult struct {
-	Success []*AttributeResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type JMXServiceGetVMOptionResult struct {
+	Success *VMOption `thrift:"success,0" db:"success" json:"success,omitempty"`
 	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
 	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceGetMBeanAttributesResult() *JMXServiceGetMBeanAttributesResult {
-	return &JMXServiceGetMBeanAttributesResult{}
+func NewJMXServiceGetVMOptionResult() *JMXServiceGetVMOptionResult {
+	return &JMXServiceGetVMOptionResult{}
 }
 
-var JMXServiceGetMBeanAttributesResult_Success_DEFAULT []*AttributeResponse
-
+var JMXServiceGetVMOptionResult_Success_DEFAULT *VMOption
 
-func (p *JMXServiceGetMBeanAttributesResult) GetSuccess() []*AttributeResponse {
+func (p *JMXServiceGetVMOptionResult) GetSuccess() *VMOption {
+	if !p.IsSetSuccess() {
+		return JMXServiceGetVMOptionResult_Success_DEFAULT
+	}
 	return p.Success
 }
 
-var JMXServiceGetMBeanAttributesResult_ConnErr_DEFAULT *JMXConnectionError
+var JMXServiceGetVMOptionResult_ConnErr_DEFAULT *JMXConnectionError
 
-func (p *JMXServiceGetMBeanAttributesResult) GetConnErr() *JMXConnectionError {
+func (p *JMXServiceGetVMOptionResult) GetConnErr() *JMXConnectionError {
 	if !p.IsSetConnErr() {
-		return JMXServiceGetMBeanAttributesResult_ConnErr_DEFAULT
+		return JMXServiceGetVMOptionResult_ConnErr_DEFAULT
 	}
 	return p.ConnErr
 }
 
-var JMXServiceGetMBeanAttributesResult_JmxErr_DEFAULT *JMXError
+var JMXServiceGetVMOptionResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceGetMBeanAttributesResult) GetJmxErr() *JMXError {
+func (p *JMXServiceGetVMOptionResult) GetJmxErr() *JMXError {
 	if !p.IsSetJmxErr() {
-		return JMXServiceGetMBeanAttributesResult_JmxErr_DEFAULT
+		return JMXServiceGetVMOptionResult_JmxErr_DEFAULT
 	}
 	return p.JmxErr
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) IsSetSuccess() bool {
+func (p *JMXServiceGetVMOptionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) IsSetConnErr() bool {
+func (p *JMXServiceGetVMOptionResult) IsSetConnErr() bool {
 	return p.ConnErr != nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) IsSetJmxErr() bool {
+func (p *JMXServiceGetVMOptionResult) IsSetJmxErr() bool {
 	return p.JmxErr != nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4829,7 +19557,7 @@ func (p *JMXServiceGetMBeanAttributesResult) Read(ctx context.Context, iprot thr
 		}
 		switch fieldId {
 		case 0:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField0(ctx, iprot); err != nil {
 					return err
 				}
@@ -4873,27 +19601,15 @@ func (p *JMXServiceGetMBeanAttributesResult) Read(ctx context.Context, iprot thr
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*AttributeResponse, 0, size)
-	p.Success = tSlice
-	for i := 0; i < size; i++ {
-		_elem47 := &AttributeResponse{}
-		if err := _elem47.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem47), err)
-		}
-		p.Success = append(p.Success, _elem47)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *JMXServiceGetVMOptionResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &VMOption{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	p.ConnErr = &JMXConnectionError{}
 	if err := p.ConnErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
@@ -4901,7 +19617,7 @@ func (p *JMXServiceGetMBeanAttributesResult) ReadField1(ctx context.Context, ipr
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceGetVMOptionResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	p.JmxErr = &JMXError{}
 	if err := p.JmxErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
@@ -4909,8 +19625,8 @@ func (p *JMXServiceGetMBeanAttributesResult) ReadField2(ctx context.Context, ipr
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getMBeanAttributes_result"); err != nil {
+func (p *JMXServiceGetVMOptionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "getVMOption_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4927,21 +19643,13 @@ func (p *JMXServiceGetMBeanAttributesResult) Write(ctx context.Context, oprot th
 	return nil
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceGetVMOptionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.Success {
-			if err := v.Write(ctx, oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
-		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
@@ -4950,7 +19658,7 @@ func (p *JMXServiceGetMBeanAttributesResult) writeField0(ctx context.Context, op
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceGetVMOptionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetConnErr() {
 		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
@@ -4965,7 +19673,7 @@ func (p *JMXServiceGetMBeanAttributesResult) writeField1(ctx context.Context, op
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceGetVMOptionResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetJmxErr() {
 		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
@@ -4980,52 +19688,52 @@ func (p *JMXServiceGetMBeanAttributesResult) writeField2(ctx context.Context, op
 	return err
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) String() string {
+func (p *JMXServiceGetVMOptionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetMBeanAttributesResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceGetVMOptionResult(%+v)", *p)
 }
 
-func (p *JMXServiceGetMBeanAttributesResult) LogValue() slog.Value {
+func (p *JMXServiceGetVMOptionResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetMBeanAttributesResult",
+		Type: "*nrprotocol.JMXServiceGetVMOptionResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetMBeanAttributesResult)(nil)
+var _ slog.LogValuer = (*JMXServiceGetVMOptionResult)(nil)
 
 // Attributes:
-//  - MBeanNamePattern
-//  - Attributes
+//  - Name
+//  - Value
 // 
-type JMXServiceQueryMBeanAttributesArgs struct {
-	MBeanNamePattern string `thrift:"mBeanNamePattern,1" db:"mBeanNamePattern" json:"mBeanNamePattern"`
-	Attributes []string `thrift:"attributes,2" db:"attributes" json:"attributes"`
+type JMXServiceSetVMOptionArgs struct {
+	Name string `thrift:"name,1" db:"name" json:"name"`
+	Value string `thrift:"value,2" db:"value" json:"value"`
 }
 
-func NewJMXServiceQueryMBeanAttributesArgs() *JMXServiceQueryMBeanAttributesArgs {
-	return &JMXServiceQueryMBeanAttributesArgs{}
+func NewJMXServiceSetVMOptionArgs() *JMXServiceSetVMOptionArgs {
+	return &JMXServiceSetVMOptionArgs{}
 }
 
 
 
-func (p *JMXServiceQueryMBeanAttributesArgs) GetMBeanNamePattern() string {
-	return p.MBeanNamePattern
+func (p *JMXServiceSetVMOptionArgs) GetName() string {
+	return p.Name
 }
 
 
 
-func (p *JMXServiceQueryMBeanAttributesArgs) GetAttributes() []string {
-	return p.Attributes
+func (p *JMXServiceSetVMOptionArgs) GetValue() string {
+	return p.Value
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceSetVMOptionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5051,7 +19759,7 @@ func (p *JMXServiceQueryMBeanAttributesArgs) Read(ctx context.Context, iprot thr
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -5075,39 +19783,26 @@ func (p *JMXServiceQueryMBeanAttributesArgs) Read(ctx context.Context, iprot thr
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceSetVMOptionArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.MBeanNamePattern = v
+		p.Name = v
 	}
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]string, 0, size)
-	p.Attributes = tSlice
-	for i := 0; i < size; i++ {
-		var _elem48 string
-		if v, err := iprot.ReadString(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem48 = v
-		}
-		p.Attributes = append(p.Attributes, _elem48)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *JMXServiceSetVMOptionArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Value = v
 	}
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "queryMBeanAttributes_args"); err != nil {
+func (p *JMXServiceSetVMOptionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "setVMOption_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5123,113 +19818,92 @@ func (p *JMXServiceQueryMBeanAttributesArgs) Write(ctx context.Context, oprot th
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "mBeanNamePattern", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:mBeanNamePattern: ", p), err)
+func (p *JMXServiceSetVMOptionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.MBeanNamePattern)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.mBeanNamePattern (1) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:mBeanNamePattern: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "attributes", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:attributes: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Attributes)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Attributes {
-		if err := oprot.WriteString(ctx, string(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *JMXServiceSetVMOptionArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "value", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteString(ctx, string(p.Value)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:attributes: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err)
 	}
 	return err
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) String() string {
+func (p *JMXServiceSetVMOptionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceQueryMBeanAttributesArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceSetVMOptionArgs(%+v)", *p)
 }
 
-func (p *JMXServiceQueryMBeanAttributesArgs) LogValue() slog.Value {
+func (p *JMXServiceSetVMOptionArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceQueryMBeanAttributesArgs",
+		Type: "*nrprotocol.JMXServiceSetVMOptionArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceQueryMBeanAttributesArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceSetVMOptionArgs)(nil)
 
 // Attributes:
-//  - Success
 //  - ConnErr
 //  - JmxErr
 // 
-type JMXServiceQueryMBeanAttributesResult struct {
-	Success []*AttributeResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type JMXServiceSetVMOptionResult struct {
 	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
 	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceQueryMBeanAttributesResult() *JMXServiceQueryMBeanAttributesResult {
-	return &JMXServiceQueryMBeanAttributesResult{}
-}
-
-var JMXServiceQueryMBeanAttributesResult_Success_DEFAULT []*AttributeResponse
-
-
-func (p *JMXServiceQueryMBeanAttributesResult) GetSuccess() []*AttributeResponse {
-	return p.Success
+func NewJMXServiceSetVMOptionResult() *JMXServiceSetVMOptionResult {
+	return &JMXServiceSetVMOptionResult{}
 }
 
-var JMXServiceQueryMBeanAttributesResult_ConnErr_DEFAULT *JMXConnectionError
+var JMXServiceSetVMOptionResult_ConnErr_DEFAULT *JMXConnectionError
 
-func (p *JMXServiceQueryMBeanAttributesResult) GetConnErr() *JMXConnectionError {
+func (p *JMXServiceSetVMOptionResult) GetConnErr() *JMXConnectionError {
 	if !p.IsSetConnErr() {
-		return JMXServiceQueryMBeanAttributesResult_ConnErr_DEFAULT
+		return JMXServiceSetVMOptionResult_ConnErr_DEFAULT
 	}
 	return p.ConnErr
 }
 
-var JMXServiceQueryMBeanAttributesResult_JmxErr_DEFAULT *JMXError
+var JMXServiceSetVMOptionResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceQueryMBeanAttributesResult) GetJmxErr() *JMXError {
+func (p *JMXServiceSetVMOptionResult) GetJmxErr() *JMXError {
 	if !p.IsSetJmxErr() {
-		return JMXServiceQueryMBeanAttributesResult_JmxErr_DEFAULT
+		return JMXServiceSetVMOptionResult_JmxErr_DEFAULT
 	}
 	return p.JmxErr
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) IsSetSuccess() bool {
-	return p.Success != nil
-}
-
-func (p *JMXServiceQueryMBeanAttributesResult) IsSetConnErr() bool {
+func (p *JMXServiceSetVMOptionResult) IsSetConnErr() bool {
 	return p.ConnErr != nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) IsSetJmxErr() bool {
+func (p *JMXServiceSetVMOptionResult) IsSetJmxErr() bool {
 	return p.JmxErr != nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceSetVMOptionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5244,16 +19918,6 @@ func (p *JMXServiceQueryMBeanAttributesResult) Read(ctx context.Context, iprot t
 			break
 		}
 		switch fieldId {
-		case 0:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField0(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		case 1:
 			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
@@ -5289,27 +19953,7 @@ func (p *JMXServiceQueryMBeanAttributesResult) Read(ctx context.Context, iprot t
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*AttributeResponse, 0, size)
-	p.Success = tSlice
-	for i := 0; i < size; i++ {
-		_elem49 := &AttributeResponse{}
-		if err := _elem49.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem49), err)
-		}
-		p.Success = append(p.Success, _elem49)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *JMXServiceQueryMBeanAttributesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceSetVMOptionResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	p.ConnErr = &JMXConnectionError{}
 	if err := p.ConnErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
@@ -5317,7 +19961,7 @@ func (p *JMXServiceQueryMBeanAttributesResult) ReadField1(ctx context.Context, i
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceSetVMOptionResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	p.JmxErr = &JMXError{}
 	if err := p.JmxErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
@@ -5325,12 +19969,11 @@ func (p *JMXServiceQueryMBeanAttributesResult) ReadField2(ctx context.Context, i
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "queryMBeanAttributes_result"); err != nil {
+func (p *JMXServiceSetVMOptionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "setVMOption_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(ctx, oprot); err != nil { return err }
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
 		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
@@ -5343,30 +19986,7 @@ func (p *JMXServiceQueryMBeanAttributesResult) Write(ctx context.Context, oprot
 	return nil
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.Success {
-			if err := v.Write(ctx, oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
-		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *JMXServiceQueryMBeanAttributesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceSetVMOptionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetConnErr() {
 		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
@@ -5381,7 +20001,7 @@ func (p *JMXServiceQueryMBeanAttributesResult) writeField1(ctx context.Context,
 	return err
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceSetVMOptionResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetJmxErr() {
 		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
@@ -5396,34 +20016,60 @@ func (p *JMXServiceQueryMBeanAttributesResult) writeField2(ctx context.Context,
 	return err
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) String() string {
+func (p *JMXServiceSetVMOptionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceQueryMBeanAttributesResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceSetVMOptionResult(%+v)", *p)
 }
 
-func (p *JMXServiceQueryMBeanAttributesResult) LogValue() slog.Value {
+func (p *JMXServiceSetVMOptionResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceQueryMBeanAttributesResult",
+		Type: "*nrprotocol.JMXServiceSetVMOptionResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceQueryMBeanAttributesResult)(nil)
+var _ slog.LogValuer = (*JMXServiceSetVMOptionResult)(nil)
 
-type JMXServiceGetInternalStatsArgs struct {
+// Attributes:
+//  - OutputFile
+//  - Live
+//  - TimeoutMs
+// 
+type JMXServiceDumpHeapArgs struct {
+	OutputFile string `thrift:"outputFile,1" db:"outputFile" json:"outputFile"`
+	Live bool `thrift:"live,2" db:"live" json:"live"`
+	TimeoutMs int64 `thrift:"timeoutMs,3" db:"timeoutMs" json:"timeoutMs"`
 }
 
-func NewJMXServiceGetInternalStatsArgs() *JMXServiceGetInternalStatsArgs {
-	return &JMXServiceGetInternalStatsArgs{}
+func NewJMXServiceDumpHeapArgs() *JMXServiceDumpHeapArgs {
+	return &JMXServiceDumpHeapArgs{}
 }
 
-func (p *JMXServiceGetInternalStatsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+
+func (p *JMXServiceDumpHeapArgs) GetOutputFile() string {
+	return p.OutputFile
+}
+
+
+
+func (p *JMXServiceDumpHeapArgs) GetLive() bool {
+	return p.Live
+}
+
+
+
+func (p *JMXServiceDumpHeapArgs) GetTimeoutMs() int64 {
+	return p.TimeoutMs
+}
+
+func (p *JMXServiceDumpHeapArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5437,8 +20083,41 @@ func (p *JMXServiceGetInternalStatsArgs) Read(ctx context.Context, iprot thrift.
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
 			return err
@@ -5450,11 +20129,41 @@ func (p *JMXServiceGetInternalStatsArgs) Read(ctx context.Context, iprot thrift.
 	return nil
 }
 
-func (p *JMXServiceGetInternalStatsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getInternalStats_args"); err != nil {
+func (p *JMXServiceDumpHeapArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.OutputFile = v
+	}
+	return nil
+}
+
+func (p *JMXServiceDumpHeapArgs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Live = v
+	}
+	return nil
+}
+
+func (p *JMXServiceDumpHeapArgs) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.TimeoutMs = v
+	}
+	return nil
+}
+
+func (p *JMXServiceDumpHeapArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "dumpHeap_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
+		if err := p.writeField3(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5465,64 +20174,105 @@ func (p *JMXServiceGetInternalStatsArgs) Write(ctx context.Context, oprot thrift
 	return nil
 }
 
-func (p *JMXServiceGetInternalStatsArgs) String() string {
+func (p *JMXServiceDumpHeapArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "outputFile", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:outputFile: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.OutputFile)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.outputFile (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:outputFile: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceDumpHeapArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "live", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:live: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Live)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.live (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:live: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceDumpHeapArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "timeoutMs", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:timeoutMs: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.TimeoutMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.timeoutMs (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:timeoutMs: ", p), err)
+	}
+	return err
+}
+
+func (p *JMXServiceDumpHeapArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetInternalStatsArgs(%+v)", *p)
+	return fmt.Sprintf("JMXServiceDumpHeapArgs(%+v)", *p)
 }
 
-func (p *JMXServiceGetInternalStatsArgs) LogValue() slog.Value {
+func (p *JMXServiceDumpHeapArgs) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetInternalStatsArgs",
+		Type: "*nrprotocol.JMXServiceDumpHeapArgs",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetInternalStatsArgs)(nil)
+var _ slog.LogValuer = (*JMXServiceDumpHeapArgs)(nil)
 
 // Attributes:
-//  - Success
+//  - ConnErr
 //  - JmxErr
 // 
-type JMXServiceGetInternalStatsResult struct {
-	Success []*InternalStat `thrift:"success,0" db:"success" json:"success,omitempty"`
-	JmxErr *JMXError `thrift:"jmxErr,1" db:"jmxErr" json:"jmxErr,omitempty"`
+type JMXServiceDumpHeapResult struct {
+	ConnErr *JMXConnectionError `thrift:"connErr,1" db:"connErr" json:"connErr,omitempty"`
+	JmxErr *JMXError `thrift:"jmxErr,2" db:"jmxErr" json:"jmxErr,omitempty"`
 }
 
-func NewJMXServiceGetInternalStatsResult() *JMXServiceGetInternalStatsResult {
-	return &JMXServiceGetInternalStatsResult{}
+func NewJMXServiceDumpHeapResult() *JMXServiceDumpHeapResult {
+	return &JMXServiceDumpHeapResult{}
 }
 
-var JMXServiceGetInternalStatsResult_Success_DEFAULT []*InternalStat
-
+var JMXServiceDumpHeapResult_ConnErr_DEFAULT *JMXConnectionError
 
-func (p *JMXServiceGetInternalStatsResult) GetSuccess() []*InternalStat {
-	return p.Success
+func (p *JMXServiceDumpHeapResult) GetConnErr() *JMXConnectionError {
+	if !p.IsSetConnErr() {
+		return JMXServiceDumpHeapResult_ConnErr_DEFAULT
+	}
+	return p.ConnErr
 }
 
-var JMXServiceGetInternalStatsResult_JmxErr_DEFAULT *JMXError
+var JMXServiceDumpHeapResult_JmxErr_DEFAULT *JMXError
 
-func (p *JMXServiceGetInternalStatsResult) GetJmxErr() *JMXError {
+func (p *JMXServiceDumpHeapResult) GetJmxErr() *JMXError {
 	if !p.IsSetJmxErr() {
-		return JMXServiceGetInternalStatsResult_JmxErr_DEFAULT
+		return JMXServiceDumpHeapResult_JmxErr_DEFAULT
 	}
 	return p.JmxErr
 }
 
-func (p *JMXServiceGetInternalStatsResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *JMXServiceDumpHeapResult) IsSetConnErr() bool {
+	return p.ConnErr != nil
 }
 
-func (p *JMXServiceGetInternalStatsResult) IsSetJmxErr() bool {
+func (p *JMXServiceDumpHeapResult) IsSetJmxErr() bool {
 	return p.JmxErr != nil
 }
 
-func (p *JMXServiceGetInternalStatsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceDumpHeapResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5537,9 +20287,9 @@ func (p *JMXServiceGetInternalStatsResult) Read(ctx context.Context, iprot thrif
 			break
 		}
 		switch fieldId {
-		case 0:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField0(ctx, iprot); err != nil {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -5547,9 +20297,9 @@ func (p *JMXServiceGetInternalStatsResult) Read(ctx context.Context, iprot thrif
 					return err
 				}
 			}
-		case 1:
+		case 2:
 			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField1(ctx, iprot); err != nil {
+				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -5572,27 +20322,15 @@ func (p *JMXServiceGetInternalStatsResult) Read(ctx context.Context, iprot thrif
 	return nil
 }
 
-func (p *JMXServiceGetInternalStatsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*InternalStat, 0, size)
-	p.Success = tSlice
-	for i := 0; i < size; i++ {
-		_elem50 := &InternalStat{}
-		if err := _elem50.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem50), err)
-		}
-		p.Success = append(p.Success, _elem50)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *JMXServiceDumpHeapResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ConnErr = &JMXConnectionError{}
+	if err := p.ConnErr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ConnErr), err)
 	}
 	return nil
 }
 
-func (p *JMXServiceGetInternalStatsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *JMXServiceDumpHeapResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	p.JmxErr = &JMXError{}
 	if err := p.JmxErr.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JmxErr), err)
@@ -5600,13 +20338,13 @@ func (p *JMXServiceGetInternalStatsResult) ReadField1(ctx context.Context, iprot
 	return nil
 }
 
-func (p *JMXServiceGetInternalStatsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "getInternalStats_result"); err != nil {
+func (p *JMXServiceDumpHeapResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "dumpHeap_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(ctx, oprot); err != nil { return err }
 		if err := p.writeField1(ctx, oprot); err != nil { return err }
+		if err := p.writeField2(ctx, oprot); err != nil { return err }
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5617,62 +20355,54 @@ func (p *JMXServiceGetInternalStatsResult) Write(ctx context.Context, oprot thri
 	return nil
 }
 
-func (p *JMXServiceGetInternalStatsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Success)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.Success {
-			if err := v.Write(ctx, oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
+func (p *JMXServiceDumpHeapResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetConnErr() {
+		if err := oprot.WriteFieldBegin(ctx, "connErr", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:connErr: ", p), err)
 		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := p.ConnErr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ConnErr), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:connErr: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JMXServiceGetInternalStatsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *JMXServiceDumpHeapResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetJmxErr() {
-		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jmxErr: ", p), err)
+		if err := oprot.WriteFieldBegin(ctx, "jmxErr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:jmxErr: ", p), err)
 		}
 		if err := p.JmxErr.Write(ctx, oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JmxErr), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jmxErr: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:jmxErr: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JMXServiceGetInternalStatsResult) String() string {
+func (p *JMXServiceDumpHeapResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JMXServiceGetInternalStatsResult(%+v)", *p)
+	return fmt.Sprintf("JMXServiceDumpHeapResult(%+v)", *p)
 }
 
-func (p *JMXServiceGetInternalStatsResult) LogValue() slog.Value {
+func (p *JMXServiceDumpHeapResult) LogValue() slog.Value {
 	if p == nil {
 		return slog.AnyValue(nil)
 	}
 	v := thrift.SlogTStructWrapper{
-		Type: "*nrprotocol.JMXServiceGetInternalStatsResult",
+		Type: "*nrprotocol.JMXServiceDumpHeapResult",
 		Value: p,
 	}
 	return slog.AnyValue(v)
 }
 
-var _ slog.LogValuer = (*JMXServiceGetInternalStatsResult)(nil)
+var _ slog.LogValuer = (*JMXServiceDumpHeapResult)(nil)
 
 
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"io"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// RecordingSettings configures a Java Flight Recorder recording started with Client.StartRecording.
+// Configuration is the predefined configuration name, e.g. "default" or "profile", applied before the Settings
+// (e.g. "jdk.CPULoad#period": "1 s"). Options are the FlightRecorderMXBean recording options, e.g.
+// "duration", "maxAge", "maxSize", "disk", "dumpOnExit" or "destination".
+type RecordingSettings nrprotocol.RecordingSettings
+
+// StartRecording creates and starts a Java Flight Recorder recording using the jdk.management.jfr:type=FlightRecorder
+// MXBean, available on Java 11+ and Java 8u262+. It returns the recording id.
+func (c *Client) StartRecording(settings RecordingSettings) (int64, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return 0, err
+	}
+	result, err := c.jmxService.StartRecording(c.ctx, (*nrprotocol.RecordingSettings)(&settings))
+	return result, c.handleError(err)
+}
+
+// StopRecording stops a recording. The recording data can be downloaded using StreamRecording until it's closed.
+func (c *Client) StopRecording(id int64) error {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return err
+	}
+	err := c.jmxService.StopRecording(c.ctx, id)
+	return c.handleError(err)
+}
+
+// CloseRecording closes a recording releasing its data in the JVM.
+func (c *Client) CloseRecording(id int64) error {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return err
+	}
+	err := c.jmxService.CloseRecording(c.ctx, id)
+	return c.handleError(err)
+}
+
+// StreamRecording opens a stream to download the recording data in the JFR file format. The chunks are pulled
+// from the JVM while reading. The returned reader has to be closed to release the stream in the JVM.
+func (c *Client) StreamRecording(id int64) (io.ReadCloser, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	streamID, err := c.jmxService.OpenRecordingStream(c.ctx, id)
+	if err = c.handleError(err); err != nil {
+		return nil, err
+	}
+	return &recordingStream{client: c, streamID: streamID}, nil
+}
+
+// recordingStream reads the recording chunks using the FlightRecorderMXBean readStream operation.
+type recordingStream struct {
+	client   *Client
+	streamID int64
+	chunk    []byte
+	eof      bool
+	closed   bool
+}
+
+func (r *recordingStream) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	for len(r.chunk) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+
+		if err := r.client.checkNRJMXProccessError(); err != nil {
+			return 0, err
+		}
+		chunk, err := r.client.jmxService.ReadRecordingStream(r.client.ctx, r.streamID)
+		if err = r.client.handleError(err); err != nil {
+			return 0, err
+		}
+
+		r.chunk = chunk
+		r.eof = len(chunk) == 0
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// Close releases the stream in the JVM.
+func (r *recordingStream) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	if err := r.client.checkNRJMXProccessError(); err != nil {
+		return err
+	}
+	err := r.client.jmxService.CloseRecordingStream(r.client.ctx, r.streamID)
+	return r.client.handleError(err)
+}
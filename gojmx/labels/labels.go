@@ -0,0 +1,304 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package labels turns the ObjectName key properties of the attribute responses into sample labels
+// and builds the metric names from a template.
+package labels
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+const (
+	// DefaultNameTemplate is used when Config.NameTemplate is not defined.
+	DefaultNameTemplate = "{domain}.{attr}"
+
+	// DomainKey and AttrKey are the name template placeholders and drop rule keys
+	// for the mBean domain and the attribute name.
+	DomainKey = "domain"
+	AttrKey   = "attr"
+)
+
+// Config configures how the key properties are mapped into labels, e.g:
+//
+//	name_template: "{domain}.{type}.{name}.{attr}"
+//	rules:
+//	  - key: topic
+//	    rename: kafka_topic
+//	  - key: name
+//	    regex: (?P<direction>Bytes(In|Out))PerSec
+//	  - key: partition
+//	    drop: true
+//	drop:
+//	  - key: topic
+//	    regex: __.*
+type Config struct {
+	// NameTemplate builds the metric name with {domain}, {attr} and the {key} properties placeholders.
+	// Key properties used in the template are not reported as labels. Missing placeholders are removed
+	// together with their duplicated separators, e.g. a.{missing}.b results in a.b
+	NameTemplate string `yaml:"name_template"`
+	// Rules rename, extract or drop the key properties. Key properties without rule are reported as they are.
+	Rules []*Rule `yaml:"rules"`
+	// Drop skips the samples whose domain, attr or key property fully matches the regex.
+	Drop []*DropRule `yaml:"drop"`
+}
+
+// Rule maps a key property into labels.
+type Rule struct {
+	Key string `yaml:"key"`
+	// Rename is the label name, the key is used when empty.
+	Rename string `yaml:"rename"`
+	// Regex extracts the label value from the key property value: the first capture group or the whole match.
+	// When it has named groups each of them is reported as a label instead. Values not matching are kept.
+	Regex string `yaml:"regex"`
+	// Drop removes the key property from the labels.
+	Drop bool `yaml:"drop"`
+}
+
+// DropRule skips the samples whose Key value fully matches the Regex.
+type DropRule struct {
+	// Key is a key property, domain or attr.
+	Key   string `yaml:"key"`
+	Regex string `yaml:"regex"`
+}
+
+// Sample is an attribute response with its labels and metric name.
+type Sample struct {
+	Name      string
+	MBean     string
+	Attribute string
+	Labels    map[string]string
+	// Response is the original attribute response, it can be an error response.
+	Response *gojmx.AttributeResponse
+}
+
+// Mapper maps the attribute responses into samples.
+type Mapper struct {
+	template     string
+	templateKeys map[string]bool
+	rules        map[string]*rule
+	drops        []*dropRule
+}
+
+type rule struct {
+	*Rule
+	regex *regexp.Regexp
+	names []string
+}
+
+type dropRule struct {
+	key   string
+	regex *regexp.Regexp
+}
+
+var placeholder = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// New validates the config and returns its Mapper.
+func New(config *Config) (*Mapper, error) {
+	if config == nil {
+		config = &Config{}
+	}
+
+	m := &Mapper{
+		template:     config.NameTemplate,
+		templateKeys: map[string]bool{},
+		rules:        map[string]*rule{},
+	}
+	if m.template == "" {
+		m.template = DefaultNameTemplate
+	}
+	for _, match := range placeholder.FindAllStringSubmatch(m.template, -1) {
+		m.templateKeys[match[1]] = true
+	}
+
+	for i, r := range config.Rules {
+		if r == nil || r.Key == "" {
+			return nil, fmt.Errorf("rule %d: key is required", i)
+		}
+		if _, exists := m.rules[r.Key]; exists {
+			return nil, fmt.Errorf("rule %d: duplicated key: '%s'", i, r.Key)
+		}
+
+		compiled := &rule{Rule: r}
+		if r.Regex != "" {
+			regex, err := regexp.Compile(r.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: invalid regex: '%s', %w", i, r.Regex, err)
+			}
+			compiled.regex = regex
+			for _, name := range regex.SubexpNames() {
+				if name != "" {
+					compiled.names = append(compiled.names, name)
+				}
+			}
+		}
+		m.rules[r.Key] = compiled
+	}
+
+	for i, d := range config.Drop {
+		if d == nil || d.Key == "" {
+			return nil, fmt.Errorf("drop rule %d: key is required", i)
+		}
+		regex, err := regexp.Compile("^(?:" + d.Regex + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("drop rule %d: invalid regex: '%s', %w", i, d.Regex, err)
+		}
+		m.drops = append(m.drops, &dropRule{key: d.Key, regex: regex})
+	}
+
+	return m, nil
+}
+
+// Map returns the sample for an attribute response, false when the sample is dropped
+// or the response name cannot be parsed.
+func (m *Mapper) Map(response *gojmx.AttributeResponse) (*Sample, bool) {
+	if response == nil {
+		return nil, false
+	}
+
+	mBeanName, attrName, ok := gojmx.SplitAttributeName(response.Name)
+	if !ok {
+		return nil, false
+	}
+	objectName, err := gojmx.ParseObjectName(mBeanName)
+	if err != nil {
+		return nil, false
+	}
+
+	values := map[string]string{
+		DomainKey: objectName.Domain,
+		AttrKey:   attrName,
+	}
+	for _, key := range objectName.Keys() {
+		values[key] = objectName.Get(key)
+	}
+
+	for _, d := range m.drops {
+		if value, ok := values[d.key]; ok && d.regex.MatchString(value) {
+			return nil, false
+		}
+	}
+
+	sample := &Sample{
+		MBean:     mBeanName,
+		Attribute: attrName,
+		Labels:    map[string]string{},
+		Response:  response,
+	}
+
+	for _, key := range objectName.Keys() {
+		if m.templateKeys[key] {
+			continue
+		}
+		m.addLabels(sample.Labels, key, objectName.Get(key))
+	}
+
+	sample.Name = m.expandName(values)
+	return sample, true
+}
+
+// MapAll maps the attribute responses skipping the dropped ones.
+func (m *Mapper) MapAll(responses []*gojmx.AttributeResponse) []*Sample {
+	result := make([]*Sample, 0, len(responses))
+	for _, response := range responses {
+		if sample, ok := m.Map(response); ok {
+			result = append(result, sample)
+		}
+	}
+	return result
+}
+
+func (m *Mapper) addLabels(labels map[string]string, key, value string) {
+	r, ok := m.rules[key]
+	if !ok {
+		labels[key] = value
+		return
+	}
+	if r.Drop {
+		return
+	}
+
+	name := r.Key
+	if r.Rename != "" {
+		name = r.Rename
+	}
+
+	if r.regex == nil {
+		labels[name] = value
+		return
+	}
+
+	match := r.regex.FindStringSubmatch(value)
+	switch {
+	case match == nil:
+		labels[name] = value
+	case len(r.names) > 0:
+		for i, group := range r.regex.SubexpNames() {
+			if group != "" {
+				labels[group] = match[i]
+			}
+		}
+	case len(match) > 1:
+		labels[name] = match[1]
+	default:
+		labels[name] = match[0]
+	}
+}
+
+// expandName replaces the template placeholders. The separators of the template left duplicated, leading or
+// trailing by empty values are removed, the separators of the values are kept.
+func (m *Mapper) expandName(values map[string]string) string {
+	var name []rune
+	// literal tells whether each rune of the name comes from the template.
+	var literal []bool
+	// skipped is true when an empty value was found after the last non-separator rune.
+	skipped := false
+
+	appendLiteral := func(text string) {
+		for _, c := range text {
+			last := len(name) - 1
+			if skipped && isSeparator(c) && (last < 0 || (literal[last] && isSeparator(name[last]))) {
+				continue
+			}
+			name = append(name, c)
+			literal = append(literal, true)
+			if !isSeparator(c) {
+				skipped = false
+			}
+		}
+	}
+
+	start := 0
+	for _, loc := range placeholder.FindAllStringSubmatchIndex(m.template, -1) {
+		appendLiteral(m.template[start:loc[0]])
+		start = loc[1]
+
+		value := values[m.template[loc[2]:loc[3]]]
+		if value == "" {
+			skipped = true
+			continue
+		}
+		for _, c := range value {
+			name = append(name, c)
+			literal = append(literal, false)
+		}
+		skipped = false
+	}
+	appendLiteral(m.template[start:])
+
+	if skipped {
+		for len(name) > 0 && literal[len(name)-1] && isSeparator(name[len(name)-1]) {
+			name = name[:len(name)-1]
+		}
+	}
+	return string(name)
+}
+
+func isSeparator(c rune) bool {
+	return c == '.' || c == '_' || c == '-' || c == '/'
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_Map(t *testing.T) {
+	config := &Config{}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+name_template: "{domain}.{type}.{name}.{attr}"
+rules:
+  - key: topic
+    rename: kafka_topic
+  - key: clientId
+    regex: consumer-(\d+)
+    rename: consumer
+  - key: request
+    regex: (?P<api>[A-Za-z]+)V(?P<version>\d+)
+  - key: partition
+    drop: true
+drop:
+  - key: topic
+    regex: __.*
+  - key: attr
+    regex: .*Rate
+`), config))
+
+	m, err := New(config)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		response string
+		expected *Sample
+	}{
+		{
+			name:     "Rename",
+			response: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=orders,partition=1,attr=Count",
+			expected: &Sample{
+				Name:      "kafka.server.BrokerTopicMetrics.MessagesInPerSec.Count",
+				MBean:     "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=orders,partition=1",
+				Attribute: "Count",
+				Labels:    map[string]string{"kafka_topic": "orders"},
+			},
+		},
+		{
+			name:     "Regex Capture",
+			response: "kafka.consumer:type=consumer-fetch-manager-metrics,clientId=consumer-12,attr=records-lag-max",
+			expected: &Sample{
+				Name:      "kafka.consumer.consumer-fetch-manager-metrics.records-lag-max",
+				MBean:     "kafka.consumer:type=consumer-fetch-manager-metrics,clientId=consumer-12",
+				Attribute: "records-lag-max",
+				Labels:    map[string]string{"consumer": "12"},
+			},
+		},
+		{
+			name:     "Regex Named Groups",
+			response: "kafka.network:type=RequestMetrics,name=RequestsPerSec,request=FetchV12,attr=Count",
+			expected: &Sample{
+				Name:      "kafka.network.RequestMetrics.RequestsPerSec.Count",
+				MBean:     "kafka.network:type=RequestMetrics,name=RequestsPerSec,request=FetchV12",
+				Attribute: "Count",
+				Labels:    map[string]string{"api": "Fetch", "version": "12"},
+			},
+		},
+		{
+			name:     "Regex Not Matching",
+			response: "kafka.network:type=RequestMetrics,name=RequestsPerSec,request=Produce,attr=Count",
+			expected: &Sample{
+				Name:      "kafka.network.RequestMetrics.RequestsPerSec.Count",
+				MBean:     "kafka.network:type=RequestMetrics,name=RequestsPerSec,request=Produce",
+				Attribute: "Count",
+				Labels:    map[string]string{"request": "Produce"},
+			},
+		},
+		{
+			name:     "Missing Template Key",
+			response: "java.lang:type=Memory,attr=HeapMemoryUsage.Used",
+			expected: &Sample{
+				Name:      "java.lang.Memory.HeapMemoryUsage.Used",
+				MBean:     "java.lang:type=Memory",
+				Attribute: "HeapMemoryUsage.Used",
+				Labels:    map[string]string{},
+			},
+		},
+		{
+			name:     "Dropped By Key Property",
+			response: "kafka.log:type=Log,name=Size,topic=__consumer_offsets,partition=0,attr=Value",
+		},
+		{
+			name:     "Dropped By Attribute",
+			response: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=orders,attr=OneMinuteRate",
+		},
+		{
+			name:     "Invalid Name",
+			response: "kafka.server:type=BrokerTopicMetrics",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := &gojmx.AttributeResponse{Name: testCase.response, ResponseType: nrprotocol.ResponseType_INT, IntValue: 1}
+
+			sample, ok := m.Map(response)
+			if testCase.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+
+			testCase.expected.Response = response
+			assert.Equal(t, testCase.expected, sample)
+		})
+	}
+}
+
+func Test_Map_NameSeparators(t *testing.T) {
+	m, err := New(&Config{NameTemplate: "{domain}.{type}.{topic}_{attr}"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		response string
+		expected string
+	}{
+		{"kafka.log:type=Log,topic=__consumer_offsets,attr=Size", "kafka.log.Log.__consumer_offsets_Size"},
+		{"kafka.log:type=Log,topic=-orders.v2-,attr=Size", "kafka.log.Log.-orders.v2-_Size"},
+		{"kafka.log:topic=orders,attr=Size", "kafka.log.orders_Size"},
+		{"kafka.log:type=Log,attr=Size", "kafka.log.Log.Size"},
+		{"kafka.log:type=_Log_,attr=Size", "kafka.log._Log_.Size"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.response, func(t *testing.T) {
+			sample, ok := m.Map(&gojmx.AttributeResponse{Name: testCase.response, ResponseType: nrprotocol.ResponseType_INT, IntValue: 1})
+			require.True(t, ok)
+			assert.Equal(t, testCase.expected, sample.Name)
+		})
+	}
+
+	m, err = New(&Config{NameTemplate: "{missing}.{domain}.{type}.{topic}"})
+	require.NoError(t, err)
+	sample, ok := m.Map(&gojmx.AttributeResponse{Name: "kafka.log:type=Log,attr=Size", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1})
+	require.True(t, ok)
+	assert.Equal(t, "kafka.log.Log", sample.Name)
+}
+
+func Test_MapAll_DefaultTemplate(t *testing.T) {
+	m, err := New(nil)
+	require.NoError(t, err)
+
+	samples := m.MapAll([]*gojmx.AttributeResponse{
+		{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1},
+		{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=LastGcInfo", ResponseType: nrprotocol.ResponseType_ERROR},
+		{Name: "invalid", ResponseType: nrprotocol.ResponseType_INT},
+	})
+
+	require.Len(t, samples, 2)
+	assert.Equal(t, "java.lang.CollectionCount", samples[0].Name)
+	assert.Equal(t, map[string]string{"type": "GarbageCollector", "name": "G1 Young Generation"}, samples[0].Labels)
+	assert.Equal(t, gojmx.ResponseTypeErr, samples[1].Response.ResponseType)
+}
+
+func Test_New_Validation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *Config
+		errMsg string
+	}{
+		{
+			name:   "Missing Key",
+			config: &Config{Rules: []*Rule{{Rename: "a"}}},
+			errMsg: "rule 0: key is required",
+		},
+		{
+			name:   "Duplicated Key",
+			config: &Config{Rules: []*Rule{{Key: "a"}, {Key: "a", Drop: true}}},
+			errMsg: "rule 1: duplicated key: 'a'",
+		},
+		{
+			name:   "Invalid Regex",
+			config: &Config{Rules: []*Rule{{Key: "a", Regex: "(a"}}},
+			errMsg: "rule 0: invalid regex: '(a'",
+		},
+		{
+			name:   "Invalid Drop Regex",
+			config: &Config{Drop: []*DropRule{{Key: "a", Regex: "(a"}}},
+			errMsg: "drop rule 0: invalid regex: '(a'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := New(testCase.config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.errMsg)
+		})
+	}
+}
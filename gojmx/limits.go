@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// QueryLimit identifies the cardinality limit of the JMXConfig that truncated a query.
+type QueryLimit nrprotocol.QueryLimit
+
+var (
+	// QueryLimitMBeansPerPattern the pattern matched more mBeans than JMXConfig.MaxMBeansPerPattern
+	QueryLimitMBeansPerPattern = nrprotocol.QueryLimit_MBEANS_PER_PATTERN
+	// QueryLimitAttributesPerMBean the mBean has more attributes than JMXConfig.MaxAttributesPerMBean
+	QueryLimitAttributesPerMBean = nrprotocol.QueryLimit_ATTRIBUTES_PER_MBEAN
+	// QueryLimitResponsesPerCall the call returned more responses than JMXConfig.MaxResponsesPerCall
+	QueryLimitResponsesPerCall = nrprotocol.QueryLimit_RESPONSES_PER_CALL
+)
+
+// Truncation reports a query truncated by the cardinality limits of the JMXConfig.
+// MBeanName is the query pattern for the mBeans and responses limits and the mBean name for the attributes limit.
+// Dropped contains up to 100 of the dropped mBean, attribute or response names, DroppedCount has the total.
+// For the responses limit, the mBeans that were not fetched are reported with the dropped responses.
+// nrjmx keeps up to 1000 truncations between calls, EvictedBefore counts the ones discarded right before this one.
+type Truncation nrprotocol.Truncation
+
+func (t *Truncation) String() string {
+	if t == nil {
+		return "<nil>"
+	}
+	s := fmt.Sprintf("Limit: %s (%d), MBean: '%s', Dropped: %d",
+		t.Limit,
+		t.LimitValue,
+		t.MBeanName,
+		t.DroppedCount,
+	)
+	if t.EvictedBefore > 0 {
+		s += fmt.Sprintf(", EvictedBefore: %d", t.EvictedBefore)
+	}
+	return s
+}
+
+func toTruncationList(in []*nrprotocol.Truncation) []*Truncation {
+	return *(*[]*Truncation)(unsafe.Pointer(&in))
+}
+
+// GetTruncations returns the queries truncated since the previous call by the cardinality limits:
+// JMXConfig.MaxMBeansPerPattern, JMXConfig.MaxAttributesPerMBean and JMXConfig.MaxResponsesPerCall.
+// The limits are enforced by nrjmx before the values are fetched. When any limit is configured the mBeans are
+// sorted by name, so the same ones are kept in every collection. It returns an empty list when there are no
+// limits configured.
+func (c *Client) GetTruncations() ([]*Truncation, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetTruncations(c.ctx)
+	return toTruncationList(result), c.handleError(err)
+}
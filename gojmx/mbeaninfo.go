@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// Impact values of an MBeanOperationInfo, as defined by javax.management.MBeanOperationInfo.
+const (
+	OperationImpactInfo       int32 = 0
+	OperationImpactAction     int32 = 1
+	OperationImpactActionInfo int32 = 2
+	OperationImpactUnknown    int32 = 3
+)
+
+// MBeanInfo is the metadata of an mBean: its attributes, operations and their descriptions.
+type MBeanInfo nrprotocol.MBeanInfo
+
+// MBeanAttributeInfo describes an mBean attribute.
+type MBeanAttributeInfo nrprotocol.MBeanAttributeInfo
+
+// MBeanOperationInfo describes an mBean operation.
+type MBeanOperationInfo nrprotocol.MBeanOperationInfo
+
+// MBeanParameterInfo describes a parameter of an mBean operation.
+type MBeanParameterInfo nrprotocol.MBeanParameterInfo
+
+func toMBeanAttributeInfoList(in []*nrprotocol.MBeanAttributeInfo) []*MBeanAttributeInfo {
+	return *(*[]*MBeanAttributeInfo)(unsafe.Pointer(&in))
+}
+
+func toMBeanOperationInfoList(in []*nrprotocol.MBeanOperationInfo) []*MBeanOperationInfo {
+	return *(*[]*MBeanOperationInfo)(unsafe.Pointer(&in))
+}
+
+func toMBeanParameterInfoList(in []*nrprotocol.MBeanParameterInfo) []*MBeanParameterInfo {
+	return *(*[]*MBeanParameterInfo)(unsafe.Pointer(&in))
+}
+
+// GetAttributes returns the mBean attributes.
+func (m *MBeanInfo) GetAttributes() []*MBeanAttributeInfo {
+	if m == nil {
+		return nil
+	}
+	return toMBeanAttributeInfoList(m.Attributes)
+}
+
+// GetOperations returns the mBean operations.
+func (m *MBeanInfo) GetOperations() []*MBeanOperationInfo {
+	if m == nil {
+		return nil
+	}
+	return toMBeanOperationInfoList(m.Operations)
+}
+
+// GetAttribute returns the attribute with the given name or nil when the mBean doesn't have it.
+func (m *MBeanInfo) GetAttribute(name string) *MBeanAttributeInfo {
+	for _, attr := range m.GetAttributes() {
+		if attr != nil && attr.Name == name {
+			return attr
+		}
+	}
+	return nil
+}
+
+func (m *MBeanInfo) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Name: '%s', ClassName: '%s', Attributes: %d, Operations: %d",
+		m.Name,
+		m.ClassName,
+		len(m.Attributes),
+		len(m.Operations),
+	)
+}
+
+func (a *MBeanAttributeInfo) String() string {
+	if a == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Name: '%s', Type: '%s', Readable: %t, Writable: %t", a.Name, a.Type, a.Readable, a.Writable)
+}
+
+// GetSignature returns the operation parameters.
+func (o *MBeanOperationInfo) GetSignature() []*MBeanParameterInfo {
+	if o == nil {
+		return nil
+	}
+	return toMBeanParameterInfoList(o.Signature)
+}
+
+func (o *MBeanOperationInfo) String() string {
+	if o == nil {
+		return "<nil>"
+	}
+	params := make([]string, 0, len(o.Signature))
+	for _, p := range o.GetSignature() {
+		params = append(params, p.String())
+	}
+	return fmt.Sprintf("%s %s(%s)", o.ReturnType, o.Name, strings.Join(params, ", "))
+}
+
+func (p *MBeanParameterInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s %s", p.Type, p.Name)
+}
+
+// InvokeOperation executes an mBean operation, e.g. InvokeOperation("java.lang:type=Memory", "gc").
+// The arguments are converted by nrjmx from their string representation to the operation parameter types,
+// only primitive types, their wrappers and strings are supported. The operation is resolved by its name and number
+// of arguments, use InvokeOperationWithSignature when the mBean has overloaded operations with the same number.
+// The result is returned using the same format as the attributes, named after the operation, e.g.
+// "java.lang:type=Threading,attr=getThreadCpuTime". Void operations return an empty list.
+func (c *Client) InvokeOperation(mBeanName, operationName string, args ...string) ([]*AttributeResponse, error) {
+	return c.InvokeOperationWithSignature(mBeanName, operationName, args, nil)
+}
+
+// InvokeOperationWithSignature executes an mBean operation providing the fully qualified parameter types,
+// e.g. []string{"long"} or []string{"java.lang.String", "boolean"}. When signature is empty it's resolved from the
+// MBeanInfo, as InvokeOperation does.
+func (c *Client) InvokeOperationWithSignature(mBeanName, operationName string, args []string, signature []string) ([]*AttributeResponse, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	if len(signature) > 0 && len(signature) != len(args) {
+		return nil, fmt.Errorf("invalid signature for operation '%s': %d types for %d arguments", operationName, len(signature), len(args))
+	}
+
+	result, err := c.jmxService.InvokeOperation(c.ctx, mBeanName, operationName, args, signature)
+	return toAttributeResponseList(result), c.handleError(err)
+}
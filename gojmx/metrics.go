@@ -0,0 +1,132 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unsafe"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// InternalMetric keeps the cumulative count, errors and latencies of the nrjmx calls of a StatType.
+// BucketCounts has the calls per latency bucket, with a bucket for each of the BucketBoundsMs upper bounds
+// and a last one for the calls over the largest bound.
+type InternalMetric nrprotocol.InternalMetric
+
+func (m *InternalMetric) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StatType: '%s', Count: %d, Errors: %d, Total: %.3fms, Avg: %.3fms, P99: %.3fms, Max: %.3fms",
+		m.StatType,
+		m.Count,
+		m.Errors,
+		m.TotalMs,
+		m.AverageMs(),
+		m.PercentileMs(99),
+		m.MaxMs,
+	)
+}
+
+// ErrorRate returns the ratio of failed calls, between 0 and 1.
+func (m *InternalMetric) ErrorRate() float64 {
+	if m == nil || m.Count == 0 {
+		return 0
+	}
+	return float64(m.Errors) / float64(m.Count)
+}
+
+// AverageMs returns the average latency of the calls.
+func (m *InternalMetric) AverageMs() float64 {
+	if m == nil || m.Count == 0 {
+		return 0
+	}
+	return m.TotalMs / float64(m.Count)
+}
+
+// PercentileMs estimates the latency percentile from the histogram, e.g. 99 for the p99. It returns the upper
+// bound of the bucket containing the percentile, limited to MaxMs.
+func (m *InternalMetric) PercentileMs(p float64) float64 {
+	if m == nil || m.Count == 0 {
+		return 0
+	}
+
+	rank := int64(math.Ceil(p / 100 * float64(m.Count)))
+	if rank < 1 {
+		rank = 1
+	}
+
+	var calls int64
+	for i, count := range m.BucketCounts {
+		calls += count
+		if calls >= rank && i < len(m.BucketBoundsMs) {
+			return math.Min(m.BucketBoundsMs[i], m.MaxMs)
+		}
+	}
+	return m.MaxMs
+}
+
+// InternalMetrics are the cumulative nrjmx call metrics per StatType since the client connected and the state
+// of the InternalStat samples buffer. EvictedSamples counts the samples discarded because the buffer reached
+// JMXConfig.MaxInternalStatsSize before they were retrieved with GetInternalStats.
+type InternalMetrics nrprotocol.InternalMetrics
+
+// Start returns the time since the metrics are collected.
+func (m *InternalMetrics) Start() time.Time {
+	return time.UnixMilli(m.StartTimestamp)
+}
+
+// GetMetrics returns the metrics per StatType sorted by StatType.
+func (m *InternalMetrics) GetMetrics() []*InternalMetric {
+	if m == nil {
+		return nil
+	}
+	return *(*[]*InternalMetric)(unsafe.Pointer(&m.Metrics))
+}
+
+// GetMetric returns the metric of the StatType or nil when there were no calls of that type.
+func (m *InternalMetrics) GetMetric(statType string) *InternalMetric {
+	for _, metric := range m.GetMetrics() {
+		if metric.StatType == statType {
+			return metric
+		}
+	}
+	return nil
+}
+
+func (m *InternalMetrics) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	var calls, errors int64
+	for _, metric := range m.Metrics {
+		calls += metric.Count
+		errors += metric.Errors
+	}
+	return fmt.Sprintf("Since: '%s', TotalCalls: %d, TotalErrors: %d, SamplesEnabled: %t, BufferedSamples: %d/%d, EvictedSamples: %d",
+		m.Start().Format(time.RFC3339),
+		calls,
+		errors,
+		m.SamplesEnabled,
+		m.BufferedSamples,
+		m.MaxSamples,
+		m.EvictedSamples,
+	)
+}
+
+// GetInternalMetrics returns the cumulative metrics of the nrjmx calls per StatType. Unlike GetInternalStats,
+// they are always collected, don't require JMXConfig.EnableInternalStats and are not reset when retrieved,
+// so they can be read by several consumers. They are kept when nrjmx reconnects to the JMX server.
+func (c *Client) GetInternalMetrics() (*InternalMetrics, error) {
+	if err := c.checkNRJMXProccessError(); err != nil {
+		return nil, err
+	}
+	result, err := c.jmxService.GetInternalMetrics(c.ctx)
+	return (*InternalMetrics)(result), c.handleError(err)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InternalMetrics(t *testing.T) {
+	start := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	metrics := &InternalMetrics{
+		StartTimestamp: start.UnixMilli(),
+		Metrics: []*nrprotocol.InternalMetric{
+			{
+				StatType:       "getAttributes",
+				Count:          100,
+				Errors:         5,
+				TotalMs:        1000,
+				MaxMs:          400,
+				BucketBoundsMs: []float64{1, 10, 100},
+				BucketCounts:   []int64{50, 40, 8, 2},
+			},
+			{
+				StatType:       "queryMBeans",
+				Count:          2,
+				TotalMs:        3,
+				MaxMs:          2,
+				BucketBoundsMs: []float64{1, 10, 100},
+				BucketCounts:   []int64{1, 1, 0, 0},
+			},
+		},
+		SamplesEnabled:  true,
+		MaxSamples:      10,
+		BufferedSamples: 10,
+		EvictedSamples:  92,
+	}
+
+	assert.Equal(t, start, metrics.Start().UTC())
+	assert.Len(t, metrics.GetMetrics(), 2)
+	assert.Nil(t, metrics.GetMetric("connect"))
+	assert.Equal(t, "Since: '2026-10-01T10:00:00Z', TotalCalls: 102, TotalErrors: 5, SamplesEnabled: true, BufferedSamples: 10/10, EvictedSamples: 92",
+		metrics.String())
+
+	getAttributes := metrics.GetMetric("getAttributes")
+	assert.Equal(t, 0.05, getAttributes.ErrorRate())
+	assert.Equal(t, 10.0, getAttributes.AverageMs())
+
+	testCases := []struct {
+		percentile float64
+		expected   float64
+	}{
+		{0, 1},
+		{50, 1},
+		{51, 10},
+		{90, 10},
+		{98, 100},
+		// The calls over the largest bound are reported with the maximum.
+		{99, 400},
+		{100, 400},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, getAttributes.PercentileMs(tc.percentile), "p%v", tc.percentile)
+	}
+
+	// The percentiles are limited to the maximum latency.
+	assert.Equal(t, 2.0, metrics.GetMetric("queryMBeans").PercentileMs(99))
+	assert.Equal(t, "StatType: 'queryMBeans', Count: 2, Errors: 0, Total: 3.000ms, Avg: 1.500ms, P99: 2.000ms, Max: 2.000ms",
+		metrics.GetMetric("queryMBeans").String())
+
+	var empty *InternalMetric
+	assert.Equal(t, 0.0, empty.PercentileMs(50))
+	assert.Equal(t, "<nil>", empty.String())
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// attrSeparator separates the mBean name from the attribute name in AttributeResponse.Name.
+const attrSeparator = ",attr="
+
+// ObjectName is a parsed JMX ObjectName with the format DOMAIN:key=value[,key=value]*
+type ObjectName struct {
+	Domain string
+	// Properties keeps the key properties. Quoted values are unquoted.
+	Properties map[string]string
+	// keys keeps the order in which the key properties were declared.
+	keys []string
+}
+
+// ParseObjectName parses a JMX ObjectName, e.g. java.lang:type=GarbageCollector,name=G1 Young Generation
+func ParseObjectName(name string) (*ObjectName, error) {
+	i := strings.Index(name, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("cannot parse mBean name: '%s', valid: 'DOMAIN:BEAN'", name)
+	}
+
+	objectName := &ObjectName{
+		Domain:     name[:i],
+		Properties: map[string]string{},
+	}
+
+	rest := name[i+1:]
+	for len(rest) > 0 {
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			if rest == "*" {
+				break
+			}
+			return nil, fmt.Errorf("cannot parse mBean name: '%s', missing value for key property: '%s'", name, rest)
+		}
+
+		key := rest[:eq]
+		value, remaining, err := parsePropertyValue(rest[eq+1:])
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse mBean name: '%s', %v", name, err)
+		}
+
+		if _, exists := objectName.Properties[key]; !exists {
+			objectName.keys = append(objectName.keys, key)
+		}
+		objectName.Properties[key] = value
+
+		rest = strings.TrimPrefix(remaining, ",")
+	}
+
+	return objectName, nil
+}
+
+// parsePropertyValue reads a key property value, handling the quoted ones,
+// and returns the remaining of the key properties list.
+func parsePropertyValue(in string) (value, remaining string, err error) {
+	if !strings.HasPrefix(in, `"`) {
+		end := strings.Index(in, ",")
+		if end < 0 {
+			return in, "", nil
+		}
+		return in[:end], in[end:], nil
+	}
+
+	sb := strings.Builder{}
+	for i := 1; i < len(in); i++ {
+		switch in[i] {
+		case '\\':
+			if i+1 >= len(in) {
+				return "", "", fmt.Errorf("invalid escape at the end of quoted value: '%s'", in)
+			}
+			i++
+			if in[i] == 'n' {
+				sb.WriteByte('\n')
+			} else {
+				sb.WriteByte(in[i])
+			}
+		case '"':
+			return sb.String(), in[i+1:], nil
+		default:
+			sb.WriteByte(in[i])
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quoted value: '%s'", in)
+}
+
+// Get returns the value of a key property or an empty string when not defined.
+func (o *ObjectName) Get(key string) string {
+	if o == nil {
+		return ""
+	}
+	return o.Properties[key]
+}
+
+// Keys returns the key properties names in the order they were declared.
+func (o *ObjectName) Keys() []string {
+	if o == nil {
+		return nil
+	}
+	return append([]string(nil), o.keys...)
+}
+
+func (o *ObjectName) String() string {
+	if o == nil {
+		return "<nil>"
+	}
+
+	props := make([]string, 0, len(o.keys))
+	for _, key := range o.keys {
+		props = append(props, fmt.Sprintf("%s=%s", key, o.Properties[key]))
+	}
+	return fmt.Sprintf("%s:%s", o.Domain, strings.Join(props, ","))
+}
+
+// SplitAttributeName splits the AttributeResponse.Name with the format DOMAIN:BEAN,attr=ATTRIBUTE
+// into the mBean name and the attribute name. Composite attributes keep the field, e.g. HeapMemoryUsage.Used
+func SplitAttributeName(name string) (mBeanName, attrName string, ok bool) {
+	i := strings.LastIndex(name, attrSeparator)
+	if i < 0 {
+		return "", "", false
+	}
+	return name[:i], name[i+len(attrSeparator):], true
+}
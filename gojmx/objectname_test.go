@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseObjectName(t *testing.T) {
+	testCases := []struct {
+		name          string
+		objectName    string
+		domain        string
+		keys          []string
+		properties    map[string]string
+		errorExpected bool
+	}{
+		{
+			name:       "Simple",
+			objectName: "java.lang:type=GarbageCollector,name=G1 Young Generation",
+			domain:     "java.lang",
+			keys:       []string{"type", "name"},
+			properties: map[string]string{"type": "GarbageCollector", "name": "G1 Young Generation"},
+		},
+		{
+			name:       "Quoted value",
+			objectName: `kafka.server:type=BrokerTopicMetrics,name="Messages,In\"PerSec",topic=orders`,
+			domain:     "kafka.server",
+			keys:       []string{"type", "name", "topic"},
+			properties: map[string]string{"type": "BrokerTopicMetrics", "name": `Messages,In"PerSec`, "topic": "orders"},
+		},
+		{
+			name:       "Pattern",
+			objectName: "java.lang:type=MemoryPool,*",
+			domain:     "java.lang",
+			keys:       []string{"type"},
+			properties: map[string]string{"type": "MemoryPool"},
+		},
+		{
+			name:          "Missing domain",
+			objectName:    "type=Cat",
+			errorExpected: true,
+		},
+		{
+			name:          "Unterminated quote",
+			objectName:    `test:name="tomas`,
+			errorExpected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			objectName, err := ParseObjectName(testCase.objectName)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.domain, objectName.Domain)
+			assert.Equal(t, testCase.keys, objectName.Keys())
+			assert.Equal(t, testCase.properties, objectName.Properties)
+		})
+	}
+}
+
+func Test_SplitAttributeName(t *testing.T) {
+	mBeanName, attrName, ok := SplitAttributeName("java.lang:type=Memory,attr=HeapMemoryUsage.Used")
+	assert.True(t, ok)
+	assert.Equal(t, "java.lang:type=Memory", mBeanName)
+	assert.Equal(t, "HeapMemoryUsage.Used", attrName)
+
+	_, _, ok = SplitAttributeName("java.lang:type=Memory")
+	assert.False(t, ok)
+}
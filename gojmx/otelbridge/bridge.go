@@ -0,0 +1,278 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package otelbridge registers OpenTelemetry asynchronous instruments backed by JMX attribute reads,
+// so gojmx can be plugged into the OpenTelemetry SDK metric pipelines.
+package otelbridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// InstrumentKind is the OpenTelemetry asynchronous instrument registered for an attribute.
+type InstrumentKind string
+
+const (
+	// KindGauge reports the attribute value as it is read, e.g. a heap usage.
+	KindGauge InstrumentKind = "gauge"
+	// KindCounter reports a monotonic cumulative attribute, e.g. a GC collection count.
+	KindCounter InstrumentKind = "counter"
+	// KindUpDownCounter reports a cumulative attribute that can decrease, e.g. a loaded class count.
+	KindUpDownCounter InstrumentKind = "updowncounter"
+)
+
+// Querier is the gojmx.Client functionality required to read the attributes.
+type Querier interface {
+	QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// Instrument maps an attribute of the mBeans matching a pattern into an OpenTelemetry instrument.
+// Each matching mBean is observed with its ObjectName key properties as attributes.
+type Instrument struct {
+	// Name, Description and Unit of the OpenTelemetry instrument.
+	Name        string
+	Description string
+	Unit        string
+	Kind        InstrumentKind
+	// MBean is the mBean name pattern, e.g. java.lang:type=GarbageCollector,name=*
+	MBean string
+	// Attribute is the attribute name, composite fields are separated by '.', e.g. HeapMemoryUsage.Used
+	// nrjmx capitalizes the composite fields, so they are matched case-insensitively.
+	Attribute string
+	// Scale multiplies the values, e.g. 0.001 for milliseconds to seconds. Zero means no scaling.
+	Scale float64
+	// KeyProperties limits the key properties reported as attributes, all of them when empty.
+	KeyProperties []string
+}
+
+// Bridge keeps the registered instruments and their callback.
+type Bridge struct {
+	querier      Querier
+	instruments  []*registeredInstrument
+	registration metric.Registration
+}
+
+type registeredInstrument struct {
+	*Instrument
+	observable metric.Float64Observable
+}
+
+// Register creates the instruments on the meter and registers a single callback reading all of them.
+// The attributes are retrieved with one query for each mBean pattern on every collection.
+func Register(meter metric.Meter, querier Querier, instruments ...*Instrument) (*Bridge, error) {
+	b := &Bridge{querier: querier}
+
+	for _, instrument := range instruments {
+		if instrument == nil {
+			continue
+		}
+		if instrument.MBean == "" || instrument.Attribute == "" {
+			return nil, fmt.Errorf("instrument '%s' requires mBean and attribute", instrument.Name)
+		}
+
+		observable, err := newObservable(meter, instrument)
+		if err != nil {
+			return nil, err
+		}
+		b.instruments = append(b.instruments, &registeredInstrument{Instrument: instrument, observable: observable})
+	}
+
+	if len(b.instruments) == 0 {
+		return nil, errors.New("at least one instrument is required")
+	}
+
+	observables := make([]metric.Observable, 0, len(b.instruments))
+	for _, instrument := range b.instruments {
+		observables = append(observables, instrument.observable)
+	}
+
+	registration, err := meter.RegisterCallback(b.observe, observables...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot register the instruments callback: %w", err)
+	}
+	b.registration = registration
+	return b, nil
+}
+
+func newObservable(meter metric.Meter, instrument *Instrument) (metric.Float64Observable, error) {
+	var observable metric.Float64Observable
+	var err error
+
+	switch instrument.Kind {
+	case KindGauge, "":
+		observable, err = meter.Float64ObservableGauge(instrument.Name,
+			metric.WithDescription(instrument.Description),
+			metric.WithUnit(instrument.Unit))
+	case KindCounter:
+		observable, err = meter.Float64ObservableCounter(instrument.Name,
+			metric.WithDescription(instrument.Description),
+			metric.WithUnit(instrument.Unit))
+	case KindUpDownCounter:
+		observable, err = meter.Float64ObservableUpDownCounter(instrument.Name,
+			metric.WithDescription(instrument.Description),
+			metric.WithUnit(instrument.Unit))
+	default:
+		return nil, fmt.Errorf("instrument '%s' has an invalid kind: '%s', valid: gauge, counter, updowncounter", instrument.Name, instrument.Kind)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create instrument '%s': %w", instrument.Name, err)
+	}
+	return observable, nil
+}
+
+// Unregister removes the callback, the instruments are not observed anymore.
+func (b *Bridge) Unregister() error {
+	return b.registration.Unregister()
+}
+
+// observe queries the mBean patterns and observes the instruments. Failing patterns are reported
+// to the SDK while the rest of the instruments are still observed.
+func (b *Bridge) observe(_ context.Context, observer metric.Observer) error {
+	var patterns []string
+	byPattern := map[string][]*registeredInstrument{}
+	for _, instrument := range b.instruments {
+		if _, ok := byPattern[instrument.MBean]; !ok {
+			patterns = append(patterns, instrument.MBean)
+		}
+		byPattern[instrument.MBean] = append(byPattern[instrument.MBean], instrument)
+	}
+
+	var errs []error
+	for _, pattern := range patterns {
+		instruments := byPattern[pattern]
+
+		// Composite attributes are requested by their name, nrjmx returns all their fields.
+		var attrs []string
+		requested := map[string]bool{}
+		for _, instrument := range instruments {
+			attr := baseAttributeName(instrument.Attribute)
+			if !requested[attr] {
+				requested[attr] = true
+				attrs = append(attrs, attr)
+			}
+		}
+
+		responses, err := b.querier.QueryMBeanAttributes(pattern, attrs...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cannot query '%s': %w", pattern, err))
+			continue
+		}
+
+		for _, response := range responses {
+			mBeanName, attrName, ok := gojmx.SplitAttributeName(response.Name)
+			if !ok || response.ResponseType == gojmx.ResponseTypeErr {
+				continue
+			}
+
+			value, err := response.GetValueAsFloat()
+			if err != nil {
+				continue
+			}
+
+			objectName, err := gojmx.ParseObjectName(mBeanName)
+			if err != nil {
+				continue
+			}
+
+			for _, instrument := range instruments {
+				if !matchesAttribute(instrument.Attribute, attrName) {
+					continue
+				}
+				observed := value
+				if instrument.Scale != 0 {
+					observed *= instrument.Scale
+				}
+				observer.ObserveFloat64(instrument.observable, observed, metric.WithAttributes(instrument.attributes(objectName)...))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// attributes returns the key properties of the mBean as instrument attributes.
+func (i *Instrument) attributes(objectName *gojmx.ObjectName) []attribute.KeyValue {
+	keys := i.KeyProperties
+	if len(keys) == 0 {
+		keys = objectName.Keys()
+	}
+
+	result := make([]attribute.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := objectName.Properties[key]; ok {
+			result = append(result, attribute.String(key, value))
+		}
+	}
+	return result
+}
+
+// matchesAttribute compares the instrument attribute with the response one. nrjmx returns the composite
+// fields with the first letter capitalized while the CompositeData keys are usually lowercase, so the
+// fields are compared case-insensitively.
+func matchesAttribute(want, got string) bool {
+	wantBase, wantField, wantComposite := strings.Cut(want, ".")
+	gotBase, gotField, gotComposite := strings.Cut(got, ".")
+	if wantBase != gotBase || wantComposite != gotComposite {
+		return false
+	}
+	return strings.EqualFold(wantField, gotField)
+}
+
+func baseAttributeName(attr string) string {
+	if i := strings.Index(attr, "."); i > 0 {
+		return attr[:i]
+	}
+	return attr
+}
+
+// Resource returns the OpenTelemetry resource describing the JMX connection, to be set on the MeterProvider.
+// The ServerInfo is optional, when provided the MBeanServer identification is added.
+func Resource(config *gojmx.JMXConfig, info *gojmx.ServerInfo) *resource.Resource {
+	var attrs []attribute.KeyValue
+
+	if config != nil {
+		if config.ConnectionURL != "" {
+			attrs = append(attrs, attribute.String("jmx.url", config.ConnectionURL))
+		} else {
+			attrs = append(attrs,
+				attribute.String("server.address", config.Hostname),
+				attribute.Int("server.port", int(config.Port)),
+			)
+		}
+		if config.UriPath != nil && *config.UriPath != "" {
+			attrs = append(attrs, attribute.String("jmx.uri_path", *config.UriPath))
+		}
+		attrs = append(attrs, attribute.String("service.instance.id", instanceID(config)))
+	}
+
+	if info != nil {
+		attrs = append(attrs,
+			attribute.String("jmx.mbean_server.id", info.MBeanServerId),
+			attribute.String("jmx.implementation.name", info.ImplementationName),
+			attribute.String("jmx.implementation.version", info.ImplementationVersion),
+			attribute.String("jmx.implementation.vendor", info.ImplementationVendor),
+		)
+	}
+
+	return resource.NewSchemaless(attrs...)
+}
+
+func instanceID(config *gojmx.JMXConfig) string {
+	if config.ConnectionURL != "" {
+		return config.ConnectionURL
+	}
+	return config.Hostname + ":" + strconv.Itoa(int(config.Port))
+}
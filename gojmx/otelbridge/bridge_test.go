@@ -0,0 +1,189 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package otelbridge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+type queryCall struct {
+	pattern string
+	attrs   []string
+}
+
+// fakeQuerier returns the configured responses for each mBean pattern and records the calls.
+type fakeQuerier struct {
+	responses map[string][]*gojmx.AttributeResponse
+	errors    map[string]error
+	calls     []queryCall
+}
+
+func (f *fakeQuerier) QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error) {
+	f.calls = append(f.calls, queryCall{pattern: mBeanNamePattern, attrs: mBeanAttrName})
+	if err, ok := f.errors[mBeanNamePattern]; ok {
+		return nil, err
+	}
+	return f.responses[mBeanNamePattern], nil
+}
+
+func Test_Register_Collect(t *testing.T) {
+	q := &fakeQuerier{
+		responses: map[string][]*gojmx.AttributeResponse{
+			"java.lang:type=Memory": {
+				{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1024},
+				{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Max", ResponseType: nrprotocol.ResponseType_INT, IntValue: 4096},
+			},
+			"java.lang:type=GarbageCollector,name=*": {
+				{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 10},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1500},
+				{Name: "java.lang:type=GarbageCollector,name=G1 Old Generation,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_ERROR},
+			},
+		},
+		errors: map[string]error{
+			"java.lang:type=ClassLoading": &gojmx.JMXError{Message: "can't find mBean"},
+		},
+	}
+
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("gojmx")
+
+	bridge, err := Register(meter, q,
+		&Instrument{Name: "jvm.memory.heap.used", Unit: "By", MBean: "java.lang:type=Memory", Attribute: "HeapMemoryUsage.Used"},
+		// The composite fields are matched case-insensitively.
+		&Instrument{Name: "jvm.memory.heap.max", Unit: "By", MBean: "java.lang:type=Memory", Attribute: "HeapMemoryUsage.max"},
+		&Instrument{
+			Name:          "jvm.gc.collections",
+			Kind:          KindCounter,
+			MBean:         "java.lang:type=GarbageCollector,name=*",
+			Attribute:     "CollectionCount",
+			KeyProperties: []string{"name"},
+		},
+		&Instrument{
+			Name:      "jvm.gc.time",
+			Unit:      "s",
+			Kind:      KindCounter,
+			MBean:     "java.lang:type=GarbageCollector,name=*",
+			Attribute: "CollectionTime",
+			Scale:     0.001,
+		},
+		&Instrument{Name: "jvm.classes.loaded", Kind: KindUpDownCounter, MBean: "java.lang:type=ClassLoading", Attribute: "LoadedClassCount"},
+	)
+	require.NoError(t, err)
+
+	// The failing patterns are reported while the rest of the instruments are observed
+	rm := metricdata.ResourceMetrics{}
+	err = reader.Collect(context.Background(), &rm)
+	assert.EqualError(t, err, "cannot query 'java.lang:type=ClassLoading': jmx error: can't find mBean, cause: , stacktrace: ")
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	// Each mBean pattern is queried once, composite attributes by their name.
+	assert.Equal(t, []queryCall{
+		{pattern: "java.lang:type=Memory", attrs: []string{"HeapMemoryUsage"}},
+		{pattern: "java.lang:type=GarbageCollector,name=*", attrs: []string{"CollectionCount", "CollectionTime"}},
+		{pattern: "java.lang:type=ClassLoading", attrs: []string{"LoadedClassCount"}},
+	}, q.calls)
+
+	young := attribute.NewSet(attribute.String("name", "G1 Young Generation"))
+	expected := []metricdata.Metrics{
+		{
+			Name: "jvm.memory.heap.used",
+			Unit: "By",
+			Data: metricdata.Gauge[float64]{
+				DataPoints: []metricdata.DataPoint[float64]{
+					{Attributes: attribute.NewSet(attribute.String("type", "Memory")), Value: 1024},
+				},
+			},
+		},
+		{
+			Name: "jvm.memory.heap.max",
+			Unit: "By",
+			Data: metricdata.Gauge[float64]{
+				DataPoints: []metricdata.DataPoint[float64]{
+					{Attributes: attribute.NewSet(attribute.String("type", "Memory")), Value: 4096},
+				},
+			},
+		},
+		{
+			Name: "jvm.gc.collections",
+			Data: metricdata.Sum[float64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[float64]{{Attributes: young, Value: 10}},
+			},
+		},
+		{
+			Name: "jvm.gc.time",
+			Unit: "s",
+			Data: metricdata.Sum[float64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[float64]{
+					{
+						Attributes: attribute.NewSet(attribute.String("type", "GarbageCollector"), attribute.String("name", "G1 Young Generation")),
+						Value:      1.5,
+					},
+				},
+			},
+		},
+	}
+
+	require.Len(t, rm.ScopeMetrics[0].Metrics, len(expected))
+	for i, m := range rm.ScopeMetrics[0].Metrics {
+		metricdatatest.AssertEqual(t, expected[i], m, metricdatatest.IgnoreTimestamp())
+	}
+
+	// AND the instruments are not observed after unregistering
+	require.NoError(t, bridge.Unregister())
+	q.calls = nil
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Empty(t, q.calls)
+}
+
+func Test_Register_Validation(t *testing.T) {
+	meter := sdkmetric.NewMeterProvider().Meter("gojmx")
+
+	_, err := Register(meter, &fakeQuerier{})
+	assert.EqualError(t, err, "at least one instrument is required")
+
+	_, err = Register(meter, &fakeQuerier{}, &Instrument{Name: "jvm.threads"})
+	assert.EqualError(t, err, "instrument 'jvm.threads' requires mBean and attribute")
+
+	_, err = Register(meter, &fakeQuerier{}, &Instrument{Name: "jvm.threads", Kind: "histogram", MBean: "java.lang:type=Threading", Attribute: "ThreadCount"})
+	assert.EqualError(t, err, "instrument 'jvm.threads' has an invalid kind: 'histogram', valid: gauge, counter, updowncounter")
+}
+
+func Test_Resource(t *testing.T) {
+	res := Resource(
+		&gojmx.JMXConfig{Hostname: "kafka-1", Port: 9999},
+		&gojmx.ServerInfo{MBeanServerId: "kafka-1_1", ImplementationName: "JMX", ImplementationVersion: "11.0.20", ImplementationVendor: "Oracle Corporation"},
+	)
+
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("server.address", "kafka-1"),
+		attribute.Int("server.port", 9999),
+		attribute.String("service.instance.id", "kafka-1:9999"),
+		attribute.String("jmx.mbean_server.id", "kafka-1_1"),
+		attribute.String("jmx.implementation.name", "JMX"),
+		attribute.String("jmx.implementation.version", "11.0.20"),
+		attribute.String("jmx.implementation.vendor", "Oracle Corporation"),
+	}, res.Attributes())
+
+	res = Resource(&gojmx.JMXConfig{ConnectionURL: "service:jmx:rmi:///jndi/rmi://kafka-1:9999/jmxrmi"}, nil)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("jmx.url", "service:jmx:rmi:///jndi/rmi://kafka-1:9999/jmxrmi"),
+		attribute.String("service.instance.id", "service:jmx:rmi:///jndi/rmi://kafka-1:9999/jmxrmi"),
+	}, res.Attributes())
+}
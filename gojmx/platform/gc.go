@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package platform
+
+import "strings"
+
+// GCGeneration classifies a garbage collector by the part of the heap it collects.
+type GCGeneration string
+
+const (
+	GCGenerationUnknown GCGeneration = "unknown"
+	// GCGenerationYoung collectors only collect the young (nursery) generation.
+	GCGenerationYoung GCGeneration = "young"
+	// GCGenerationOld collectors collect the old generation or the whole heap.
+	GCGenerationOld GCGeneration = "old"
+)
+
+// gcGenerations maps the collector names used by HotSpot and OpenJ9 to their generation.
+var gcGenerations = map[string]GCGeneration{
+	// HotSpot
+	"Copy":                GCGenerationYoung,
+	"PS Scavenge":         GCGenerationYoung,
+	"ParNew":              GCGenerationYoung,
+	"G1 Young Generation": GCGenerationYoung,
+	"ZGC Minor Cycles":    GCGenerationYoung,
+	"ZGC Minor Pauses":    GCGenerationYoung,
+	"MarkSweepCompact":    GCGenerationOld,
+	"PS MarkSweep":        GCGenerationOld,
+	"ConcurrentMarkSweep": GCGenerationOld,
+	"G1 Old Generation":   GCGenerationOld,
+	"G1 Concurrent GC":    GCGenerationOld,
+	"ZGC Major Cycles":    GCGenerationOld,
+	"ZGC Major Pauses":    GCGenerationOld,
+	"ZGC Cycles":          GCGenerationOld,
+	"ZGC Pauses":          GCGenerationOld,
+	"Shenandoah Cycles":   GCGenerationOld,
+	"Shenandoah Pauses":   GCGenerationOld,
+	// OpenJ9
+	"scavenge":               GCGenerationYoung,
+	"partial gc":             GCGenerationYoung,
+	"global":                 GCGenerationOld,
+	"global garbage collect": GCGenerationOld,
+}
+
+// ClassifyGC returns the generation collected by a garbage collector given its name.
+func ClassifyGC(name string) GCGeneration {
+	if generation, ok := gcGenerations[name]; ok {
+		return generation
+	}
+	if generation, ok := gcGenerations[strings.ToLower(name)]; ok {
+		return generation
+	}
+	return GCGenerationUnknown
+}
+
+// GCSnapshot is a java.lang:type=GarbageCollector MXBean.
+type GCSnapshot struct {
+	Name       string
+	Generation GCGeneration
+	// CollectionCount is the cumulative number of collections.
+	CollectionCount int64
+	// CollectionTimeMs is the cumulative collection elapsed time in milliseconds.
+	CollectionTimeMs int64
+}
+
+// GarbageCollectors reads all the java.lang:type=GarbageCollector MXBeans, sorted by mBean name.
+func GarbageCollectors(q Querier) ([]*GCSnapshot, error) {
+	beans, err := queryBeans(q, "java.lang:type=GarbageCollector,name=*",
+		"CollectionCount", "CollectionTime")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*GCSnapshot, 0, len(beans))
+	for _, b := range beans {
+		name := b.name.Get("name")
+		result = append(result, &GCSnapshot{
+			Name:             name,
+			Generation:       ClassifyGC(name),
+			CollectionCount:  b.intValue("CollectionCount"),
+			CollectionTimeMs: b.intValue("CollectionTime"),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package platform
+
+// MemorySnapshot is the java.lang:type=Memory MXBean.
+type MemorySnapshot struct {
+	HeapMemoryUsage                MemoryUsage
+	NonHeapMemoryUsage             MemoryUsage
+	ObjectPendingFinalizationCount int64
+}
+
+// MemoryPoolSnapshot is a java.lang:type=MemoryPool MXBean.
+type MemoryPoolSnapshot struct {
+	Name string
+	// Type is HEAP or NON_HEAP.
+	Type      string
+	Valid     bool
+	Usage     MemoryUsage
+	PeakUsage MemoryUsage
+	// CollectionUsage is not supported by all the pools, in that case is left empty.
+	CollectionUsage MemoryUsage
+}
+
+// BufferPoolSnapshot is a java.nio:type=BufferPool MXBean.
+type BufferPoolSnapshot struct {
+	Name          string
+	Count         int64
+	MemoryUsed    int64
+	TotalCapacity int64
+}
+
+// Memory reads the java.lang:type=Memory MXBean.
+func Memory(q Querier) (*MemorySnapshot, error) {
+	b, err := queryBean(q, "java.lang:type=Memory",
+		"HeapMemoryUsage", "NonHeapMemoryUsage", "ObjectPendingFinalizationCount")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemorySnapshot{
+		HeapMemoryUsage:                b.memoryUsage("HeapMemoryUsage"),
+		NonHeapMemoryUsage:             b.memoryUsage("NonHeapMemoryUsage"),
+		ObjectPendingFinalizationCount: b.intValue("ObjectPendingFinalizationCount"),
+	}, nil
+}
+
+// MemoryPools reads all the java.lang:type=MemoryPool MXBeans, sorted by mBean name.
+func MemoryPools(q Querier) ([]*MemoryPoolSnapshot, error) {
+	beans, err := queryBeans(q, "java.lang:type=MemoryPool,name=*",
+		"Type", "Valid", "Usage", "PeakUsage", "CollectionUsage")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*MemoryPoolSnapshot, 0, len(beans))
+	for _, b := range beans {
+		result = append(result, &MemoryPoolSnapshot{
+			Name:            b.name.Get("name"),
+			Type:            b.stringValue("Type"),
+			Valid:           b.boolValue("Valid"),
+			Usage:           b.memoryUsage("Usage"),
+			PeakUsage:       b.memoryUsage("PeakUsage"),
+			CollectionUsage: b.memoryUsage("CollectionUsage"),
+		})
+	}
+	return result, nil
+}
+
+// BufferPools reads all the java.nio:type=BufferPool MXBeans (direct and mapped), sorted by mBean name.
+func BufferPools(q Querier) ([]*BufferPoolSnapshot, error) {
+	beans, err := queryBeans(q, "java.nio:type=BufferPool,name=*",
+		"Count", "MemoryUsed", "TotalCapacity")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*BufferPoolSnapshot, 0, len(beans))
+	for _, b := range beans {
+		result = append(result, &BufferPoolSnapshot{
+			Name:          b.name.Get("name"),
+			Count:         b.intValue("Count"),
+			MemoryUsed:    b.intValue("MemoryUsed"),
+			TotalCapacity: b.intValue("TotalCapacity"),
+		})
+	}
+	return result, nil
+}
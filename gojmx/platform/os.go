@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package platform
+
+// OperatingSystemSnapshot is the java.lang:type=OperatingSystem MXBean.
+// Most of the attributes are provided by the vendor extensions (com.sun.management and
+// com.ibm.lang.management), the ones not available in the monitored JVM are left empty.
+type OperatingSystemSnapshot struct {
+	Name                string
+	Arch                string
+	Version             string
+	AvailableProcessors int64
+	SystemLoadAverage   float64
+	// SystemCpuLoad and ProcessCpuLoad are in the [0.0,1.0] interval, negative when not available.
+	SystemCpuLoad  float64
+	ProcessCpuLoad float64
+	// ProcessCpuTimeNs is the cumulative CPU time used by the JVM process in nanoseconds.
+	ProcessCpuTimeNs           int64
+	TotalMemorySize            int64
+	FreeMemorySize             int64
+	TotalSwapSpaceSize         int64
+	FreeSwapSpaceSize          int64
+	CommittedVirtualMemorySize int64
+	OpenFileDescriptorCount    int64
+	MaxFileDescriptorCount     int64
+}
+
+// OperatingSystem reads the java.lang:type=OperatingSystem MXBean.
+func OperatingSystem(q Querier) (*OperatingSystemSnapshot, error) {
+	b, err := queryBean(q, "java.lang:type=OperatingSystem",
+		"Name", "Arch", "Version", "AvailableProcessors", "SystemLoadAverage",
+		// HotSpot renamed the system attributes on Java 14, OpenJ9 uses its own names.
+		"CpuLoad", "SystemCpuLoad", "ProcessCpuLoad",
+		"ProcessCpuTime", "ProcessCpuTimeByNS",
+		"TotalMemorySize", "TotalPhysicalMemorySize", "TotalPhysicalMemory",
+		"FreeMemorySize", "FreePhysicalMemorySize",
+		"TotalSwapSpaceSize", "FreeSwapSpaceSize",
+		"CommittedVirtualMemorySize", "ProcessVirtualMemorySize",
+		"OpenFileDescriptorCount", "MaxFileDescriptorCount")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &OperatingSystemSnapshot{
+		Name:                       b.stringValue("Name"),
+		Arch:                       b.stringValue("Arch"),
+		Version:                    b.stringValue("Version"),
+		AvailableProcessors:        b.intValue("AvailableProcessors"),
+		SystemLoadAverage:          b.floatValue("SystemLoadAverage"),
+		SystemCpuLoad:              -1,
+		ProcessCpuLoad:             -1,
+		TotalMemorySize:            b.intValue("TotalMemorySize", "TotalPhysicalMemorySize", "TotalPhysicalMemory"),
+		FreeMemorySize:             b.intValue("FreeMemorySize", "FreePhysicalMemorySize"),
+		TotalSwapSpaceSize:         b.intValue("TotalSwapSpaceSize"),
+		FreeSwapSpaceSize:          b.intValue("FreeSwapSpaceSize"),
+		CommittedVirtualMemorySize: b.intValue("CommittedVirtualMemorySize", "ProcessVirtualMemorySize"),
+		OpenFileDescriptorCount:    b.intValue("OpenFileDescriptorCount"),
+		MaxFileDescriptorCount:     b.intValue("MaxFileDescriptorCount"),
+	}
+
+	if _, ok := b.lookup("CpuLoad", "SystemCpuLoad"); ok {
+		snapshot.SystemCpuLoad = b.floatValue("CpuLoad", "SystemCpuLoad")
+	}
+	if _, ok := b.lookup("ProcessCpuLoad"); ok {
+		snapshot.ProcessCpuLoad = b.floatValue("ProcessCpuLoad")
+	}
+
+	// Older IBM J9 versions report ProcessCpuTime in 100ns units, ProcessCpuTimeByNS is always in nanoseconds.
+	if _, ok := b.lookup("ProcessCpuTimeByNS"); ok {
+		snapshot.ProcessCpuTimeNs = b.intValue("ProcessCpuTimeByNS")
+	} else {
+		snapshot.ProcessCpuTimeNs = b.intValue("ProcessCpuTime")
+	}
+
+	return snapshot, nil
+}
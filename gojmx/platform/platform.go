@@ -0,0 +1,185 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package platform reads the standard platform MXBeans (java.lang and java.nio domains)
+// through a gojmx.Client and returns them as typed snapshots.
+//
+// Attributes that are not available in the monitored JVM are left with their zero value,
+// this way the same snapshot can be used for HotSpot and OpenJ9 based JVMs.
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// ErrMBeanNotFound is returned when the requested platform MXBean is not registered.
+var ErrMBeanNotFound = errors.New("platform mBean not found")
+
+// Querier is the gojmx.Client functionality required to read the platform MXBeans.
+type Querier interface {
+	QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// MemoryUsage is the java.lang.management.MemoryUsage composite value.
+type MemoryUsage struct {
+	Init      int64
+	Used      int64
+	Committed int64
+	// Max is -1 when undefined.
+	Max int64
+}
+
+// UsedRatio returns Used/Max or 0 when Max is undefined.
+func (m MemoryUsage) UsedRatio() float64 {
+	if m.Max <= 0 {
+		return 0
+	}
+	return float64(m.Used) / float64(m.Max)
+}
+
+// bean keeps the successfully retrieved attribute values of an mBean.
+type bean struct {
+	name   *gojmx.ObjectName
+	values map[string]*gojmx.AttributeResponse
+}
+
+// queryBeans retrieves the attributes for all the mBeans matching the pattern,
+// sorted by mBean name. Attributes that failed are skipped. The mBeans whose attributes all failed are skipped,
+// as nrjmx returns an error for each requested attribute of a name without wildcards that is not registered.
+func queryBeans(q Querier, pattern string, attrs ...string) ([]*bean, error) {
+	response, err := q.QueryMBeanAttributes(pattern, attrs...)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]*bean{}
+	var names []string
+
+	for _, attr := range response {
+		if attr == nil {
+			continue
+		}
+
+		mBeanName, attrName, ok := gojmx.SplitAttributeName(attr.Name)
+		if !ok {
+			continue
+		}
+
+		b, exists := byName[mBeanName]
+		if !exists {
+			objectName, err := gojmx.ParseObjectName(mBeanName)
+			if err != nil {
+				continue
+			}
+			b = &bean{
+				name:   objectName,
+				values: map[string]*gojmx.AttributeResponse{},
+			}
+			byName[mBeanName] = b
+			names = append(names, mBeanName)
+		}
+
+		if attr.ResponseType == gojmx.ResponseTypeErr {
+			continue
+		}
+		b.values[attrName] = attr
+	}
+
+	sort.Strings(names)
+
+	result := make([]*bean, 0, len(names))
+	for _, name := range names {
+		if b := byName[name]; len(b.values) > 0 {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
+
+// queryBean retrieves the attributes for a single mBean.
+func queryBean(q Querier, mBeanName string, attrs ...string) (*bean, error) {
+	beans, err := queryBeans(q, mBeanName, attrs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(beans) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMBeanNotFound, mBeanName)
+	}
+	return beans[0], nil
+}
+
+// lookup returns the value of the first available attribute name.
+// Multiple names are used to support the attribute differences between JVM vendors and versions.
+func (b *bean) lookup(names ...string) (*gojmx.AttributeResponse, bool) {
+	for _, name := range names {
+		if value, ok := b.values[name]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func (b *bean) intValue(names ...string) int64 {
+	value, ok := b.lookup(names...)
+	if !ok {
+		return 0
+	}
+	if value.ResponseType == gojmx.ResponseTypeInt {
+		return value.IntValue
+	}
+	floatValue, err := value.GetValueAsFloat()
+	if err != nil {
+		return 0
+	}
+	return int64(floatValue)
+}
+
+func (b *bean) floatValue(names ...string) float64 {
+	value, ok := b.lookup(names...)
+	if !ok {
+		return 0
+	}
+	floatValue, err := value.GetValueAsFloat()
+	if err != nil {
+		return 0
+	}
+	return floatValue
+}
+
+func (b *bean) stringValue(names ...string) string {
+	value, ok := b.lookup(names...)
+	if !ok {
+		return ""
+	}
+	if value.ResponseType == gojmx.ResponseTypeString {
+		return value.StringValue
+	}
+	return fmt.Sprintf("%v", value.GetValue())
+}
+
+func (b *bean) boolValue(names ...string) bool {
+	value, ok := b.lookup(names...)
+	if !ok {
+		return false
+	}
+	return value.ResponseType == gojmx.ResponseTypeBool && value.BoolValue
+}
+
+// memoryUsage reads a MemoryUsage composite attribute, e.g. HeapMemoryUsage.Used
+func (b *bean) memoryUsage(attr string) MemoryUsage {
+	return MemoryUsage{
+		Init:      b.intValue(attr + ".Init"),
+		Used:      b.intValue(attr + ".Used"),
+		Committed: b.intValue(attr + ".Committed"),
+		Max:       b.intValue(attr + ".Max"),
+	}
+}
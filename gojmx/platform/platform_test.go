@@ -0,0 +1,320 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package platform
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuerier returns the configured responses for each mBean pattern.
+type fakeQuerier map[string][]*gojmx.AttributeResponse
+
+func (f fakeQuerier) QueryMBeanAttributes(mBeanNamePattern string, _ ...string) ([]*gojmx.AttributeResponse, error) {
+	return f[mBeanNamePattern], nil
+}
+
+func intAttr(name string, value int64) *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{Name: name, ResponseType: nrprotocol.ResponseType_INT, IntValue: value}
+}
+
+func doubleAttr(name string, value float64) *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{Name: name, ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: value}
+}
+
+func stringAttr(name string, value string) *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{Name: name, ResponseType: nrprotocol.ResponseType_STRING, StringValue: value}
+}
+
+func boolAttr(name string, value bool) *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{Name: name, ResponseType: nrprotocol.ResponseType_BOOL, BoolValue: value}
+}
+
+func errAttr(name string) *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{Name: name, ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "can't get attribute"}
+}
+
+func Test_Memory(t *testing.T) {
+	q := fakeQuerier{
+		"java.lang:type=Memory": {
+			intAttr("java.lang:type=Memory,attr=HeapMemoryUsage.Init", 10),
+			intAttr("java.lang:type=Memory,attr=HeapMemoryUsage.Used", 25),
+			intAttr("java.lang:type=Memory,attr=HeapMemoryUsage.Committed", 50),
+			intAttr("java.lang:type=Memory,attr=HeapMemoryUsage.Max", 100),
+			intAttr("java.lang:type=Memory,attr=NonHeapMemoryUsage.Used", 5),
+			intAttr("java.lang:type=Memory,attr=NonHeapMemoryUsage.Max", -1),
+			errAttr("java.lang:type=Memory,attr=ObjectPendingFinalizationCount"),
+		},
+	}
+
+	memory, err := Memory(q)
+	require.NoError(t, err)
+
+	assert.Equal(t, &MemorySnapshot{
+		HeapMemoryUsage:    MemoryUsage{Init: 10, Used: 25, Committed: 50, Max: 100},
+		NonHeapMemoryUsage: MemoryUsage{Used: 5, Max: -1},
+	}, memory)
+	assert.Equal(t, 0.25, memory.HeapMemoryUsage.UsedRatio())
+	assert.Equal(t, 0.0, memory.NonHeapMemoryUsage.UsedRatio())
+}
+
+func Test_MBeanNotFound(t *testing.T) {
+	_, err := Memory(fakeQuerier{})
+	assert.True(t, errors.Is(err, ErrMBeanNotFound))
+
+	// nrjmx returns an error for each attribute of a name without wildcards that is not registered.
+	notFound := func(name string) *gojmx.AttributeResponse {
+		return &gojmx.AttributeResponse{
+			Name:         name,
+			ResponseType: nrprotocol.ResponseType_ERROR,
+			StatusMsg:    "can't get attribute, error: 'java.lang:type=Memory', cause: 'java.lang:type=Memory', stacktrace: ''",
+			Error:        &nrprotocol.AttributeError{Kind: nrprotocol.ErrorKind_MBEAN_NOT_FOUND, ExceptionClass: "javax.management.InstanceNotFoundException"},
+		}
+	}
+	_, err = Memory(fakeQuerier{
+		"java.lang:type=Memory": {
+			notFound("java.lang:type=Memory,attr=HeapMemoryUsage"),
+			notFound("java.lang:type=Memory,attr=NonHeapMemoryUsage"),
+		},
+	})
+	assert.True(t, errors.Is(err, ErrMBeanNotFound))
+}
+
+func Test_GarbageCollectors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		responses []*gojmx.AttributeResponse
+		expected  []*GCSnapshot
+	}{
+		{
+			name: "HotSpot G1",
+			responses: []*gojmx.AttributeResponse{
+				intAttr("java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionCount", 12),
+				intAttr("java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionTime", 120),
+				intAttr("java.lang:type=GarbageCollector,name=G1 Old Generation,attr=CollectionCount", 1),
+				intAttr("java.lang:type=GarbageCollector,name=G1 Old Generation,attr=CollectionTime", 30),
+			},
+			expected: []*GCSnapshot{
+				{Name: "G1 Old Generation", Generation: GCGenerationOld, CollectionCount: 1, CollectionTimeMs: 30},
+				{Name: "G1 Young Generation", Generation: GCGenerationYoung, CollectionCount: 12, CollectionTimeMs: 120},
+			},
+		},
+		{
+			name: "OpenJ9 gencon",
+			responses: []*gojmx.AttributeResponse{
+				intAttr("java.lang:type=GarbageCollector,name=scavenge,attr=CollectionCount", 7),
+				intAttr("java.lang:type=GarbageCollector,name=scavenge,attr=CollectionTime", 70),
+				intAttr("java.lang:type=GarbageCollector,name=global,attr=CollectionCount", 2),
+				errAttr("java.lang:type=GarbageCollector,name=global,attr=CollectionTime"),
+			},
+			expected: []*GCSnapshot{
+				{Name: "global", Generation: GCGenerationOld, CollectionCount: 2},
+				{Name: "scavenge", Generation: GCGenerationYoung, CollectionCount: 7, CollectionTimeMs: 70},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q := fakeQuerier{"java.lang:type=GarbageCollector,name=*": testCase.responses}
+
+			gcs, err := GarbageCollectors(q)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, gcs)
+		})
+	}
+}
+
+func Test_ClassifyGC(t *testing.T) {
+	assert.Equal(t, GCGenerationYoung, ClassifyGC("PS Scavenge"))
+	assert.Equal(t, GCGenerationOld, ClassifyGC("ZGC Major Cycles"))
+	assert.Equal(t, GCGenerationOld, ClassifyGC("Global"))
+	assert.Equal(t, GCGenerationUnknown, ClassifyGC("Epsilon"))
+}
+
+func Test_MemoryPools_BufferPools(t *testing.T) {
+	q := fakeQuerier{
+		"java.lang:type=MemoryPool,name=*": {
+			stringAttr("java.lang:type=MemoryPool,name=G1 Eden Space,attr=Type", "HEAP"),
+			boolAttr("java.lang:type=MemoryPool,name=G1 Eden Space,attr=Valid", true),
+			intAttr("java.lang:type=MemoryPool,name=G1 Eden Space,attr=Usage.Used", 3),
+			intAttr("java.lang:type=MemoryPool,name=G1 Eden Space,attr=PeakUsage.Used", 4),
+			intAttr("java.lang:type=MemoryPool,name=G1 Eden Space,attr=CollectionUsage.Used", 1),
+			stringAttr("java.lang:type=MemoryPool,name=Metaspace,attr=Type", "NON_HEAP"),
+			boolAttr("java.lang:type=MemoryPool,name=Metaspace,attr=Valid", true),
+			intAttr("java.lang:type=MemoryPool,name=Metaspace,attr=Usage.Used", 8),
+			errAttr("java.lang:type=MemoryPool,name=Metaspace,attr=CollectionUsage"),
+		},
+		"java.nio:type=BufferPool,name=*": {
+			intAttr("java.nio:type=BufferPool,name=direct,attr=Count", 2),
+			intAttr("java.nio:type=BufferPool,name=direct,attr=MemoryUsed", 2048),
+			intAttr("java.nio:type=BufferPool,name=direct,attr=TotalCapacity", 2048),
+		},
+	}
+
+	pools, err := MemoryPools(q)
+	require.NoError(t, err)
+	assert.Equal(t, []*MemoryPoolSnapshot{
+		{
+			Name:            "G1 Eden Space",
+			Type:            "HEAP",
+			Valid:           true,
+			Usage:           MemoryUsage{Used: 3},
+			PeakUsage:       MemoryUsage{Used: 4},
+			CollectionUsage: MemoryUsage{Used: 1},
+		},
+		{
+			Name:  "Metaspace",
+			Type:  "NON_HEAP",
+			Valid: true,
+			Usage: MemoryUsage{Used: 8},
+		},
+	}, pools)
+
+	bufferPools, err := BufferPools(q)
+	require.NoError(t, err)
+	assert.Equal(t, []*BufferPoolSnapshot{
+		{Name: "direct", Count: 2, MemoryUsed: 2048, TotalCapacity: 2048},
+	}, bufferPools)
+}
+
+func Test_Runtime(t *testing.T) {
+	testCases := []struct {
+		name     string
+		vmName   string
+		vmVendor string
+		expected Vendor
+	}{
+		{name: "HotSpot", vmName: "OpenJDK 64-Bit Server VM", vmVendor: "Eclipse Adoptium", expected: VendorHotSpot},
+		{name: "Oracle", vmName: "Java HotSpot(TM) 64-Bit Server VM", vmVendor: "Oracle Corporation", expected: VendorHotSpot},
+		{name: "OpenJ9", vmName: "Eclipse OpenJ9 VM", vmVendor: "Eclipse OpenJ9", expected: VendorOpenJ9},
+		{name: "IBM J9", vmName: "IBM J9 VM", vmVendor: "IBM Corporation", expected: VendorOpenJ9},
+		{name: "Unknown", vmName: "Substrate VM", vmVendor: "Oracle Corporation", expected: VendorUnknown},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q := fakeQuerier{
+				"java.lang:type=Runtime": {
+					stringAttr("java.lang:type=Runtime,attr=Name", "1@localhost"),
+					stringAttr("java.lang:type=Runtime,attr=VmName", testCase.vmName),
+					stringAttr("java.lang:type=Runtime,attr=VmVendor", testCase.vmVendor),
+					intAttr("java.lang:type=Runtime,attr=StartTime", 1700000000000),
+					intAttr("java.lang:type=Runtime,attr=Uptime", 1500),
+				},
+			}
+
+			runtime, err := Runtime(q)
+			require.NoError(t, err)
+			assert.Equal(t, "1@localhost", runtime.Name)
+			assert.Equal(t, testCase.expected, runtime.Vendor)
+			assert.Equal(t, time.UnixMilli(1700000000000), runtime.StartTime)
+			assert.Equal(t, 1500*time.Millisecond, runtime.Uptime)
+		})
+	}
+}
+
+func Test_Threading_ClassLoading(t *testing.T) {
+	q := fakeQuerier{
+		"java.lang:type=Threading": {
+			intAttr("java.lang:type=Threading,attr=ThreadCount", 20),
+			intAttr("java.lang:type=Threading,attr=PeakThreadCount", 25),
+			intAttr("java.lang:type=Threading,attr=DaemonThreadCount", 15),
+			intAttr("java.lang:type=Threading,attr=TotalStartedThreadCount", 40),
+		},
+		"java.lang:type=ClassLoading": {
+			intAttr("java.lang:type=ClassLoading,attr=LoadedClassCount", 1000),
+			intAttr("java.lang:type=ClassLoading,attr=TotalLoadedClassCount", 1100),
+			intAttr("java.lang:type=ClassLoading,attr=UnloadedClassCount", 100),
+		},
+	}
+
+	threading, err := Threading(q)
+	require.NoError(t, err)
+	assert.Equal(t, &ThreadingSnapshot{
+		ThreadCount:             20,
+		PeakThreadCount:         25,
+		DaemonThreadCount:       15,
+		TotalStartedThreadCount: 40,
+	}, threading)
+
+	classLoading, err := ClassLoading(q)
+	require.NoError(t, err)
+	assert.Equal(t, &ClassLoadingSnapshot{
+		LoadedClassCount:      1000,
+		TotalLoadedClassCount: 1100,
+		UnloadedClassCount:    100,
+	}, classLoading)
+}
+
+func Test_OperatingSystem(t *testing.T) {
+	testCases := []struct {
+		name      string
+		responses []*gojmx.AttributeResponse
+		expected  *OperatingSystemSnapshot
+	}{
+		{
+			name: "HotSpot 17",
+			responses: []*gojmx.AttributeResponse{
+				stringAttr("java.lang:type=OperatingSystem,attr=Name", "Linux"),
+				intAttr("java.lang:type=OperatingSystem,attr=AvailableProcessors", 4),
+				doubleAttr("java.lang:type=OperatingSystem,attr=CpuLoad", 0.5),
+				doubleAttr("java.lang:type=OperatingSystem,attr=ProcessCpuLoad", 0.1),
+				intAttr("java.lang:type=OperatingSystem,attr=ProcessCpuTime", 3000),
+				intAttr("java.lang:type=OperatingSystem,attr=TotalMemorySize", 1024),
+				intAttr("java.lang:type=OperatingSystem,attr=FreeMemorySize", 512),
+				intAttr("java.lang:type=OperatingSystem,attr=CommittedVirtualMemorySize", 256),
+			},
+			expected: &OperatingSystemSnapshot{
+				Name:                       "Linux",
+				AvailableProcessors:        4,
+				SystemCpuLoad:              0.5,
+				ProcessCpuLoad:             0.1,
+				ProcessCpuTimeNs:           3000,
+				TotalMemorySize:            1024,
+				FreeMemorySize:             512,
+				CommittedVirtualMemorySize: 256,
+			},
+		},
+		{
+			name: "OpenJ9",
+			responses: []*gojmx.AttributeResponse{
+				stringAttr("java.lang:type=OperatingSystem,attr=Name", "Linux"),
+				doubleAttr("java.lang:type=OperatingSystem,attr=SystemCpuLoad", 0.25),
+				intAttr("java.lang:type=OperatingSystem,attr=ProcessCpuTime", 30),
+				intAttr("java.lang:type=OperatingSystem,attr=ProcessCpuTimeByNS", 3000),
+				intAttr("java.lang:type=OperatingSystem,attr=TotalPhysicalMemory", 1024),
+				intAttr("java.lang:type=OperatingSystem,attr=FreePhysicalMemorySize", 512),
+				intAttr("java.lang:type=OperatingSystem,attr=ProcessVirtualMemorySize", 256),
+			},
+			expected: &OperatingSystemSnapshot{
+				Name:                       "Linux",
+				SystemCpuLoad:              0.25,
+				ProcessCpuLoad:             -1,
+				ProcessCpuTimeNs:           3000,
+				TotalMemorySize:            1024,
+				FreeMemorySize:             512,
+				CommittedVirtualMemorySize: 256,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q := fakeQuerier{"java.lang:type=OperatingSystem": testCase.responses}
+
+			os, err := OperatingSystem(q)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, os)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package platform
+
+import (
+	"strings"
+	"time"
+)
+
+// Vendor identifies the JVM implementation.
+type Vendor string
+
+const (
+	VendorUnknown Vendor = "unknown"
+	VendorHotSpot Vendor = "hotspot"
+	VendorOpenJ9  Vendor = "openj9"
+)
+
+// DetectVendor returns the JVM implementation given the java.lang:type=Runtime VmName and VmVendor.
+func DetectVendor(vmName, vmVendor string) Vendor {
+	for _, value := range []string{vmName, vmVendor} {
+		lower := strings.ToLower(value)
+		if strings.Contains(lower, "j9") {
+			return VendorOpenJ9
+		}
+	}
+	lower := strings.ToLower(vmName)
+	if strings.Contains(lower, "hotspot") || strings.Contains(lower, "openjdk") {
+		return VendorHotSpot
+	}
+	return VendorUnknown
+}
+
+// RuntimeSnapshot is the java.lang:type=Runtime MXBean.
+type RuntimeSnapshot struct {
+	// Name is usually pid@hostname.
+	Name        string
+	VmName      string
+	VmVendor    string
+	VmVersion   string
+	SpecVersion string
+	Vendor      Vendor
+	StartTime   time.Time
+	Uptime      time.Duration
+}
+
+// ThreadingSnapshot is the java.lang:type=Threading MXBean.
+type ThreadingSnapshot struct {
+	ThreadCount             int64
+	PeakThreadCount         int64
+	DaemonThreadCount       int64
+	TotalStartedThreadCount int64
+}
+
+// ClassLoadingSnapshot is the java.lang:type=ClassLoading MXBean.
+type ClassLoadingSnapshot struct {
+	LoadedClassCount      int64
+	TotalLoadedClassCount int64
+	UnloadedClassCount    int64
+}
+
+// Runtime reads the java.lang:type=Runtime MXBean.
+func Runtime(q Querier) (*RuntimeSnapshot, error) {
+	b, err := queryBean(q, "java.lang:type=Runtime",
+		"Name", "VmName", "VmVendor", "VmVersion", "SpecVersion", "StartTime", "Uptime")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &RuntimeSnapshot{
+		Name:        b.stringValue("Name"),
+		VmName:      b.stringValue("VmName"),
+		VmVendor:    b.stringValue("VmVendor"),
+		VmVersion:   b.stringValue("VmVersion"),
+		SpecVersion: b.stringValue("SpecVersion"),
+		Uptime:      time.Duration(b.intValue("Uptime")) * time.Millisecond,
+	}
+	snapshot.Vendor = DetectVendor(snapshot.VmName, snapshot.VmVendor)
+
+	if startTime := b.intValue("StartTime"); startTime > 0 {
+		snapshot.StartTime = time.UnixMilli(startTime)
+	}
+
+	return snapshot, nil
+}
+
+// Threading reads the java.lang:type=Threading MXBean.
+func Threading(q Querier) (*ThreadingSnapshot, error) {
+	b, err := queryBean(q, "java.lang:type=Threading",
+		"ThreadCount", "PeakThreadCount", "DaemonThreadCount", "TotalStartedThreadCount")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ThreadingSnapshot{
+		ThreadCount:             b.intValue("ThreadCount"),
+		PeakThreadCount:         b.intValue("PeakThreadCount"),
+		DaemonThreadCount:       b.intValue("DaemonThreadCount"),
+		TotalStartedThreadCount: b.intValue("TotalStartedThreadCount"),
+	}, nil
+}
+
+// ClassLoading reads the java.lang:type=ClassLoading MXBean.
+func ClassLoading(q Querier) (*ClassLoadingSnapshot, error) {
+	b, err := queryBean(q, "java.lang:type=ClassLoading",
+		"LoadedClassCount", "TotalLoadedClassCount", "UnloadedClassCount")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClassLoadingSnapshot{
+		LoadedClassCount:      b.intValue("LoadedClassCount"),
+		TotalLoadedClassCount: b.intValue("TotalLoadedClassCount"),
+		UnloadedClassCount:    b.intValue("UnloadedClassCount"),
+	}, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package prometheus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// ClientQuerier is a Querier opening the gojmx.Client on the first request. When the nrjmx process fails
+// the client is closed and a new one is opened on the next request, so a long running exporter recovers.
+// JMX connection failures are recovered by the nrjmx process itself.
+type ClientQuerier struct {
+	ctx    context.Context
+	config *gojmx.JMXConfig
+
+	mu     sync.Mutex
+	client *gojmx.Client
+}
+
+var _ Querier = (*ClientQuerier)(nil)
+
+// NewClientQuerier returns a ClientQuerier for the JMX endpoint.
+func NewClientQuerier(ctx context.Context, config *gojmx.JMXConfig) *ClientQuerier {
+	return &ClientQuerier{
+		ctx:    ctx,
+		config: config,
+	}
+}
+
+// QueryMBeanNames calls gojmx.Client.QueryMBeanNames.
+func (q *ClientQuerier) QueryMBeanNames(mBeanPattern string) (result []string, err error) {
+	err = q.do(func(client *gojmx.Client) error {
+		result, err = client.QueryMBeanNames(mBeanPattern)
+		return err
+	})
+	return result, err
+}
+
+// QueryMBeanAttributes calls gojmx.Client.QueryMBeanAttributes.
+func (q *ClientQuerier) QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) (result []*gojmx.AttributeResponse, err error) {
+	err = q.do(func(client *gojmx.Client) error {
+		result, err = client.QueryMBeanAttributes(mBeanNamePattern, mBeanAttrName...)
+		return err
+	})
+	return result, err
+}
+
+// GetMBeanInfo calls gojmx.Client.GetMBeanInfo.
+func (q *ClientQuerier) GetMBeanInfo(mBeanName string) (result *gojmx.MBeanInfo, err error) {
+	err = q.do(func(client *gojmx.Client) error {
+		result, err = client.GetMBeanInfo(mBeanName)
+		return err
+	})
+	return result, err
+}
+
+// Close closes the gojmx.Client when opened.
+func (q *ClientQuerier) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.client == nil {
+		return nil
+	}
+	err := q.client.Close()
+	q.client = nil
+	return err
+}
+
+func (q *ClientQuerier) do(request func(client *gojmx.Client) error) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.client == nil {
+		client, err := gojmx.NewClient(q.ctx).Open(q.config)
+		if err != nil {
+			_ = client.Close()
+			return err
+		}
+		q.client = client
+	}
+
+	err := request(q.client)
+	if _, ok := gojmx.IsJMXClientError(err); ok || !q.client.IsRunning() {
+		_ = q.client.Close()
+		q.client = nil
+	}
+	return err
+}
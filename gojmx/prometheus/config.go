@@ -0,0 +1,208 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package prometheus
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MetricType is the Prometheus type of the metrics produced by a rule.
+type MetricType string
+
+const (
+	MetricTypeGauge   MetricType = "gauge"
+	MetricTypeCounter MetricType = "counter"
+	MetricTypeUntyped MetricType = "untyped"
+)
+
+// Config has the jmx_exporter configuration format, only the fields related to the metrics are supported:
+//
+//	lowercaseOutputName: true
+//	includeObjectNames: ["java.lang:*"]
+//	rules:
+//	  - pattern: 'java.lang<type=Memory><HeapMemoryUsage>(\w+)'
+//	    name: jvm_memory_heap_$1_bytes
+//	    help: Heap memory $1
+//	    type: GAUGE
+//	  - pattern: 'java.lang<type=GarbageCollector, name=(.+)><>CollectionTime'
+//	    name: jvm_gc_collection_seconds_total
+//	    labels:
+//	      gc: $1
+//	    type: COUNTER
+//	    valueFactor: 0.001
+//
+// Rules are matched in order against a string with the format:
+//
+//	domain<beanPropertyName1=beanPropertyValue1, beanPropertyName2=beanPropertyValue2><compositeAttrName>attrName: value
+//
+// The first matching rule defines the metric. When no rules are defined all the attributes are exported
+// with the default format, when rules are defined the attributes not matching any of them are skipped.
+type Config struct {
+	LowercaseOutputName       bool `yaml:"lowercaseOutputName"`
+	LowercaseOutputLabelNames bool `yaml:"lowercaseOutputLabelNames"`
+	// IncludeObjectNames are the mBean name patterns to scrape, all of them when empty.
+	IncludeObjectNames []string `yaml:"includeObjectNames"`
+	// ExcludeObjectNames are the mBean name patterns to skip.
+	ExcludeObjectNames []string `yaml:"excludeObjectNames"`
+	// WhitelistObjectNames and BlacklistObjectNames are the deprecated names of IncludeObjectNames and ExcludeObjectNames.
+	WhitelistObjectNames []string `yaml:"whitelistObjectNames"`
+	BlacklistObjectNames []string `yaml:"blacklistObjectNames"`
+	Rules                []*Rule  `yaml:"rules"`
+
+	rules []*rule
+}
+
+// Rule maps the attributes matching Pattern into a metric.
+// Name, Help, Value and Labels can reference the Pattern capture groups, e.g. $1.
+// When Name is not defined the matching attributes are exported with the default format.
+type Rule struct {
+	Pattern string            `yaml:"pattern"`
+	Name    string            `yaml:"name"`
+	Help    string            `yaml:"help"`
+	Labels  map[string]string `yaml:"labels"`
+	// Type is GAUGE, COUNTER or UNTYPED, the default.
+	Type MetricType `yaml:"type"`
+	// Value replaces the attribute value, useful for mapping string attributes.
+	Value string `yaml:"value"`
+	// ValueFactor multiplies the value, e.g. 0.001 for milliseconds to seconds.
+	ValueFactor *float64 `yaml:"valueFactor"`
+	// AttrNameSnakeCase converts the attribute name to snake case before matching it.
+	AttrNameSnakeCase bool `yaml:"attrNameSnakeCase"`
+}
+
+// rule is a validated Rule with its pattern compiled and its templates converted to the regexp.Expand syntax.
+type rule struct {
+	pattern     *regexp.Regexp
+	name        string
+	help        string
+	value       string
+	labels      []labelTemplate
+	metricType  MetricType
+	valueFactor float64
+	snakeCase   bool
+}
+
+type labelTemplate struct {
+	name  string
+	value string
+}
+
+// groupReference matches the jmx_exporter capture group references, e.g. $1.
+var groupReference = regexp.MustCompile(`\$(\d+)`)
+
+// Parse parses and validates a jmx_exporter configuration.
+func Parse(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("cannot parse exporter config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadFile reads, parses and validates a jmx_exporter configuration file.
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read exporter config: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks the configuration and compiles its rules. It's required when the Config is not built by Parse.
+func (c *Config) Validate() error {
+	c.rules = nil
+
+	for i, r := range c.Rules {
+		if r == nil {
+			return fmt.Errorf("rule %d is empty", i)
+		}
+
+		compiled, err := r.compile()
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+		c.rules = append(c.rules, compiled)
+	}
+
+	if len(c.rules) == 0 {
+		c.rules = []*rule{{metricType: MetricTypeUntyped, valueFactor: 1}}
+	}
+	return nil
+}
+
+func (r *Rule) compile() (*rule, error) {
+	if r.Name == "" && (r.Help != "" || r.Value != "" || len(r.Labels) > 0) {
+		return nil, fmt.Errorf("name is required when labels, help or value are defined")
+	}
+
+	compiled := &rule{
+		name:        expandTemplate(r.Name),
+		help:        expandTemplate(r.Help),
+		value:       expandTemplate(r.Value),
+		metricType:  MetricTypeUntyped,
+		valueFactor: 1,
+		snakeCase:   r.AttrNameSnakeCase,
+	}
+
+	if r.Pattern != "" {
+		pattern, err := regexp.Compile("^.*(?:" + r.Pattern + ").*$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: '%s', %w", r.Pattern, err)
+		}
+		compiled.pattern = pattern
+	}
+
+	if r.Type != "" {
+		compiled.metricType = MetricType(strings.ToLower(string(r.Type)))
+		switch compiled.metricType {
+		case MetricTypeGauge, MetricTypeCounter, MetricTypeUntyped:
+		default:
+			return nil, fmt.Errorf("invalid type: '%s', valid: GAUGE, COUNTER, UNTYPED", r.Type)
+		}
+	}
+
+	if r.ValueFactor != nil {
+		compiled.valueFactor = *r.ValueFactor
+	}
+
+	names := make([]string, 0, len(r.Labels))
+	for name := range r.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		compiled.labels = append(compiled.labels, labelTemplate{
+			name:  expandTemplate(name),
+			value: expandTemplate(r.Labels[name]),
+		})
+	}
+
+	return compiled, nil
+}
+
+// expandTemplate converts the $1 references into ${1}, otherwise regexp.Expand takes $1_ as a named group.
+func expandTemplate(template string) string {
+	return groupReference.ReplaceAllString(template, "$${$1}")
+}
+
+// objectNames returns the included and excluded mBean name patterns, including the deprecated fields.
+func (c *Config) objectNames() (include, exclude []string) {
+	include = append(append(include, c.IncludeObjectNames...), c.WhitelistObjectNames...)
+	exclude = append(append(exclude, c.ExcludeObjectNames...), c.BlacklistObjectNames...)
+	if len(include) == 0 {
+		include = []string{"*:*"}
+	}
+	return include, exclude
+}
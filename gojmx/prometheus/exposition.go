@@ -0,0 +1,143 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package prometheus
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Format is a text exposition format.
+type Format string
+
+const (
+	// FormatText is the Prometheus text exposition format 0.0.4.
+	FormatText Format = "text/plain; version=0.0.4; charset=utf-8"
+	// FormatOpenMetrics is the OpenMetrics text format 1.0.0.
+	FormatOpenMetrics Format = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// NegotiateFormat returns FormatOpenMetrics when accepted by the scraper, FormatText otherwise.
+func NegotiateFormat(accept string) Format {
+	for _, mediaType := range strings.Split(accept, ",") {
+		if strings.HasPrefix(strings.TrimSpace(mediaType), "application/openmetrics-text") {
+			return FormatOpenMetrics
+		}
+	}
+	return FormatText
+}
+
+// Label is a metric label.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a metric value with its labels.
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family groups the samples of a metric.
+type Family struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []*Sample
+}
+
+// WriteFamilies writes the families sorted by name in the given format.
+func WriteFamilies(w io.Writer, families []*Family, format Format) error {
+	sorted := append([]*Family(nil), families...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, family := range sorted {
+		writeFamily(bw, family, format)
+	}
+	if format == FormatOpenMetrics {
+		bw.WriteString("# EOF\n")
+	}
+	return bw.Flush()
+}
+
+func writeFamily(w *bufio.Writer, family *Family, format Format) {
+	name, sampleName := family.Name, family.Name
+	metricType := family.Type
+	if metricType == "" {
+		metricType = MetricTypeUntyped
+	}
+
+	if format == FormatOpenMetrics {
+		// OpenMetrics counters samples have the _total suffix, not present in the family name.
+		if metricType == MetricTypeCounter {
+			name = strings.TrimSuffix(name, "_total")
+			sampleName = name + "_total"
+		}
+		if metricType == MetricTypeUntyped {
+			metricType = "unknown"
+		}
+	}
+
+	if family.Help != "" {
+		w.WriteString("# HELP " + name + " " + escapeHelp(family.Help, format) + "\n")
+	}
+	w.WriteString("# TYPE " + name + " " + string(metricType) + "\n")
+
+	for _, sample := range family.Samples {
+		w.WriteString(sampleName)
+		if len(sample.Labels) > 0 {
+			w.WriteByte('{')
+			for i, label := range sample.Labels {
+				if i > 0 {
+					w.WriteByte(',')
+				}
+				w.WriteString(label.Name + `="` + escapeLabelValue(label.Value) + `"`)
+			}
+			w.WriteByte('}')
+		}
+		w.WriteByte(' ')
+		w.WriteString(formatValue(sample.Value))
+		w.WriteByte('\n')
+	}
+}
+
+var (
+	helpEscaper        = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	openMetricsEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// escapeHelp escapes the HELP text, OpenMetrics escapes the double quotes too.
+func escapeHelp(help string, format Format) string {
+	if format == FormatOpenMetrics {
+		return openMetricsEscaper.Replace(help)
+	}
+	return helpEscaper.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return openMetricsEscaper.Replace(value)
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,362 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package prometheus exposes the JMX attributes retrieved through gojmx in the Prometheus and OpenMetrics
+// text formats, mapping them into metrics with jmx_exporter compatible rules.
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+const (
+	scrapeDurationMetric = "jmx_scrape_duration_seconds"
+	scrapeErrorMetric    = "jmx_scrape_error"
+)
+
+// Querier is the gojmx.Client functionality required to scrape a target.
+type Querier interface {
+	QueryMBeanNames(mBeanPattern string) ([]string, error)
+	QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+	GetMBeanInfo(mBeanName string) (*gojmx.MBeanInfo, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// Target is a JMX endpoint scraped by the Handler.
+type Target struct {
+	Name string
+	// Labels are added to all the target metrics, required for telling apart the targets scraped together.
+	Labels  map[string]string
+	Querier Querier
+
+	// mu serializes the scrapes, the gojmx.Client doesn't support concurrent requests.
+	mu sync.Mutex
+	// descriptions caches the attribute descriptions of the scraped mBeans, used as metrics HELP.
+	descriptions map[string]map[string]string
+}
+
+// Handler is an http.Handler serving the metrics of the configured targets.
+// All the targets are scraped on each request unless a single one is selected with the target query parameter.
+type Handler struct {
+	config  *Config
+	targets []*Target
+}
+
+// NewHandler returns a Handler scraping the targets with the rules of the config.
+func NewHandler(config *Config, targets ...*Target) (*Handler, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	if config.rules == nil {
+		if err := config.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("at least one target is required")
+	}
+
+	names := map[string]bool{}
+	for _, target := range targets {
+		if target == nil || target.Querier == nil {
+			return nil, errors.New("target querier is required")
+		}
+		if names[target.Name] {
+			return nil, fmt.Errorf("duplicated target: '%s'", target.Name)
+		}
+		names[target.Name] = true
+	}
+
+	return &Handler{
+		config:  config,
+		targets: targets,
+	}, nil
+}
+
+// ServeHTTP scrapes the targets and writes the metrics in the format accepted by the scraper.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targets := h.targets
+	if name := r.URL.Query().Get("target"); name != "" {
+		targets = nil
+		for _, target := range h.targets {
+			if target.Name == name {
+				targets = []*Target{target}
+			}
+		}
+		if targets == nil {
+			http.Error(w, fmt.Sprintf("unknown target: '%s'", name), http.StatusNotFound)
+			return
+		}
+	}
+
+	format := NegotiateFormat(r.Header.Get("Accept"))
+	w.Header().Set("Content-Type", string(format))
+	_ = WriteFamilies(w, h.gather(targets), format)
+}
+
+// Gather scrapes all the targets and returns the metric families.
+// Each target reports jmx_scrape_duration_seconds and jmx_scrape_error besides the mapped attributes.
+func (h *Handler) Gather() []*Family {
+	return h.gather(h.targets)
+}
+
+type scrapeResult struct {
+	metrics  []*metric
+	err      error
+	duration time.Duration
+}
+
+func (h *Handler) gather(targets []*Target) []*Family {
+	results := make([]*scrapeResult, len(targets))
+
+	wg := sync.WaitGroup{}
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target *Target) {
+			defer wg.Done()
+			start := time.Now()
+			metrics, err := target.scrape(h.config)
+			results[i] = &scrapeResult{metrics: metrics, err: err, duration: time.Since(start)}
+		}(i, target)
+	}
+	wg.Wait()
+
+	g := newGatherer()
+	for i, target := range targets {
+		result := results[i]
+
+		scrapeError := 0.0
+		if result.err != nil {
+			scrapeError = 1
+		}
+		g.add(&metric{
+			name:       scrapeDurationMetric,
+			help:       "Time this JMX scrape took, in seconds.",
+			metricType: MetricTypeGauge,
+			value:      result.duration.Seconds(),
+		}, target.Labels)
+		g.add(&metric{
+			name:       scrapeErrorMetric,
+			help:       "Non-zero if this scrape failed.",
+			metricType: MetricTypeGauge,
+			value:      scrapeError,
+		}, target.Labels)
+
+		for _, m := range result.metrics {
+			g.add(m, target.Labels)
+		}
+	}
+	return g.families
+}
+
+// scrape retrieves the target attributes and maps them with the config rules.
+// Failures retrieving an mBean pattern are skipped, other failures abort the scrape.
+func (t *Target) scrape(config *Config) ([]*metric, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	include, exclude := config.objectNames()
+
+	excluded := map[string]bool{}
+	for _, pattern := range exclude {
+		names, err := t.Querier.QueryMBeanNames(pattern)
+		if err != nil {
+			if _, ok := gojmx.IsJMXError(err); ok {
+				continue
+			}
+			return nil, err
+		}
+		for _, name := range names {
+			excluded[name] = true
+		}
+	}
+
+	descriptions := &descriptionCache{
+		querier:  t.Querier,
+		previous: t.descriptions,
+		current:  map[string]map[string]string{},
+		failed:   map[string]bool{},
+	}
+	defer func() {
+		t.descriptions = descriptions.current
+	}()
+
+	var result []*metric
+	seen := map[string]bool{}
+	for _, pattern := range include {
+		responses, err := t.fetch(pattern, excluded)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, response := range responses {
+			if response == nil || seen[response.Name] {
+				continue
+			}
+			seen[response.Name] = true
+
+			attr, ok := newAttribute(response)
+			if !ok || excluded[attr.mBeanName] {
+				continue
+			}
+			attr.description = descriptions.get(attr.mBeanName, attr.rootAttrName())
+
+			if m, ok := config.apply(attr); ok {
+				result = append(result, m)
+			}
+		}
+	}
+	return result, nil
+}
+
+// fetch retrieves the attributes of the mBeans matching the pattern without reading the excluded ones.
+// The pattern is fetched at once unless it matches an excluded mBean, then each remaining mBean is fetched
+// on its own. Failures retrieving the pattern or an mBean are skipped, other failures are returned.
+func (t *Target) fetch(pattern string, excluded map[string]bool) ([]*gojmx.AttributeResponse, error) {
+	var names []string
+	if len(excluded) > 0 {
+		var err error
+		names, err = t.Querier.QueryMBeanNames(pattern)
+		if err != nil {
+			if _, ok := gojmx.IsJMXError(err); ok {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+
+	remaining := names[:0:0]
+	for _, name := range names {
+		if !excluded[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	if len(remaining) == len(names) {
+		responses, err := t.Querier.QueryMBeanAttributes(pattern)
+		if _, ok := gojmx.IsJMXError(err); ok {
+			return nil, nil
+		}
+		return responses, err
+	}
+
+	var result []*gojmx.AttributeResponse
+	for _, name := range remaining {
+		responses, err := t.Querier.QueryMBeanAttributes(name)
+		if err != nil {
+			if _, ok := gojmx.IsJMXError(err); ok {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, responses...)
+	}
+	return result, nil
+}
+
+// descriptionCache retrieves the attribute descriptions of the mBeans scraped, reusing the ones from
+// the previous scrape. mBeans no longer scraped are dropped and failures are retried on the next scrape.
+type descriptionCache struct {
+	querier  Querier
+	previous map[string]map[string]string
+	current  map[string]map[string]string
+	failed   map[string]bool
+}
+
+func (d *descriptionCache) get(mBeanName, attrName string) string {
+	if descriptions, ok := d.current[mBeanName]; ok {
+		return descriptions[attrName]
+	}
+	if descriptions, ok := d.previous[mBeanName]; ok {
+		d.current[mBeanName] = descriptions
+		return descriptions[attrName]
+	}
+	if d.failed[mBeanName] {
+		return ""
+	}
+
+	info, err := d.querier.GetMBeanInfo(mBeanName)
+	if err != nil {
+		d.failed[mBeanName] = true
+		return ""
+	}
+
+	descriptions := map[string]string{}
+	for _, attr := range info.GetAttributes() {
+		descriptions[attr.Name] = attr.Description
+	}
+	d.current[mBeanName] = descriptions
+	return descriptions[attrName]
+}
+
+// gatherer groups the metrics into families, dropping the duplicated series.
+type gatherer struct {
+	families []*Family
+	byName   map[string]*Family
+	series   map[string]bool
+}
+
+func newGatherer() *gatherer {
+	return &gatherer{
+		byName: map[string]*Family{},
+		series: map[string]bool{},
+	}
+}
+
+func (g *gatherer) add(m *metric, targetLabels map[string]string) {
+	labels := append([]Label(nil), m.labels...)
+
+	names := make([]string, 0, len(targetLabels))
+	for name := range targetLabels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !hasLabel(labels, name) {
+			labels = append(labels, Label{Name: name, Value: targetLabels[name]})
+		}
+	}
+
+	key := seriesKey(m.name, labels)
+	if g.series[key] {
+		return
+	}
+	g.series[key] = true
+
+	family, ok := g.byName[m.name]
+	if !ok {
+		family = &Family{Name: m.name, Help: m.help, Type: m.metricType}
+		g.byName[m.name] = family
+		g.families = append(g.families, family)
+	}
+	family.Samples = append(family.Samples, &Sample{Labels: labels, Value: m.value})
+}
+
+func hasLabel(labels []Label, name string) bool {
+	for _, label := range labels {
+		if label.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func seriesKey(name string, labels []Label) string {
+	pairs := make([]string, 0, len(labels))
+	for _, label := range labels {
+		pairs = append(pairs, label.Name+"="+label.Value)
+	}
+	sort.Strings(pairs)
+	return name + "{" + strings.Join(pairs, "\xff") + "}"
+}
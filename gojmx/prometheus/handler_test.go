@@ -0,0 +1,243 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package prometheus
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuerier returns the configured responses for each mBean pattern and records the attributes and mBean info requests.
+type fakeQuerier struct {
+	names     map[string][]string
+	responses map[string][]*gojmx.AttributeResponse
+	infos     map[string]*gojmx.MBeanInfo
+	err       error
+	attrCalls []string
+	infoCalls []string
+}
+
+func (f *fakeQuerier) QueryMBeanNames(mBeanPattern string) ([]string, error) {
+	return f.names[mBeanPattern], f.err
+}
+
+func (f *fakeQuerier) QueryMBeanAttributes(mBeanNamePattern string, _ ...string) ([]*gojmx.AttributeResponse, error) {
+	f.attrCalls = append(f.attrCalls, mBeanNamePattern)
+	return f.responses[mBeanNamePattern], f.err
+}
+
+func (f *fakeQuerier) GetMBeanInfo(mBeanName string) (*gojmx.MBeanInfo, error) {
+	f.infoCalls = append(f.infoCalls, mBeanName)
+	info, ok := f.infos[mBeanName]
+	if !ok {
+		return nil, &gojmx.JMXError{Message: "can't find mBean: " + mBeanName}
+	}
+	return info, nil
+}
+
+func newFakeQuerier() *fakeQuerier {
+	return &fakeQuerier{
+		names: map[string][]string{
+			"java.lang:*": {
+				"java.lang:type=Memory",
+				"java.lang:type=Threading",
+				"java.lang:type=MemoryPool,name=Metaspace",
+				"java.lang:type=GarbageCollector,name=G1 Young Generation",
+			},
+			"java.lang:type=MemoryPool,*": {"java.lang:type=MemoryPool,name=Metaspace"},
+		},
+		responses: map[string][]*gojmx.AttributeResponse{
+			"java.lang:type=Memory": {
+				{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1024},
+			},
+			"java.lang:type=Threading": {
+				{Name: "java.lang:type=Threading,attr=ThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 12},
+			},
+			"java.lang:type=MemoryPool,name=Metaspace": {
+				{Name: "java.lang:type=MemoryPool,name=Metaspace,attr=Usage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 10},
+			},
+			"java.lang:type=GarbageCollector,name=G1 Young Generation": {
+				{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1500},
+			},
+		},
+		infos: map[string]*gojmx.MBeanInfo{
+			"java.lang:type=Threading": {
+				Attributes: []*nrprotocol.MBeanAttributeInfo{{Name: "ThreadCount", Description: "Number of \"live\" threads"}},
+			},
+		},
+	}
+}
+
+const testConfig = `
+includeObjectNames: ["java.lang:*"]
+excludeObjectNames: ["java.lang:type=MemoryPool,*"]
+rules:
+  - pattern: 'java.lang<type=GarbageCollector, name=(.+)><>CollectionTime'
+    name: jvm_gc_collection_seconds_total
+    help: GC time
+    labels:
+      gc: $1
+    type: COUNTER
+    valueFactor: 0.001
+  - pattern: 'java.lang<type=Threading><>ThreadCount'
+    name: jvm_threads
+    type: GAUGE
+`
+
+// scrapeDuration matches the scrape duration sample, the only one that changes between scrapes.
+var scrapeDuration = regexp.MustCompile(`(?m)^(jmx_scrape_duration_seconds(\{.*\})?) .*$`)
+
+func scrape(t *testing.T, handler http.Handler, url, accept string) (string, string) {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	body, err := io.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	return scrapeDuration.ReplaceAllString(string(body), "$1 0"), rec.Result().Header.Get("Content-Type")
+}
+
+func Test_Handler_Text(t *testing.T) {
+	config, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	querier := newFakeQuerier()
+	handler, err := NewHandler(config, &Target{Name: "app", Querier: querier})
+	require.NoError(t, err)
+
+	body, contentType := scrape(t, handler, "/metrics", "")
+	assert.Equal(t, string(FormatText), contentType)
+	// The excluded mBean is not read.
+	assert.Equal(t, []string{
+		"java.lang:type=Memory",
+		"java.lang:type=Threading",
+		"java.lang:type=GarbageCollector,name=G1 Young Generation",
+	}, querier.attrCalls)
+	assert.Equal(t, `# HELP jmx_scrape_duration_seconds Time this JMX scrape took, in seconds.
+# TYPE jmx_scrape_duration_seconds gauge
+jmx_scrape_duration_seconds 0
+# HELP jmx_scrape_error Non-zero if this scrape failed.
+# TYPE jmx_scrape_error gauge
+jmx_scrape_error 0
+# HELP jvm_gc_collection_seconds_total GC time
+# TYPE jvm_gc_collection_seconds_total counter
+jvm_gc_collection_seconds_total{gc="G1 Young Generation"} 1.5
+# HELP jvm_threads Number of "live" threads (java.lang<type=Threading><>ThreadCount)
+# TYPE jvm_threads gauge
+jvm_threads 12
+`, body)
+}
+
+func Test_Handler_OpenMetrics(t *testing.T) {
+	config, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	handler, err := NewHandler(config,
+		&Target{Name: "app", Labels: map[string]string{"instance": "app:9999"}, Querier: newFakeQuerier()},
+		&Target{Name: "broken", Labels: map[string]string{"instance": "broken:9999"}, Querier: &fakeQuerier{err: errors.New("nrjmx process exited")}},
+	)
+	require.NoError(t, err)
+
+	body, contentType := scrape(t, handler, "/metrics", "application/openmetrics-text;version=1.0.0,text/plain;version=0.0.4;q=0.5")
+	assert.Equal(t, string(FormatOpenMetrics), contentType)
+	assert.Equal(t, `# HELP jmx_scrape_duration_seconds Time this JMX scrape took, in seconds.
+# TYPE jmx_scrape_duration_seconds gauge
+jmx_scrape_duration_seconds{instance="app:9999"} 0
+jmx_scrape_duration_seconds{instance="broken:9999"} 0
+# HELP jmx_scrape_error Non-zero if this scrape failed.
+# TYPE jmx_scrape_error gauge
+jmx_scrape_error{instance="app:9999"} 0
+jmx_scrape_error{instance="broken:9999"} 1
+# HELP jvm_gc_collection_seconds GC time
+# TYPE jvm_gc_collection_seconds counter
+jvm_gc_collection_seconds_total{gc="G1 Young Generation",instance="app:9999"} 1.5
+# HELP jvm_threads Number of \"live\" threads (java.lang<type=Threading><>ThreadCount)
+# TYPE jvm_threads gauge
+jvm_threads{instance="app:9999"} 12
+# EOF
+`, body)
+
+	// Single target selected by the query parameter.
+	body, _ = scrape(t, handler, "/metrics?target=broken", "")
+	assert.Equal(t, `# HELP jmx_scrape_duration_seconds Time this JMX scrape took, in seconds.
+# TYPE jmx_scrape_duration_seconds gauge
+jmx_scrape_duration_seconds{instance="broken:9999"} 0
+# HELP jmx_scrape_error Non-zero if this scrape failed.
+# TYPE jmx_scrape_error gauge
+jmx_scrape_error{instance="broken:9999"} 1
+`, body)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics?target=missing", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func Test_Handler_DescriptionsCache(t *testing.T) {
+	config, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	querier := newFakeQuerier()
+	handler, err := NewHandler(config, &Target{Name: "app", Querier: querier})
+	require.NoError(t, err)
+
+	handler.Gather()
+	assert.ElementsMatch(t, []string{
+		"java.lang:type=Threading",
+		"java.lang:type=GarbageCollector,name=G1 Young Generation",
+		"java.lang:type=Memory",
+	}, querier.infoCalls)
+
+	// Only the failed requests are retried.
+	querier.infoCalls = nil
+	handler.Gather()
+	assert.ElementsMatch(t, []string{
+		"java.lang:type=GarbageCollector,name=G1 Young Generation",
+		"java.lang:type=Memory",
+	}, querier.infoCalls)
+}
+
+func Test_Handler_FetchesPatternWithoutExclusions(t *testing.T) {
+	config, err := Parse([]byte(`
+includeObjectNames: ["java.lang:type=GarbageCollector,*"]
+excludeObjectNames: ["java.lang:type=MemoryPool,*"]
+`))
+	require.NoError(t, err)
+
+	querier := newFakeQuerier()
+	querier.names["java.lang:type=GarbageCollector,*"] = []string{"java.lang:type=GarbageCollector,name=G1 Young Generation"}
+	handler, err := NewHandler(config, &Target{Name: "app", Querier: querier})
+	require.NoError(t, err)
+
+	handler.Gather()
+	assert.Equal(t, []string{"java.lang:type=GarbageCollector,*"}, querier.attrCalls)
+}
+
+func Test_NewHandler_Validation(t *testing.T) {
+	_, err := NewHandler(nil)
+	assert.EqualError(t, err, "at least one target is required")
+
+	_, err = NewHandler(nil, &Target{Name: "a", Querier: &fakeQuerier{}}, &Target{Name: "a", Querier: &fakeQuerier{}})
+	assert.EqualError(t, err, "duplicated target: 'a'")
+}
+
+func Test_NegotiateFormat(t *testing.T) {
+	assert.Equal(t, FormatText, NegotiateFormat(""))
+	assert.Equal(t, FormatText, NegotiateFormat("text/plain;version=0.0.4;q=1,*/*;q=0.1"))
+	assert.Equal(t, FormatOpenMetrics, NegotiateFormat("application/openmetrics-text;version=1.0.0;q=0.75,text/plain;q=0.5"))
+}
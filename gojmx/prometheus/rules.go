@@ -0,0 +1,248 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package prometheus
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// attribute is a scraped attribute value split in the parts matched by the rules.
+type attribute struct {
+	mBeanName string
+	mBean     *gojmx.ObjectName
+	// attrKeys are the composite attribute names, e.g. HeapMemoryUsage for HeapMemoryUsage.Used
+	attrKeys []string
+	attrName string
+	// value is the attribute value as matched by the rules.
+	value       string
+	number      float64
+	isNumber    bool
+	description string
+}
+
+// newAttribute splits an AttributeResponse, attributes with errors are not returned.
+func newAttribute(response *gojmx.AttributeResponse) (*attribute, bool) {
+	mBeanName, attrName, ok := gojmx.SplitAttributeName(response.Name)
+	if !ok {
+		return nil, false
+	}
+
+	mBean, err := gojmx.ParseObjectName(mBeanName)
+	if err != nil {
+		return nil, false
+	}
+
+	attr := &attribute{mBeanName: mBeanName, mBean: mBean}
+	parts := strings.Split(attrName, ".")
+	// nrjmx capitalizes the composite fields, the rules match the CompositeData keys as jmx_exporter does.
+	for i := 1; i < len(parts); i++ {
+		parts[i] = lowerFirst(parts[i])
+	}
+	attr.attrKeys, attr.attrName = parts[:len(parts)-1], parts[len(parts)-1]
+
+	switch response.ResponseType {
+	case gojmx.ResponseTypeInt:
+		attr.value, attr.number, attr.isNumber = strconv.FormatInt(response.IntValue, 10), float64(response.IntValue), true
+	case gojmx.ResponseTypeDouble:
+		attr.value, attr.number, attr.isNumber = strconv.FormatFloat(response.DoubleValue, 'f', -1, 64), response.DoubleValue, true
+	case gojmx.ResponseTypeBool:
+		attr.value, attr.isNumber = strconv.FormatBool(response.BoolValue), true
+		if response.BoolValue {
+			attr.number = 1
+		}
+	case gojmx.ResponseTypeString:
+		attr.value = response.StringValue
+	default:
+		return nil, false
+	}
+	return attr, true
+}
+
+// lowerFirst returns the name with its first letter in lowercase, e.g. used for Used.
+func lowerFirst(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	if r == utf8.RuneError {
+		return name
+	}
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// rootAttrName returns the name of the mBean attribute, without the composite fields.
+func (a *attribute) rootAttrName() string {
+	if len(a.attrKeys) > 0 {
+		return a.attrKeys[0]
+	}
+	return a.attrName
+}
+
+// beanName returns the attribute mBean with the format matched by the rules:
+// domain<beanPropertyName1=beanPropertyValue1, beanPropertyName2=beanPropertyValue2><compositeAttrName>
+func (a *attribute) beanName() string {
+	props := make([]string, 0, len(a.mBean.Properties))
+	for _, key := range a.mBean.Keys() {
+		props = append(props, key+"="+a.mBean.Get(key))
+	}
+	return fmt.Sprintf("%s<%s><%s>", a.mBean.Domain, strings.Join(props, ", "), strings.Join(a.attrKeys, ", "))
+}
+
+// metric is an attribute mapped by a rule.
+type metric struct {
+	name       string
+	help       string
+	metricType MetricType
+	labels     []Label
+	value      float64
+}
+
+// apply maps the attribute with the first matching rule. It returns false when the attribute is not exported.
+func (c *Config) apply(attr *attribute) (*metric, bool) {
+	beanName := attr.beanName()
+
+	for _, r := range c.rules {
+		attrName := attr.attrName
+		if r.snakeCase {
+			attrName = toSnakeCase(attrName)
+		}
+
+		matchName := beanName + attrName + ": " + attr.value
+		var match []int
+		if r.pattern != nil {
+			if match = r.pattern.FindStringSubmatchIndex(matchName); match == nil {
+				continue
+			}
+		}
+
+		// Non numeric values are only exported by the rules replacing the value.
+		if r.value == "" && !attr.isNumber {
+			continue
+		}
+
+		help := strings.TrimSpace(fmt.Sprintf("%s (%s%s)", attr.description, beanName, attrName))
+		if r.name == "" {
+			return c.defaultMetric(attr, attrName, help, r), true
+		}
+
+		expand := func(template string) string { return template }
+		if r.pattern != nil {
+			expand = func(template string) string {
+				return string(r.pattern.ExpandString(nil, template, matchName, match))
+			}
+		}
+
+		m := &metric{
+			name:       c.metricName(expand(r.name)),
+			help:       help,
+			metricType: r.metricType,
+			value:      attr.number,
+		}
+		if m.name == "" {
+			return nil, false
+		}
+		if r.help != "" {
+			m.help = expand(r.help)
+		}
+
+		if r.value != "" {
+			value, err := strconv.ParseFloat(strings.TrimSpace(expand(r.value)), 64)
+			if err != nil {
+				return nil, false
+			}
+			m.value = value
+		}
+		m.value *= r.valueFactor
+
+		for _, label := range r.labels {
+			name := c.labelName(expand(label.name))
+			if name == "" {
+				continue
+			}
+			m.labels = append(m.labels, Label{Name: name, Value: expand(label.value)})
+		}
+		return m, true
+	}
+
+	return nil, false
+}
+
+// defaultMetric maps the attribute with the jmx_exporter default format:
+// domain_beanPropertyValue1_compositeAttrName_attrName{beanPropertyName2="beanPropertyValue2", ...}
+func (c *Config) defaultMetric(attr *attribute, attrName, help string, r *rule) *metric {
+	keys := attr.mBean.Keys()
+
+	parts := []string{attr.mBean.Domain}
+	if len(keys) > 0 {
+		parts = append(parts, attr.mBean.Get(keys[0]))
+	}
+	parts = append(append(parts, attr.attrKeys...), attrName)
+
+	m := &metric{
+		name:       c.metricName(strings.Join(parts, "_")),
+		help:       help,
+		metricType: r.metricType,
+		value:      attr.number * r.valueFactor,
+	}
+	if len(keys) > 1 {
+		for _, key := range keys[1:] {
+			m.labels = append(m.labels, Label{Name: c.labelName(key), Value: attr.mBean.Get(key)})
+		}
+	}
+	return m
+}
+
+func (c *Config) metricName(name string) string {
+	name = safeName(name)
+	if c.LowercaseOutputName {
+		name = strings.ToLower(name)
+	}
+	return name
+}
+
+func (c *Config) labelName(name string) string {
+	name = safeName(name)
+	if c.LowercaseOutputLabelNames {
+		name = strings.ToLower(name)
+	}
+	return name
+}
+
+// safeName replaces the characters not allowed in metric and label names by '_' collapsing the consecutive ones.
+func safeName(name string) string {
+	sb := strings.Builder{}
+	var prev rune
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == ':') {
+			c = '_'
+		}
+		if c == '_' && prev == '_' {
+			continue
+		}
+		sb.WriteRune(c)
+		prev = c
+	}
+	return sb.String()
+}
+
+// toSnakeCase converts a camel case attribute name, consecutive upper case letters are kept together,
+// e.g. HeapMemoryUsage to heap_memory_usage and MBeanCount to mbean_count.
+func toSnakeCase(name string) string {
+	sb := strings.Builder{}
+	prevUpperOrUnderscore := true
+	for _, c := range name {
+		isUpper := unicode.IsUpper(c)
+		if isUpper && !prevUpperOrUnderscore {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(unicode.ToLower(c))
+		prevUpperOrUnderscore = isUpper || c == '_'
+	}
+	return sb.String()
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse_Validation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		errMsg string
+	}{
+		{
+			name:   "Labels Without Name",
+			config: "rules:\n  - pattern: 'java.lang<.*>'\n    labels:\n      a: b\n",
+			errMsg: "rule 0: name is required when labels, help or value are defined",
+		},
+		{
+			name:   "Invalid Pattern",
+			config: "rules:\n  - pattern: 'java.lang<(.*>'\n",
+			errMsg: "rule 0: invalid pattern: 'java.lang<(.*>'",
+		},
+		{
+			name:   "Invalid Type",
+			config: "rules:\n  - pattern: '.*'\n    name: test\n    type: HISTOGRAM\n",
+			errMsg: "rule 0: invalid type: 'HISTOGRAM', valid: GAUGE, COUNTER, UNTYPED",
+		},
+		{
+			name:   "Empty Rule",
+			config: "rules:\n  -\n",
+			errMsg: "rule 0 is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse([]byte(testCase.config))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.errMsg)
+		})
+	}
+}
+
+func Test_Apply(t *testing.T) {
+	config, err := Parse([]byte(`
+lowercaseOutputName: true
+lowercaseOutputLabelNames: true
+rules:
+  - pattern: 'java.lang<type=Memory><HeapMemoryUsage>(\w+)'
+    name: jvm_memory_heap_$1_bytes
+    help: Heap memory $1
+    type: GAUGE
+  - pattern: 'java.lang<type=GarbageCollector, name=(.+)><>CollectionTime'
+    name: jvm_gc_collection_seconds_total
+    labels:
+      GC: $1
+    type: COUNTER
+    valueFactor: 0.001
+  - pattern: 'test<type=Cat, name=(\w+)><>State: (\w+)'
+    name: test_cat_state
+    labels:
+      name: $1
+      state: $2
+    value: 1
+  - pattern: 'java.lang<type=Threading><>(\w+)'
+    attrNameSnakeCase: true
+  - pattern: 'java.lang<type=OperatingSystem><>(.*):'
+`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		response *gojmx.AttributeResponse
+		expected *metric
+	}{
+		{
+			name:     "Composite Attribute",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 100},
+			expected: &metric{name: "jvm_memory_heap_used_bytes", help: "Heap memory used", metricType: MetricTypeGauge, value: 100},
+		},
+		{
+			name:     "Labels And Value Factor",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionTime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1500},
+			expected: &metric{
+				name:       "jvm_gc_collection_seconds_total",
+				help:       "Heap (java.lang<type=GarbageCollector, name=G1 Young Generation><>CollectionTime)",
+				metricType: MetricTypeCounter,
+				labels:     []Label{{Name: "gc", Value: "G1 Young Generation"}},
+				value:      1.5,
+			},
+		},
+		{
+			name:     "String Value Mapped",
+			response: &gojmx.AttributeResponse{Name: "test:type=Cat,name=tomas,attr=State", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "sleeping"},
+			expected: &metric{
+				name:       "test_cat_state",
+				help:       "Heap (test<type=Cat, name=tomas><>State)",
+				metricType: MetricTypeUntyped,
+				labels:     []Label{{Name: "name", Value: "tomas"}, {Name: "state", Value: "sleeping"}},
+				value:      1,
+			},
+		},
+		{
+			name:     "Default Format Snake Case",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=Threading,attr=ThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 12},
+			expected: &metric{name: "java_lang_threading_thread_count", help: "Heap (java.lang<type=Threading><>thread_count)", metricType: MetricTypeUntyped, value: 12},
+		},
+		{
+			name:     "Default Format Bool",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=OperatingSystem,attr=Available", ResponseType: nrprotocol.ResponseType_BOOL, BoolValue: true},
+			expected: &metric{name: "java_lang_operatingsystem_available", help: "Heap (java.lang<type=OperatingSystem><>Available)", metricType: MetricTypeUntyped, value: 1},
+		},
+		{
+			name:     "String Value Not Mapped",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=OperatingSystem,attr=Arch", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "amd64"},
+		},
+		{
+			name:     "No Matching Rule",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=Runtime,attr=Uptime", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1},
+		},
+		{
+			name:     "Attribute Error",
+			response: &gojmx.AttributeResponse{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_ERROR},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			attr, ok := newAttribute(testCase.response)
+			if !ok {
+				assert.Nil(t, testCase.expected)
+				return
+			}
+			attr.description = "Heap"
+
+			m, ok := config.apply(attr)
+			assert.Equal(t, testCase.expected != nil, ok)
+			assert.Equal(t, testCase.expected, m)
+		})
+	}
+}
+
+func Test_Apply_DefaultFormat(t *testing.T) {
+	config := &Config{}
+	require.NoError(t, config.Validate())
+
+	attr, ok := newAttribute(&gojmx.AttributeResponse{
+		Name:         "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec,topic=orders,attr=Count",
+		ResponseType: nrprotocol.ResponseType_INT,
+		IntValue:     7,
+	})
+	require.True(t, ok)
+
+	m, ok := config.apply(attr)
+	require.True(t, ok)
+	assert.Equal(t, &metric{
+		name:       "kafka_server_BrokerTopicMetrics_Count",
+		help:       "(kafka.server<type=BrokerTopicMetrics, name=MessagesInPerSec, topic=orders><>Count)",
+		metricType: MetricTypeUntyped,
+		labels: []Label{
+			{Name: "name", Value: "MessagesInPerSec"},
+			{Name: "topic", Value: "orders"},
+		},
+		value: 7,
+	}, m)
+}
+
+func Test_SafeName(t *testing.T) {
+	assert.Equal(t, "java_lang_G1_Young_Generation", safeName("java.lang_G1 Young Generation"))
+	assert.Equal(t, "a_b:c", safeName("a__-b:c"))
+}
+
+func Test_ToSnakeCase(t *testing.T) {
+	assert.Equal(t, "heap_memory_usage", toSnakeCase("HeapMemoryUsage"))
+	assert.Equal(t, "mbean_count", toSnakeCase("MBeanCount"))
+	assert.Equal(t, "already_snake", toSnakeCase("already_snake"))
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package rate computes the deltas and per-second rates of cumulative JMX counters between collections.
+package rate
+
+import (
+	"sync"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+const (
+	// StartTimeAttr and UptimeAttr are used to detect the JVM restarts.
+	StartTimeAttr = "java.lang:type=Runtime,attr=StartTime"
+	UptimeAttr    = "java.lang:type=Runtime,attr=Uptime"
+)
+
+// Sample is the change of a counter between two collections.
+type Sample struct {
+	// Name is the attribute identity, the AttributeResponse.Name for the observed attributes.
+	Name    string
+	Delta   float64
+	Rate    float64
+	Elapsed time.Duration
+	// Reset is true when the counter was reset since the previous collection, because of a JVM restart
+	// or because its value decreased. In that case the counter is considered to start from 0.
+	Reset bool
+}
+
+// DeltaResponse returns the delta as an AttributeResponse with the same name as the counter.
+func (s *Sample) DeltaResponse() *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{
+		Name:         s.Name,
+		ResponseType: gojmx.ResponseTypeDouble,
+		DoubleValue:  s.Delta,
+	}
+}
+
+// RateResponse returns the per-second rate as an AttributeResponse with the same name as the counter.
+func (s *Sample) RateResponse() *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{
+		Name:         s.Name,
+		ResponseType: gojmx.ResponseTypeDouble,
+		DoubleValue:  s.Rate,
+	}
+}
+
+type observation struct {
+	value     float64
+	timestamp time.Time
+	// generation is the JVM generation when the value was observed.
+	generation int
+}
+
+// Engine keeps the previous value of the counters of a single JVM.
+// Use an Engine for each monitored JVM, the restart detection applies to all of its counters.
+type Engine struct {
+	lock     sync.Mutex
+	previous map[string]observation
+
+	// JVM restart detection.
+	generation int
+	startTime  int64
+	uptime     int64
+}
+
+// New returns an empty Engine.
+func New() *Engine {
+	return &Engine{
+		previous: map[string]observation{},
+	}
+}
+
+// Observe computes the samples for the numeric attributes collected at the timestamp.
+// The first observation of each attribute only records its value, so no sample is returned for it.
+// When the attributes include the Runtime StartTime or Uptime, they are used to detect the JVM restarts.
+func (e *Engine) Observe(timestamp time.Time, attrs []*gojmx.AttributeResponse) []*Sample {
+	var startTime, uptime int64
+	for _, attr := range attrs {
+		if attr == nil || attr.ResponseType != gojmx.ResponseTypeInt {
+			continue
+		}
+		switch attr.Name {
+		case StartTimeAttr:
+			startTime = attr.IntValue
+		case UptimeAttr:
+			uptime = attr.IntValue
+		}
+	}
+	if startTime > 0 || uptime > 0 {
+		e.ObserveRuntime(startTime, uptime)
+	}
+
+	var result []*Sample
+	for _, attr := range attrs {
+		if attr == nil || attr.Name == StartTimeAttr || attr.Name == UptimeAttr {
+			continue
+		}
+
+		var value float64
+		switch attr.ResponseType {
+		case gojmx.ResponseTypeInt:
+			value = float64(attr.IntValue)
+		case gojmx.ResponseTypeDouble:
+			value = attr.DoubleValue
+		default:
+			continue
+		}
+
+		if sample, ok := e.ObserveValue(attr.Name, timestamp, value); ok {
+			result = append(result, sample)
+		}
+	}
+	return result
+}
+
+// ObserveRuntime records the JVM Runtime StartTime and Uptime in milliseconds, 0 when unknown.
+// A JVM restart is detected when the StartTime changes or the Uptime decreases.
+func (e *Engine) ObserveRuntime(startTime, uptime int64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	restarted := (startTime > 0 && e.startTime > 0 && startTime != e.startTime) ||
+		(uptime > 0 && e.uptime > 0 && uptime < e.uptime)
+	if restarted {
+		e.generation++
+	}
+
+	if startTime > 0 {
+		e.startTime = startTime
+	}
+	if uptime > 0 {
+		e.uptime = uptime
+	}
+}
+
+// ObserveValue computes the sample for a counter identified by the key.
+// It returns false for the first observation of the counter.
+func (e *Engine) ObserveValue(key string, timestamp time.Time, value float64) (*Sample, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	previous, exists := e.previous[key]
+	e.previous[key] = observation{
+		value:      value,
+		timestamp:  timestamp,
+		generation: e.generation,
+	}
+
+	if !exists {
+		return nil, false
+	}
+
+	sample := &Sample{
+		Name:    key,
+		Delta:   value - previous.value,
+		Elapsed: timestamp.Sub(previous.timestamp),
+	}
+
+	if previous.generation != e.generation {
+		// The JVM restarted, the counter started from 0 when the JVM was up.
+		sample.Reset = true
+		sample.Delta = value
+		if e.uptime > 0 {
+			sample.Elapsed = time.Duration(e.uptime) * time.Millisecond
+		}
+	} else if value < previous.value {
+		// The counter was reset, e.g. the mBean was registered again.
+		sample.Reset = true
+		sample.Delta = value
+	}
+
+	if sample.Elapsed > 0 {
+		sample.Rate = sample.Delta / sample.Elapsed.Seconds()
+	}
+	return sample, true
+}
+
+// Forget removes the counters not observed since the provided time, e.g. from mBeans that were unregistered.
+func (e *Engine) Forget(notObservedSince time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for key, previous := range e.previous {
+		if previous.timestamp.Before(notObservedSince) {
+			delete(e.previous, key)
+		}
+	}
+}
+
+// Len returns the number of tracked counters.
+func (e *Engine) Len() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return len(e.previous)
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package rate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const collectionCount = "java.lang:type=GarbageCollector,name=G1 Young Generation,attr=CollectionCount"
+
+func intAttr(name string, value int64) *gojmx.AttributeResponse {
+	return &gojmx.AttributeResponse{Name: name, ResponseType: nrprotocol.ResponseType_INT, IntValue: value}
+}
+
+func Test_Observe(t *testing.T) {
+	engine := New()
+	now := time.Now()
+
+	// GIVEN a first collection
+	samples := engine.Observe(now, []*gojmx.AttributeResponse{
+		intAttr(StartTimeAttr, 1000),
+		intAttr(UptimeAttr, 60000),
+		intAttr(collectionCount, 10),
+		{Name: "test:type=Cat,name=tomas,attr=DoubleValue", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: 1.5},
+		{Name: "test:type=Cat,name=tomas,attr=Name", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "tomas"},
+	})
+
+	// THEN no samples are returned
+	assert.Empty(t, samples)
+	assert.Equal(t, 2, engine.Len())
+
+	// WHEN the counters increase
+	samples = engine.Observe(now.Add(10*time.Second), []*gojmx.AttributeResponse{
+		intAttr(StartTimeAttr, 1000),
+		intAttr(UptimeAttr, 70000),
+		intAttr(collectionCount, 30),
+		{Name: "test:type=Cat,name=tomas,attr=DoubleValue", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: 2.5},
+	})
+
+	// THEN the deltas and rates are returned
+	assert.Equal(t, []*Sample{
+		{Name: collectionCount, Delta: 20, Rate: 2, Elapsed: 10 * time.Second},
+		{Name: "test:type=Cat,name=tomas,attr=DoubleValue", Delta: 1, Rate: 0.1, Elapsed: 10 * time.Second},
+	}, samples)
+
+	assert.Equal(t, &gojmx.AttributeResponse{
+		Name:         collectionCount,
+		ResponseType: nrprotocol.ResponseType_DOUBLE,
+		DoubleValue:  2,
+	}, samples[0].RateResponse())
+	assert.Equal(t, 20.0, samples[0].DeltaResponse().DoubleValue)
+}
+
+func Test_Observe_JVMRestart(t *testing.T) {
+	testCases := []struct {
+		name    string
+		runtime []*gojmx.AttributeResponse
+	}{
+		{
+			name:    "Start Time Changed",
+			runtime: []*gojmx.AttributeResponse{intAttr(StartTimeAttr, 50000), intAttr(UptimeAttr, 5000)},
+		},
+		{
+			name:    "Uptime Decreased",
+			runtime: []*gojmx.AttributeResponse{intAttr(UptimeAttr, 5000)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			engine := New()
+			now := time.Now()
+
+			engine.Observe(now, []*gojmx.AttributeResponse{
+				intAttr(StartTimeAttr, 1000),
+				intAttr(UptimeAttr, 60000),
+				intAttr(collectionCount, 100),
+			})
+
+			// WHEN the JVM restarts and the counter is higher than before
+			samples := engine.Observe(now.Add(30*time.Second), append(testCase.runtime, intAttr(collectionCount, 150)))
+
+			// THEN the counter is considered to start from 0 when the JVM started
+			require.Len(t, samples, 1)
+			assert.Equal(t, &Sample{
+				Name:    collectionCount,
+				Delta:   150,
+				Rate:    30,
+				Elapsed: 5 * time.Second,
+				Reset:   true,
+			}, samples[0])
+		})
+	}
+}
+
+func Test_ObserveValue_CounterReset(t *testing.T) {
+	engine := New()
+	now := time.Now()
+
+	_, ok := engine.ObserveValue("requests", now, 100)
+	assert.False(t, ok)
+
+	sample, ok := engine.ObserveValue("requests", now.Add(2*time.Second), 10)
+	require.True(t, ok)
+	assert.Equal(t, &Sample{Name: "requests", Delta: 10, Rate: 5, Elapsed: 2 * time.Second, Reset: true}, sample)
+
+	sample, ok = engine.ObserveValue("requests", now.Add(4*time.Second), 20)
+	require.True(t, ok)
+	assert.False(t, sample.Reset)
+	assert.Equal(t, 10.0, sample.Delta)
+}
+
+func Test_Forget(t *testing.T) {
+	engine := New()
+	now := time.Now()
+
+	engine.ObserveValue("old", now, 1)
+	engine.ObserveValue("new", now.Add(time.Minute), 1)
+
+	engine.Forget(now.Add(time.Second))
+	assert.Equal(t, 1, engine.Len())
+
+	_, ok := engine.ObserveValue("old", now.Add(2*time.Minute), 2)
+	assert.False(t, ok)
+}
@@ -0,0 +1,666 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// Error types of a CallError.
+const (
+	CallErrorJMX        = "jmx"
+	CallErrorConnection = "connection"
+	CallErrorTransport  = "transport"
+)
+
+// CallRecord is a request to the nrjmx subprocess with its response, as written by Client.RecordTo.
+type CallRecord struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	// Args are the request arguments encoded as a JSON array.
+	Args   json.RawMessage `json:"args,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *CallError      `json:"error,omitempty"`
+	// ResultError is set instead of Result when the result could not be encoded, the replay returns it as a JMXError.
+	ResultError string        `json:"result_error,omitempty"`
+	Duration    time.Duration `json:"duration_ns"`
+}
+
+// CallError is the error returned by a recorded request.
+type CallError struct {
+	// Type is jmx, connection or transport.
+	Type         string  `json:"type"`
+	Message      string  `json:"message"`
+	CauseMessage string  `json:"cause_message,omitempty"`
+	CauseClass   *string `json:"cause_class,omitempty"`
+	Stacktrace   string  `json:"stacktrace,omitempty"`
+}
+
+func newCallError(err error) *CallError {
+	if err == nil {
+		return nil
+	}
+	switch e := err.(type) {
+	case *nrprotocol.JMXError:
+		return &CallError{Type: CallErrorJMX, Message: e.Message, CauseMessage: e.CauseMessage, CauseClass: e.CauseClass, Stacktrace: e.Stacktrace}
+	case *nrprotocol.JMXConnectionError:
+		return &CallError{Type: CallErrorConnection, Message: e.Message}
+	default:
+		return &CallError{Type: CallErrorTransport, Message: err.Error()}
+	}
+}
+
+func (e *CallError) toError() error {
+	switch e.Type {
+	case CallErrorJMX:
+		return &nrprotocol.JMXError{Message: e.Message, CauseMessage: e.CauseMessage, CauseClass: e.CauseClass, Stacktrace: e.Stacktrace}
+	case CallErrorConnection:
+		return &nrprotocol.JMXConnectionError{Message: e.Message}
+	default:
+		return errors.New(e.Message)
+	}
+}
+
+// ReadCallRecords reads the calls written by Client.RecordTo.
+func ReadCallRecords(r io.Reader) ([]*CallRecord, error) {
+	var records []*CallRecord
+
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	for {
+		record := &CallRecord{}
+		err := decoder.Decode(record)
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read call record %d: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+}
+
+// RecordTo writes every request to the nrjmx subprocess with its response, error and duration to w as JSON lines.
+// The recording can be served later by NewReplayClient, e.g. for reproducing a JMX layout in tests.
+// It can be called before or after Open, a nil writer stops the recording. The passwords of the JMXConfig are not
+// recorded. It must not be called concurrently with the requests.
+func (c *Client) RecordTo(w io.Writer) {
+	if recorder, ok := c.jmxService.(*recordingService); ok {
+		c.jmxService = recorder.service
+	}
+
+	c.recordWriter = w
+	if w != nil && c.jmxService != nil {
+		c.jmxService = newRecordingService(c.jmxService, w, c.version)
+	}
+}
+
+// recordingService records the calls to the wrapped nrjmx service.
+type recordingService struct {
+	service nrprotocol.JMXService
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+func newRecordingService(service nrprotocol.JMXService, w io.Writer, version string) *recordingService {
+	s := &recordingService{
+		service: service,
+		encoder: json.NewEncoder(w),
+	}
+	// The version is retrieved before the recording starts, it's recorded for the replay.
+	s.record("GetClientVersion", nil, time.Now(), version, nil)
+	return s
+}
+
+// record writes the call. Write failures are ignored, the recording must not affect the requests.
+// A result that cannot be encoded is recorded as ResultError.
+func (s *recordingService) record(method string, args []interface{}, start time.Time, result interface{}, err error) {
+	record := &CallRecord{
+		Time:     start,
+		Method:   method,
+		Error:    newCallError(err),
+		Duration: time.Since(start),
+	}
+	if args != nil {
+		record.Args, _ = json.Marshal(args)
+	}
+	if err == nil && result != nil {
+		data, marshalErr := json.Marshal(result)
+		if marshalErr != nil {
+			record.ResultError = marshalErr.Error()
+		} else {
+			record.Result = data
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_ = s.encoder.Encode(record)
+}
+
+func (s *recordingService) Connect(ctx context.Context, config *nrprotocol.JMXConfig) error {
+	start := time.Now()
+	err := s.service.Connect(ctx, config)
+
+	hidden := &nrprotocol.JMXConfig{}
+	if config != nil {
+		*hidden = *config
+		hidden.Password, hidden.KeyStorePassword, hidden.TrustStorePassword = "", "", ""
+	}
+	s.record("Connect", []interface{}{hidden}, start, nil, err)
+	return err
+}
+
+func (s *recordingService) Disconnect(ctx context.Context) error {
+	start := time.Now()
+	err := s.service.Disconnect(ctx)
+	s.record("Disconnect", []interface{}{}, start, nil, err)
+	return err
+}
+
+func (s *recordingService) GetClientVersion(ctx context.Context) (string, error) {
+	start := time.Now()
+	r, err := s.service.GetClientVersion(ctx)
+	s.record("GetClientVersion", nil, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) QueryMBeanNames(ctx context.Context, mBeanNamePattern string) ([]string, error) {
+	start := time.Now()
+	r, err := s.service.QueryMBeanNames(ctx, mBeanNamePattern)
+	s.record("QueryMBeanNames", []interface{}{mBeanNamePattern}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetMBeanAttributeNames(ctx context.Context, mBeanName string) ([]string, error) {
+	start := time.Now()
+	r, err := s.service.GetMBeanAttributeNames(ctx, mBeanName)
+	s.record("GetMBeanAttributeNames", []interface{}{mBeanName}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetMBeanInfo(ctx context.Context, mBeanName string) (*nrprotocol.MBeanInfo, error) {
+	start := time.Now()
+	r, err := s.service.GetMBeanInfo(ctx, mBeanName)
+	s.record("GetMBeanInfo", []interface{}{mBeanName}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetMBeanAttributes(ctx context.Context, mBeanName string, attributes []string) ([]*nrprotocol.AttributeResponse, error) {
+	start := time.Now()
+	r, err := s.service.GetMBeanAttributes(ctx, mBeanName, attributes)
+	s.record("GetMBeanAttributes", []interface{}{mBeanName, attributes}, start, attributeResponses(r), err)
+	return r, err
+}
+
+func (s *recordingService) QueryMBeanAttributes(ctx context.Context, mBeanNamePattern string, attributes []string) ([]*nrprotocol.AttributeResponse, error) {
+	start := time.Now()
+	r, err := s.service.QueryMBeanAttributes(ctx, mBeanNamePattern, attributes)
+	s.record("QueryMBeanAttributes", []interface{}{mBeanNamePattern, attributes}, start, attributeResponses(r), err)
+	return r, err
+}
+
+func (s *recordingService) InvokeOperation(ctx context.Context, mBeanName string, operationName string, arguments []string, signature []string) ([]*nrprotocol.AttributeResponse, error) {
+	start := time.Now()
+	r, err := s.service.InvokeOperation(ctx, mBeanName, operationName, arguments, signature)
+	s.record("InvokeOperation", []interface{}{mBeanName, operationName, arguments, signature}, start, attributeResponses(r), err)
+	return r, err
+}
+
+func (s *recordingService) GetInternalStats(ctx context.Context) ([]*nrprotocol.InternalStat, error) {
+	start := time.Now()
+	r, err := s.service.GetInternalStats(ctx)
+	s.record("GetInternalStats", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetInternalMetrics(ctx context.Context) (*nrprotocol.InternalMetrics, error) {
+	start := time.Now()
+	r, err := s.service.GetInternalMetrics(ctx)
+	s.record("GetInternalMetrics", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetTruncations(ctx context.Context) ([]*nrprotocol.Truncation, error) {
+	start := time.Now()
+	r, err := s.service.GetTruncations(ctx)
+	s.record("GetTruncations", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetDomains(ctx context.Context) ([]string, error) {
+	start := time.Now()
+	r, err := s.service.GetDomains(ctx)
+	s.record("GetDomains", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetMBeanCount(ctx context.Context, mBeanNamePattern string) (int64, error) {
+	start := time.Now()
+	r, err := s.service.GetMBeanCount(ctx, mBeanNamePattern)
+	s.record("GetMBeanCount", []interface{}{mBeanNamePattern}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetServerInfo(ctx context.Context) (*nrprotocol.ServerInfo, error) {
+	start := time.Now()
+	r, err := s.service.GetServerInfo(ctx)
+	s.record("GetServerInfo", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) ThreadDump(ctx context.Context, options *nrprotocol.ThreadDumpOptions) ([]*nrprotocol.ThreadInfo, error) {
+	start := time.Now()
+	r, err := s.service.ThreadDump(ctx, options)
+	s.record("ThreadDump", []interface{}{options}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) FindDeadlocks(ctx context.Context) ([]*nrprotocol.ThreadInfo, error) {
+	start := time.Now()
+	r, err := s.service.FindDeadlocks(ctx)
+	s.record("FindDeadlocks", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) DiagnosticCommand(ctx context.Context, command string, arguments []string) (string, error) {
+	start := time.Now()
+	r, err := s.service.DiagnosticCommand(ctx, command, arguments)
+	s.record("DiagnosticCommand", []interface{}{command, arguments}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) StartRecording(ctx context.Context, settings *nrprotocol.RecordingSettings) (int64, error) {
+	start := time.Now()
+	r, err := s.service.StartRecording(ctx, settings)
+	s.record("StartRecording", []interface{}{settings}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) StopRecording(ctx context.Context, recordingId int64) error {
+	start := time.Now()
+	err := s.service.StopRecording(ctx, recordingId)
+	s.record("StopRecording", []interface{}{recordingId}, start, nil, err)
+	return err
+}
+
+func (s *recordingService) CloseRecording(ctx context.Context, recordingId int64) error {
+	start := time.Now()
+	err := s.service.CloseRecording(ctx, recordingId)
+	s.record("CloseRecording", []interface{}{recordingId}, start, nil, err)
+	return err
+}
+
+func (s *recordingService) OpenRecordingStream(ctx context.Context, recordingId int64) (int64, error) {
+	start := time.Now()
+	r, err := s.service.OpenRecordingStream(ctx, recordingId)
+	s.record("OpenRecordingStream", []interface{}{recordingId}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) ReadRecordingStream(ctx context.Context, streamId int64) ([]byte, error) {
+	start := time.Now()
+	r, err := s.service.ReadRecordingStream(ctx, streamId)
+	s.record("ReadRecordingStream", []interface{}{streamId}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) CloseRecordingStream(ctx context.Context, streamId int64) error {
+	start := time.Now()
+	err := s.service.CloseRecordingStream(ctx, streamId)
+	s.record("CloseRecordingStream", []interface{}{streamId}, start, nil, err)
+	return err
+}
+
+func (s *recordingService) GetVMOptions(ctx context.Context) ([]*nrprotocol.VMOption, error) {
+	start := time.Now()
+	r, err := s.service.GetVMOptions(ctx)
+	s.record("GetVMOptions", []interface{}{}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) GetVMOption(ctx context.Context, name string) (*nrprotocol.VMOption, error) {
+	start := time.Now()
+	r, err := s.service.GetVMOption(ctx, name)
+	s.record("GetVMOption", []interface{}{name}, start, r, err)
+	return r, err
+}
+
+func (s *recordingService) SetVMOption(ctx context.Context, name string, value string) error {
+	start := time.Now()
+	err := s.service.SetVMOption(ctx, name, value)
+	s.record("SetVMOption", []interface{}{name, value}, start, nil, err)
+	return err
+}
+
+func (s *recordingService) DumpHeap(ctx context.Context, outputFile string, live bool, timeoutMs int64) error {
+	start := time.Now()
+	err := s.service.DumpHeap(ctx, outputFile, live, timeoutMs)
+	s.record("DumpHeap", []interface{}{outputFile, live, timeoutMs}, start, nil, err)
+	return err
+}
+
+// NewReplayClient returns a Client that serves the calls recorded by Client.RecordTo instead of starting the nrjmx
+// subprocess. The client is used as any other one, starting with Open. The calls are matched by method and
+// arguments, repeated calls are served in the recorded order and the last response is repeated once they are
+// exhausted. Calls that were not recorded fail with a *JMXError. Open and Close always succeed unless the recorded
+// Connect failed.
+func NewReplayClient(ctx context.Context, r io.Reader) (*Client, error) {
+	records, err := ReadCallRecords(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewClient(ctx)
+	c.replay = newReplayService(records)
+	return c, nil
+}
+
+// openReplay replaces the nrjmx subprocess with the replay service.
+func (c *Client) openReplay(config *JMXConfig) (*Client, error) {
+	process := newProcess(c.ctx)
+	process.state.Start()
+	c.nrJMXProcess = process
+
+	c.replay.onDisconnect = func() {
+		process.state.Stop(nil)
+	}
+	c.jmxService = c.replay
+
+	version, err := c.replay.GetClientVersion(c.ctx)
+	if err == nil {
+		c.version = version
+	}
+
+	if c.recordWriter != nil {
+		c.jmxService = newRecordingService(c.jmxService, c.recordWriter, c.version)
+	}
+
+	return c, c.connect(config)
+}
+
+// attributeResponses records the attribute responses with the non-finite doubles as the strings "NaN", "+Inf" and
+// "-Inf", JSON has no representation for them.
+type attributeResponses []*nrprotocol.AttributeResponse
+
+// recordedAttribute replaces the doubleValue of the attribute response in JSON.
+type recordedAttribute struct {
+	*nrprotocol.AttributeResponse
+	DoubleValue json.RawMessage `json:"doubleValue"`
+}
+
+func (r attributeResponses) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte("null"), nil
+	}
+
+	recorded := make([]*recordedAttribute, len(r))
+	for i, response := range r {
+		if response == nil {
+			continue
+		}
+		value := strconv.FormatFloat(response.DoubleValue, 'g', -1, 64)
+		if math.IsNaN(response.DoubleValue) || math.IsInf(response.DoubleValue, 0) {
+			value = strconv.Quote(value)
+		}
+		recorded[i] = &recordedAttribute{AttributeResponse: response, DoubleValue: json.RawMessage(value)}
+	}
+	return json.Marshal(recorded)
+}
+
+func (r *attributeResponses) UnmarshalJSON(data []byte) error {
+	var recorded []*recordedAttribute
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return err
+	}
+	if recorded == nil {
+		*r = nil
+		return nil
+	}
+
+	*r = make(attributeResponses, len(recorded))
+	for i, attr := range recorded {
+		if attr == nil {
+			continue
+		}
+		if attr.AttributeResponse == nil {
+			attr.AttributeResponse = &nrprotocol.AttributeResponse{}
+		}
+		if len(attr.DoubleValue) > 0 && string(attr.DoubleValue) != "null" {
+			value := string(attr.DoubleValue)
+			if unquoted, err := strconv.Unquote(value); err == nil {
+				value = unquoted
+			}
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid doubleValue: %s", attr.DoubleValue)
+			}
+			attr.AttributeResponse.DoubleValue = f
+		}
+		(*r)[i] = attr.AttributeResponse
+	}
+	return nil
+}
+
+// replayService serves the recorded calls.
+type replayService struct {
+	lock         sync.Mutex
+	calls        map[string][]*CallRecord
+	served       map[string]int
+	onDisconnect func()
+}
+
+func newReplayService(records []*CallRecord) *replayService {
+	s := &replayService{
+		calls:  map[string][]*CallRecord{},
+		served: map[string]int{},
+	}
+	for _, record := range records {
+		key := replayKey(record.Method, record.Args)
+		s.calls[key] = append(s.calls[key], record)
+	}
+	return s
+}
+
+// replayKey identifies a call by method and arguments. Connect and Disconnect are matched by method,
+// the replay is independent of the JMXConfig.
+func replayKey(method string, args json.RawMessage) string {
+	if method == "Connect" || method == "Disconnect" {
+		return method
+	}
+
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, args); err != nil {
+		return method + string(args)
+	}
+	return method + compacted.String()
+}
+
+// replay decodes the recorded result of the call into result and returns the recorded error.
+func (s *replayService) replay(method string, args []interface{}, result interface{}) error {
+	var rawArgs json.RawMessage
+	if args != nil {
+		rawArgs, _ = json.Marshal(args)
+	}
+	key := replayKey(method, rawArgs)
+
+	s.lock.Lock()
+	records := s.calls[key]
+	i := s.served[key]
+	if i < len(records)-1 {
+		s.served[key]++
+	}
+	s.lock.Unlock()
+
+	if len(records) == 0 {
+		if method == "Connect" || method == "Disconnect" {
+			return nil
+		}
+		return &nrprotocol.JMXError{Message: fmt.Sprintf("no recorded call for %s%s", method, rawArgs)}
+	}
+
+	record := records[i]
+	if record.Error != nil {
+		return record.Error.toError()
+	}
+	if record.ResultError != "" {
+		return &nrprotocol.JMXError{Message: fmt.Sprintf("recorded result for %s%s could not be encoded: %s", method, rawArgs, record.ResultError)}
+	}
+	if result != nil && len(record.Result) > 0 {
+		if err := json.Unmarshal(record.Result, result); err != nil {
+			return &nrprotocol.JMXError{Message: fmt.Sprintf("cannot decode recorded result for %s%s: %v", method, rawArgs, err)}
+		}
+	}
+	return nil
+}
+
+func (s *replayService) Connect(_ context.Context, _ *nrprotocol.JMXConfig) error {
+	return s.replay("Connect", nil, nil)
+}
+
+func (s *replayService) Disconnect(_ context.Context) error {
+	err := s.replay("Disconnect", nil, nil)
+	if s.onDisconnect != nil {
+		s.onDisconnect()
+	}
+	return err
+}
+
+func (s *replayService) GetClientVersion(_ context.Context) (r string, err error) {
+	err = s.replay("GetClientVersion", nil, &r)
+	return
+}
+
+func (s *replayService) QueryMBeanNames(_ context.Context, mBeanNamePattern string) (r []string, err error) {
+	err = s.replay("QueryMBeanNames", []interface{}{mBeanNamePattern}, &r)
+	return
+}
+
+func (s *replayService) GetMBeanAttributeNames(_ context.Context, mBeanName string) (r []string, err error) {
+	err = s.replay("GetMBeanAttributeNames", []interface{}{mBeanName}, &r)
+	return
+}
+
+func (s *replayService) GetMBeanInfo(_ context.Context, mBeanName string) (r *nrprotocol.MBeanInfo, err error) {
+	err = s.replay("GetMBeanInfo", []interface{}{mBeanName}, &r)
+	return
+}
+
+func (s *replayService) GetMBeanAttributes(_ context.Context, mBeanName string, attributes []string) (r []*nrprotocol.AttributeResponse, err error) {
+	err = s.replay("GetMBeanAttributes", []interface{}{mBeanName, attributes}, (*attributeResponses)(&r))
+	return
+}
+
+func (s *replayService) QueryMBeanAttributes(_ context.Context, mBeanNamePattern string, attributes []string) (r []*nrprotocol.AttributeResponse, err error) {
+	err = s.replay("QueryMBeanAttributes", []interface{}{mBeanNamePattern, attributes}, (*attributeResponses)(&r))
+	return
+}
+
+func (s *replayService) InvokeOperation(_ context.Context, mBeanName string, operationName string, arguments []string, signature []string) (r []*nrprotocol.AttributeResponse, err error) {
+	err = s.replay("InvokeOperation", []interface{}{mBeanName, operationName, arguments, signature}, (*attributeResponses)(&r))
+	return
+}
+
+func (s *replayService) GetInternalStats(_ context.Context) (r []*nrprotocol.InternalStat, err error) {
+	err = s.replay("GetInternalStats", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) GetInternalMetrics(_ context.Context) (r *nrprotocol.InternalMetrics, err error) {
+	err = s.replay("GetInternalMetrics", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) GetTruncations(_ context.Context) (r []*nrprotocol.Truncation, err error) {
+	err = s.replay("GetTruncations", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) GetDomains(_ context.Context) (r []string, err error) {
+	err = s.replay("GetDomains", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) GetMBeanCount(_ context.Context, mBeanNamePattern string) (r int64, err error) {
+	err = s.replay("GetMBeanCount", []interface{}{mBeanNamePattern}, &r)
+	return
+}
+
+func (s *replayService) GetServerInfo(_ context.Context) (r *nrprotocol.ServerInfo, err error) {
+	err = s.replay("GetServerInfo", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) ThreadDump(_ context.Context, options *nrprotocol.ThreadDumpOptions) (r []*nrprotocol.ThreadInfo, err error) {
+	err = s.replay("ThreadDump", []interface{}{options}, &r)
+	return
+}
+
+func (s *replayService) FindDeadlocks(_ context.Context) (r []*nrprotocol.ThreadInfo, err error) {
+	err = s.replay("FindDeadlocks", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) DiagnosticCommand(_ context.Context, command string, arguments []string) (r string, err error) {
+	err = s.replay("DiagnosticCommand", []interface{}{command, arguments}, &r)
+	return
+}
+
+func (s *replayService) StartRecording(_ context.Context, settings *nrprotocol.RecordingSettings) (r int64, err error) {
+	err = s.replay("StartRecording", []interface{}{settings}, &r)
+	return
+}
+
+func (s *replayService) StopRecording(_ context.Context, recordingId int64) error {
+	return s.replay("StopRecording", []interface{}{recordingId}, nil)
+}
+
+func (s *replayService) CloseRecording(_ context.Context, recordingId int64) error {
+	return s.replay("CloseRecording", []interface{}{recordingId}, nil)
+}
+
+func (s *replayService) OpenRecordingStream(_ context.Context, recordingId int64) (r int64, err error) {
+	err = s.replay("OpenRecordingStream", []interface{}{recordingId}, &r)
+	return
+}
+
+func (s *replayService) ReadRecordingStream(_ context.Context, streamId int64) (r []byte, err error) {
+	err = s.replay("ReadRecordingStream", []interface{}{streamId}, &r)
+	return
+}
+
+func (s *replayService) CloseRecordingStream(_ context.Context, streamId int64) error {
+	return s.replay("CloseRecordingStream", []interface{}{streamId}, nil)
+}
+
+func (s *replayService) GetVMOptions(_ context.Context) (r []*nrprotocol.VMOption, err error) {
+	err = s.replay("GetVMOptions", []interface{}{}, &r)
+	return
+}
+
+func (s *replayService) GetVMOption(_ context.Context, name string) (r *nrprotocol.VMOption, err error) {
+	err = s.replay("GetVMOption", []interface{}{name}, &r)
+	return
+}
+
+func (s *replayService) SetVMOption(_ context.Context, name string, value string) error {
+	return s.replay("SetVMOption", []interface{}{name, value}, nil)
+}
+
+func (s *replayService) DumpHeap(_ context.Context, outputFile string, live bool, timeoutMs int64) error {
+	return s.replay("DumpHeap", []interface{}{outputFile, live, timeoutMs}, nil)
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecording = `{"time":"2026-10-01T10:00:00Z","method":"GetClientVersion","result":"2.13.0","duration_ns":0}
+{"time":"2026-10-01T10:00:00Z","method":"Connect","args":[{"hostname":"localhost","port":7199}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"QueryMBeanNames","args":["java.lang:type=Memory"],"result":["java.lang:type=Memory"],"duration_ns":1000}
+{"time":"2026-10-01T10:00:01Z","method":"QueryMBeanAttributes","args":["java.lang:type=Threading",["ThreadCount"]],"result":[{"name":"java.lang:type=Threading,attr=ThreadCount","responseType":"INT","intValue":20}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:02Z","method":"QueryMBeanAttributes","args":["java.lang:type=Threading",["ThreadCount"]],"result":[{"name":"java.lang:type=Threading,attr=ThreadCount","responseType":"INT","intValue":25}],"duration_ns":1000}
+{"time":"2026-10-01T10:00:03Z","method":"GetMBeanInfo","args":["java.lang:type=Missing"],"error":{"type":"jmx","message":"instance not found"},"duration_ns":1000}
+`
+
+func Test_ReplayClient(t *testing.T) {
+	client, err := NewReplayClient(context.Background(), strings.NewReader(testRecording))
+	require.NoError(t, err)
+
+	recording := &bytes.Buffer{}
+	client.RecordTo(recording)
+
+	// The replay doesn't depend on the JMXConfig.
+	client, err = client.Open(&JMXConfig{Hostname: "other", Port: 9999, Password: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "2.13.0", client.GetClientVersion())
+	assert.True(t, client.IsRunning())
+
+	names, err := client.QueryMBeanNames("java.lang:type=Memory")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"java.lang:type=Memory"}, names)
+
+	// Repeated calls are served in order and the last response is repeated.
+	var values []interface{}
+	for i := 0; i < 3; i++ {
+		response, err := client.QueryMBeanAttributes("java.lang:type=Threading", "ThreadCount")
+		require.NoError(t, err)
+		require.Len(t, response, 1)
+		values = append(values, response[0].GetValue())
+	}
+	assert.Equal(t, []interface{}{int64(20), int64(25), int64(25)}, values)
+
+	_, err = client.GetMBeanInfo("java.lang:type=Missing")
+	jmxErr, ok := IsJMXError(err)
+	require.True(t, ok)
+	assert.Equal(t, "instance not found", jmxErr.Message)
+
+	_, err = client.GetDomains()
+	jmxErr, ok = IsJMXError(err)
+	require.True(t, ok)
+	assert.Equal(t, "no recorded call for GetDomains[]", jmxErr.Message)
+
+	require.NoError(t, client.Close())
+	assert.False(t, client.IsRunning())
+
+	// The calls served by the replay were recorded.
+	records, err := ReadCallRecords(recording)
+	require.NoError(t, err)
+
+	var methods []string
+	for _, record := range records {
+		methods = append(methods, record.Method)
+	}
+	assert.Equal(t, []string{
+		"GetClientVersion", "Connect", "QueryMBeanNames",
+		"QueryMBeanAttributes", "QueryMBeanAttributes", "QueryMBeanAttributes",
+		"GetMBeanInfo", "GetDomains", "Disconnect",
+	}, methods)
+
+	assert.JSONEq(t, `"2.13.0"`, string(records[0].Result))
+	assert.NotContains(t, string(records[1].Args), "secret")
+	assert.Contains(t, string(records[1].Args), `"hostname":"other"`)
+	assert.JSONEq(t, `["java.lang:type=Threading",["ThreadCount"]]`, string(records[3].Args))
+	assert.Equal(t, &CallError{Type: CallErrorJMX, Message: "instance not found"}, records[6].Error)
+	assert.Nil(t, records[6].Result)
+}
+
+func Test_CallError(t *testing.T) {
+	causeClass := "java.lang.UnsupportedOperationException"
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "JMX Error",
+			err:  &nrprotocol.JMXError{Message: "failed", CauseMessage: "cause", CauseClass: &causeClass, Stacktrace: "at ..."},
+		},
+		{
+			name: "Connection Error",
+			err:  &nrprotocol.JMXConnectionError{Message: "connection refused"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.err, newCallError(testCase.err).toError())
+		})
+	}
+
+	assert.Nil(t, newCallError(nil))
+}
+
+func Test_ReplayClient_NonFinite(t *testing.T) {
+	recorded := `{"time":"2026-10-01T10:00:00Z","method":"GetClientVersion","result":"2.13.0","duration_ns":0}
+{"time":"2026-10-01T10:00:01Z","method":"QueryMBeanAttributes","args":["test:type=Cat",["Ratio","Max","Min","Half"]],"result":[{"name":"test:type=Cat,attr=Ratio","responseType":"DOUBLE","doubleValue":"NaN"},{"name":"test:type=Cat,attr=Max","responseType":"DOUBLE","doubleValue":"+Inf"},{"name":"test:type=Cat,attr=Min","responseType":"DOUBLE","doubleValue":"-Inf"},{"name":"test:type=Cat,attr=Half","responseType":"DOUBLE","doubleValue":0.5}],"duration_ns":1000}
+`
+	client, err := NewReplayClient(context.Background(), strings.NewReader(recorded))
+	require.NoError(t, err)
+
+	recording := &bytes.Buffer{}
+	client.RecordTo(recording)
+	client, err = client.Open(&JMXConfig{})
+	require.NoError(t, err)
+
+	response, err := client.QueryMBeanAttributes("test:type=Cat", "Ratio", "Max", "Min", "Half")
+	require.NoError(t, err)
+	require.Len(t, response, 4)
+	assert.True(t, math.IsNaN(response[0].DoubleValue))
+	assert.Equal(t, math.Inf(1), response[1].DoubleValue)
+	assert.Equal(t, math.Inf(-1), response[2].DoubleValue)
+	assert.Equal(t, 0.5, response[3].DoubleValue)
+
+	// The non-finite values are recorded again as strings.
+	records, err := ReadCallRecords(recording)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "QueryMBeanAttributes", records[2].Method)
+	assert.Empty(t, records[2].ResultError)
+	assert.Contains(t, string(records[2].Result), `"doubleValue":"NaN"`)
+	assert.Contains(t, string(records[2].Result), `"doubleValue":"+Inf"`)
+	assert.Contains(t, string(records[2].Result), `"doubleValue":"-Inf"`)
+	assert.Contains(t, string(records[2].Result), `"doubleValue":0.5`)
+}
+
+func Test_Record_ResultError(t *testing.T) {
+	recording := &bytes.Buffer{}
+	service := newRecordingService(nil, recording, "2.13.0")
+	service.record("GetInternalStats", []interface{}{}, time.Now(), []*nrprotocol.InternalStat{{Milliseconds: math.NaN()}}, nil)
+
+	records, err := ReadCallRecords(recording)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Nil(t, records[1].Result)
+	assert.Equal(t, "json: unsupported value: NaN", records[1].ResultError)
+
+	// The replay doesn't serve an empty result.
+	_, err = newReplayService(records).GetInternalStats(context.Background())
+	jmxErr, ok := err.(*nrprotocol.JMXError)
+	require.True(t, ok)
+	assert.Equal(t, "recorded result for GetInternalStats[] could not be encoded: json: unsupported value: NaN", jmxErr.Message)
+}
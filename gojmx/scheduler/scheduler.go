@@ -0,0 +1,306 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package scheduler runs groups of mBean queries periodically, each group at its own interval.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// ErrBudgetExceeded is reported when a group run takes longer than its budget, the remaining queries are skipped.
+var ErrBudgetExceeded = errors.New("collection cycle budget exceeded")
+
+// Querier is the gojmx.Client functionality required to run the query groups.
+type Querier interface {
+	QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// Query is an mBean pattern and the attributes to retrieve, all of them when empty.
+type Query struct {
+	MBean      string
+	Attributes []string
+}
+
+// Group is a set of queries run together at the same interval.
+type Group struct {
+	Name     string
+	Interval time.Duration
+	// Jitter delays each run a random duration up to Jitter, spreading the load of the groups.
+	Jitter time.Duration
+	// Budget limits the duration of a run. When not defined it's RequestTimeout for each query, up to the Interval.
+	Budget  time.Duration
+	Queries []*Query
+}
+
+// Config configures the Scheduler.
+type Config struct {
+	// RequestTimeout is the JMXConfig.RequestTimeoutMs of the client, used for the groups budget.
+	RequestTimeout time.Duration
+	// OnResult is called with the result of each run. When not defined the results are sent to the Results channel.
+	OnResult func(*Result)
+}
+
+// Result is the outcome of a group run.
+type Result struct {
+	Group     string
+	Start     time.Time
+	Duration  time.Duration
+	Responses []*gojmx.AttributeResponse
+	// Errors contains the *QueryError for the queries that failed and ErrBudgetExceeded when the run was cut short.
+	Errors []error
+}
+
+// QueryError is reported when an mBean query fails.
+type QueryError struct {
+	Pattern string
+	Err     error
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("query '%s' failed: %v", e.Pattern, e.Err)
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// GroupStats are the runs statistics of a group.
+type GroupStats struct {
+	Runs      uint64
+	Successes uint64
+	Failures  uint64
+	// Skipped counts the runs not started because the previous one was still in progress.
+	Skipped       uint64
+	LastRun       time.Time
+	LastDuration  time.Duration
+	MaxDuration   time.Duration
+	TotalDuration time.Duration
+	LastErrors    []error
+}
+
+// AvgDuration returns the average duration of the runs.
+func (s GroupStats) AvgDuration() time.Duration {
+	if s.Runs == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(s.Runs)
+}
+
+// Scheduler runs the query groups at their intervals. A run is skipped when the previous one of the same group
+// is still in progress. Queries are serialized, the gojmx.Client doesn't support concurrent requests.
+type Scheduler struct {
+	querier Querier
+	config  Config
+	groups  []*group
+	results chan *Result
+
+	// queryMu serializes the requests to the querier.
+	queryMu sync.Mutex
+	statsMu sync.Mutex
+}
+
+type group struct {
+	*Group
+	budget  time.Duration
+	running chan struct{}
+	stats   GroupStats
+}
+
+// New validates the groups and returns a Scheduler for them.
+func New(querier Querier, config Config, groups ...*Group) (*Scheduler, error) {
+	if len(groups) == 0 {
+		return nil, errors.New("at least one group is required")
+	}
+
+	s := &Scheduler{
+		querier: querier,
+		config:  config,
+	}
+
+	names := map[string]bool{}
+	for _, g := range groups {
+		if g == nil || g.Interval <= 0 {
+			return nil, errors.New("group interval must be greater than zero")
+		}
+		if names[g.Name] {
+			return nil, fmt.Errorf("duplicated group: '%s'", g.Name)
+		}
+		names[g.Name] = true
+
+		if len(g.Queries) == 0 {
+			return nil, fmt.Errorf("group '%s' has no queries", g.Name)
+		}
+
+		s.groups = append(s.groups, &group{
+			Group:   g,
+			budget:  budget(g, config.RequestTimeout),
+			running: make(chan struct{}, 1),
+		})
+	}
+
+	if config.OnResult == nil {
+		s.results = make(chan *Result, len(groups))
+	}
+	return s, nil
+}
+
+func budget(g *Group, requestTimeout time.Duration) time.Duration {
+	if g.Budget > 0 {
+		return g.Budget
+	}
+	if requestTimeout <= 0 {
+		return g.Interval
+	}
+	return min(requestTimeout*time.Duration(len(g.Queries)), g.Interval)
+}
+
+// Results returns the channel receiving the results when Config.OnResult is not defined.
+// It's closed when Run returns.
+func (s *Scheduler) Results() <-chan *Result {
+	return s.results
+}
+
+// Run runs the groups until the context is done, the first run of each group starts immediately.
+func (s *Scheduler) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, g := range s.groups {
+		wg.Add(1)
+		go func(g *group) {
+			defer wg.Done()
+			s.schedule(ctx, g)
+		}(g)
+	}
+	wg.Wait()
+
+	if s.results != nil {
+		close(s.results)
+	}
+}
+
+func (s *Scheduler) schedule(ctx context.Context, g *group) {
+	ticker := time.NewTicker(g.Interval)
+	defer ticker.Stop()
+
+	runs := sync.WaitGroup{}
+	defer runs.Wait()
+
+	for {
+		select {
+		case g.running <- struct{}{}:
+			runs.Add(1)
+			go func() {
+				defer runs.Done()
+				defer func() { <-g.running }()
+				s.run(ctx, g)
+			}()
+		default:
+			s.statsMu.Lock()
+			g.stats.Skipped++
+			s.statsMu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, g *group) {
+	if g.Jitter > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rand.N(g.Jitter)):
+		}
+	}
+
+	result := s.collect(g)
+
+	s.statsMu.Lock()
+	g.stats.Runs++
+	if len(result.Errors) == 0 {
+		g.stats.Successes++
+	} else {
+		g.stats.Failures++
+	}
+	g.stats.LastRun = result.Start
+	g.stats.LastDuration = result.Duration
+	g.stats.MaxDuration = max(g.stats.MaxDuration, result.Duration)
+	g.stats.TotalDuration += result.Duration
+	g.stats.LastErrors = result.Errors
+	s.statsMu.Unlock()
+
+	if s.config.OnResult != nil {
+		s.config.OnResult(result)
+		return
+	}
+
+	select {
+	case s.results <- result:
+	case <-ctx.Done():
+	}
+}
+
+// collect runs the group queries once. When the budget is exceeded the remaining queries are skipped.
+// Failing queries don't prevent running the remaining ones unless the error is not a *gojmx.JMXError,
+// e.g. a connection error.
+func (s *Scheduler) collect(g *group) *Result {
+	result := &Result{
+		Group: g.Name,
+		Start: time.Now(),
+	}
+	defer func() {
+		result.Duration = time.Since(result.Start)
+	}()
+
+	for _, q := range g.Queries {
+		if time.Since(result.Start) >= g.budget {
+			result.Errors = append(result.Errors, ErrBudgetExceeded)
+			return result
+		}
+
+		s.queryMu.Lock()
+		responses, err := s.querier.QueryMBeanAttributes(q.MBean, q.Attributes...)
+		s.queryMu.Unlock()
+
+		if err != nil {
+			result.Errors = append(result.Errors, &QueryError{Pattern: q.MBean, Err: err})
+			if _, ok := gojmx.IsJMXError(err); !ok {
+				return result
+			}
+			continue
+		}
+		result.Responses = append(result.Responses, responses...)
+	}
+
+	if time.Since(result.Start) > g.budget {
+		result.Errors = append(result.Errors, ErrBudgetExceeded)
+	}
+	return result
+}
+
+// Stats returns the runs statistics of each group.
+func (s *Scheduler) Stats() map[string]GroupStats {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	result := make(map[string]GroupStats, len(s.groups))
+	for _, g := range s.groups {
+		result[g.Name] = g.stats
+	}
+	return result
+}
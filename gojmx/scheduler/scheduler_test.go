@@ -0,0 +1,188 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQuerier returns a response for each pattern after the configured delay, tracking the concurrent requests.
+type fakeQuerier struct {
+	delay  time.Duration
+	errors map[string]error
+
+	mu            sync.Mutex
+	calls         []string
+	inFlight      int
+	maxConcurrent int
+}
+
+func (f *fakeQuerier) QueryMBeanAttributes(mBeanNamePattern string, _ ...string) ([]*gojmx.AttributeResponse, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, mBeanNamePattern)
+	f.inFlight++
+	f.maxConcurrent = max(f.maxConcurrent, f.inFlight)
+	f.mu.Unlock()
+
+	time.Sleep(f.delay)
+
+	f.mu.Lock()
+	f.inFlight--
+	f.mu.Unlock()
+
+	if err, ok := f.errors[mBeanNamePattern]; ok {
+		return nil, err
+	}
+	return []*gojmx.AttributeResponse{
+		{Name: mBeanNamePattern + ",attr=Value", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1},
+	}, nil
+}
+
+func Test_Run_Intervals(t *testing.T) {
+	q := &fakeQuerier{}
+
+	var mu sync.Mutex
+	var results []*Result
+	s, err := New(q, Config{OnResult: func(r *Result) {
+		mu.Lock()
+		defer mu.Unlock()
+		results = append(results, r)
+	}},
+		&Group{Name: "fast", Interval: 10 * time.Millisecond, Queries: []*Query{{MBean: "test:type=Fast"}}},
+		&Group{Name: "slow", Interval: 100 * time.Millisecond, Jitter: 5 * time.Millisecond, Queries: []*Query{{MBean: "test:type=Slow"}}},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	s.Run(ctx)
+
+	stats := s.Stats()
+	assert.GreaterOrEqual(t, stats["fast"].Runs, uint64(5))
+	assert.Equal(t, stats["fast"].Runs, stats["fast"].Successes)
+	assert.Equal(t, uint64(2), stats["slow"].Runs)
+	assert.Equal(t, uint64(2), stats["slow"].Successes)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, results)
+	for _, r := range results {
+		assert.Empty(t, r.Errors)
+		require.Len(t, r.Responses, 1)
+		if r.Group == "fast" {
+			assert.Equal(t, "test:type=Fast,attr=Value", r.Responses[0].Name)
+		} else {
+			assert.Equal(t, "test:type=Slow,attr=Value", r.Responses[0].Name)
+		}
+	}
+}
+
+func Test_Run_SkipsOverlappingRuns(t *testing.T) {
+	q := &fakeQuerier{delay: 30 * time.Millisecond}
+
+	s, err := New(q, Config{OnResult: func(*Result) {}},
+		&Group{Name: "a", Interval: 5 * time.Millisecond, Queries: []*Query{{MBean: "test:type=A"}}},
+		&Group{Name: "b", Interval: 5 * time.Millisecond, Queries: []*Query{{MBean: "test:type=B"}}},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	s.Run(ctx)
+
+	stats := s.Stats()
+	assert.Greater(t, stats["a"].Skipped, uint64(0))
+	assert.Greater(t, stats["b"].Skipped, uint64(0))
+	assert.GreaterOrEqual(t, stats["a"].MaxDuration, 30*time.Millisecond)
+
+	// The requests of the groups are serialized.
+	assert.Equal(t, 1, q.maxConcurrent)
+}
+
+func Test_Run_ResultsChannel(t *testing.T) {
+	s, err := New(&fakeQuerier{}, Config{},
+		&Group{Name: "a", Interval: time.Hour, Queries: []*Query{{MBean: "test:type=A"}}},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go s.Run(ctx)
+
+	result := <-s.Results()
+	assert.Equal(t, "a", result.Group)
+	assert.Len(t, result.Responses, 1)
+
+	cancel()
+	_, open := <-s.Results()
+	assert.False(t, open)
+}
+
+func Test_Collect_Budget(t *testing.T) {
+	q := &fakeQuerier{delay: 15 * time.Millisecond}
+
+	s, err := New(q, Config{RequestTimeout: 10 * time.Millisecond},
+		&Group{Name: "a", Interval: time.Hour, Queries: []*Query{
+			{MBean: "test:type=A"},
+			{MBean: "test:type=B"},
+			{MBean: "test:type=C"},
+		}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Millisecond, s.groups[0].budget)
+
+	result := s.collect(s.groups[0])
+	assert.Len(t, result.Responses, 2)
+	assert.Equal(t, []error{ErrBudgetExceeded}, result.Errors)
+	assert.Equal(t, []string{"test:type=A", "test:type=B"}, q.calls)
+}
+
+func Test_Collect_Errors(t *testing.T) {
+	q := &fakeQuerier{errors: map[string]error{
+		"test:type=Missing": &gojmx.JMXError{Message: "can't find mBean"},
+		"test:type=Broken":  errors.New("connection lost"),
+	}}
+
+	s, err := New(q, Config{},
+		&Group{Name: "a", Interval: time.Hour, Queries: []*Query{
+			{MBean: "test:type=Missing"},
+			{MBean: "test:type=A"},
+			{MBean: "test:type=Broken"},
+			{MBean: "test:type=B"},
+		}},
+	)
+	require.NoError(t, err)
+
+	result := s.collect(s.groups[0])
+	assert.Len(t, result.Responses, 1)
+	require.Len(t, result.Errors, 2)
+	assert.EqualError(t, result.Errors[0], "query 'test:type=Missing' failed: jmx error: can't find mBean, cause: , stacktrace: ")
+	assert.EqualError(t, result.Errors[1], "query 'test:type=Broken' failed: connection lost")
+	assert.Equal(t, []string{"test:type=Missing", "test:type=A", "test:type=Broken"}, q.calls)
+}
+
+func Test_New_Validation(t *testing.T) {
+	_, err := New(&fakeQuerier{}, Config{})
+	assert.EqualError(t, err, "at least one group is required")
+
+	_, err = New(&fakeQuerier{}, Config{}, &Group{Name: "a"})
+	assert.EqualError(t, err, "group interval must be greater than zero")
+
+	_, err = New(&fakeQuerier{}, Config{}, &Group{Name: "a", Interval: time.Second})
+	assert.EqualError(t, err, "group 'a' has no queries")
+
+	group := &Group{Name: "a", Interval: time.Second, Queries: []*Query{{MBean: "test:*"}}}
+	_, err = New(&fakeQuerier{}, Config{}, group, group)
+	assert.EqualError(t, err, "duplicated group: 'a'")
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// DiffOptions configures which numeric changes are reported.
+// A change is reported when it exceeds both thresholds, with the zero values every change is reported.
+type DiffOptions struct {
+	// MinDelta is the absolute change required, e.g. 100 for a counter.
+	MinDelta float64
+	// MinRatio is the change relative to the previous value required, e.g. 0.1 for 10%.
+	// Changes from 0 always exceed it.
+	MinRatio float64
+	// IgnoreStrings skips the changes of string and bool values.
+	IgnoreStrings bool
+}
+
+// Report contains the differences between two snapshots, sorted by mBean and attribute names.
+type Report struct {
+	Before time.Time `json:"before"`
+	After  time.Time `json:"after"`
+
+	AddedMBeans   []string `json:"added_mbeans,omitempty"`
+	RemovedMBeans []string `json:"removed_mbeans,omitempty"`
+	// AddedAttributes and RemovedAttributes are reported for the mBeans present in both snapshots.
+	AddedAttributes   []*AttributeRef `json:"added_attributes,omitempty"`
+	RemovedAttributes []*AttributeRef `json:"removed_attributes,omitempty"`
+	// TypeChanges contains the attributes whose value type changed, e.g. an attribute that started failing.
+	TypeChanges []*Change `json:"type_changes,omitempty"`
+	// NumericChanges contains the numeric values that changed over the DiffOptions thresholds.
+	NumericChanges []*Change `json:"numeric_changes,omitempty"`
+	// ValueChanges contains the string and bool values that changed, and the doubles that changed from or to
+	// a non-finite value, e.g. NaN, which have no delta.
+	ValueChanges []*Change `json:"value_changes,omitempty"`
+}
+
+// AttributeRef identifies an mBean attribute.
+type AttributeRef struct {
+	MBean     string `json:"mbean"`
+	Attribute string `json:"attribute"`
+}
+
+// Change is an attribute that differs between the snapshots.
+type Change struct {
+	MBean     string `json:"mbean"`
+	Attribute string `json:"attribute"`
+	Before    *Value `json:"before"`
+	After     *Value `json:"after"`
+	// Delta and Ratio are set for the NumericChanges. Ratio is +Inf for changes from 0, it's encoded as null in JSON.
+	Delta float64  `json:"delta,omitempty"`
+	Ratio *float64 `json:"ratio,omitempty"`
+}
+
+// Diff compares two snapshots.
+func Diff(before, after *Snapshot, opts DiffOptions) *Report {
+	report := &Report{}
+	if before == nil {
+		before = &Snapshot{}
+	}
+	if after == nil {
+		after = &Snapshot{}
+	}
+	report.Before, report.After = before.Time, after.Time
+
+	for _, mBeanName := range before.MBeanNames() {
+		if _, exists := after.MBeans[mBeanName]; !exists {
+			report.RemovedMBeans = append(report.RemovedMBeans, mBeanName)
+		}
+	}
+
+	for _, mBeanName := range after.MBeanNames() {
+		beforeAttrs, exists := before.MBeans[mBeanName]
+		if !exists {
+			report.AddedMBeans = append(report.AddedMBeans, mBeanName)
+			continue
+		}
+		afterAttrs := after.MBeans[mBeanName]
+
+		for _, attrName := range attrNames(beforeAttrs) {
+			if _, exists := afterAttrs[attrName]; !exists {
+				report.RemovedAttributes = append(report.RemovedAttributes, &AttributeRef{MBean: mBeanName, Attribute: attrName})
+			}
+		}
+
+		for _, attrName := range attrNames(afterAttrs) {
+			beforeValue, exists := beforeAttrs[attrName]
+			if !exists {
+				report.AddedAttributes = append(report.AddedAttributes, &AttributeRef{MBean: mBeanName, Attribute: attrName})
+				continue
+			}
+			report.compare(mBeanName, attrName, beforeValue, afterAttrs[attrName], opts)
+		}
+	}
+
+	return report
+}
+
+func (r *Report) compare(mBeanName, attrName string, before, after *Value, opts DiffOptions) {
+	change := &Change{MBean: mBeanName, Attribute: attrName, Before: before, After: after}
+
+	if before.Type != after.Type {
+		r.TypeChanges = append(r.TypeChanges, change)
+		return
+	}
+
+	switch before.Type {
+	case TypeInt, TypeDouble:
+		if !before.IsFinite() || !after.IsFinite() {
+			if before.String() != after.String() {
+				r.ValueChanges = append(r.ValueChanges, change)
+			}
+			return
+		}
+
+		beforeValue, _ := before.Float64()
+		afterValue, _ := after.Float64()
+		change.Delta = afterValue - beforeValue
+		if change.Delta == 0 || math.Abs(change.Delta) < opts.MinDelta {
+			return
+		}
+
+		ratio := math.Inf(1)
+		if beforeValue != 0 {
+			ratio = math.Abs(change.Delta / beforeValue)
+		}
+		if ratio < opts.MinRatio {
+			return
+		}
+		if !math.IsInf(ratio, 1) {
+			change.Ratio = &ratio
+		}
+		r.NumericChanges = append(r.NumericChanges, change)
+	case TypeString, TypeBool:
+		if !opts.IgnoreStrings && before.Value != after.Value {
+			r.ValueChanges = append(r.ValueChanges, change)
+		}
+	}
+}
+
+// IsEmpty returns true when the snapshots don't have differences.
+func (r *Report) IsEmpty() bool {
+	return len(r.AddedMBeans) == 0 && len(r.RemovedMBeans) == 0 &&
+		len(r.AddedAttributes) == 0 && len(r.RemovedAttributes) == 0 &&
+		len(r.TypeChanges) == 0 && len(r.NumericChanges) == 0 && len(r.ValueChanges) == 0
+}
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteText writes the report in a human readable format, a line per difference prefixed by + for the added
+// mBeans and attributes, - for the removed ones, ! for the type changes and ~ for the value changes, e.g.
+// "~ java.lang:type=Threading ThreadCount: 20 -> 45 (+25, +125.0%)".
+func (r *Report) WriteText(w io.Writer) error {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Snapshots: %s -> %s\n", formatTime(r.Before), formatTime(r.After)))
+	if r.IsEmpty() {
+		sb.WriteString("No differences\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	for _, mBeanName := range r.AddedMBeans {
+		sb.WriteString(fmt.Sprintf("+ mBean %s\n", mBeanName))
+	}
+	for _, mBeanName := range r.RemovedMBeans {
+		sb.WriteString(fmt.Sprintf("- mBean %s\n", mBeanName))
+	}
+	for _, attr := range r.AddedAttributes {
+		sb.WriteString(fmt.Sprintf("+ %s %s\n", attr.MBean, attr.Attribute))
+	}
+	for _, attr := range r.RemovedAttributes {
+		sb.WriteString(fmt.Sprintf("- %s %s\n", attr.MBean, attr.Attribute))
+	}
+	for _, change := range r.TypeChanges {
+		sb.WriteString(fmt.Sprintf("! %s %s: %s -> %s\n", change.MBean, change.Attribute,
+			typedValue(change.Before), typedValue(change.After)))
+	}
+	for _, change := range r.NumericChanges {
+		ratio := "new"
+		if change.Ratio != nil {
+			ratio = fmt.Sprintf("%+.1f%%", math.Copysign(*change.Ratio*100, change.Delta))
+		}
+		sb.WriteString(fmt.Sprintf("~ %s %s: %s -> %s (%+g, %s)\n", change.MBean, change.Attribute,
+			change.Before, change.After, change.Delta, ratio))
+	}
+	for _, change := range r.ValueChanges {
+		sb.WriteString(fmt.Sprintf("~ %s %s: %s -> %s\n", change.MBean, change.Attribute, change.Before, change.After))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// typedValue formats the value with its type, e.g. int(10), errors already include it.
+func typedValue(v *Value) string {
+	if v.Type == TypeError {
+		return v.String()
+	}
+	return fmt.Sprintf("%s(%s)", v.Type, v)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return t.Format(time.RFC3339)
+}
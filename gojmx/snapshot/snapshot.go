@@ -0,0 +1,272 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package snapshot stores the attributes collected from a JMX server and compares them between collection runs,
+// e.g. before and after a deployment.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// Value types of a snapshot attribute.
+const (
+	TypeString = "string"
+	TypeDouble = "double"
+	TypeInt    = "int"
+	TypeBool   = "bool"
+	TypeError  = "error"
+)
+
+// Snapshot keeps the attribute values of the mBeans at a point in time.
+type Snapshot struct {
+	Time time.Time `json:"time"`
+	// MBeans maps the mBean names to their attributes. Composite attributes keep the field, e.g. HeapMemoryUsage.Used
+	MBeans map[string]map[string]*Value `json:"mbeans"`
+}
+
+// Value is an attribute value with its type. Error is set for the attributes that couldn't be retrieved.
+type Value struct {
+	Type string `json:"type"`
+	// Value is a string, float64, int64 or bool, matching the Type. The doubles that are not finite are stored
+	// in JSON as the strings "NaN", "+Inf" and "-Inf", JSON has no representation for them.
+	Value interface{} `json:"value,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+// New builds a snapshot with the attribute responses. Responses whose name cannot be parsed are skipped.
+func New(attrs []*gojmx.AttributeResponse) *Snapshot {
+	s := &Snapshot{
+		Time:   time.Now(),
+		MBeans: map[string]map[string]*Value{},
+	}
+	s.Add(attrs)
+	return s
+}
+
+// Add includes the attribute responses in the snapshot, replacing the existing values.
+func (s *Snapshot) Add(attrs []*gojmx.AttributeResponse) {
+	for _, attr := range attrs {
+		if attr == nil {
+			continue
+		}
+		mBeanName, attrName, ok := gojmx.SplitAttributeName(attr.Name)
+		if !ok {
+			continue
+		}
+
+		value := &Value{}
+		switch attr.ResponseType {
+		case gojmx.ResponseTypeString:
+			value.Type, value.Value = TypeString, attr.StringValue
+		case gojmx.ResponseTypeDouble:
+			value.Type, value.Value = TypeDouble, attr.DoubleValue
+		case gojmx.ResponseTypeInt:
+			value.Type, value.Value = TypeInt, attr.IntValue
+		case gojmx.ResponseTypeBool:
+			value.Type, value.Value = TypeBool, attr.BoolValue
+		default:
+			value.Type, value.Error = TypeError, attr.StatusMsg
+		}
+
+		if _, exists := s.MBeans[mBeanName]; !exists {
+			s.MBeans[mBeanName] = map[string]*Value{}
+		}
+		s.MBeans[mBeanName][attrName] = value
+	}
+}
+
+// Get returns the value of an mBean attribute or nil when not in the snapshot.
+func (s *Snapshot) Get(mBeanName, attrName string) *Value {
+	if s == nil {
+		return nil
+	}
+	return s.MBeans[mBeanName][attrName]
+}
+
+// MBeanNames returns the mBean names sorted alphabetically.
+func (s *Snapshot) MBeanNames() []string {
+	if s == nil {
+		return nil
+	}
+	names := make([]string, 0, len(s.MBeans))
+	for name := range s.MBeans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Write stores the snapshot as JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Save stores the snapshot as JSON in a file.
+func (s *Snapshot) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("cannot create snapshot file: %w", err)
+	}
+	if err := s.Write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("cannot write snapshot file: %w", err)
+	}
+	return f.Close()
+}
+
+// Read loads a snapshot stored as JSON.
+func Read(r io.Reader) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("cannot parse snapshot: %w", err)
+	}
+	if s.MBeans == nil {
+		s.MBeans = map[string]map[string]*Value{}
+	}
+	return s, nil
+}
+
+// Load reads a snapshot file.
+func Load(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read snapshot file: %w", err)
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// MarshalJSON encodes the non-finite doubles as strings.
+func (v *Value) MarshalJSON() ([]byte, error) {
+	type value Value
+	encoded := *(*value)(v)
+	if !v.IsFinite() {
+		encoded.Value = strconv.FormatFloat(v.Value.(float64), 'f', -1, 64)
+	}
+	return json.Marshal(&encoded)
+}
+
+// UnmarshalJSON restores the Go type of the value, JSON numbers would be read as float64.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+		Error string          `json:"error"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	v.Type, v.Error, v.Value = raw.Type, raw.Error, nil
+
+	var err error
+	switch raw.Type {
+	case TypeString:
+		var s string
+		if len(raw.Value) > 0 {
+			err = json.Unmarshal(raw.Value, &s)
+		}
+		v.Value = s
+	case TypeDouble:
+		var f float64
+		if len(raw.Value) > 0 && raw.Value[0] == '"' {
+			var nonFinite string
+			if err = json.Unmarshal(raw.Value, &nonFinite); err == nil {
+				f, err = parseNonFinite(nonFinite)
+			}
+		} else if len(raw.Value) > 0 {
+			err = json.Unmarshal(raw.Value, &f)
+		}
+		v.Value = f
+	case TypeInt:
+		var i int64
+		if len(raw.Value) > 0 {
+			i, err = strconv.ParseInt(string(raw.Value), 10, 64)
+		}
+		v.Value = i
+	case TypeBool:
+		var b bool
+		if len(raw.Value) > 0 {
+			err = json.Unmarshal(raw.Value, &b)
+		}
+		v.Value = b
+	case TypeError:
+	default:
+		return fmt.Errorf("unknown value type: '%s'", raw.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s value: %s", raw.Type, raw.Value)
+	}
+	return nil
+}
+
+// parseNonFinite parses the string representation of the non-finite doubles.
+func parseNonFinite(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return 0, fmt.Errorf("not a non-finite double: '%s'", s)
+	}
+	return f, nil
+}
+
+// IsFinite returns false for the doubles that are NaN or infinite, true for the rest of values.
+func (v *Value) IsFinite() bool {
+	if v == nil {
+		return true
+	}
+	f, ok := v.Value.(float64)
+	return !ok || !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// Float64 returns the value as float64 when it's numeric. Bool values are converted to 1 or 0.
+func (v *Value) Float64() (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	switch value := v.Value.(type) {
+	case float64:
+		return value, true
+	case int64:
+		return float64(value), true
+	case bool:
+		if value {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func (v *Value) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	if v.Type == TypeError {
+		return fmt.Sprintf("error(%s)", v.Error)
+	}
+	return fmt.Sprintf("%v", v.Value)
+}
+
+// attrNames returns the attribute names sorted alphabetically.
+func attrNames(attrs map[string]*Value) []string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
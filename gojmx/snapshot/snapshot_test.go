@@ -0,0 +1,249 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package snapshot
+
+import (
+	"bytes"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	beforeTime = time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	afterTime  = time.Date(2026, 10, 1, 11, 0, 0, 0, time.UTC)
+)
+
+func newBefore() *Snapshot {
+	s := New([]*gojmx.AttributeResponse{
+		{Name: "java.lang:type=Threading,attr=ThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 20},
+		{Name: "java.lang:type=Threading,attr=DaemonThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 10},
+		{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1000},
+		{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Max", ResponseType: nrprotocol.ResponseType_INT, IntValue: 4000},
+		{Name: "java.lang:type=OperatingSystem,attr=ProcessCpuLoad", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: 0},
+		{Name: "java.lang:type=Runtime,attr=VmVersion", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "17.0.1"},
+		{Name: "java.lang:type=Runtime,attr=CollectionUsageThreshold", ResponseType: nrprotocol.ResponseType_INT, IntValue: 0},
+		{Name: "java.lang:type=MemoryPool,name=G1 Eden Space,attr=Valid", ResponseType: nrprotocol.ResponseType_BOOL, BoolValue: true},
+	})
+	s.Time = beforeTime
+	return s
+}
+
+func newAfter() *Snapshot {
+	s := New([]*gojmx.AttributeResponse{
+		{Name: "java.lang:type=Threading,attr=ThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 45},
+		{Name: "java.lang:type=Threading,attr=DaemonThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 11},
+		{Name: "java.lang:type=Threading,attr=PeakThreadCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: 50},
+		{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: 500},
+		{Name: "java.lang:type=OperatingSystem,attr=ProcessCpuLoad", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: 0.5},
+		{Name: "java.lang:type=Runtime,attr=VmVersion", ResponseType: nrprotocol.ResponseType_STRING, StringValue: "21.0.2"},
+		{Name: "java.lang:type=Runtime,attr=CollectionUsageThreshold", ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "unsupported"},
+		{Name: "java.lang:type=MemoryPool,name=ZHeap,attr=Valid", ResponseType: nrprotocol.ResponseType_BOOL, BoolValue: true},
+	})
+	s.Time = afterTime
+	return s
+}
+
+func Test_Snapshot_JSON(t *testing.T) {
+	s := newBefore()
+	s.Add([]*gojmx.AttributeResponse{
+		{Name: "test:type=Cat,attr=Missing", ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "not found"},
+		{Name: "invalid", ResponseType: nrprotocol.ResponseType_INT, IntValue: 1},
+	})
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, s.Save(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.True(t, s.Time.Equal(loaded.Time))
+	loaded.Time = s.Time
+	assert.Equal(t, s, loaded)
+
+	assert.Equal(t, int64(20), loaded.Get("java.lang:type=Threading", "ThreadCount").Value)
+	assert.Equal(t, "error(not found)", loaded.Get("test:type=Cat", "Missing").String())
+	assert.Nil(t, loaded.Get("test:type=Cat", "Name"))
+
+	_, err = Read(bytes.NewBufferString(`{"mbeans": {"test:type=Cat": {"Name": {"type": "date"}}}}`))
+	assert.EqualError(t, err, "cannot parse snapshot: unknown value type: 'date'")
+}
+
+func Test_Snapshot_NonFinite(t *testing.T) {
+	s := New([]*gojmx.AttributeResponse{
+		{Name: "test:type=Cat,attr=NaN", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.NaN()},
+		{Name: "test:type=Cat,attr=Inf", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.Inf(1)},
+		{Name: "test:type=Cat,attr=NegInf", ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: math.Inf(-1)},
+	})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, s.Write(buf))
+	assert.Contains(t, buf.String(), `"value": "NaN"`)
+	assert.Contains(t, buf.String(), `"value": "+Inf"`)
+	assert.Contains(t, buf.String(), `"value": "-Inf"`)
+
+	loaded, err := Read(buf)
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(loaded.Get("test:type=Cat", "NaN").Value.(float64)))
+	assert.Equal(t, math.Inf(1), loaded.Get("test:type=Cat", "Inf").Value)
+	assert.Equal(t, math.Inf(-1), loaded.Get("test:type=Cat", "NegInf").Value)
+	assert.False(t, loaded.Get("test:type=Cat", "NaN").IsFinite())
+
+	_, err = Read(bytes.NewBufferString(`{"mbeans": {"test:type=Cat": {"Ratio": {"type": "double", "value": "1.5"}}}}`))
+	assert.EqualError(t, err, `cannot parse snapshot: invalid double value: "1.5"`)
+}
+
+func Test_Diff_NonFinite(t *testing.T) {
+	double := func(name string, value float64) *gojmx.AttributeResponse {
+		return &gojmx.AttributeResponse{Name: "test:type=Cat,attr=" + name, ResponseType: nrprotocol.ResponseType_DOUBLE, DoubleValue: value}
+	}
+	before := New([]*gojmx.AttributeResponse{
+		double("StillNaN", math.NaN()),
+		double("StillInf", math.Inf(1)),
+		double("BecameNaN", 0.5),
+		double("BecameFinite", math.Inf(-1)),
+	})
+	after := New([]*gojmx.AttributeResponse{
+		double("StillNaN", math.NaN()),
+		double("StillInf", math.Inf(1)),
+		double("BecameNaN", math.NaN()),
+		double("BecameFinite", 2),
+	})
+
+	report := Diff(before, after, DiffOptions{})
+	assert.Empty(t, report.NumericChanges)
+	require.Len(t, report.ValueChanges, 2)
+	assert.Equal(t, "BecameFinite", report.ValueChanges[0].Attribute)
+	assert.Equal(t, "BecameNaN", report.ValueChanges[1].Attribute)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.WriteJSON(buf))
+	assert.Contains(t, buf.String(), `"value": "NaN"`)
+
+	buf.Reset()
+	require.NoError(t, report.WriteText(buf))
+	assert.Contains(t, buf.String(), "~ test:type=Cat BecameFinite: -Inf -> 2\n")
+	assert.Contains(t, buf.String(), "~ test:type=Cat BecameNaN: 0.5 -> NaN\n")
+}
+
+func Test_Diff(t *testing.T) {
+	report := Diff(newBefore(), newAfter(), DiffOptions{MinRatio: 0.2})
+
+	ratio := func(v float64) *float64 { return &v }
+	assert.Equal(t, []string{"java.lang:type=MemoryPool,name=ZHeap"}, report.AddedMBeans)
+	assert.Equal(t, []string{"java.lang:type=MemoryPool,name=G1 Eden Space"}, report.RemovedMBeans)
+	assert.Equal(t, []*AttributeRef{{MBean: "java.lang:type=Threading", Attribute: "PeakThreadCount"}}, report.AddedAttributes)
+	assert.Equal(t, []*AttributeRef{{MBean: "java.lang:type=Memory", Attribute: "HeapMemoryUsage.Max"}}, report.RemovedAttributes)
+	assert.Equal(t, []*Change{
+		{
+			MBean:     "java.lang:type=Runtime",
+			Attribute: "CollectionUsageThreshold",
+			Before:    &Value{Type: TypeInt, Value: int64(0)},
+			After:     &Value{Type: TypeError, Error: "unsupported"},
+		},
+	}, report.TypeChanges)
+	// DaemonThreadCount changed 10% and it's under the MinRatio.
+	assert.Equal(t, []*Change{
+		{
+			MBean:     "java.lang:type=Memory",
+			Attribute: "HeapMemoryUsage.Used",
+			Before:    &Value{Type: TypeInt, Value: int64(1000)},
+			After:     &Value{Type: TypeInt, Value: int64(500)},
+			Delta:     -500,
+			Ratio:     ratio(0.5),
+		},
+		{
+			MBean:     "java.lang:type=OperatingSystem",
+			Attribute: "ProcessCpuLoad",
+			Before:    &Value{Type: TypeDouble, Value: float64(0)},
+			After:     &Value{Type: TypeDouble, Value: 0.5},
+			Delta:     0.5,
+		},
+		{
+			MBean:     "java.lang:type=Threading",
+			Attribute: "ThreadCount",
+			Before:    &Value{Type: TypeInt, Value: int64(20)},
+			After:     &Value{Type: TypeInt, Value: int64(45)},
+			Delta:     25,
+			Ratio:     ratio(1.25),
+		},
+	}, report.NumericChanges)
+	assert.Len(t, report.ValueChanges, 1)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.WriteText(buf))
+	assert.Equal(t, `Snapshots: 2026-10-01T10:00:00Z -> 2026-10-01T11:00:00Z
++ mBean java.lang:type=MemoryPool,name=ZHeap
+- mBean java.lang:type=MemoryPool,name=G1 Eden Space
++ java.lang:type=Threading PeakThreadCount
+- java.lang:type=Memory HeapMemoryUsage.Max
+! java.lang:type=Runtime CollectionUsageThreshold: int(0) -> error(unsupported)
+~ java.lang:type=Memory HeapMemoryUsage.Used: 1000 -> 500 (-500, -50.0%)
+~ java.lang:type=OperatingSystem ProcessCpuLoad: 0 -> 0.5 (+0.5, new)
+~ java.lang:type=Threading ThreadCount: 20 -> 45 (+25, +125.0%)
+~ java.lang:type=Runtime VmVersion: 17.0.1 -> 21.0.2
+`, buf.String())
+}
+
+func Test_Diff_Options(t *testing.T) {
+	report := Diff(newBefore(), newAfter(), DiffOptions{MinDelta: 100, IgnoreStrings: true})
+	require.Len(t, report.NumericChanges, 1)
+	assert.Equal(t, "HeapMemoryUsage.Used", report.NumericChanges[0].Attribute)
+	assert.Empty(t, report.ValueChanges)
+
+	report = Diff(newBefore(), newBefore(), DiffOptions{})
+	assert.True(t, report.IsEmpty())
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.WriteText(buf))
+	assert.Equal(t, "Snapshots: 2026-10-01T10:00:00Z -> 2026-10-01T10:00:00Z\nNo differences\n", buf.String())
+}
+
+func Test_Report_WriteJSON(t *testing.T) {
+	report := Diff(newBefore(), newAfter(), DiffOptions{MinDelta: 20, IgnoreStrings: true})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.WriteJSON(buf))
+	assert.JSONEq(t, `{
+  "before": "2026-10-01T10:00:00Z",
+  "after": "2026-10-01T11:00:00Z",
+  "added_mbeans": ["java.lang:type=MemoryPool,name=ZHeap"],
+  "removed_mbeans": ["java.lang:type=MemoryPool,name=G1 Eden Space"],
+  "added_attributes": [{"mbean": "java.lang:type=Threading", "attribute": "PeakThreadCount"}],
+  "removed_attributes": [{"mbean": "java.lang:type=Memory", "attribute": "HeapMemoryUsage.Max"}],
+  "type_changes": [
+    {
+      "mbean": "java.lang:type=Runtime",
+      "attribute": "CollectionUsageThreshold",
+      "before": {"type": "int", "value": 0},
+      "after": {"type": "error", "error": "unsupported"}
+    }
+  ],
+  "numeric_changes": [
+    {
+      "mbean": "java.lang:type=Memory",
+      "attribute": "HeapMemoryUsage.Used",
+      "before": {"type": "int", "value": 1000},
+      "after": {"type": "int", "value": 500},
+      "delta": -500,
+      "ratio": 0.5
+    },
+    {
+      "mbean": "java.lang:type=Threading",
+      "attribute": "ThreadCount",
+      "before": {"type": "int", "value": 20},
+      "after": {"type": "int", "value": 45},
+      "delta": 25,
+      "ratio": 1.25
+    }
+  ]
+}`, buf.String())
+}
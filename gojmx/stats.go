@@ -0,0 +1,233 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultStatsTopN is the number of slowest mBeans and attributes reported when StatsAnalysisOptions.TopN is not defined.
+	DefaultStatsTopN = 10
+	// DefaultStatsBuckets is the maximum number of histogram buckets when StatsAnalysisOptions.BucketSize is not defined.
+	DefaultStatsBuckets = 20
+)
+
+// StatsAnalysisOptions configures InternalStatsList.Analyze.
+type StatsAnalysisOptions struct {
+	// TopN is the number of slowest mBeans and attributes to report. (default: 10)
+	TopN int
+	// BucketSize is the histogram bucket size. By default, it's the span of the stats divided in up to 20
+	// buckets, rounded up to the second.
+	BucketSize time.Duration
+}
+
+// LatencySummary aggregates the calls of a group of InternalStats. The latencies are in milliseconds.
+type LatencySummary struct {
+	Count     int     `json:"count"`
+	Errors    int     `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+	TotalMs   float64 `json:"totalMs"`
+	P50Ms     float64 `json:"p50Ms"`
+	P90Ms     float64 `json:"p90Ms"`
+	P99Ms     float64 `json:"p99Ms"`
+	MaxMs     float64 `json:"maxMs"`
+}
+
+// StatTypeSummary aggregates the calls of a StatType, e.g. getAttributes or queryMBeans.
+type StatTypeSummary struct {
+	StatType string `json:"statType"`
+	LatencySummary
+}
+
+// MBeanSummary aggregates the calls for an mBean. Attribute is empty for the mBean aggregations.
+type MBeanSummary struct {
+	MBean     string `json:"mBean"`
+	Attribute string `json:"attribute,omitempty"`
+	LatencySummary
+}
+
+// StatsBucket aggregates the calls started during the bucket.
+type StatsBucket struct {
+	Start   time.Time `json:"start"`
+	Count   int       `json:"count"`
+	Errors  int       `json:"errors"`
+	TotalMs float64   `json:"totalMs"`
+	MaxMs   float64   `json:"maxMs"`
+}
+
+// StatsAnalysis is the result of InternalStatsList.Analyze.
+type StatsAnalysis struct {
+	// Start and End are the start times of the first and the last calls.
+	Start time.Time      `json:"start"`
+	End   time.Time      `json:"end"`
+	Total LatencySummary `json:"total"`
+	// ByType is sorted by the total time, the slowest first.
+	ByType []*StatTypeSummary `json:"byType"`
+	// SlowestMBeans and SlowestAttributes are sorted by the total time, the slowest first.
+	SlowestMBeans     []*MBeanSummary `json:"slowestMBeans"`
+	SlowestAttributes []*MBeanSummary `json:"slowestAttributes"`
+	BucketSize        time.Duration   `json:"bucketSize"`
+	Histogram         []*StatsBucket  `json:"histogram"`
+}
+
+// Analyze aggregates the stats to find which calls make the collection slow: the latency percentiles and the
+// error rate per StatType, the top-N slowest mBeans and attributes and a histogram of the calls over time.
+// The duration of a call is assigned to each of its attributes, as the attributes of a call are fetched together
+// unless they fail.
+func (is InternalStatsList) Analyze(options StatsAnalysisOptions) *StatsAnalysis {
+	if options.TopN <= 0 {
+		options.TopN = DefaultStatsTopN
+	}
+
+	analysis := &StatsAnalysis{}
+	if len(is) == 0 {
+		return analysis
+	}
+
+	var all []*InternalStat
+	byType := map[string][]*InternalStat{}
+	byMBean := map[string][]*InternalStat{}
+	byAttribute := map[[2]string][]*InternalStat{}
+
+	first, last := int64(math.MaxInt64), int64(math.MinInt64)
+	for _, stat := range is {
+		if stat == nil {
+			continue
+		}
+		all = append(all, stat)
+		byType[stat.StatType] = append(byType[stat.StatType], stat)
+		if stat.MBean != "" {
+			byMBean[stat.MBean] = append(byMBean[stat.MBean], stat)
+			for _, attr := range stat.Attrs {
+				key := [2]string{stat.MBean, attr}
+				byAttribute[key] = append(byAttribute[key], stat)
+			}
+		}
+		if stat.StartTimestamp < first {
+			first = stat.StartTimestamp
+		}
+		if stat.StartTimestamp > last {
+			last = stat.StartTimestamp
+		}
+	}
+	if len(all) == 0 {
+		return analysis
+	}
+
+	analysis.Start = time.UnixMilli(first)
+	analysis.End = time.UnixMilli(last)
+	analysis.Total = summarize(all)
+
+	for statType, stats := range byType {
+		analysis.ByType = append(analysis.ByType, &StatTypeSummary{StatType: statType, LatencySummary: summarize(stats)})
+	}
+	sort.Slice(analysis.ByType, func(i, j int) bool {
+		a, b := analysis.ByType[i], analysis.ByType[j]
+		if a.TotalMs != b.TotalMs {
+			return a.TotalMs > b.TotalMs
+		}
+		return a.StatType < b.StatType
+	})
+
+	for mBean, stats := range byMBean {
+		analysis.SlowestMBeans = append(analysis.SlowestMBeans, &MBeanSummary{MBean: mBean, LatencySummary: summarize(stats)})
+	}
+	analysis.SlowestMBeans = slowest(analysis.SlowestMBeans, options.TopN)
+
+	for key, stats := range byAttribute {
+		analysis.SlowestAttributes = append(analysis.SlowestAttributes, &MBeanSummary{MBean: key[0], Attribute: key[1], LatencySummary: summarize(stats)})
+	}
+	analysis.SlowestAttributes = slowest(analysis.SlowestAttributes, options.TopN)
+
+	analysis.BucketSize = options.BucketSize
+	if analysis.BucketSize <= 0 {
+		span := time.Duration(last-first+1) * time.Millisecond
+		analysis.BucketSize = (span/DefaultStatsBuckets + time.Second - 1).Truncate(time.Second)
+		if analysis.BucketSize < time.Second {
+			analysis.BucketSize = time.Second
+		}
+	}
+	analysis.Histogram = histogram(all, first, analysis.BucketSize)
+
+	return analysis
+}
+
+func summarize(stats []*InternalStat) LatencySummary {
+	summary := LatencySummary{Count: len(stats)}
+
+	durations := make([]float64, 0, len(stats))
+	for _, stat := range stats {
+		if !stat.Successful {
+			summary.Errors++
+		}
+		summary.TotalMs += stat.Milliseconds
+		durations = append(durations, stat.Milliseconds)
+	}
+	if len(durations) == 0 {
+		return summary
+	}
+	sort.Float64s(durations)
+
+	summary.ErrorRate = float64(summary.Errors) / float64(summary.Count)
+	summary.P50Ms = percentile(durations, 50)
+	summary.P90Ms = percentile(durations, 90)
+	summary.P99Ms = percentile(durations, 99)
+	summary.MaxMs = durations[len(durations)-1]
+	return summary
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func slowest(summaries []*MBeanSummary, n int) []*MBeanSummary {
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		if a.TotalMs != b.TotalMs {
+			return a.TotalMs > b.TotalMs
+		}
+		if a.MBean != b.MBean {
+			return a.MBean < b.MBean
+		}
+		return a.Attribute < b.Attribute
+	})
+	if len(summaries) > n {
+		summaries = summaries[:n]
+	}
+	return summaries
+}
+
+func histogram(stats []*InternalStat, first int64, bucketSize time.Duration) []*StatsBucket {
+	size := bucketSize.Milliseconds()
+	if size <= 0 {
+		size = 1
+	}
+
+	var buckets []*StatsBucket
+	for _, stat := range stats {
+		i := int((stat.StartTimestamp - first) / size)
+		for len(buckets) <= i {
+			buckets = append(buckets, &StatsBucket{Start: time.UnixMilli(first + int64(len(buckets))*size)})
+		}
+
+		bucket := buckets[i]
+		bucket.Count++
+		if !stat.Successful {
+			bucket.Errors++
+		}
+		bucket.TotalMs += stat.Milliseconds
+		bucket.MaxMs = math.Max(bucket.MaxMs, stat.Milliseconds)
+	}
+	return buckets
+}
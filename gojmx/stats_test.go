@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InternalStatsList_Analyze(t *testing.T) {
+	start := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC).UnixMilli()
+
+	stats := InternalStatsList{
+		{StatType: "queryMBeans", MBean: "java.lang:*", Milliseconds: 4, StartTimestamp: start, Successful: true},
+		{StatType: "getAttributes", MBean: "java.lang:type=Memory", Attrs: []string{"HeapMemoryUsage", "Verbose"}, Milliseconds: 10, StartTimestamp: start + 100, Successful: true},
+		{StatType: "getAttributes", MBean: "java.lang:type=Threading", Attrs: []string{"ThreadCount"}, Milliseconds: 2, StartTimestamp: start + 200, Successful: true},
+		{StatType: "getAttributes", MBean: "java.lang:type=Memory", Attrs: []string{"Verbose"}, Milliseconds: 40, StartTimestamp: start + 1500, Successful: false},
+		{StatType: "getAttributes", MBean: "java.lang:type=Threading", Attrs: []string{"ThreadCount"}, Milliseconds: 3, StartTimestamp: start + 3200, Successful: true},
+		nil,
+	}
+
+	analysis := stats.Analyze(StatsAnalysisOptions{TopN: 2, BucketSize: time.Second})
+
+	assert.Equal(t, start, analysis.Start.UnixMilli())
+	assert.Equal(t, start+3200, analysis.End.UnixMilli())
+	assert.Equal(t, LatencySummary{Count: 5, Errors: 1, ErrorRate: 0.2, TotalMs: 59, P50Ms: 4, P90Ms: 40, P99Ms: 40, MaxMs: 40}, analysis.Total)
+
+	require.Len(t, analysis.ByType, 2)
+	assert.Equal(t, &StatTypeSummary{
+		StatType:       "getAttributes",
+		LatencySummary: LatencySummary{Count: 4, Errors: 1, ErrorRate: 0.25, TotalMs: 55, P50Ms: 3, P90Ms: 40, P99Ms: 40, MaxMs: 40},
+	}, analysis.ByType[0])
+	assert.Equal(t, "queryMBeans", analysis.ByType[1].StatType)
+
+	// The calls are aggregated by mBean and their durations assigned to each of their attributes.
+	require.Len(t, analysis.SlowestMBeans, 2)
+	assert.Equal(t, "java.lang:type=Memory", analysis.SlowestMBeans[0].MBean)
+	assert.Equal(t, 50.0, analysis.SlowestMBeans[0].TotalMs)
+	assert.Equal(t, "java.lang:type=Threading", analysis.SlowestMBeans[1].MBean)
+
+	require.Len(t, analysis.SlowestAttributes, 2)
+	assert.Equal(t, &MBeanSummary{
+		MBean:          "java.lang:type=Memory",
+		Attribute:      "Verbose",
+		LatencySummary: LatencySummary{Count: 2, Errors: 1, ErrorRate: 0.5, TotalMs: 50, P50Ms: 10, P90Ms: 40, P99Ms: 40, MaxMs: 40},
+	}, analysis.SlowestAttributes[0])
+	assert.Equal(t, "HeapMemoryUsage", analysis.SlowestAttributes[1].Attribute)
+
+	// The empty buckets are kept so the histogram is continuous.
+	require.Len(t, analysis.Histogram, 4)
+	assert.Equal(t, &StatsBucket{Start: time.UnixMilli(start), Count: 3, TotalMs: 16, MaxMs: 10}, analysis.Histogram[0])
+	assert.Equal(t, &StatsBucket{Start: time.UnixMilli(start + 1000), Count: 1, Errors: 1, TotalMs: 40, MaxMs: 40}, analysis.Histogram[1])
+	assert.Equal(t, 0, analysis.Histogram[2].Count)
+	assert.Equal(t, 1, analysis.Histogram[3].Count)
+}
+
+func Test_InternalStatsList_Analyze_Defaults(t *testing.T) {
+	assert.Equal(t, &StatsAnalysis{}, InternalStatsList(nil).Analyze(StatsAnalysisOptions{}))
+
+	start := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC).UnixMilli()
+	var stats InternalStatsList
+	for i := int64(0); i < 100; i++ {
+		stats = append(stats, &InternalStat{StatType: "getAttributes", MBean: "test:type=Bean", Attrs: []string{"Value"}, Milliseconds: float64(i + 1), StartTimestamp: start + i*1000, Successful: true})
+	}
+
+	analysis := stats.Analyze(StatsAnalysisOptions{})
+	assert.Equal(t, 5*time.Second, analysis.BucketSize)
+	assert.Len(t, analysis.Histogram, DefaultStatsBuckets)
+	assert.Equal(t, 50.0, analysis.Total.P50Ms)
+	assert.Equal(t, 90.0, analysis.Total.P90Ms)
+	assert.Equal(t, 99.0, analysis.Total.P99Ms)
+	assert.Equal(t, 100.0, analysis.Total.MaxMs)
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+)
+
+// ThreadState is the java.lang.Thread.State of a ThreadInfo.
+type ThreadState nrprotocol.ThreadState
+
+var (
+	ThreadStateNew          = nrprotocol.ThreadState_NEW
+	ThreadStateRunnable     = nrprotocol.ThreadState_RUNNABLE
+	ThreadStateBlocked      = nrprotocol.ThreadState_BLOCKED
+	ThreadStateWaiting      = nrprotocol.ThreadState_WAITING
+	ThreadStateTimedWaiting = nrprotocol.ThreadState_TIMED_WAITING
+	ThreadStateTerminated   = nrprotocol.ThreadState_TERMINATED
+)
+
+// ThreadDumpOptions configures the information retrieved by Client.ThreadDump.
+// LockedMonitors and LockedSynchronizers are ignored when not supported by the JVM.
+// MaxDepth limits the number of stack frames for each thread, 0 retrieves all of them.
+type ThreadDumpOptions nrprotocol.ThreadDumpOptions
+
+// ThreadInfo is the java.lang.management.ThreadInfo returned by the ThreadMXBean.
+type ThreadInfo nrprotocol.ThreadInfo
+
+// StackFrame is a stack trace element of a ThreadInfo with the monitors locked on it.
+type StackFrame nrprotocol.StackFrame
+
+// LockInfo is a monitor or ownable synchronizer.
+type LockInfo nrprotocol.LockInfo
+
+func toThreadInfoList(in []*nrprotocol.ThreadInfo) []*ThreadInfo {
+	return *(*[]*ThreadInfo)(unsafe.Pointer(&in))
+}
+
+func toStackFrameList(in []*nrprotocol.StackFrame) []*StackFrame {
+	return *(*[]*StackFrame)(unsafe.Pointer(&in))
+}
+
+func toLockInfoList(in []*nrprotocol.LockInfo) []*LockInfo {
+	return *(*[]*LockInfo)(unsafe.Pointer(&in))
+}
+
+// GetStackTrace returns the thread stack frames, the top of the stack first.
+func (t *ThreadInfo) GetStackTrace() []*StackFrame {
+	if t == nil {
+		return nil
+	}
+	return toStackFrameList(t.StackTrace)
+}
+
+// GetLockInfo returns the object the thread is blocked or waiting on, nil otherwise.
+func (t *ThreadInfo) GetLockInfo() *LockInfo {
+	if t == nil {
+		return nil
+	}
+	return (*LockInfo)(t.LockInfo)
+}
+
+// GetLockedSynchronizers returns the ownable synchronizers locked by the thread.
+func (t *ThreadInfo) GetLockedSynchronizers() []*LockInfo {
+	if t == nil {
+		return nil
+	}
+	return toLockInfoList(t.LockedSynchronizers)
+}
+
+func (t *ThreadInfo) String() string {
+	if t == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("\"%s\" Id=%d %s", t.ThreadName, t.ThreadId, t.ThreadState)
+}
+
+// GetLockedMonitors returns the monitors locked when the frame was executed.
+func (f *StackFrame) GetLockedMonitors() []*LockInfo {
+	if f == nil {
+		return nil
+	}
+	return toLockInfoList(f.LockedMonitors)
+}
+
+// String formats the frame as java.lang.StackTraceElement does, e.g. java.lang.Thread.run(Thread.java:750)
+func (f *StackFrame) String() string {
+	if f == nil {
+		return "<nil>"
+	}
+
+	var location string
+	switch {
+	case f.NativeMethod:
+		location = "Native Method"
+	case f.FileName != "" && f.LineNumber >= 0:
+		location = fmt.Sprintf("%s:%d", f.FileName, f.LineNumber)
+	case f.FileName != "":
+		location = f.FileName
+	default:
+		location = "Unknown Source"
+	}
+	return fmt.Sprintf("%s.%s(%s)", f.ClassName, f.MethodName, location)
+}
+
+// String formats the lock as jstack does. The identity hash code is used instead of the object address.
+func (l *LockInfo) String() string {
+	if l == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("<0x%016x> (a %s)", uint32(l.IdentityHashCode), l.ClassName)
+}
+
+// isParking returns true when the thread is waiting on a java.util.concurrent lock, e.g. an ownable synchronizer.
+// The lock class is used when the stack trace is not available.
+func (t *ThreadInfo) isParking() bool {
+	if t.ThreadState != ThreadStateWaiting && t.ThreadState != ThreadStateTimedWaiting {
+		return false
+	}
+	frames := t.GetStackTrace()
+	if len(frames) > 0 {
+		return frames[0].MethodName == "park" && strings.HasSuffix(frames[0].ClassName, ".Unsafe")
+	}
+	return t.LockInfo != nil && strings.HasPrefix(t.LockInfo.ClassName, "java.util.concurrent.")
+}
+
+// isSleeping returns true when the thread is in Thread.sleep.
+func (t *ThreadInfo) isSleeping() bool {
+	frames := t.GetStackTrace()
+	return len(frames) > 0 && frames[0].ClassName == "java.lang.Thread" && strings.HasPrefix(frames[0].MethodName, "sleep")
+}
+
+// stateDetail returns the jstack description of what the thread is waiting for.
+func (t *ThreadInfo) stateDetail() string {
+	if t.ThreadState == ThreadStateBlocked {
+		return " (on object monitor)"
+	}
+	if t.ThreadState != ThreadStateWaiting && t.ThreadState != ThreadStateTimedWaiting {
+		return ""
+	}
+
+	switch {
+	case t.isParking():
+		return " (parking)"
+	case t.isSleeping():
+		return " (sleeping)"
+	case t.LockInfo != nil:
+		return " (on object monitor)"
+	default:
+		return ""
+	}
+}
+
+// FormatThreadDump formats the threads using the jstack text format, so the result can be
+// used with the existing thread dump analyzers.
+func FormatThreadDump(threads []*ThreadInfo) string {
+	sb := strings.Builder{}
+	sb.WriteString("Full thread dump:\n\n")
+
+	for _, thread := range threads {
+		writeThread(&sb, thread)
+	}
+
+	return sb.String()
+}
+
+// FormatDeadlocks formats the threads returned by Client.FindDeadlocks using the jstack text format.
+func FormatDeadlocks(threads []*ThreadInfo) string {
+	if len(threads) == 0 {
+		return "No deadlocks found.\n"
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("Found Java-level deadlock:\n")
+	sb.WriteString("=============================\n")
+
+	for _, thread := range threads {
+		sb.WriteString(fmt.Sprintf("\"%s\":\n", thread.ThreadName))
+		if lock := thread.GetLockInfo(); lock != nil {
+			if thread.isParking() {
+				sb.WriteString(fmt.Sprintf("  waiting for ownable synchronizer %s,\n", lock))
+			} else {
+				sb.WriteString(fmt.Sprintf("  waiting to lock monitor %s,\n", lock))
+			}
+		}
+		sb.WriteString(fmt.Sprintf("  which is held by \"%s\"\n", thread.LockOwnerName))
+	}
+
+	sb.WriteString("\nJava stack information for the threads listed above:\n")
+	sb.WriteString("===================================================\n")
+
+	for _, thread := range threads {
+		writeThread(&sb, thread)
+	}
+
+	sb.WriteString(fmt.Sprintf("Found %d deadlocked threads.\n", len(threads)))
+	return sb.String()
+}
+
+func writeThread(sb *strings.Builder, thread *ThreadInfo) {
+	if thread == nil {
+		return
+	}
+
+	sb.WriteString(fmt.Sprintf("\"%s\" #%d", thread.ThreadName, thread.ThreadId))
+	if thread.InNative {
+		sb.WriteString(" (in native)")
+	}
+	if thread.Suspended {
+		sb.WriteString(" (suspended)")
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString(fmt.Sprintf("   java.lang.Thread.State: %s%s\n", thread.ThreadState, thread.stateDetail()))
+
+	for i, frame := range thread.GetStackTrace() {
+		sb.WriteString(fmt.Sprintf("\tat %s\n", frame))
+
+		if lock := thread.GetLockInfo(); i == 0 && lock != nil {
+			switch {
+			case thread.ThreadState == ThreadStateBlocked:
+				sb.WriteString(fmt.Sprintf("\t- waiting to lock %s\n", lock))
+			case thread.isParking():
+				sb.WriteString(fmt.Sprintf("\t- parking to wait for  %s\n", lock))
+			default:
+				sb.WriteString(fmt.Sprintf("\t- waiting on %s\n", lock))
+			}
+		}
+
+		for _, monitor := range frame.GetLockedMonitors() {
+			sb.WriteString(fmt.Sprintf("\t- locked %s\n", monitor))
+		}
+	}
+
+	sb.WriteString("\n   Locked ownable synchronizers:\n")
+	synchronizers := thread.GetLockedSynchronizers()
+	if len(synchronizers) == 0 {
+		sb.WriteString("\t- None\n")
+	}
+	for _, synchronizer := range synchronizers {
+		sb.WriteString(fmt.Sprintf("\t- %s\n", synchronizer))
+	}
+	sb.WriteString("\n")
+}
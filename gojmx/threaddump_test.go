@@ -0,0 +1,141 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package gojmx
+
+import (
+	"testing"
+
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FormatThreadDump(t *testing.T) {
+	threads := []*ThreadInfo{
+		{
+			ThreadId:    1,
+			ThreadName:  "main",
+			ThreadState: nrprotocol.ThreadState_TIMED_WAITING,
+			StackTrace: []*nrprotocol.StackFrame{
+				{ClassName: "java.lang.Thread", MethodName: "sleep", NativeMethod: true},
+				{ClassName: "org.newrelic.jmx.Service", MethodName: "main", FileName: "Service.java", LineNumber: 42},
+			},
+		},
+		{
+			ThreadId:    12,
+			ThreadName:  "worker-1",
+			ThreadState: nrprotocol.ThreadState_BLOCKED,
+			LockInfo:    &nrprotocol.LockInfo{ClassName: "java.lang.Object", IdentityHashCode: 0x1b6d3586},
+			StackTrace: []*nrprotocol.StackFrame{
+				{
+					ClassName:  "org.newrelic.jmx.Worker",
+					MethodName: "run",
+					FileName:   "Worker.java",
+					LineNumber: 10,
+					LockedMonitors: []*nrprotocol.LockInfo{
+						{ClassName: "java.lang.String", IdentityHashCode: 0x4554617c},
+					},
+				},
+			},
+			LockedSynchronizers: []*nrprotocol.LockInfo{
+				{ClassName: "java.util.concurrent.locks.ReentrantLock$NonfairSync", IdentityHashCode: 0x74a14482},
+			},
+		},
+		{
+			ThreadId:    13,
+			ThreadName:  "pool-1-thread-1",
+			ThreadState: nrprotocol.ThreadState_WAITING,
+			LockInfo:    &nrprotocol.LockInfo{ClassName: "java.util.concurrent.locks.AbstractQueuedSynchronizer$ConditionObject", IdentityHashCode: 0x10},
+			StackTrace: []*nrprotocol.StackFrame{
+				{ClassName: "sun.misc.Unsafe", MethodName: "park", NativeMethod: true},
+				{ClassName: "java.util.concurrent.locks.LockSupport", MethodName: "park", LineNumber: -1},
+			},
+		},
+	}
+
+	expected := `Full thread dump:
+
+"main" #1
+   java.lang.Thread.State: TIMED_WAITING (sleeping)
+	at java.lang.Thread.sleep(Native Method)
+	at org.newrelic.jmx.Service.main(Service.java:42)
+
+   Locked ownable synchronizers:
+	- None
+
+"worker-1" #12
+   java.lang.Thread.State: BLOCKED (on object monitor)
+	at org.newrelic.jmx.Worker.run(Worker.java:10)
+	- waiting to lock <0x000000001b6d3586> (a java.lang.Object)
+	- locked <0x000000004554617c> (a java.lang.String)
+
+   Locked ownable synchronizers:
+	- <0x0000000074a14482> (a java.util.concurrent.locks.ReentrantLock$NonfairSync)
+
+"pool-1-thread-1" #13
+   java.lang.Thread.State: WAITING (parking)
+	at sun.misc.Unsafe.park(Native Method)
+	- parking to wait for  <0x0000000000000010> (a java.util.concurrent.locks.AbstractQueuedSynchronizer$ConditionObject)
+	at java.util.concurrent.locks.LockSupport.park(Unknown Source)
+
+   Locked ownable synchronizers:
+	- None
+
+`
+	assert.Equal(t, expected, FormatThreadDump(threads))
+}
+
+func Test_FormatDeadlocks(t *testing.T) {
+	assert.Equal(t, "No deadlocks found.\n", FormatDeadlocks(nil))
+
+	threads := []*ThreadInfo{
+		{
+			ThreadId:      20,
+			ThreadName:    "t1",
+			ThreadState:   nrprotocol.ThreadState_BLOCKED,
+			LockInfo:      &nrprotocol.LockInfo{ClassName: "java.lang.Object", IdentityHashCode: 2},
+			LockOwnerName: "t2",
+		},
+		{
+			ThreadId:      21,
+			ThreadName:    "t2",
+			ThreadState:   nrprotocol.ThreadState_BLOCKED,
+			LockInfo:      &nrprotocol.LockInfo{ClassName: "java.lang.Object", IdentityHashCode: 1},
+			LockOwnerName: "t1",
+		},
+	}
+
+	formatted := FormatDeadlocks(threads)
+	assert.Contains(t, formatted, "\"t1\":\n  waiting to lock monitor <0x0000000000000002> (a java.lang.Object),\n  which is held by \"t2\"\n")
+	assert.Contains(t, formatted, "\"t2\" #21\n   java.lang.Thread.State: BLOCKED (on object monitor)\n")
+	assert.Contains(t, formatted, "Found 2 deadlocked threads.\n")
+
+	// Threads waiting for ownable synchronizers are parking.
+	threads = []*ThreadInfo{
+		{
+			ThreadId:      30,
+			ThreadName:    "t3",
+			ThreadState:   nrprotocol.ThreadState_WAITING,
+			LockInfo:      &nrprotocol.LockInfo{ClassName: "java.util.concurrent.locks.ReentrantLock$NonfairSync", IdentityHashCode: 4},
+			LockOwnerName: "t4",
+			StackTrace: []*nrprotocol.StackFrame{
+				{ClassName: "jdk.internal.misc.Unsafe", MethodName: "park", NativeMethod: true},
+			},
+		},
+		{
+			ThreadId:      31,
+			ThreadName:    "t4",
+			ThreadState:   nrprotocol.ThreadState_WAITING,
+			LockInfo:      &nrprotocol.LockInfo{ClassName: "java.util.concurrent.locks.ReentrantLock$NonfairSync", IdentityHashCode: 3},
+			LockOwnerName: "t3",
+		},
+	}
+
+	formatted = FormatDeadlocks(threads)
+	assert.Contains(t, formatted, "\"t3\":\n  waiting for ownable synchronizer <0x0000000000000004> (a java.util.concurrent.locks.ReentrantLock$NonfairSync),\n  which is held by \"t4\"\n")
+	assert.Contains(t, formatted, "\"t4\":\n  waiting for ownable synchronizer <0x0000000000000003> (a java.util.concurrent.locks.ReentrantLock$NonfairSync),\n  which is held by \"t3\"\n")
+	assert.Contains(t, formatted, "\tat jdk.internal.misc.Unsafe.park(Native Method)\n\t- parking to wait for  <0x0000000000000004> (a java.util.concurrent.locks.ReentrantLock$NonfairSync)\n")
+	assert.Contains(t, formatted, "\"t4\" #31\n   java.lang.Thread.State: WAITING (parking)\n")
+}
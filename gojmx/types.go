@@ -96,6 +96,14 @@ func (j *JMXError) String() string {
 		removeNewLines(j.Stacktrace))
 }
 
+// GetCauseClass returns the class name of the exception that originated the error, when reported by nrjmx.
+func (j *JMXError) GetCauseClass() string {
+	if j == nil || j.CauseClass == nil {
+		return ""
+	}
+	return *j.CauseClass
+}
+
 func removeNewLines(text string) string {
 	text = strings.Replace(text, "\n", "\\n", -1)
 	text = strings.Replace(text, "\r", " ", -1)
@@ -161,6 +169,75 @@ var (
 	ResponseTypeErr = nrprotocol.ResponseType_ERROR
 )
 
+// ErrorKind classifies the failure of an AttributeResponse with ResponseTypeErr.
+type ErrorKind nrprotocol.ErrorKind
+
+var (
+	// ErrorKindUnknown failure that couldn't be classified
+	ErrorKindUnknown = nrprotocol.ErrorKind_UNKNOWN
+	// ErrorKindAttributeNotFound the MBean doesn't expose the attribute
+	ErrorKindAttributeNotFound = nrprotocol.ErrorKind_ATTRIBUTE_NOT_FOUND
+	// ErrorKindMBeanNotFound the MBean is not registered
+	ErrorKindMBeanNotFound = nrprotocol.ErrorKind_MBEAN_NOT_FOUND
+	// ErrorKindUnsupportedOperation the attribute getter is not supported by the JVM or the MBean
+	ErrorKindUnsupportedOperation = nrprotocol.ErrorKind_UNSUPPORTED_OPERATION
+	// ErrorKindUnsupportedType the attribute value type cannot be converted by nrjmx
+	ErrorKindUnsupportedType = nrprotocol.ErrorKind_UNSUPPORTED_TYPE
+	// ErrorKindSerialization the attribute value cannot be transferred from the JMX server
+	ErrorKindSerialization = nrprotocol.ErrorKind_SERIALIZATION
+	// ErrorKindSecurity the access to the attribute is not allowed
+	ErrorKindSecurity = nrprotocol.ErrorKind_SECURITY
+	// ErrorKindNullValue the attribute value is null
+	ErrorKindNullValue = nrprotocol.ErrorKind_NULL_VALUE
+	// ErrorKindGetterException the attribute getter failed on the JMX server
+	ErrorKindGetterException = nrprotocol.ErrorKind_GETTER_EXCEPTION
+	// ErrorKindNotReturned the JMX server didn't return a value for the requested attribute
+	ErrorKindNotReturned = nrprotocol.ErrorKind_NOT_RETURNED
+)
+
+// AttributeError keeps the structured details of an AttributeResponse with ResponseTypeErr.
+type AttributeError nrprotocol.AttributeError
+
+func (e *AttributeError) String() string {
+	if e == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("kind: %s, exception: '%s', cause: '%s: %s'",
+		e.Kind,
+		e.ExceptionClass,
+		e.CauseClass,
+		removeNewLines(e.CauseMessage))
+}
+
+// IsPermanent returns true when retrying the request won't retrieve the attribute,
+// e.g. the attribute doesn't exist or its value type is not supported.
+// Transient failures like a null value or an exception thrown by the getter return false.
+func (e *AttributeError) IsPermanent() bool {
+	if e == nil {
+		return false
+	}
+
+	switch e.Kind {
+	case ErrorKindAttributeNotFound,
+		ErrorKindUnsupportedOperation,
+		ErrorKindUnsupportedType,
+		ErrorKindSerialization,
+		ErrorKindSecurity:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetAttributeError returns the structured error for an AttributeResponse with ResponseTypeErr.
+// It returns nil when the attribute value was retrieved.
+func (j *AttributeResponse) GetAttributeError() *AttributeError {
+	if j == nil {
+		return nil
+	}
+	return (*AttributeError)(j.Error)
+}
+
 // InternalStat gathers stats about queries performed by nrjmx.
 type InternalStat nrprotocol.InternalStat
 
@@ -210,6 +287,24 @@ func (is InternalStatsList) String() string {
 	)
 }
 
+// ServerInfo keeps the JMX server metadata.
+type ServerInfo nrprotocol.ServerInfo
+
+func (s *ServerInfo) String() string {
+	if s == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Implementation: '%s %s (%s)', SpecificationVersion: '%s', MBeanServerId: '%s', DefaultDomain: '%s', ConnectionId: '%s'",
+		s.ImplementationName,
+		s.ImplementationVersion,
+		s.ImplementationVendor,
+		s.SpecificationVersion,
+		s.MBeanServerId,
+		s.DefaultDomain,
+		s.ConnectionId,
+	)
+}
+
 // JMXClientError is returned when there is an nrjmx process error.
 // Those errors require opening a new client.
 type JMXClientError struct {
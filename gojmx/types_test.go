@@ -145,3 +145,67 @@ func Test_JMXAttribute_GetValueAsFloat(t *testing.T) {
 		})
 	}
 }
+
+func Test_AttributeError_IsPermanent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jmxAttr  *AttributeResponse
+		expected bool
+	}{
+		{
+			name: "Attribute Not Found",
+			jmxAttr: &AttributeResponse{
+				Name:         "test:type=Cat,name=tomas,attr=Missing",
+				ResponseType: nrprotocol.ResponseType_ERROR,
+				Error:        &nrprotocol.AttributeError{Kind: nrprotocol.ErrorKind_ATTRIBUTE_NOT_FOUND},
+			},
+			expected: true,
+		},
+		{
+			name: "Unsupported Operation",
+			jmxAttr: &AttributeResponse{
+				Name:         "java.lang:type=MemoryPool,name=Metaspace,attr=CollectionUsageThreshold",
+				ResponseType: nrprotocol.ResponseType_ERROR,
+				Error: &nrprotocol.AttributeError{
+					Kind:           nrprotocol.ErrorKind_UNSUPPORTED_OPERATION,
+					ExceptionClass: "javax.management.RuntimeMBeanException",
+					CauseClass:     "java.lang.UnsupportedOperationException",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Null Value",
+			jmxAttr: &AttributeResponse{
+				Name:         "test:type=Cat,name=tomas,attr=DateValue",
+				ResponseType: nrprotocol.ResponseType_ERROR,
+				Error:        &nrprotocol.AttributeError{Kind: nrprotocol.ErrorKind_NULL_VALUE},
+			},
+			expected: false,
+		},
+		{
+			name: "Getter Exception",
+			jmxAttr: &AttributeResponse{
+				Name:         "test:type=Cat,name=tomas,attr=DoubleValue",
+				ResponseType: nrprotocol.ResponseType_ERROR,
+				Error:        &nrprotocol.AttributeError{Kind: nrprotocol.ErrorKind_GETTER_EXCEPTION},
+			},
+			expected: false,
+		},
+		{
+			name: "No Error",
+			jmxAttr: &AttributeResponse{
+				Name:         "test:type=Cat,name=tomas,attr=Name",
+				ResponseType: nrprotocol.ResponseType_STRING,
+				StringValue:  "tomas",
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.jmxAttr.GetAttributeError().IsPermanent())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package watch
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Func selects the value of a row evaluated by a Condition.
+type Func string
+
+const (
+	// FuncValue evaluates the current value.
+	FuncValue Func = ""
+	// FuncDelta evaluates the change since the previous poll.
+	FuncDelta Func = "delta"
+	// FuncRate evaluates the per-second change since the previous poll.
+	FuncRate Func = "rate"
+)
+
+// Condition is a threshold on a numeric attribute, e.g. "ThreadCount > 500", "rate(CollectionCount) >= 10"
+// or "HeapMemoryUsage.Used > 90%". Percentages are relative to the Max field of the same composite attribute,
+// e.g. HeapMemoryUsage.Max, they don't hold when the Max is unknown. The condition applies to all the watched
+// mBeans with the attribute.
+type Condition struct {
+	Func      Func
+	Attribute string
+	Operator  string
+	Threshold float64
+	Percent   bool
+}
+
+var conditionRegex = regexp.MustCompile(`^\s*(?:(rate|delta)\(\s*([^)]+?)\s*\)|(\S+?))\s*(>=|<=|==|!=|>|<)\s*([-+]?[0-9.]+(?:[eE][-+]?[0-9]+)?)\s*(%?)\s*$`)
+
+// ParseCondition parses a condition with the format [rate|delta](ATTRIBUTE) OPERATOR THRESHOLD[%].
+// The operators are >, >=, <, <=, == and !=.
+func ParseCondition(text string) (*Condition, error) {
+	match := conditionRegex.FindStringSubmatch(text)
+	if match == nil {
+		return nil, fmt.Errorf("invalid condition: '%s', valid: [rate|delta](ATTRIBUTE) OPERATOR THRESHOLD[%%]", text)
+	}
+
+	threshold, err := strconv.ParseFloat(match[5], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition: '%s' threshold: %w", text, err)
+	}
+
+	condition := &Condition{
+		Func:      Func(match[1]),
+		Attribute: match[2],
+		Operator:  match[4],
+		Threshold: threshold,
+		Percent:   match[6] == "%",
+	}
+	if condition.Attribute == "" {
+		condition.Attribute = match[3]
+	}
+
+	if condition.Percent && !strings.Contains(condition.Attribute, ".") {
+		return nil, fmt.Errorf("invalid condition: '%s', percentages require a composite attribute field, e.g. HeapMemoryUsage.Used", text)
+	}
+	return condition, nil
+}
+
+func (c *Condition) String() string {
+	attribute := c.Attribute
+	if c.Func != FuncValue {
+		attribute = fmt.Sprintf("%s(%s)", c.Func, c.Attribute)
+	}
+	threshold := strconv.FormatFloat(c.Threshold, 'f', -1, 64)
+	if c.Percent {
+		threshold += "%"
+	}
+	return fmt.Sprintf("%s %s %s", attribute, c.Operator, threshold)
+}
+
+// value returns the row value evaluated by the condition, false when it's not available.
+// values are the numeric values of the poll by mBean and attribute, used for the percentages.
+func (c *Condition) value(row *Row, values map[string]float64) (float64, bool) {
+	if row.Attribute != c.Attribute {
+		return 0, false
+	}
+
+	var value float64
+	switch c.Func {
+	case FuncDelta:
+		if row.Delta == nil {
+			return 0, false
+		}
+		value = *row.Delta
+	case FuncRate:
+		if row.Rate == nil {
+			return 0, false
+		}
+		value = *row.Rate
+	default:
+		if !row.numeric {
+			return 0, false
+		}
+		value = row.number
+	}
+
+	if c.Percent {
+		field := strings.LastIndex(c.Attribute, ".")
+		maxValue, ok := values[valueKey(row.MBean, c.Attribute[:field]+".Max")]
+		if !ok || maxValue <= 0 {
+			return 0, false
+		}
+		value = value / maxValue * 100
+	}
+	return value, true
+}
+
+// holds reports if the condition holds for the row and the evaluated value.
+func (c *Condition) holds(row *Row, values map[string]float64) (float64, bool) {
+	value, ok := c.value(row, values)
+	if !ok {
+		return 0, false
+	}
+
+	switch c.Operator {
+	case ">":
+		return value, value > c.Threshold
+	case ">=":
+		return value, value >= c.Threshold
+	case "<":
+		return value, value < c.Threshold
+	case "<=":
+		return value, value <= c.Threshold
+	case "==":
+		return value, value == c.Threshold
+	case "!=":
+		return value, value != c.Threshold
+	default:
+		return value, false
+	}
+}
+
+func valueKey(mBean, attribute string) string {
+	return mBean + ",attr=" + attribute
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package watch
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	colorReset  = "\x1b[0m"
+	colorBold   = "\x1b[1m"
+	colorYellow = "\x1b[33m"
+	colorRed    = "\x1b[31m"
+)
+
+var header = []string{"", "MBEAN", "ATTRIBUTE", "VALUE", "DELTA", "RATE/S"}
+
+// Render writes the table. The first column marks the failed rows with !!, the warnings with ! and the
+// changed values with *. With Config.Color the rows are also highlighted.
+func (w *Watcher) Render(out io.Writer, table *Table) error {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Every %s: %s    %s\n", w.config.Interval, w.config.Pattern, table.Time.Format("2006-01-02 15:04:05")))
+
+	if table.Error != "" {
+		sb.WriteString("error: " + table.Error + "\n")
+		_, err := io.WriteString(out, sb.String())
+		return err
+	}
+
+	cells := [][]string{header}
+	for _, row := range table.Rows {
+		cells = append(cells, []string{marker(row), row.MBean, row.Attribute, formatValue(row), formatDelta(row.Delta), formatRate(row.Rate)})
+	}
+
+	// The marker column has a fixed width so the tables don't shift between polls.
+	widths := make([]int, len(header))
+	widths[0] = 2
+	for _, line := range cells {
+		for i, cell := range line {
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	for i, line := range cells {
+		var row *Row
+		if i > 0 {
+			row = table.Rows[i-1]
+		}
+
+		var cols []string
+		for j, cell := range line {
+			padded := cell + strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell))
+			if j == 3 && row != nil && row.Changed && w.config.Color {
+				padded = colorBold + padded + colorReset + w.rowColor(row)
+			}
+			cols = append(cols, padded)
+		}
+
+		text := strings.TrimRight(strings.Join(cols, "  "), " ")
+		if color := w.rowColor(row); color != "" {
+			text = color + text + colorReset
+		}
+		sb.WriteString(text + "\n")
+	}
+
+	for _, failed := range table.Failed {
+		sb.WriteString("condition held: " + failed + "\n")
+	}
+
+	_, err := io.WriteString(out, sb.String())
+	return err
+}
+
+func (w *Watcher) rowColor(row *Row) string {
+	if row == nil || !w.config.Color {
+		return ""
+	}
+	switch {
+	case row.Fail:
+		return colorRed
+	case row.Warn:
+		return colorYellow
+	default:
+		return ""
+	}
+}
+
+func marker(row *Row) string {
+	switch {
+	case row.Fail:
+		return "!!"
+	case row.Warn:
+		return "!"
+	case row.Changed:
+		return "*"
+	default:
+		return ""
+	}
+}
+
+func formatValue(row *Row) string {
+	if row.Error != "" {
+		return "error: " + row.Error
+	}
+	if row.numeric {
+		return formatNumber(row.number)
+	}
+	return fmt.Sprint(row.Value)
+}
+
+func formatDelta(delta *float64) string {
+	if delta == nil {
+		return ""
+	}
+	if *delta > 0 {
+		return "+" + formatNumber(*delta)
+	}
+	return formatNumber(*delta)
+}
+
+func formatRate(rate *float64) string {
+	if rate == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*rate, 'f', 2, 64)
+}
+
+// formatNumber writes the integers without decimals and the other values with 2 decimals.
+func formatNumber(value float64) string {
+	if value == math.Trunc(value) && math.Abs(value) < 1e15 {
+		return strconv.FormatFloat(value, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package watch polls the attributes of the mBeans matching a pattern and renders a refreshing table with
+// the current values, their delta since the previous poll and their per-second rate. The changed values and
+// the values over the warning thresholds are highlighted. It stops with a *ConditionError when a fail
+// condition holds, so it can be used from scripts.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+)
+
+// DefaultInterval is used when Config.Interval is not defined.
+const DefaultInterval = 2 * time.Second
+
+// Querier is the subset of the gojmx.Client used to poll the attributes.
+type Querier interface {
+	QueryMBeanAttributes(mBeanNamePattern string, mBeanAttrName ...string) ([]*gojmx.AttributeResponse, error)
+}
+
+var _ Querier = (*gojmx.Client)(nil)
+
+// Config configures the watched attributes and the conditions.
+type Config struct {
+	// Pattern is the mBean glob pattern DOMAIN:BEAN.
+	Pattern string
+	// Attributes to watch, all of them when empty.
+	Attributes []string
+	Interval   time.Duration
+	// Count stops after the number of polls, 0 polls until the context is done.
+	Count int
+	// Warn conditions highlight the rows.
+	Warn []*Condition
+	// Fail conditions stop the watch with a *ConditionError.
+	Fail []*Condition
+	// Refresh clears the screen before each table, otherwise the tables are appended.
+	Refresh bool
+	// Color highlights the rows using ANSI colors.
+	Color bool
+}
+
+// Row is an attribute of a poll.
+type Row struct {
+	MBean     string
+	Attribute string
+	Value     interface{}
+	// Delta and Rate are nil for the first poll and for the non numeric values.
+	Delta *float64
+	Rate  *float64
+	// Changed is true when the value changed since the previous poll.
+	Changed bool
+	Warn    bool
+	Fail    bool
+	Error   string
+
+	numeric bool
+	number  float64
+}
+
+// Table is the result of a poll.
+type Table struct {
+	Time time.Time
+	Rows []*Row
+	// Failed describes the fail conditions that held.
+	Failed []string
+	// Error is the *gojmx.JMXError message when the poll failed.
+	Error string
+}
+
+// ConditionError is returned by Run when a fail condition holds.
+type ConditionError struct {
+	Conditions []string
+}
+
+func (e *ConditionError) Error() string {
+	return "condition held: " + strings.Join(e.Conditions, "; ")
+}
+
+type previousValue struct {
+	value   string
+	numeric bool
+	number  float64
+	time    time.Time
+}
+
+// Watcher keeps the previous values to compute the deltas and the rates.
+type Watcher struct {
+	querier  Querier
+	config   Config
+	previous map[string]previousValue
+}
+
+// New returns a Watcher for the Querier.
+func New(q Querier, config Config) *Watcher {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	return &Watcher{
+		querier:  q,
+		config:   config,
+		previous: map[string]previousValue{},
+	}
+}
+
+// Run polls and renders the tables until the context is done or Config.Count polls are done.
+// *gojmx.JMXError failures are rendered and the watch continues, the other errors stop it.
+func (w *Watcher) Run(ctx context.Context, out io.Writer) error {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for i := 0; w.config.Count <= 0 || i < w.config.Count; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		table, err := w.Poll(time.Now())
+		if err != nil {
+			return err
+		}
+
+		if w.config.Refresh {
+			_, _ = io.WriteString(out, clearScreen)
+		} else if i > 0 {
+			_, _ = io.WriteString(out, "\n")
+		}
+		if err := w.Render(out, table); err != nil {
+			return err
+		}
+
+		if len(table.Failed) > 0 {
+			return &ConditionError{Conditions: table.Failed}
+		}
+	}
+	return nil
+}
+
+// Poll retrieves the attributes and evaluates the conditions.
+func (w *Watcher) Poll(now time.Time) (*Table, error) {
+	table := &Table{Time: now}
+
+	attrs, err := w.querier.QueryMBeanAttributes(w.config.Pattern, w.config.Attributes...)
+	if err != nil {
+		if jmxErr, ok := gojmx.IsJMXError(err); ok {
+			table.Error = jmxErr.Message
+			return table, nil
+		}
+		return nil, err
+	}
+
+	sort.SliceStable(attrs, func(i, j int) bool {
+		return attrs[i].Name < attrs[j].Name
+	})
+
+	values := map[string]float64{}
+	for _, attr := range attrs {
+		mBean, name, ok := gojmx.SplitAttributeName(attr.Name)
+		if !ok {
+			continue
+		}
+
+		row := &Row{MBean: mBean, Attribute: name}
+		table.Rows = append(table.Rows, row)
+
+		if attr.ResponseType == gojmx.ResponseTypeErr {
+			row.Error = attr.StatusMsg
+			continue
+		}
+
+		row.Value = attr.GetValue()
+		switch attr.ResponseType {
+		case gojmx.ResponseTypeInt, gojmx.ResponseTypeDouble:
+			row.numeric = true
+			row.number, _ = attr.GetValueAsFloat()
+			values[attr.Name] = row.number
+		}
+
+		current := previousValue{value: fmt.Sprint(row.Value), numeric: row.numeric, number: row.number, time: now}
+		if previous, ok := w.previous[attr.Name]; ok {
+			row.Changed = previous.value != current.value
+			if row.numeric && previous.numeric {
+				delta := row.number - previous.number
+				row.Delta = &delta
+				if elapsed := now.Sub(previous.time).Seconds(); elapsed > 0 {
+					rate := delta / elapsed
+					row.Rate = &rate
+				}
+			}
+		}
+		w.previous[attr.Name] = current
+	}
+
+	for _, row := range table.Rows {
+		for _, condition := range w.config.Warn {
+			if _, ok := condition.holds(row, values); ok {
+				row.Warn = true
+			}
+		}
+		for _, condition := range w.config.Fail {
+			if value, ok := condition.holds(row, values); ok {
+				row.Fail = true
+				current := formatNumber(value)
+				if condition.Percent {
+					current += "%"
+				}
+				table.Failed = append(table.Failed, fmt.Sprintf("%s %s (%s)", row.MBean, condition, current))
+			}
+		}
+	}
+	return table, nil
+}
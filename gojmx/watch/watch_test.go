@@ -0,0 +1,199 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package watch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/newrelic/nrjmx/gojmx"
+	"github.com/newrelic/nrjmx/gojmx/internal/nrprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQuerier struct {
+	polls [][]*gojmx.AttributeResponse
+	calls int
+	err   error
+}
+
+func (f *fakeQuerier) QueryMBeanAttributes(string, ...string) ([]*gojmx.AttributeResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	poll := f.polls[len(f.polls)-1]
+	if f.calls < len(f.polls) {
+		poll = f.polls[f.calls]
+	}
+	f.calls++
+	return poll, nil
+}
+
+func memoryPoll(used, max int64, collections int64, state string) []*gojmx.AttributeResponse {
+	return []*gojmx.AttributeResponse{
+		{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Used", ResponseType: nrprotocol.ResponseType_INT, IntValue: used},
+		{Name: "java.lang:type=Memory,attr=HeapMemoryUsage.Max", ResponseType: nrprotocol.ResponseType_INT, IntValue: max},
+		{Name: "java.lang:type=Memory,attr=CollectionCount", ResponseType: nrprotocol.ResponseType_INT, IntValue: collections},
+		{Name: "java.lang:type=Memory,attr=State", ResponseType: nrprotocol.ResponseType_STRING, StringValue: state},
+		{Name: "java.lang:type=Memory,attr=Broken", ResponseType: nrprotocol.ResponseType_ERROR, StatusMsg: "unsupported"},
+	}
+}
+
+func mustParse(t *testing.T, text string) *Condition {
+	condition, err := ParseCondition(text)
+	require.NoError(t, err)
+	return condition
+}
+
+func Test_ParseCondition(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected *Condition
+		err      string
+	}{
+		{"Value", "ThreadCount > 500", &Condition{Attribute: "ThreadCount", Operator: ">", Threshold: 500}, ""},
+		{"Spaces", "  ThreadCount>=1.5e3 ", &Condition{Attribute: "ThreadCount", Operator: ">=", Threshold: 1500}, ""},
+		{"Rate", "rate(CollectionCount) != 0", &Condition{Func: FuncRate, Attribute: "CollectionCount", Operator: "!=", Threshold: 0}, ""},
+		{"Delta", "delta( Count ) < -10", &Condition{Func: FuncDelta, Attribute: "Count", Operator: "<", Threshold: -10}, ""},
+		{"Percent", "HeapMemoryUsage.Used > 90%", &Condition{Attribute: "HeapMemoryUsage.Used", Operator: ">", Threshold: 90, Percent: true}, ""},
+		{"MissingThreshold", "ThreadCount >", nil, "invalid condition: 'ThreadCount >', valid: [rate|delta](ATTRIBUTE) OPERATOR THRESHOLD[%]"},
+		{"InvalidOperator", "ThreadCount => 5", nil, "invalid condition: 'ThreadCount => 5', valid: [rate|delta](ATTRIBUTE) OPERATOR THRESHOLD[%]"},
+		{"InvalidNumber", "ThreadCount > 1.2.3", nil, "invalid condition: 'ThreadCount > 1.2.3' threshold: strconv.ParseFloat: parsing \"1.2.3\": invalid syntax"},
+		{"PercentWithoutField", "ThreadCount > 90%", nil, "invalid condition: 'ThreadCount > 90%', percentages require a composite attribute field, e.g. HeapMemoryUsage.Used"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			condition, err := ParseCondition(tc.text)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, condition)
+		})
+	}
+
+	assert.Equal(t, "rate(CollectionCount) >= 2.5", mustParse(t, "rate(CollectionCount)>=2.5").String())
+	assert.Equal(t, "HeapMemoryUsage.Used > 90%", mustParse(t, "HeapMemoryUsage.Used>90 %").String())
+}
+
+func Test_Poll(t *testing.T) {
+	q := &fakeQuerier{polls: [][]*gojmx.AttributeResponse{
+		memoryPoll(50, 100, 10, "ok"),
+		memoryPoll(95, 100, 14, "ok"),
+		memoryPoll(95, 100, 14, "busy"),
+	}}
+	w := New(q, Config{
+		Pattern: "java.lang:type=Memory",
+		Warn:    []*Condition{mustParse(t, "rate(CollectionCount) > 1")},
+		Fail:    []*Condition{mustParse(t, "HeapMemoryUsage.Used > 90%")},
+	})
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	table, err := w.Poll(now)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 5)
+	assert.Empty(t, table.Failed)
+
+	// Sorted by attribute name, without deltas on the first poll.
+	assert.Equal(t, "Broken", table.Rows[0].Attribute)
+	assert.Equal(t, "unsupported", table.Rows[0].Error)
+	assert.Equal(t, "CollectionCount", table.Rows[1].Attribute)
+	assert.Nil(t, table.Rows[1].Delta)
+	assert.False(t, table.Rows[1].Changed)
+
+	table, err = w.Poll(now.Add(2 * time.Second))
+	require.NoError(t, err)
+
+	collections := table.Rows[1]
+	assert.True(t, collections.Changed)
+	assert.Equal(t, 4.0, *collections.Delta)
+	assert.Equal(t, 2.0, *collections.Rate)
+	assert.True(t, collections.Warn)
+
+	used := table.Rows[3]
+	assert.Equal(t, "HeapMemoryUsage.Used", used.Attribute)
+	assert.True(t, used.Fail)
+	assert.Equal(t, []string{"java.lang:type=Memory HeapMemoryUsage.Used > 90% (95%)"}, table.Failed)
+
+	// Non numeric values are only marked as changed.
+	table, err = w.Poll(now.Add(4 * time.Second))
+	require.NoError(t, err)
+	state := table.Rows[4]
+	assert.Equal(t, "State", state.Attribute)
+	assert.True(t, state.Changed)
+	assert.Nil(t, state.Delta)
+	assert.False(t, table.Rows[1].Changed)
+	assert.Equal(t, 0.0, *table.Rows[1].Rate)
+	assert.False(t, table.Rows[1].Warn)
+}
+
+func Test_Run(t *testing.T) {
+	q := &fakeQuerier{polls: [][]*gojmx.AttributeResponse{
+		memoryPoll(50, 100, 10, "ok"),
+		memoryPoll(60, 100, 12, "ok"),
+	}}
+	w := New(q, Config{Pattern: "java.lang:type=Memory", Interval: time.Millisecond, Count: 2})
+
+	out := &bytes.Buffer{}
+	require.NoError(t, w.Run(context.Background(), out))
+
+	assert.Equal(t, 2, q.calls)
+	assert.Contains(t, out.String(), "Every 1ms: java.lang:type=Memory")
+	assert.Contains(t, out.String(), `    MBEAN                  ATTRIBUTE             VALUE               DELTA  RATE/S
+    java.lang:type=Memory  Broken                error: unsupported
+    java.lang:type=Memory  CollectionCount       10
+    java.lang:type=Memory  HeapMemoryUsage.Max   100
+    java.lang:type=Memory  HeapMemoryUsage.Used  50
+    java.lang:type=Memory  State                 ok
+`)
+	assert.Contains(t, out.String(), "*   java.lang:type=Memory  CollectionCount       12                  +2")
+	assert.NotContains(t, out.String(), clearScreen)
+	assert.NotContains(t, out.String(), colorReset)
+}
+
+func Test_Run_Conditions(t *testing.T) {
+	q := &fakeQuerier{polls: [][]*gojmx.AttributeResponse{
+		memoryPoll(50, 100, 10, "ok"),
+		memoryPoll(95, 100, 12, "ok"),
+	}}
+	w := New(q, Config{
+		Pattern:  "java.lang:type=Memory",
+		Interval: time.Millisecond,
+		Fail:     []*Condition{mustParse(t, "HeapMemoryUsage.Used >= 90%")},
+		Refresh:  true,
+		Color:    true,
+	})
+
+	out := &bytes.Buffer{}
+	err := w.Run(context.Background(), out)
+	require.Error(t, err)
+
+	var conditionErr *ConditionError
+	require.ErrorAs(t, err, &conditionErr)
+	assert.EqualError(t, err, "condition held: java.lang:type=Memory HeapMemoryUsage.Used >= 90% (95%)")
+	assert.Equal(t, 2, q.calls)
+	assert.Contains(t, out.String(), clearScreen)
+	assert.Contains(t, out.String(), colorRed+"!!  java.lang:type=Memory  HeapMemoryUsage.Used  "+colorBold+"95 ")
+}
+
+func Test_Run_Errors(t *testing.T) {
+	// JMX errors are rendered and the watch continues.
+	q := &fakeQuerier{err: &gojmx.JMXError{Message: "instance not found"}}
+	out := &bytes.Buffer{}
+	require.NoError(t, New(q, Config{Interval: time.Millisecond, Count: 2}).Run(context.Background(), out))
+	assert.Contains(t, out.String(), "error: instance not found")
+
+	// Other errors stop it.
+	q.err = fmt.Errorf("connection lost")
+	assert.EqualError(t, New(q, Config{}).Run(context.Background(), out), "connection lost")
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.apache.commons.lang3.exception.ExceptionUtils;
+import org.newrelic.nrjmx.v2.nrprotocol.AttributeError;
+import org.newrelic.nrjmx.v2.nrprotocol.ErrorKind;
+import org.newrelic.nrjmx.v2.nrprotocol.JMXError;
+
+import javax.management.*;
+import java.io.ObjectStreamException;
+import java.rmi.UnmarshalException;
+
+/**
+ * AttributeErrors classifies the failures that happen while retrieving an attribute value,
+ * so the consumers can tell a permanently unsupported attribute from a transient failure.
+ */
+public class AttributeErrors {
+
+    /**
+     * ValueException is attached as cause to the JMXError raised when a retrieved value cannot be parsed.
+     */
+    public static class ValueException extends Exception {
+        private final ErrorKind kind;
+
+        public ValueException(ErrorKind kind, String message) {
+            super(message);
+            this.kind = kind;
+        }
+
+        public ErrorKind getKind() {
+            return kind;
+        }
+    }
+
+    /**
+     * withCause attaches the exception that originated the JMXError, required for classifying it later.
+     *
+     * @param error JMXError to be returned
+     * @param cause Throwable that originated the error
+     * @return JMXError the same error with the cause attached
+     */
+    public static JMXError withCause(JMXError error, Throwable cause) {
+        if (cause != null && error.getCause() == null) {
+            error.initCause(cause);
+        }
+        return error;
+    }
+
+    /**
+     * valueError builds the JMXError reported when a retrieved value cannot be parsed.
+     *
+     * @param kind    ErrorKind of the failure
+     * @param message String describing the failure
+     * @return JMXError with a ValueException cause
+     */
+    public static JMXError valueError(ErrorKind kind, String message) {
+        return withCause(new JMXError().setMessage(message), new ValueException(kind, message));
+    }
+
+    /**
+     * fromJMXError builds the AttributeError for an attribute that failed with a JMXError.
+     *
+     * @param error JMXError raised while retrieving or parsing the attribute
+     * @return AttributeError structured error details
+     */
+    public static AttributeError fromJMXError(JMXError error) {
+        if (error == null || error.getCause() == null) {
+            return new AttributeError().setKind(ErrorKind.UNKNOWN);
+        }
+        return fromThrowable(error.getCause());
+    }
+
+    /**
+     * fromThrowable builds the AttributeError for an attribute that failed with an exception.
+     *
+     * @param throwable exception raised while retrieving or parsing the attribute
+     * @return AttributeError structured error details
+     */
+    public static AttributeError fromThrowable(Throwable throwable) {
+        AttributeError attributeError = new AttributeError()
+                .setKind(classify(throwable))
+                .setExceptionClass("")
+                .setCauseClass("")
+                .setCauseMessage("");
+
+        if (throwable == null || throwable instanceof ValueException) {
+            return attributeError;
+        }
+
+        attributeError.setExceptionClass(throwable.getClass().getName());
+
+        Throwable rootCause = ExceptionUtils.getRootCause(throwable);
+        if (rootCause != null && rootCause != throwable) {
+            attributeError
+                    .setCauseClass(rootCause.getClass().getName())
+                    .setCauseMessage(rootCause.getMessage() == null ? "" : rootCause.getMessage());
+        }
+
+        return attributeError;
+    }
+
+    /**
+     * notReturned builds the AttributeError for a requested attribute that was missing from the server response.
+     *
+     * @return AttributeError structured error details
+     */
+    public static AttributeError notReturned() {
+        return new AttributeError()
+                .setKind(ErrorKind.NOT_RETURNED)
+                .setExceptionClass("")
+                .setCauseClass("")
+                .setCauseMessage("");
+    }
+
+    /**
+     * classify walks the exception chain looking for the most specific failure.
+     * Exceptions thrown by the attribute getter are wrapped by the MBeanServer, in that case
+     * we keep looking into the causes before reporting it as GETTER_EXCEPTION.
+     *
+     * @param throwable exception raised while retrieving or parsing the attribute
+     * @return ErrorKind of the failure
+     */
+    static ErrorKind classify(Throwable throwable) {
+        ErrorKind kind = ErrorKind.UNKNOWN;
+
+        for (Throwable t : ExceptionUtils.getThrowableList(throwable)) {
+            if (t instanceof ValueException) {
+                return ((ValueException) t).getKind();
+            } else if (t instanceof AttributeNotFoundException) {
+                return ErrorKind.ATTRIBUTE_NOT_FOUND;
+            } else if (t instanceof InstanceNotFoundException) {
+                return ErrorKind.MBEAN_NOT_FOUND;
+            } else if (t instanceof UnsupportedOperationException) {
+                return ErrorKind.UNSUPPORTED_OPERATION;
+            } else if (t instanceof SecurityException) {
+                return ErrorKind.SECURITY;
+            } else if (t instanceof UnmarshalException
+                    || t instanceof ObjectStreamException
+                    || t instanceof ClassNotFoundException) {
+                return ErrorKind.SERIALIZATION;
+            } else if (t instanceof MBeanException
+                    || t instanceof RuntimeMBeanException
+                    || t instanceof RuntimeErrorException
+                    || t instanceof ReflectionException) {
+                kind = ErrorKind.GETTER_EXCEPTION;
+            }
+        }
+
+        return kind;
+    }
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import javax.management.MBeanInfo;
+import javax.management.MBeanOperationInfo;
+
+/**
+ * DiagnosticCommands maps the jcmd commands to the operations of the com.sun.management:type=DiagnosticCommand MBean.
+ */
+public class DiagnosticCommands {
+    public static final String MBEAN_NAME = "com.sun.management:type=DiagnosticCommand";
+
+    /**
+     * operationName converts a jcmd command name into the MBean operation name, e.g. GC.class_histogram into
+     * gcClassHistogram. It follows the same rules as the JDK DiagnosticCommand MBean implementation.
+     * Names that are not jcmd commands (without '.') are returned as they are.
+     *
+     * @param command String jcmd command name
+     * @return String the MBean operation name
+     */
+    public static String operationName(String command) {
+        if (command == null || !command.contains(".")) {
+            return command;
+        }
+
+        StringBuilder sb = new StringBuilder();
+        boolean toLower = true;
+        boolean toUpper = false;
+        for (char c : command.toCharArray()) {
+            if (c == '.' || c == '_') {
+                toLower = false;
+                toUpper = true;
+            } else if (toUpper) {
+                toUpper = false;
+                sb.append(Character.toUpperCase(c));
+            } else if (toLower) {
+                sb.append(Character.toLowerCase(c));
+            } else {
+                sb.append(c);
+            }
+        }
+        return sb.toString();
+    }
+
+    /**
+     * findOperation returns the MBean operation for the command.
+     *
+     * @param info          MBeanInfo of the DiagnosticCommand MBean
+     * @param operationName String name of the operation
+     * @return MBeanOperationInfo the operation or null when the command is not available
+     */
+    public static MBeanOperationInfo findOperation(MBeanInfo info, String operationName) {
+        if (info == null) {
+            return null;
+        }
+
+        for (MBeanOperationInfo operation : info.getOperations()) {
+            if (operation.getName().equals(operationName)) {
+                return operation;
+            }
+        }
+        return null;
+    }
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import javax.management.MBeanServerNotification;
+import javax.management.Notification;
+import javax.management.NotificationListener;
+import javax.management.ObjectInstance;
+import javax.management.ObjectName;
+import javax.management.relation.MBeanServerNotificationFilter;
+import java.util.Collections;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import java.util.concurrent.ConcurrentHashMap;
+import java.util.concurrent.atomic.AtomicLong;
+
+/**
+ * DiscoveryCache keeps the mBeans matching each queried pattern and the attribute names of each mBean,
+ * so the collection cycles only request the attribute values. Entries expire after the TTL and are invalidated
+ * by the MBeanServerDelegate registration and unregistration notifications.
+ */
+public class DiscoveryCache implements NotificationListener {
+
+    private static class Entry<T> {
+        private final T value;
+        private final long expiresAtMs;
+
+        private Entry(T value, long expiresAtMs) {
+            this.value = value;
+            this.expiresAtMs = expiresAtMs;
+        }
+    }
+
+    private final long ttlMs;
+
+    private final Map<ObjectName, Entry<Set<ObjectInstance>>> queries = new ConcurrentHashMap<>();
+
+    private final Map<ObjectName, Entry<List<String>>> attributeNames = new ConcurrentHashMap<>();
+
+    /* generation changes on each invalidation, values retrieved before it are not cached. */
+    private final AtomicLong generation = new AtomicLong();
+
+    public DiscoveryCache(long ttlMs) {
+        this.ttlMs = ttlMs;
+    }
+
+    /**
+     * filter returns the NotificationFilter for the MBeanServerDelegate notifications handled by the cache.
+     *
+     * @return MBeanServerNotificationFilter for the registration and unregistration of all the mBeans
+     */
+    public static MBeanServerNotificationFilter filter() {
+        MBeanServerNotificationFilter filter = new MBeanServerNotificationFilter();
+        filter.enableAllObjectNames();
+        filter.enableType(MBeanServerNotification.REGISTRATION_NOTIFICATION);
+        filter.enableType(MBeanServerNotification.UNREGISTRATION_NOTIFICATION);
+        return filter;
+    }
+
+    /**
+     * generation returns the current generation, required for storing the values retrieved afterwards.
+     *
+     * @return long the current generation
+     */
+    public long generation() {
+        return generation.get();
+    }
+
+    /**
+     * getQuery returns the cached mBeans matching a pattern.
+     *
+     * @param pattern ObjectName queried
+     * @return Set<ObjectInstance> matching mBeans or null when not cached or expired
+     */
+    public Set<ObjectInstance> getQuery(ObjectName pattern) {
+        return get(queries, pattern);
+    }
+
+    /**
+     * putQuery caches the mBeans matching a pattern, unless the cache was invalidated after retrieving them.
+     *
+     * @param pattern    ObjectName queried
+     * @param mBeans     Set<ObjectInstance> matching mBeans
+     * @param generation long returned by generation() before retrieving the mBeans
+     */
+    public void putQuery(ObjectName pattern, Set<ObjectInstance> mBeans, long generation) {
+        put(queries, pattern, Collections.unmodifiableSet(mBeans), generation);
+    }
+
+    /**
+     * getAttributeNames returns the cached attribute names of an mBean.
+     *
+     * @param objectName ObjectName of the mBean
+     * @return List<String> attribute names or null when not cached or expired
+     */
+    public List<String> getAttributeNames(ObjectName objectName) {
+        return get(attributeNames, objectName);
+    }
+
+    /**
+     * putAttributeNames caches the attribute names of an mBean, unless the cache was invalidated after retrieving them.
+     *
+     * @param objectName ObjectName of the mBean
+     * @param names      List<String> attribute names
+     * @param generation long returned by generation() before retrieving the names
+     */
+    public void putAttributeNames(ObjectName objectName, List<String> names, long generation) {
+        put(attributeNames, objectName, Collections.unmodifiableList(names), generation);
+    }
+
+    /**
+     * clear removes all the entries, required when the notifications could have been missed, e.g. on reconnection.
+     */
+    public void clear() {
+        generation.incrementAndGet();
+        queries.clear();
+        attributeNames.clear();
+    }
+
+    /**
+     * invalidate removes the entries affected by the registration or unregistration of an mBean.
+     *
+     * @param objectName ObjectName of the registered or unregistered mBean
+     */
+    public void invalidate(ObjectName objectName) {
+        generation.incrementAndGet();
+        attributeNames.remove(objectName);
+        queries.keySet().removeIf(pattern -> pattern.apply(objectName));
+    }
+
+    @Override
+    public void handleNotification(Notification notification, Object handback) {
+        if (notification instanceof MBeanServerNotification) {
+            invalidate(((MBeanServerNotification) notification).getMBeanName());
+        }
+    }
+
+    private <K, T> T get(Map<K, Entry<T>> entries, K key) {
+        Entry<T> entry = entries.get(key);
+        if (entry == null) {
+            return null;
+        }
+        if (System.currentTimeMillis() >= entry.expiresAtMs) {
+            entries.remove(key, entry);
+            return null;
+        }
+        return entry.value;
+    }
+
+    private <K, T> void put(Map<K, Entry<T>> entries, K key, T value, long generation) {
+        if (value == null || generation != this.generation.get()) {
+            return;
+        }
+        entries.put(key, new Entry<>(value, System.currentTimeMillis() + ttlMs));
+    }
+}
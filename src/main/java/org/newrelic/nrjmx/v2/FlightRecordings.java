@@ -0,0 +1,75 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import javax.management.openmbean.*;
+import java.util.HashMap;
+import java.util.Map;
+
+/**
+ * FlightRecordings contains the helpers required to invoke the jdk.management.jfr:type=FlightRecorder MXBean
+ * operations without depending on the jdk.management.jfr module.
+ */
+public class FlightRecordings {
+    public static final String MBEAN_NAME = "jdk.management.jfr:type=FlightRecorder";
+
+    /* blockSize is the size of the chunks returned by readStream. */
+    public static final String STREAM_BLOCK_SIZE = String.valueOf(1024 * 1024);
+
+    /* MAP_TYPE_NAME is the open type name used by the MXBeans for Map<String, String>. */
+    private static final String MAP_TYPE_NAME = "java.util.Map<java.lang.String, java.lang.String>";
+
+    private static final String[] MAP_ITEMS = new String[]{"key", "value"};
+
+    /**
+     * toTabularData converts a Map<String, String> into the open type expected by the MXBean operations.
+     *
+     * @param map Map<String, String> to convert
+     * @return TabularData the MXBean open type value
+     * @throws OpenDataException when the open type cannot be created
+     */
+    public static TabularData toTabularData(Map<String, String> map) throws OpenDataException {
+        CompositeType rowType = new CompositeType(MAP_TYPE_NAME, MAP_TYPE_NAME, MAP_ITEMS, MAP_ITEMS,
+                new OpenType<?>[]{SimpleType.STRING, SimpleType.STRING});
+        TabularType tabularType = new TabularType(MAP_TYPE_NAME, MAP_TYPE_NAME, rowType, new String[]{"key"});
+
+        TabularDataSupport result = new TabularDataSupport(tabularType);
+        if (map == null) {
+            return result;
+        }
+
+        for (Map.Entry<String, String> entry : map.entrySet()) {
+            result.put(new CompositeDataSupport(rowType, MAP_ITEMS, new Object[]{entry.getKey(), entry.getValue()}));
+        }
+        return result;
+    }
+
+    /**
+     * fromTabularData converts the open type returned by the MXBean operations into a Map<String, String>.
+     *
+     * @param data Object returned by the MXBean operation
+     * @return Map<String, String> the converted value, empty when the data is not a TabularData
+     */
+    public static Map<String, String> fromTabularData(Object data) {
+        Map<String, String> result = new HashMap<>();
+        if (!(data instanceof TabularData)) {
+            return result;
+        }
+
+        for (Object row : ((TabularData) data).values()) {
+            if (!(row instanceof CompositeData)) {
+                continue;
+            }
+            CompositeData cd = (CompositeData) row;
+            Object key = cd.get("key");
+            Object value = cd.get("value");
+            if (key != null) {
+                result.put(key.toString(), value == null ? "" : value.toString());
+            }
+        }
+        return result;
+    }
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.apache.commons.lang3.exception.ExceptionUtils;
+import org.newrelic.nrjmx.v2.nrprotocol.VMOption;
+
+import javax.management.openmbean.CompositeData;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * HotSpotDiagnostics converts the com.sun.management:type=HotSpotDiagnostic MXBean responses.
+ */
+public class HotSpotDiagnostics {
+    public static final String MBEAN_NAME = "com.sun.management:type=HotSpotDiagnostic";
+
+    /**
+     * toVMOption converts the com.sun.management.VMOption CompositeData.
+     *
+     * @param data CompositeData returned by the MXBean
+     * @return VMOption the converted option
+     */
+    public static VMOption toVMOption(CompositeData data) {
+        return new VMOption()
+                .setName(getString(data, "name"))
+                .setValue(getString(data, "value"))
+                .setOrigin(getString(data, "origin"))
+                .setWriteable(Boolean.TRUE.equals(data.get("writeable")));
+    }
+
+    /**
+     * toVMOptions converts the com.sun.management.VMOption CompositeData array returned by DiagnosticOptions.
+     *
+     * @param data Object returned by the MXBean
+     * @return List<VMOption> the converted options
+     */
+    public static List<VMOption> toVMOptions(Object data) {
+        List<VMOption> result = new ArrayList<>();
+        if (!(data instanceof CompositeData[])) {
+            return result;
+        }
+
+        for (CompositeData cd : (CompositeData[]) data) {
+            if (cd != null) {
+                result.add(toVMOption(cd));
+            }
+        }
+        return result;
+    }
+
+    /**
+     * parseFlagNames returns the names of the flags listed by the VM.flags -all diagnostic command, with lines like:
+     * "     bool HeapDumpOnOutOfMemoryError               = false            {manageable} {default}"
+     *
+     * @param output String VM.flags -all command output
+     * @return List<String> the flag names
+     */
+    public static List<String> parseFlagNames(String output) {
+        List<String> names = new ArrayList<>();
+        if (output == null) {
+            return names;
+        }
+
+        for (String line : output.split("\\r?\\n")) {
+            String[] fields = line.trim().split("\\s+");
+            // Older JVMs mark the flags changed from the default value with ":=".
+            if (fields.length >= 3 && (fields[2].equals("=") || fields[2].equals(":="))) {
+                names.add(fields[1]);
+            }
+        }
+        return names;
+    }
+
+    /**
+     * rootCauseClass returns the class name of the exception that originated the failure,
+     * used by the consumers to tell the failure reason apart.
+     *
+     * @param throwable exception raised by the operation
+     * @return String the root cause class name
+     */
+    public static String rootCauseClass(Throwable throwable) {
+        Throwable rootCause = ExceptionUtils.getRootCause(throwable);
+        if (rootCause == null) {
+            rootCause = throwable;
+        }
+        return rootCause == null ? "" : rootCause.getClass().getName();
+    }
+
+    /**
+     * rootCauseMessage returns the message of the exception that originated the failure.
+     *
+     * @param throwable exception raised by the operation
+     * @return String the root cause message
+     */
+    public static String rootCauseMessage(Throwable throwable) {
+        Throwable rootCause = ExceptionUtils.getRootCause(throwable);
+        if (rootCause == null) {
+            rootCause = throwable;
+        }
+        return rootCause == null || rootCause.getMessage() == null ? "" : rootCause.getMessage();
+    }
+
+    private static String getString(CompositeData data, String key) {
+        Object value = data.containsKey(key) ? data.get(key) : null;
+        return value == null ? "" : value.toString();
+    }
+}
@@ -5,75 +5,182 @@
 
 package org.newrelic.nrjmx.v2;
 
+import org.newrelic.nrjmx.v2.nrprotocol.InternalMetric;
+import org.newrelic.nrjmx.v2.nrprotocol.InternalMetrics;
 import org.newrelic.nrjmx.v2.nrprotocol.InternalStat;
 
+import java.util.ArrayDeque;
 import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.Deque;
 import java.util.List;
+import java.util.Map;
+import java.util.TreeMap;
 
 /**
  * InternalStats class used to collect internal nrjmx query stats for troubleshooting.
+ * The cumulative metrics per stat type are always kept, the samples only when they are enabled.
  */
 public class InternalStats {
-    private List<InternalStat> stats;
+    /* BUCKET_BOUNDS_MS are the upper bounds of the latency histogram buckets, the last bucket has no upper bound. */
+    final static private List<Double> BUCKET_BOUNDS_MS = Arrays.asList(
+            1.0, 2.5, 5.0, 10.0, 25.0, 50.0, 100.0, 250.0, 500.0, 1000.0, 2500.0, 5000.0, 10000.0);
+
+    final static private long DEFAULT_MAX_SIZE = 100000;
+
+    private Deque<InternalStat> stats;
+
+    /* samplesEnabled defines if the samples are kept for getStats. */
+    private final boolean samplesEnabled;
 
     /* maxSize defines how many stats we can keep in memory. When limit is reached old ones are discarded. */
-    private long maxSize;
+    private final long maxSize;
 
-    final static private long DEFAULT_MAX_SIZE = 100000;
+    private final long startTimestamp;
+
+    private final Map<String, Metric> metrics = new TreeMap<>();
+
+    private long evicted;
 
     /**
      * InternalStats constructor.
      *
-     * @param maxSize long defines how many stats we can keep in memory. When limit is reached old ones are discarded.
+     * @param samplesEnabled boolean defines if the samples are kept, the metrics are always kept.
+     * @param maxSize        long defines how many stats we can keep in memory. When limit is reached old ones are discarded.
      */
-    public InternalStats(long maxSize) {
+    public InternalStats(boolean samplesEnabled, long maxSize) {
         if (maxSize < 1) {
             maxSize = DEFAULT_MAX_SIZE;
         }
 
+        this.samplesEnabled = samplesEnabled;
         this.maxSize = maxSize;
-        this.stats = new ArrayList<>();
+        this.startTimestamp = System.currentTimeMillis();
+        this.stats = new ArrayDeque<>();
     }
 
     /**
      * Records a new InternalStat and returns it for attaching more data if required.
+     * The stat is added to the metrics when it's completed with {@link #complete(InternalStat)}.
      *
      * @param statType String name of the stat.
      * @return InternalStat new registered stat to add data to it.
      */
     public InternalStat record(String statType) {
-        synchronized (stats) {
+        InternalStat stat = new InternalStat()
+                .setStartTimestamp(System.currentTimeMillis())
+                .setMilliseconds((double) System.nanoTime() / 1000000.0)
+                .setStatType(statType)
+                .setRequestId(RequestIdProtocol.current());
+
+        if (!samplesEnabled) {
+            return stat;
+        }
+
+        synchronized (this) {
             if (stats.size() >= maxSize) {
-                stats.remove(0);
+                stats.pollFirst();
+                evicted++;
             }
+            stats.addLast(stat);
+        }
+        return stat;
+    }
 
-            InternalStat stat = new InternalStat()
-                    .setStartTimestamp(System.currentTimeMillis())
-                    .setMilliseconds((double) System.nanoTime() / 1000000.0)
-                    .setStatType(statType);
-            stats.add(stat);
-            return stat;
+    /**
+     * Calculates the elapsed ms since the stat was recorded, attaches it to the stat and adds it to the metrics.
+     *
+     * @param internalStat InternalStat returned by record.
+     */
+    public void complete(InternalStat internalStat) {
+        setElapsedMs(internalStat);
+
+        synchronized (this) {
+            metrics.computeIfAbsent(internalStat.statType, k -> new Metric()).add(internalStat);
         }
     }
 
     /**
      * Returns all the collected stats and clear them.
      *
-     *
      * @return List<InternalStat> returns all collected internal stats.
      */
     public List<InternalStat> getStats() {
-        synchronized (this.stats) {
+        synchronized (this) {
             List<InternalStat> stats = new ArrayList<>(this.stats);
-            this.stats = new ArrayList<>();
+            this.stats = new ArrayDeque<>();
             return stats;
         }
     }
 
+    /**
+     * Returns the cumulative metrics per stat type since the stats were created, without resetting them.
+     *
+     * @return InternalMetrics the metrics and the state of the samples buffer.
+     */
+    public InternalMetrics getMetrics() {
+        synchronized (this) {
+            List<InternalMetric> result = new ArrayList<>();
+            for (Map.Entry<String, Metric> entry : metrics.entrySet()) {
+                result.add(entry.getValue().toInternalMetric(entry.getKey()));
+            }
+
+            return new InternalMetrics()
+                    .setStartTimestamp(startTimestamp)
+                    .setMetrics(result)
+                    .setSamplesEnabled(samplesEnabled)
+                    .setMaxSamples(samplesEnabled ? maxSize : 0)
+                    .setBufferedSamples(stats.size())
+                    .setEvictedSamples(evicted);
+        }
+    }
+
     /**
      * Calculate the elapsed ms with .3f precision since the stat was recorded and attach it to the stat.
      */
     public static void setElapsedMs(InternalStat internalStat) {
         internalStat.setMilliseconds((double) System.nanoTime() / 1000000.0 - internalStat.milliseconds);
     }
+
+    /**
+     * Metric aggregates the completed stats of a stat type.
+     */
+    private static class Metric {
+        private long count;
+        private long errors;
+        private double totalMs;
+        private double maxMs;
+        private final long[] buckets = new long[BUCKET_BOUNDS_MS.size() + 1];
+
+        private void add(InternalStat stat) {
+            count++;
+            if (!stat.successful) {
+                errors++;
+            }
+            totalMs += stat.milliseconds;
+            maxMs = Math.max(maxMs, stat.milliseconds);
+
+            int bucket = 0;
+            while (bucket < BUCKET_BOUNDS_MS.size() && stat.milliseconds > BUCKET_BOUNDS_MS.get(bucket)) {
+                bucket++;
+            }
+            buckets[bucket]++;
+        }
+
+        private InternalMetric toInternalMetric(String statType) {
+            List<Long> bucketCounts = new ArrayList<>(buckets.length);
+            for (long bucket : buckets) {
+                bucketCounts.add(bucket);
+            }
+
+            return new InternalMetric()
+                    .setStatType(statType)
+                    .setCount(count)
+                    .setErrors(errors)
+                    .setTotalMs(totalMs)
+                    .setMaxMs(maxMs)
+                    .setBucketBoundsMs(new ArrayList<>(BUCKET_BOUNDS_MS))
+                    .setBucketCounts(bucketCounts);
+        }
+    }
 }
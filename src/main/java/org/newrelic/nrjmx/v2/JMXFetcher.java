@@ -9,7 +9,11 @@ import org.apache.commons.lang3.exception.ExceptionUtils;
 import org.newrelic.nrjmx.v2.nrprotocol.*;
 
 import javax.management.*;
+import javax.management.MBeanAttributeInfo;
+import javax.management.MBeanInfo;
+import javax.management.MBeanOperationInfo;
 import javax.management.openmbean.CompositeData;
+import javax.management.openmbean.TabularData;
 import javax.management.remote.JMXConnector;
 import javax.management.remote.JMXConnectorFactory;
 import javax.management.remote.JMXServiceURL;
@@ -18,7 +22,9 @@ import java.io.BufferedReader;
 import java.io.IOException;
 import java.io.InputStream;
 import java.io.InputStreamReader;
+import java.lang.management.ManagementFactory;
 import java.math.BigDecimal;
+import java.nio.ByteBuffer;
 import java.rmi.ConnectException;
 import java.text.DateFormat;
 import java.util.*;
@@ -46,9 +52,15 @@ public class JMXFetcher {
     /* JMX configuration used to connect to JMX endpoint. */
     private JMXConfig jmxConfig;
 
-    /* InternalStats used for troubleshooting. */
+    /* InternalStats used for troubleshooting, null until the first connection. */
     private InternalStats internalStats;
 
+    /* DiscoveryCache keeps the mBean queries and attribute names, null when disabled. */
+    private DiscoveryCache discoveryCache;
+
+    /* queryLimits enforces the cardinality limits of the queries when configured. */
+    private QueryLimits queryLimits;
+
     /* knownConnectionExceptions is used to detect when a disconnect should happen.
      * This is needed because of different implementations on various JMX connectors (e.g. JBoss)
      * that doesn't trow rmi.ConnectException.
@@ -89,8 +101,18 @@ public class JMXFetcher {
 
         this.jmxConfig = jmxConfig;
 
-        if (jmxConfig.enableInternalStats) {
-            this.internalStats = new InternalStats(jmxConfig.maxInternalStatsSize);
+        // The metrics are always collected, the samples only when the internal stats are enabled.
+        // They are kept across reconnections, so the metrics stay cumulative.
+        if (this.internalStats == null) {
+            this.internalStats = new InternalStats(jmxConfig.enableInternalStats, jmxConfig.maxInternalStatsSize);
+        }
+
+        this.queryLimits = QueryLimits.fromConfig(jmxConfig);
+
+        // Notifications could have been missed while disconnected, so the cache starts empty.
+        this.discoveryCache = null;
+        if (jmxConfig.discoveryCacheTtlMs > 0) {
+            this.discoveryCache = new DiscoveryCache(jmxConfig.discoveryCacheTtlMs);
         }
 
         String connectionString = buildConnectionString(jmxConfig);
@@ -118,7 +140,15 @@ public class JMXFetcher {
             throw new JMXConnectionError(message);
         } finally {
             if (internalStat != null) {
-                InternalStats.setElapsedMs(internalStat);
+                internalStats.complete(internalStat);
+            }
+        }
+
+        if (this.discoveryCache != null) {
+            try {
+                this.connection.addNotificationListener(MBeanServerDelegate.DELEGATE_NAME, discoveryCache, DiscoveryCache.filter(), null);
+            } catch (Exception e) {
+                // Without notifications the cache entries are only refreshed when they expire.
             }
         }
     }
@@ -162,6 +192,10 @@ public class JMXFetcher {
         // Mark the connector as null in case to allow reconnection.
         this.connector = null;
 
+        if (this.discoveryCache != null) {
+            this.discoveryCache.clear();
+        }
+
         try {
             oldConnector.close();
             if (internalStat != null) {
@@ -170,7 +204,7 @@ public class JMXFetcher {
         } catch (Exception e) {
         } finally {
             if (internalStat != null) {
-                InternalStats.setElapsedMs(internalStat);
+                internalStats.complete(internalStat);
             }
         }
     }
@@ -201,7 +235,11 @@ public class JMXFetcher {
      */
     public List<String> queryMBeanNames(String mBeanGlobPattern) throws JMXConnectionError, JMXError {
         ObjectName objectName = getObjectName(mBeanGlobPattern);
-        return queryMBeans(objectName)
+        Collection<ObjectInstance> mBeans = queryMBeans(objectName);
+        if (queryLimits != null) {
+            mBeans = queryLimits.limitMBeans(mBeanGlobPattern, mBeans);
+        }
+        return mBeans
                 .stream()
                 .map(ObjectInstance::getObjectName)
                 .map(ObjectName::toString)
@@ -222,6 +260,15 @@ public class JMXFetcher {
                     .setMessage("can't query MBeans, provided objectName is Null");
         }
 
+        DiscoveryCache cache = this.discoveryCache;
+        if (cache != null) {
+            Set<ObjectInstance> cached = cache.getQuery(objectName);
+            if (cached != null) {
+                return cached;
+            }
+        }
+        long generation = cache != null ? cache.generation() : 0;
+
         Set<ObjectInstance> result = null;
 
         InternalStat internalStat = null;
@@ -241,6 +288,10 @@ public class JMXFetcher {
                 internalStat.setSuccessful(true);
             }
 
+            if (cache != null) {
+                cache.putQuery(objectName, result, generation);
+            }
+
             return result;
         } catch (JMXConnectionError je) {
             throw je;
@@ -254,7 +305,7 @@ public class JMXFetcher {
                     .setStacktrace(getStackTrace(e));
         } finally {
             if (internalStat != null) {
-                InternalStats.setElapsedMs(internalStat);
+                internalStats.complete(internalStat);
 
                 if (result != null) {
                     internalStat.setResponseCount(result.size());
@@ -293,6 +344,15 @@ public class JMXFetcher {
                     .setMessage("can't get attribute names, provided objectName is Null");
         }
 
+        DiscoveryCache cache = this.discoveryCache;
+        if (cache != null) {
+            List<String> cached = cache.getAttributeNames(objectName);
+            if (cached != null) {
+                return cached;
+            }
+        }
+        long generation = cache != null ? cache.generation() : 0;
+
         MBeanInfo info;
 
         InternalStat internalStat = null;
@@ -323,7 +383,7 @@ public class JMXFetcher {
                     .setStacktrace(getStackTrace(e));
         } finally {
             if (internalStat != null) {
-                InternalStats.setElapsedMs(internalStat);
+                internalStats.complete(internalStat);
             }
         }
 
@@ -343,9 +403,177 @@ public class JMXFetcher {
             result.add(attrInfo.getName());
         }
 
+        if (cache != null) {
+            cache.putAttributeNames(objectName, result, generation);
+        }
+
         return result;
     }
 
+    /**
+     * getMBeanInfo returns the metadata of an mBean: its attributes, operations and their descriptions.
+     *
+     * @param mBeanName of which we want to retrieve the metadata
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return MBeanInfo containing the mBean metadata
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public org.newrelic.nrjmx.v2.nrprotocol.MBeanInfo getMBeanInfo(String mBeanName, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> getMBeanInfo(getObjectName(mBeanName))),
+                timeoutMs
+        );
+    }
+
+    /**
+     * getMBeanInfo returns the metadata of an mBean: its attributes, operations and their descriptions.
+     *
+     * @param objectName of which we want to retrieve the metadata
+     * @return MBeanInfo containing the mBean metadata
+     * @throws JMXConnectionError JMX connection related exception
+     * @throws JMXError           JMX related Exception
+     */
+    private org.newrelic.nrjmx.v2.nrprotocol.MBeanInfo getMBeanInfo(ObjectName objectName) throws JMXConnectionError, JMXError {
+        if (objectName == null) {
+            throw new JMXError()
+                    .setMessage("can't get mBean info, provided objectName is Null");
+        }
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("getMBeanInfo")
+                    .setMBean(objectName.toString());
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+
+            MBeanInfo info = withConnectionExceptionHandler(() ->
+                    conn.getMBeanInfo(objectName)
+            );
+
+            org.newrelic.nrjmx.v2.nrprotocol.MBeanInfo result = MBeanInfos.toMBeanInfo(objectName.toString(), info);
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(result.getAttributesSize());
+            }
+            return result;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while requesting mBean info, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't find mBean: " + objectName)
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
+    /**
+     * invokeOperation executes an mBean operation and returns its result.
+     *
+     * @param mBeanName     of which we want to execute the operation
+     * @param operationName name of the operation
+     * @param arguments     List of the arguments in their string representation
+     * @param signature     List of the fully qualified parameter types, resolved from the MBeanInfo when empty
+     * @param timeoutMs     long timeout for the request in milliseconds
+     * @return List<AttributeResponse> the operation result, empty for void operations
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public List<AttributeResponse> invokeOperation(String mBeanName, String operationName, List<String> arguments, List<String> signature, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> invokeOperation(getObjectName(mBeanName), operationName, arguments, signature)),
+                timeoutMs
+        );
+    }
+
+    /**
+     * invokeOperation executes an mBean operation and returns its result.
+     *
+     * @param objectName    of which we want to execute the operation
+     * @param operationName name of the operation
+     * @param arguments     List of the arguments in their string representation
+     * @param signature     List of the fully qualified parameter types, resolved from the MBeanInfo when empty
+     * @return List<AttributeResponse> the operation result, empty for void operations
+     * @throws JMXConnectionError JMX connection related exception
+     * @throws JMXError           JMX related Exception
+     */
+    private List<AttributeResponse> invokeOperation(ObjectName objectName, String operationName, List<String> arguments, List<String> signature) throws JMXConnectionError, JMXError {
+        if (objectName == null) {
+            throw new JMXError()
+                    .setMessage("can't invoke operation, provided objectName is Null");
+        }
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("invokeOperation")
+                    .setMBean(objectName.toString())
+                    .setAttrs(Collections.singletonList(operationName));
+        }
+
+        List<AttributeResponse> output = new ArrayList<>();
+        try {
+            MBeanServerConnection conn = getConnection();
+
+            int argsCount = arguments == null ? 0 : arguments.size();
+            String[] types;
+            if (signature == null || signature.isEmpty()) {
+                MBeanInfo info = withConnectionExceptionHandler(() -> conn.getMBeanInfo(objectName));
+                types = Operations.resolveSignature(info, operationName, argsCount);
+            } else {
+                types = signature.toArray(new String[0]);
+            }
+            Object[] params = Operations.convertArguments(arguments, types);
+
+            Object result = withConnectionExceptionHandler(() ->
+                    conn.invoke(objectName, operationName, params, types)
+            );
+
+            if (result != null) {
+                String name = formatAttributeName(objectName, operationName);
+                try {
+                    parseValue(name, result, output);
+                } catch (JMXError je) {
+                    output.clear();
+                    output.add(new AttributeResponse()
+                            .setName(name)
+                            .setResponseType(ResponseType.STRING)
+                            .setStringValue(Operations.toString(result)));
+                }
+            }
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(output.size());
+            }
+            return output;
+        } catch (JMXConnectionError | JMXError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while invoking operation, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage(String.format("can't invoke operation '%s' on mBean: %s", operationName, objectName))
+                    .setCauseMessage(HotSpotDiagnostics.rootCauseMessage(e))
+                    .setCauseClass(HotSpotDiagnostics.rootCauseClass(e))
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
     /**
      * getMBeanAttributes returns the attribute values for an mBeanName.
      *
@@ -361,6 +589,9 @@ public class JMXFetcher {
         withTimeout(
                 executor.submit((Callable<Void>) () -> {
                     getMBeanAttributes(getObjectName(mBeanName), attributes, result);
+                    if (queryLimits != null) {
+                        queryLimits.limitResponses(mBeanName, result, Collections.emptyList());
+                    }
                     return null;
                 }), timeoutMs
         );
@@ -391,6 +622,10 @@ public class JMXFetcher {
             attributes = getMBeanAttributeNames(objectName);
         }
 
+        if (queryLimits != null) {
+            attributes = queryLimits.limitAttributes(objectName.toString(), attributes);
+        }
+
         InternalStat internalStat = null;
         if (this.internalStats != null) {
             internalStat = internalStats.record("getAttributes")
@@ -430,13 +665,14 @@ public class JMXFetcher {
                     output.add(new AttributeResponse()
                             .setName(formattedAttrName)
                             .setResponseType(ResponseType.ERROR)
-                            .setStatusMsg(statusMessage));
+                            .setStatusMsg(statusMessage)
+                            .setError(AttributeErrors.fromJMXError(je)));
                 }
             }
             return;
         } finally {
             if (internalStat != null) {
-                InternalStats.setElapsedMs(internalStat);
+                internalStats.complete(internalStat);
             }
         }
 
@@ -471,7 +707,8 @@ public class JMXFetcher {
                     output.add(new AttributeResponse()
                             .setName(formattedAttrName)
                             .setResponseType(ResponseType.ERROR)
-                            .setStatusMsg(statusMessage));
+                            .setStatusMsg(statusMessage)
+                            .setError(AttributeErrors.fromJMXError(je)));
                 }
             }
         } finally {
@@ -481,7 +718,8 @@ public class JMXFetcher {
                 output.add(new AttributeResponse()
                         .setName(formattedAttrName)
                         .setResponseType(ResponseType.ERROR)
-                        .setStatusMsg("failed to retrieve attribute value from server"));
+                        .setStatusMsg("failed to retrieve attribute value from server")
+                        .setError(AttributeErrors.notReturned()));
             }
         }
     }
@@ -529,10 +767,11 @@ public class JMXFetcher {
             String message = String.format("can't connect to JMX server, error: '%s'", ce.getMessage());
             throw new JMXConnectionError(message);
         } catch (Exception e) {
-            throw new JMXError()
+            // Keep the original exception as cause to classify the attribute error.
+            throw AttributeErrors.withCause(new JMXError()
                     .setMessage("can't get attribute: " + attribute + " for bean: " + objectName + ": ")
                     .setCauseMessage(e.getMessage())
-                    .setStacktrace(getStackTrace(e));
+                    .setStacktrace(getStackTrace(e)), e);
         }
 
 
@@ -567,142 +806,1047 @@ public class JMXFetcher {
     public List<AttributeResponse> queryMBeanAttributes(String mBeanGlobPattern, List<String> attributes) throws JMXError, JMXConnectionError {
         ObjectName pattern = getObjectName(mBeanGlobPattern);
 
-        Set<ObjectInstance> mBeans;
+        Collection<ObjectInstance> mBeans;
         if (mBeanGlobPattern.contains("*")) {
             mBeans = queryMBeans(pattern);
         } else {
             mBeans = new HashSet<>(Arrays.asList(new ObjectInstance(pattern, "")));
         }
 
+        if (queryLimits != null) {
+            mBeans = queryLimits.limitMBeans(mBeanGlobPattern, mBeans);
+        }
+
         List<AttributeResponse> result = new ArrayList<>();
 
+        // mBeans that are not fetched because the call already reached the responses limit.
+        List<String> skippedMBeans = new ArrayList<>();
+
         for (ObjectInstance mBean : mBeans) {
             if (mBean == null) {
                 continue;
             }
             ObjectName objectName = mBean.getObjectName();
 
+            if (queryLimits != null && queryLimits.isResponseLimitReached(result.size())) {
+                skippedMBeans.add(objectName.toString());
+                continue;
+            }
+
             getMBeanAttributes(objectName, attributes, result);
         }
 
+        if (queryLimits != null) {
+            queryLimits.limitResponses(mBeanGlobPattern, result, skippedMBeans);
+        }
+
         return result;
     }
 
     /**
-     * getObjectName returns the ObjectName for an mBeanName required on performing JMX requests.
+     * getDomains returns the domains in which there are registered mBeans.
      *
-     * @param mBeanName to build the ObjectName
-     * @return ObjectName for the mBeanName
-     * @throws JMXError JMX related Exception
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return List<String> containing the domain names
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
      */
-    private ObjectName getObjectName(String mBeanName) throws JMXError {
-        try {
-            return new ObjectName(mBeanName);
-        } catch (MalformedObjectNameException me) {
-            throw new JMXError()
-                    .setMessage("cannot parse MBean glob pattern: '" + mBeanName + "', valid: 'DOMAIN:BEAN'")
-                    .setCauseMessage(me.getMessage())
-                    .setStacktrace(getStackTrace(me));
-        }
+    public List<String> getDomains(long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> getDomains()),
+                timeoutMs
+        );
     }
 
     /**
-     * withTimeout executes a task with timeout.
+     * getDomains returns the domains in which there are registered mBeans.
      *
-     * @param future    is the task that has to be executed
-     * @param timeoutMs timeout in milliseconds after which we terminate the task
-     * @param <T>       Generic type for the task
-     * @return Task result
+     * @return List<String> containing the domain names
      * @throws JMXError           JMX related Exception
      * @throws JMXConnectionError JMX connection related exception
      */
-    private <T> T withTimeout(Future<T> future, long timeoutMs) throws JMXError, JMXConnectionError {
+    public List<String> getDomains() throws JMXConnectionError, JMXError {
+        String[] domains = null;
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("getDomains");
+        }
 
         try {
-            if (timeoutMs <= 0) {
-                return future.get();
-            }
-            return future.get(timeoutMs, TimeUnit.MILLISECONDS);
+            MBeanServerConnection conn = getConnection();
 
-        } catch (InterruptedException e) {
-            Thread.currentThread().interrupt();
-            throw new JMXError()
-                    .setMessage("request was interrupted " + e.getMessage())
-                    .setCauseMessage(e.getMessage())
-                    .setStacktrace(getStackTrace(e));
-        } catch (TimeoutException e) {
-            throw new JMXError()
-                    .setMessage("request timeout exceeded: " + timeoutMs + "ms")
-                    .setCauseMessage(e.getMessage())
-                    .setStacktrace(getStackTrace(e));
-        } catch (ExecutionException e) {
-            if (e.getCause() instanceof JMXError) {
-                throw (JMXError) e.getCause();
-            } else if (e.getCause() instanceof JMXConnectionError) {
-                throw (JMXConnectionError) e.getCause();
+            domains = withConnectionExceptionHandler(conn::getDomains);
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
             }
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while requesting domains, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
             throw new JMXError()
-                    .setMessage("failed to execute operation, error: " + e.getMessage())
+                    .setMessage("can't get domains")
+                    .setCauseMessage(e.getMessage())
                     .setStacktrace(getStackTrace(e));
         } finally {
-            future.cancel(true);
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+
+                if (domains != null) {
+                    internalStat.setResponseCount(domains.length);
+                }
+            }
+        }
+
+        List<String> result = new ArrayList<>();
+        if (domains == null) {
+            return result;
         }
+
+        result.addAll(Arrays.asList(domains));
+        Collections.sort(result);
+        return result;
     }
 
     /**
-     * withConnectionExceptionHandler is needed while performing JMX requests
-     * to detect if there is a connection exception that require a disconnect.
+     * getMBeanCount returns the number of mBeans that match the provided pattern.
      *
-     * @param task
-     * @param <T>
-     * @return
-     * @throws Exception
+     * @param mBeanGlobPattern String glob pattern DOMAIN:BEAN e.g *:* or jboss.as:subsystem=remoting,*
+     * @param timeoutMs        long timeout for the request in milliseconds
+     * @return long number of mBeans that were found
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
      */
-    private <T> T withConnectionExceptionHandler(Callable<T> task) throws Exception {
-        try {
-            return task.call();
-        } catch (
-            // Not connection errors.
-                MBeanException
-                | AttributeNotFoundException
-                | InstanceNotFoundException
-                | ReflectionException
-                | RuntimeOperationsException
-                | IllegalArgumentException
-                | IntrospectionException e) {
-            throw e;
-        } catch (Exception e) {
-            if (e instanceof ConnectException) {
-                disconnect();
-                throw e;
-            }
-
-            boolean isConnErr = knownConnectionExceptions.contains(e.getClass().getName());
-            if (isConnErr || !isConnectionAlive()) {
-                disconnect();
-                throw new ConnectException(e.getMessage());
-            }
-            throw e;
-        }
+    public long getMBeanCount(String mBeanGlobPattern, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> getMBeanCount(mBeanGlobPattern)),
+                timeoutMs
+        );
     }
 
     /**
-     * parseValue converts the received value from JMX into an JMXAttribute object.
+     * getMBeanCount returns the number of mBeans that match the provided pattern.
+     * When no pattern is provided the total number of registered mBeans is returned.
      *
-     * @param mBeanAttributeName of the value
-     * @param value              that has to be converted
-     * @throws JMXError JMX related Exception
+     * @param mBeanGlobPattern String glob pattern DOMAIN:BEAN e.g *:* or jboss.as:subsystem=remoting,*
+     * @return long number of mBeans that were found
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
      */
-    private void parseValue(String mBeanAttributeName, Object value, List<AttributeResponse> output) throws JMXError {
-        if (output == null) {
+    public long getMBeanCount(String mBeanGlobPattern) throws JMXConnectionError, JMXError {
+        // Null ObjectName means all the registered mBeans.
+        ObjectName objectName = null;
+        if (mBeanGlobPattern != null && !mBeanGlobPattern.isEmpty()) {
+            objectName = getObjectName(mBeanGlobPattern);
+        }
+
+        Long result = null;
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("getMBeanCount")
+                    .setMBean(objectName == null ? "" : objectName.toString());
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+
+            ObjectName finalObjectName = objectName;
+            result = withConnectionExceptionHandler(() -> {
+                if (finalObjectName == null || ObjectName.WILDCARD.equals(finalObjectName)) {
+                    return conn.getMBeanCount().longValue();
+                }
+                return (long) conn.queryNames(finalObjectName, null).size();
+            });
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+            }
+
+            return result;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while counting mBeans, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't count beans for query: " + mBeanGlobPattern)
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+
+                if (result != null) {
+                    internalStat.setResponseCount(result);
+                }
+            }
+        }
+    }
+
+    /**
+     * getServerInfo returns the MBeanServerDelegate metadata of the JMX endpoint together with the connection id.
+     *
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return ServerInfo the JMX server metadata
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public ServerInfo getServerInfo(long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> getServerInfo()),
+                timeoutMs
+        );
+    }
+
+    /**
+     * getServerInfo returns the MBeanServerDelegate metadata of the JMX endpoint together with the connection id.
+     *
+     * @return ServerInfo the JMX server metadata
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public ServerInfo getServerInfo() throws JMXConnectionError, JMXError {
+        ServerInfo serverInfo = new ServerInfo();
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("getServerInfo")
+                    .setMBean(MBeanServerDelegate.DELEGATE_NAME.toString());
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+
+            AttributeList attributeList = withConnectionExceptionHandler(() ->
+                    conn.getAttributes(MBeanServerDelegate.DELEGATE_NAME, new String[]{
+                            "MBeanServerId",
+                            "SpecificationVersion",
+                            "ImplementationName",
+                            "ImplementationVersion",
+                            "ImplementationVendor",
+                    })
+            );
+
+            Map<String, String> values = new HashMap<>();
+            if (attributeList != null) {
+                for (Attribute attr : attributeList.asList()) {
+                    values.put(attr.getName(), attr.getValue() == null ? "" : attr.getValue().toString());
+                }
+            }
+
+            serverInfo
+                    .setMBeanServerId(values.getOrDefault("MBeanServerId", ""))
+                    .setSpecificationVersion(values.getOrDefault("SpecificationVersion", ""))
+                    .setImplementationName(values.getOrDefault("ImplementationName", ""))
+                    .setImplementationVersion(values.getOrDefault("ImplementationVersion", ""))
+                    .setImplementationVendor(values.getOrDefault("ImplementationVendor", ""))
+                    .setDefaultDomain(withConnectionExceptionHandler(conn::getDefaultDomain))
+                    .setConnectionId(withConnectionExceptionHandler(connector::getConnectionId));
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(values.size());
+            }
+
+            return serverInfo;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while requesting server info, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't get JMX server info")
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
+    /**
+     * threadDump returns the state and stack trace of all the live threads using the ThreadMXBean.
+     *
+     * @param options   ThreadDumpOptions to include the locked monitors, synchronizers and limit the stack depth
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return List<ThreadInfo> containing all the live threads
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public List<ThreadInfo> threadDump(ThreadDumpOptions options, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> threadDump(options)),
+                timeoutMs
+        );
+    }
+
+    /**
+     * threadDump returns the state and stack trace of all the live threads using the ThreadMXBean.
+     *
+     * @param options ThreadDumpOptions to include the locked monitors, synchronizers and limit the stack depth
+     * @return List<ThreadInfo> containing all the live threads
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public List<ThreadInfo> threadDump(ThreadDumpOptions options) throws JMXConnectionError, JMXError {
+        if (options == null) {
+            options = new ThreadDumpOptions();
+        }
+
+        List<ThreadInfo> result = null;
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("threadDump")
+                    .setMBean(ManagementFactory.THREAD_MXBEAN_NAME);
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+            ObjectName threadMXBean = new ObjectName(ManagementFactory.THREAD_MXBEAN_NAME);
+
+            ThreadDumpOptions finalOptions = options;
+            CompositeData[] threads = withConnectionExceptionHandler(() ->
+                    (CompositeData[]) conn.invoke(threadMXBean, "dumpAllThreads",
+                            new Object[]{finalOptions.lockedMonitors, finalOptions.lockedSynchronizers},
+                            new String[]{boolean.class.getName(), boolean.class.getName()})
+            );
+
+            result = ThreadDumps.fromCompositeData(threads, options.maxDepth);
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+            }
+
+            return result;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while requesting thread dump, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't get thread dump")
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+
+                if (result != null) {
+                    internalStat.setResponseCount(result.size());
+                }
+            }
+        }
+    }
+
+    /**
+     * findDeadlocks returns the threads that are deadlocked waiting for monitors or ownable synchronizers.
+     *
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return List<ThreadInfo> containing the deadlocked threads, empty when there is no deadlock
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public List<ThreadInfo> findDeadlocks(long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> findDeadlocks()),
+                timeoutMs
+        );
+    }
+
+    /**
+     * findDeadlocks returns the threads that are deadlocked waiting for monitors or ownable synchronizers.
+     *
+     * @return List<ThreadInfo> containing the deadlocked threads, empty when there is no deadlock
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public List<ThreadInfo> findDeadlocks() throws JMXConnectionError, JMXError {
+        List<ThreadInfo> result = null;
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("findDeadlocks")
+                    .setMBean(ManagementFactory.THREAD_MXBEAN_NAME);
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+            ObjectName threadMXBean = new ObjectName(ManagementFactory.THREAD_MXBEAN_NAME);
+
+            long[] threadIds = withConnectionExceptionHandler(() ->
+                    (long[]) conn.invoke(threadMXBean, "findDeadlockedThreads", null, null)
+            );
+
+            if (threadIds == null || threadIds.length == 0) {
+                result = new ArrayList<>();
+            } else {
+                CompositeData[] threads = withConnectionExceptionHandler(() ->
+                        (CompositeData[]) conn.invoke(threadMXBean, "getThreadInfo",
+                                new Object[]{threadIds, true, true},
+                                new String[]{long[].class.getName(), boolean.class.getName(), boolean.class.getName()})
+                );
+                result = ThreadDumps.fromCompositeData(threads, 0);
+            }
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+            }
+
+            return result;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while looking for deadlocks, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't find deadlocked threads")
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+
+                if (result != null) {
+                    internalStat.setResponseCount(result.size());
+                }
+            }
+        }
+    }
+
+    /**
+     * diagnosticCommand executes a jcmd command using the com.sun.management:type=DiagnosticCommand MBean.
+     *
+     * @param command   String jcmd command name, e.g. GC.class_histogram, or the MBean operation name
+     * @param arguments List<String> the command arguments, e.g. -all
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return String the command text output
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public String diagnosticCommand(String command, List<String> arguments, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> diagnosticCommand(command, arguments)),
+                timeoutMs
+        );
+    }
+
+    /**
+     * diagnosticCommand executes a jcmd command using the com.sun.management:type=DiagnosticCommand MBean.
+     *
+     * @param command   String jcmd command name, e.g. GC.class_histogram, or the MBean operation name
+     * @param arguments List<String> the command arguments, e.g. -all
+     * @return String the command text output
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public String diagnosticCommand(String command, List<String> arguments) throws JMXConnectionError, JMXError {
+        String operationName = DiagnosticCommands.operationName(command);
+        String[] args = arguments == null ? new String[0] : arguments.toArray(new String[0]);
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("diagnosticCommand")
+                    .setMBean(DiagnosticCommands.MBEAN_NAME)
+                    .setAttrs(Collections.singletonList(operationName));
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+            ObjectName diagnosticCommand = new ObjectName(DiagnosticCommands.MBEAN_NAME);
+
+            MBeanInfo info = withConnectionExceptionHandler(() -> conn.getMBeanInfo(diagnosticCommand));
+            MBeanOperationInfo operation = DiagnosticCommands.findOperation(info, operationName);
+            if (operation == null) {
+                throw new JMXError()
+                        .setMessage("diagnostic command not available: " + command);
+            }
+
+            Object result = withConnectionExceptionHandler(() -> {
+                if (operation.getSignature().length == 0) {
+                    return conn.invoke(diagnosticCommand, operationName, null, null);
+                }
+                return conn.invoke(diagnosticCommand, operationName,
+                        new Object[]{args},
+                        new String[]{String[].class.getName()});
+            });
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(1);
+            }
+
+            return result == null ? "" : result.toString();
+        } catch (JMXConnectionError | JMXError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while executing diagnostic command, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't execute diagnostic command: " + command)
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
+    /**
+     * startRecording creates and starts a Java Flight Recorder recording using the FlightRecorder MXBean.
+     *
+     * @param settings  RecordingSettings containing the predefined configuration, settings and options of the recording
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return long the recording id
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public long startRecording(RecordingSettings settings, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> startRecording(settings)),
+                timeoutMs
+        );
+    }
+
+    /**
+     * startRecording creates and starts a Java Flight Recorder recording using the FlightRecorder MXBean.
+     * The predefined configuration (e.g. default or profile) is applied first and then overridden by the settings.
+     *
+     * @param settings RecordingSettings containing the predefined configuration, settings and options of the recording
+     * @return long the recording id
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public long startRecording(RecordingSettings settings) throws JMXConnectionError, JMXError {
+        if (settings == null) {
+            settings = new RecordingSettings();
+        }
+
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record("startRecording")
+                    .setMBean(FlightRecordings.MBEAN_NAME);
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+            ObjectName flightRecorder = new ObjectName(FlightRecordings.MBEAN_NAME);
+
+            long recordingId = withConnectionExceptionHandler(() ->
+                    (Long) conn.invoke(flightRecorder, "newRecording", null, null)
+            );
+
+            try {
+                startRecording(conn, flightRecorder, recordingId, settings);
+            } catch (Exception e) {
+                try {
+                    conn.invoke(flightRecorder, "closeRecording",
+                            new Object[]{recordingId},
+                            new String[]{long.class.getName()});
+                } catch (Exception ignored) {
+                }
+                throw e;
+            }
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(1);
+            }
+
+            return recordingId;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while starting recording, error: '%s'", ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't start recording")
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
+    /**
+     * startRecording configures and starts a recording created with newRecording.
+     *
+     * @param conn           MBeanServerConnection to the JMX endpoint
+     * @param flightRecorder ObjectName of the FlightRecorder MXBean
+     * @param recordingId    long the recording id
+     * @param settings       RecordingSettings to apply
+     * @throws Exception when any of the operations fail
+     */
+    private void startRecording(MBeanServerConnection conn, ObjectName flightRecorder, long recordingId, RecordingSettings settings) throws Exception {
+        if (settings.configuration != null && !settings.configuration.equals("")) {
+            withConnectionExceptionHandler(() ->
+                    conn.invoke(flightRecorder, "setPredefinedConfiguration",
+                            new Object[]{recordingId, settings.configuration},
+                            new String[]{long.class.getName(), String.class.getName()})
+            );
+        }
+
+        if (settings.settings != null && !settings.settings.isEmpty()) {
+            Map<String, String> recordingSettings = FlightRecordings.fromTabularData(withConnectionExceptionHandler(() ->
+                    conn.invoke(flightRecorder, "getRecordingSettings",
+                            new Object[]{recordingId},
+                            new String[]{long.class.getName()})
+            ));
+            recordingSettings.putAll(settings.settings);
+
+            TabularData data = FlightRecordings.toTabularData(recordingSettings);
+            withConnectionExceptionHandler(() ->
+                    conn.invoke(flightRecorder, "setRecordingSettings",
+                            new Object[]{recordingId, data},
+                            new String[]{long.class.getName(), TabularData.class.getName()})
+            );
+        }
+
+        Map<String, String> options = new HashMap<>();
+        if (settings.options != null) {
+            options.putAll(settings.options);
+        }
+        if (settings.name != null && !settings.name.equals("")) {
+            options.put("name", settings.name);
+        }
+
+        if (!options.isEmpty()) {
+            TabularData data = FlightRecordings.toTabularData(options);
+            withConnectionExceptionHandler(() ->
+                    conn.invoke(flightRecorder, "setRecordingOptions",
+                            new Object[]{recordingId, data},
+                            new String[]{long.class.getName(), TabularData.class.getName()})
+            );
+        }
+
+        withConnectionExceptionHandler(() ->
+                conn.invoke(flightRecorder, "startRecording",
+                        new Object[]{recordingId},
+                        new String[]{long.class.getName()})
+        );
+    }
+
+    /**
+     * stopRecording stops a Java Flight Recorder recording. The recording data is available until it is closed.
+     *
+     * @param recordingId long the recording id
+     * @param timeoutMs   long timeout for the request in milliseconds
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public void stopRecording(long recordingId, long timeoutMs) throws JMXError, JMXConnectionError {
+        withTimeout(executor.submit((Callable<Void>) () -> {
+            invokeFlightRecorder("stopRecording", new Object[]{recordingId}, new String[]{long.class.getName()});
+            return null;
+        }), timeoutMs);
+    }
+
+    /**
+     * closeRecording closes a Java Flight Recorder recording releasing its data.
+     *
+     * @param recordingId long the recording id
+     * @param timeoutMs   long timeout for the request in milliseconds
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public void closeRecording(long recordingId, long timeoutMs) throws JMXError, JMXConnectionError {
+        withTimeout(executor.submit((Callable<Void>) () -> {
+            invokeFlightRecorder("closeRecording", new Object[]{recordingId}, new String[]{long.class.getName()});
+            return null;
+        }), timeoutMs);
+    }
+
+    /**
+     * openRecordingStream opens a stream to download the data of a Java Flight Recorder recording.
+     *
+     * @param recordingId long the recording id
+     * @param timeoutMs   long timeout for the request in milliseconds
+     * @return long the stream id to be used with readRecordingStream
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public long openRecordingStream(long recordingId, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(executor.submit(() -> {
+            Map<String, String> options = new HashMap<>();
+            options.put("blockSize", FlightRecordings.STREAM_BLOCK_SIZE);
+
+            return (Long) invokeFlightRecorder("openStream",
+                    new Object[]{recordingId, FlightRecordings.toTabularData(options)},
+                    new String[]{long.class.getName(), TabularData.class.getName()});
+        }), timeoutMs);
+    }
+
+    /**
+     * readRecordingStream reads the next chunk of a recording stream.
+     *
+     * @param streamId  long the stream id returned by openRecordingStream
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return ByteBuffer the next chunk, empty when the end of the stream is reached
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public ByteBuffer readRecordingStream(long streamId, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(executor.submit(() -> {
+            byte[] chunk = (byte[]) invokeFlightRecorder("readStream",
+                    new Object[]{streamId},
+                    new String[]{long.class.getName()});
+            return ByteBuffer.wrap(chunk == null ? new byte[0] : chunk);
+        }), timeoutMs);
+    }
+
+    /**
+     * closeRecordingStream closes a recording stream.
+     *
+     * @param streamId  long the stream id returned by openRecordingStream
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public void closeRecordingStream(long streamId, long timeoutMs) throws JMXError, JMXConnectionError {
+        withTimeout(executor.submit((Callable<Void>) () -> {
+            invokeFlightRecorder("closeStream", new Object[]{streamId}, new String[]{long.class.getName()});
+            return null;
+        }), timeoutMs);
+    }
+
+    /**
+     * invokeFlightRecorder invokes an operation of the jdk.management.jfr:type=FlightRecorder MXBean.
+     *
+     * @param operationName String name of the operation
+     * @param params        Object[] operation parameters
+     * @param signature     String[] operation signature
+     * @return Object the operation result
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    private Object invokeFlightRecorder(String operationName, Object[] params, String[] signature) throws JMXConnectionError, JMXError {
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record(operationName)
+                    .setMBean(FlightRecordings.MBEAN_NAME);
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+            ObjectName flightRecorder = new ObjectName(FlightRecordings.MBEAN_NAME);
+
+            Object result = withConnectionExceptionHandler(() ->
+                    conn.invoke(flightRecorder, operationName, params, signature)
+            );
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(1);
+            }
+
+            return result;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while invoking flight recorder operation: '%s', error: '%s'", operationName, ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't invoke flight recorder operation: " + operationName)
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
+    /**
+     * getVMOptions returns all the VM options with their origin and writeable flag. The names are listed with the
+     * VM.flags -all diagnostic command and each option is read using the HotSpotDiagnostic MXBean. When the
+     * diagnostic command is not available only the writeable options are returned.
+     *
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return List<VMOption> containing the VM options
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public List<VMOption> getVMOptions(long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> getVMOptions()),
+                timeoutMs
+        );
+    }
+
+    private List<VMOption> getVMOptions() throws JMXError, JMXConnectionError {
+        List<String> names;
+        try {
+            names = HotSpotDiagnostics.parseFlagNames(diagnosticCommand("VM.flags", Collections.singletonList("-all")));
+        } catch (JMXError je) {
+            return HotSpotDiagnostics.toVMOptions(
+                    invokeHotSpotDiagnostic("getVMOptions", conn ->
+                            conn.getAttribute(new ObjectName(HotSpotDiagnostics.MBEAN_NAME), "DiagnosticOptions")
+                    )
+            );
+        }
+
+        return HotSpotDiagnostics.toVMOptions(
+                invokeHotSpotDiagnostic("getVMOptions", conn -> {
+                    ObjectName hotSpotDiagnostic = new ObjectName(HotSpotDiagnostics.MBEAN_NAME);
+                    List<CompositeData> options = new ArrayList<>();
+                    for (String name : names) {
+                        try {
+                            options.add((CompositeData) conn.invoke(hotSpotDiagnostic, "getVMOption",
+                                    new Object[]{name},
+                                    new String[]{String.class.getName()}));
+                        } catch (MBeanException | RuntimeMBeanException | ReflectionException e) {
+                            // Some flags listed by VM.flags are not exposed by the MXBean.
+                        }
+                    }
+                    return options.toArray(new CompositeData[0]);
+                })
+        );
+    }
+
+    /**
+     * getVMOption returns a VM option using the HotSpotDiagnostic MXBean.
+     *
+     * @param name      String name of the VM option, e.g. HeapDumpOnOutOfMemoryError
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @return VMOption the VM option with its value and origin
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public VMOption getVMOption(String name, long timeoutMs) throws JMXError, JMXConnectionError {
+        return withTimeout(
+                executor.submit(() -> HotSpotDiagnostics.toVMOption((CompositeData)
+                        invokeHotSpotDiagnostic("getVMOption", conn ->
+                                conn.invoke(new ObjectName(HotSpotDiagnostics.MBEAN_NAME), "getVMOption",
+                                        new Object[]{name},
+                                        new String[]{String.class.getName()})
+                        )
+                )),
+                timeoutMs
+        );
+    }
+
+    /**
+     * setVMOption sets a writeable VM option, e.g. the manageable HeapDumpOnOutOfMemoryError flag.
+     *
+     * @param name      String name of the VM option
+     * @param value     String new value of the VM option
+     * @param timeoutMs long timeout for the request in milliseconds
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public void setVMOption(String name, String value, long timeoutMs) throws JMXError, JMXConnectionError {
+        withTimeout(executor.submit((Callable<Void>) () -> {
+            invokeHotSpotDiagnostic("setVMOption", conn ->
+                    conn.invoke(new ObjectName(HotSpotDiagnostics.MBEAN_NAME), "setVMOption",
+                            new Object[]{name, value},
+                            new String[]{String.class.getName(), String.class.getName()})
+            );
+            return null;
+        }), timeoutMs);
+    }
+
+    /**
+     * dumpHeap writes a heap dump in the hprof format into the JVM host filesystem.
+     *
+     * @param outputFile String path of the heap dump in the JVM host, it must have the .hprof extension
+     * @param live       boolean to dump only the live objects
+     * @param timeoutMs  long timeout for the dump in milliseconds, 0 waits until the dump is written
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    public void dumpHeap(String outputFile, boolean live, long timeoutMs) throws JMXError, JMXConnectionError {
+        withTimeout(executor.submit((Callable<Void>) () -> {
+            invokeHotSpotDiagnostic("dumpHeap", conn ->
+                    conn.invoke(new ObjectName(HotSpotDiagnostics.MBEAN_NAME), "dumpHeap",
+                            new Object[]{outputFile, live},
+                            new String[]{String.class.getName(), boolean.class.getName()})
+            );
+            return null;
+        }), timeoutMs);
+    }
+
+    /**
+     * HotSpotDiagnosticRequest is a request performed on the HotSpotDiagnostic MXBean.
+     */
+    private interface HotSpotDiagnosticRequest {
+        Object execute(MBeanServerConnection conn) throws Exception;
+    }
+
+    /**
+     * invokeHotSpotDiagnostic executes a request on the com.sun.management:type=HotSpotDiagnostic MXBean.
+     * The failures include the root cause class, so the consumers can tell the failure reason apart.
+     *
+     * @param operationName String name of the operation used for the errors and internal stats
+     * @param request       HotSpotDiagnosticRequest to execute
+     * @return Object the request result
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    private Object invokeHotSpotDiagnostic(String operationName, HotSpotDiagnosticRequest request) throws JMXConnectionError, JMXError {
+        InternalStat internalStat = null;
+        if (this.internalStats != null) {
+            internalStat = internalStats.record(operationName)
+                    .setMBean(HotSpotDiagnostics.MBEAN_NAME);
+        }
+
+        try {
+            MBeanServerConnection conn = getConnection();
+
+            Object result = withConnectionExceptionHandler(() -> request.execute(conn));
+
+            if (internalStat != null) {
+                internalStat.setSuccessful(true);
+                internalStat.setResponseCount(1);
+            }
+
+            return result;
+        } catch (JMXConnectionError je) {
+            throw je;
+        } catch (ConnectException ce) {
+            String message = String.format("problem occurred when talking to the JMX server while invoking hotspot diagnostic operation: '%s', error: '%s'", operationName, ce.getMessage());
+            throw new JMXConnectionError(message);
+        } catch (Exception e) {
+            throw new JMXError()
+                    .setMessage("can't invoke hotspot diagnostic operation: " + operationName)
+                    .setCauseMessage(HotSpotDiagnostics.rootCauseMessage(e))
+                    .setCauseClass(HotSpotDiagnostics.rootCauseClass(e))
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            if (internalStat != null) {
+                internalStats.complete(internalStat);
+            }
+        }
+    }
+
+    /**
+     * getObjectName returns the ObjectName for an mBeanName required on performing JMX requests.
+     *
+     * @param mBeanName to build the ObjectName
+     * @return ObjectName for the mBeanName
+     * @throws JMXError JMX related Exception
+     */
+    private ObjectName getObjectName(String mBeanName) throws JMXError {
+        try {
+            return new ObjectName(mBeanName);
+        } catch (MalformedObjectNameException me) {
+            throw new JMXError()
+                    .setMessage("cannot parse MBean glob pattern: '" + mBeanName + "', valid: 'DOMAIN:BEAN'")
+                    .setCauseMessage(me.getMessage())
+                    .setStacktrace(getStackTrace(me));
+        }
+    }
+
+    /**
+     * withTimeout executes a task with timeout.
+     *
+     * @param future    is the task that has to be executed
+     * @param timeoutMs timeout in milliseconds after which we terminate the task
+     * @param <T>       Generic type for the task
+     * @return Task result
+     * @throws JMXError           JMX related Exception
+     * @throws JMXConnectionError JMX connection related exception
+     */
+    private <T> T withTimeout(Future<T> future, long timeoutMs) throws JMXError, JMXConnectionError {
+
+        try {
+            if (timeoutMs <= 0) {
+                return future.get();
+            }
+            return future.get(timeoutMs, TimeUnit.MILLISECONDS);
+
+        } catch (InterruptedException e) {
+            Thread.currentThread().interrupt();
+            throw new JMXError()
+                    .setMessage("request was interrupted " + e.getMessage())
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } catch (TimeoutException e) {
+            throw new JMXError()
+                    .setMessage("request timeout exceeded: " + timeoutMs + "ms")
+                    .setCauseMessage(e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } catch (ExecutionException e) {
+            if (e.getCause() instanceof JMXError) {
+                throw (JMXError) e.getCause();
+            } else if (e.getCause() instanceof JMXConnectionError) {
+                throw (JMXConnectionError) e.getCause();
+            }
+            throw new JMXError()
+                    .setMessage("failed to execute operation, error: " + e.getMessage())
+                    .setStacktrace(getStackTrace(e));
+        } finally {
+            future.cancel(true);
+        }
+    }
+
+    /**
+     * withConnectionExceptionHandler is needed while performing JMX requests
+     * to detect if there is a connection exception that require a disconnect.
+     *
+     * @param task
+     * @param <T>
+     * @return
+     * @throws Exception
+     */
+    private <T> T withConnectionExceptionHandler(Callable<T> task) throws Exception {
+        try {
+            return task.call();
+        } catch (
+            // Not connection errors.
+                MBeanException
+                | AttributeNotFoundException
+                | InstanceNotFoundException
+                | ReflectionException
+                | RuntimeOperationsException
+                | IllegalArgumentException
+                | IntrospectionException e) {
+            throw e;
+        } catch (Exception e) {
+            if (e instanceof ConnectException) {
+                disconnect();
+                throw e;
+            }
+
+            boolean isConnErr = knownConnectionExceptions.contains(e.getClass().getName());
+            if (isConnErr || !isConnectionAlive()) {
+                disconnect();
+                throw new ConnectException(e.getMessage());
+            }
+            throw e;
+        }
+    }
+
+    /**
+     * parseValue converts the received value from JMX into an JMXAttribute object.
+     *
+     * @param mBeanAttributeName of the value
+     * @param value              that has to be converted
+     * @throws JMXError JMX related Exception
+     */
+    private void parseValue(String mBeanAttributeName, Object value, List<AttributeResponse> output) throws JMXError {
+        if (output == null) {
             output = new ArrayList<>();
         }
         AttributeResponse attr = new AttributeResponse();
         attr.name = mBeanAttributeName;
 
         if (value == null) {
-            throw new JMXError()
-                    .setMessage("found a null value for bean: " + mBeanAttributeName);
+            throw AttributeErrors.valueError(ErrorKind.NULL_VALUE, "found a null value for bean: " + mBeanAttributeName);
         } else if (value instanceof java.lang.Double) {
             attr.doubleValue = (Double) value;
             attr.responseType = ResponseType.DOUBLE;
@@ -743,8 +1887,7 @@ public class JMXFetcher {
             }
             return;
         } else {
-            throw new JMXError()
-                    .setMessage("unsuported data type (" + value.getClass() + ") for bean " + mBeanAttributeName);
+            throw AttributeErrors.valueError(ErrorKind.UNSUPPORTED_TYPE, "unsuported data type (" + value.getClass() + ") for bean " + mBeanAttributeName);
         }
         output.add(attr);
     }
@@ -755,7 +1898,7 @@ public class JMXFetcher {
      * @return List<InternalStat> the collected nrjmx internal query stats.
      */
     public List<InternalStat> getInternalStats() throws JMXError {
-        if (internalStats == null) {
+        if (internalStats == null || !jmxConfig.enableInternalStats) {
             throw new JMXError()
                     .setMessage("internal stats not activated");
         }
@@ -763,6 +1906,33 @@ public class JMXFetcher {
         return internalStats.getStats();
     }
 
+    /**
+     * getInternalMetrics returns the cumulative metrics per stat type since the connection, without resetting them.
+     *
+     * @return InternalMetrics the metrics and the state of the internal stats samples buffer.
+     */
+    public InternalMetrics getInternalMetrics() throws JMXError {
+        if (internalStats == null) {
+            throw new JMXError()
+                    .setMessage("internal metrics not available before connecting");
+        }
+
+        return internalStats.getMetrics();
+    }
+
+    /**
+     * getTruncations returns the queries truncated by the configured limits since the previous call.
+     *
+     * @return List<Truncation> the truncations, empty when there are no limits configured.
+     */
+    public List<Truncation> getTruncations() {
+        if (queryLimits == null) {
+            return new ArrayList<>();
+        }
+
+        return queryLimits.getTruncations();
+    }
+
     /**
      * isConnectionAlive check if the connection is still open.
      * This method might not work for custom connector implementations.
@@ -5,6 +5,7 @@
 
 package org.newrelic.nrjmx.v2;
 
+import java.nio.ByteBuffer;
 import java.util.List;
 
 import org.apache.thrift.TException;
@@ -60,6 +61,11 @@ public class JMXServiceHandler implements JMXService.Iface {
         return jmxFetcher.getMBeanAttributeNames(mBeanName, requestTimeoutMs);
     }
 
+    @Override
+    public MBeanInfo getMBeanInfo(String mBeanName) throws TException {
+        return jmxFetcher.getMBeanInfo(mBeanName, requestTimeoutMs);
+    }
+
     @Override
     public List<AttributeResponse> getMBeanAttributes(String mBeanName, List<String> attributes) throws TException {
         return jmxFetcher.getMBeanAttributes(mBeanName, attributes, requestTimeoutMs);
@@ -70,11 +76,107 @@ public class JMXServiceHandler implements JMXService.Iface {
         return jmxFetcher.queryMBeanAttributes(mBeanNamePattern, attributes, requestTimeoutMs);
     }
 
+    @Override
+    public List<AttributeResponse> invokeOperation(String mBeanName, String operationName, List<String> arguments, List<String> signature) throws TException {
+        return jmxFetcher.invokeOperation(mBeanName, operationName, arguments, signature, requestTimeoutMs);
+    }
+
     @Override
     public List<InternalStat> getInternalStats() throws TException {
         return jmxFetcher.getInternalStats();
     }
 
+    @Override
+    public InternalMetrics getInternalMetrics() throws TException {
+        return jmxFetcher.getInternalMetrics();
+    }
+
+    @Override
+    public List<Truncation> getTruncations() throws TException {
+        return jmxFetcher.getTruncations();
+    }
+
+    @Override
+    public List<String> getDomains() throws TException {
+        return jmxFetcher.getDomains(requestTimeoutMs);
+    }
+
+    @Override
+    public long getMBeanCount(String mBeanNamePattern) throws TException {
+        return jmxFetcher.getMBeanCount(mBeanNamePattern, requestTimeoutMs);
+    }
+
+    @Override
+    public ServerInfo getServerInfo() throws TException {
+        return jmxFetcher.getServerInfo(requestTimeoutMs);
+    }
+
+    @Override
+    public List<ThreadInfo> threadDump(ThreadDumpOptions options) throws TException {
+        return jmxFetcher.threadDump(options, requestTimeoutMs);
+    }
+
+    @Override
+    public List<ThreadInfo> findDeadlocks() throws TException {
+        return jmxFetcher.findDeadlocks(requestTimeoutMs);
+    }
+
+    @Override
+    public String diagnosticCommand(String command, List<String> arguments) throws TException {
+        return jmxFetcher.diagnosticCommand(command, arguments, requestTimeoutMs);
+    }
+
+    @Override
+    public long startRecording(RecordingSettings settings) throws TException {
+        return jmxFetcher.startRecording(settings, requestTimeoutMs);
+    }
+
+    @Override
+    public void stopRecording(long recordingId) throws TException {
+        jmxFetcher.stopRecording(recordingId, requestTimeoutMs);
+    }
+
+    @Override
+    public void closeRecording(long recordingId) throws TException {
+        jmxFetcher.closeRecording(recordingId, requestTimeoutMs);
+    }
+
+    @Override
+    public long openRecordingStream(long recordingId) throws TException {
+        return jmxFetcher.openRecordingStream(recordingId, requestTimeoutMs);
+    }
+
+    @Override
+    public ByteBuffer readRecordingStream(long streamId) throws TException {
+        return jmxFetcher.readRecordingStream(streamId, requestTimeoutMs);
+    }
+
+    @Override
+    public void closeRecordingStream(long streamId) throws TException {
+        jmxFetcher.closeRecordingStream(streamId, requestTimeoutMs);
+    }
+
+    @Override
+    public List<VMOption> getVMOptions() throws TException {
+        return jmxFetcher.getVMOptions(requestTimeoutMs);
+    }
+
+    @Override
+    public VMOption getVMOption(String name) throws TException {
+        return jmxFetcher.getVMOption(name, requestTimeoutMs);
+    }
+
+    @Override
+    public void setVMOption(String name, String value) throws TException {
+        jmxFetcher.setVMOption(name, value, requestTimeoutMs);
+    }
+
+    @Override
+    public void dumpHeap(String outputFile, boolean live, long timeoutMs) throws TException {
+        // Large heaps take longer than the regular requests, so the dump has its own timeout.
+        jmxFetcher.dumpHeap(outputFile, live, timeoutMs);
+    }
+
     public void addServer(TServer server) {
         this.server = server;
     }
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.newrelic.nrjmx.v2.nrprotocol.MBeanAttributeInfo;
+import org.newrelic.nrjmx.v2.nrprotocol.MBeanInfo;
+import org.newrelic.nrjmx.v2.nrprotocol.MBeanOperationInfo;
+import org.newrelic.nrjmx.v2.nrprotocol.MBeanParameterInfo;
+
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * MBeanInfos converts the javax.management.MBeanInfo into the nrprotocol structures.
+ */
+public class MBeanInfos {
+
+    /**
+     * toMBeanInfo converts the metadata returned by the MBeanServer for an mBean.
+     *
+     * @param mBeanName String name of the mBean
+     * @param info      javax.management.MBeanInfo returned by the MBeanServer
+     * @return MBeanInfo the converted metadata
+     */
+    public static MBeanInfo toMBeanInfo(String mBeanName, javax.management.MBeanInfo info) {
+        MBeanInfo result = new MBeanInfo()
+                .setName(mBeanName)
+                .setClassName(nullToEmpty(info.getClassName()))
+                .setDescription(nullToEmpty(info.getDescription()))
+                .setAttributes(new ArrayList<>())
+                .setOperations(new ArrayList<>());
+
+        if (info.getAttributes() != null) {
+            for (javax.management.MBeanAttributeInfo attrInfo : info.getAttributes()) {
+                if (attrInfo == null) {
+                    continue;
+                }
+                result.addToAttributes(new MBeanAttributeInfo()
+                        .setName(attrInfo.getName())
+                        .setType(nullToEmpty(attrInfo.getType()))
+                        .setDescription(nullToEmpty(attrInfo.getDescription()))
+                        .setReadable(attrInfo.isReadable())
+                        .setWritable(attrInfo.isWritable()));
+            }
+        }
+
+        if (info.getOperations() != null) {
+            for (javax.management.MBeanOperationInfo operationInfo : info.getOperations()) {
+                if (operationInfo == null) {
+                    continue;
+                }
+                result.addToOperations(new MBeanOperationInfo()
+                        .setName(operationInfo.getName())
+                        .setReturnType(nullToEmpty(operationInfo.getReturnType()))
+                        .setDescription(nullToEmpty(operationInfo.getDescription()))
+                        .setSignature(toParameters(operationInfo.getSignature()))
+                        .setImpact(operationInfo.getImpact()));
+            }
+        }
+
+        return result;
+    }
+
+    /**
+     * toParameters converts the signature of an mBean operation.
+     *
+     * @param signature javax.management.MBeanParameterInfo[] of the operation
+     * @return List<MBeanParameterInfo> the converted parameters
+     */
+    static List<MBeanParameterInfo> toParameters(javax.management.MBeanParameterInfo[] signature) {
+        List<MBeanParameterInfo> result = new ArrayList<>();
+        if (signature == null) {
+            return result;
+        }
+
+        for (javax.management.MBeanParameterInfo parameter : signature) {
+            result.add(new MBeanParameterInfo()
+                    .setName(nullToEmpty(parameter.getName()))
+                    .setType(nullToEmpty(parameter.getType()))
+                    .setDescription(nullToEmpty(parameter.getDescription())));
+        }
+        return result;
+    }
+
+    private static String nullToEmpty(String value) {
+        return value == null ? "" : value;
+    }
+}
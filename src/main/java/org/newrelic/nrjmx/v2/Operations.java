@@ -0,0 +1,149 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.newrelic.nrjmx.v2.nrprotocol.JMXError;
+
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.List;
+
+/**
+ * Operations resolves the signature of the mBean operations and converts the string arguments received
+ * from the client into the parameter types.
+ */
+public class Operations {
+
+    /**
+     * resolveSignature finds the parameter types of the operation with the given name and number of parameters.
+     *
+     * @param info          MBeanInfo of the mBean
+     * @param operationName name of the operation
+     * @param argsCount     number of arguments provided
+     * @return String[] the fully qualified parameter types
+     * @throws JMXError the operation doesn't exist or it's ambiguous
+     */
+    public static String[] resolveSignature(javax.management.MBeanInfo info, String operationName, int argsCount) throws JMXError {
+        List<String[]> candidates = new ArrayList<>();
+        for (javax.management.MBeanOperationInfo operation : info.getOperations()) {
+            if (!operation.getName().equals(operationName) || operation.getSignature().length != argsCount) {
+                continue;
+            }
+            candidates.add(Arrays.stream(operation.getSignature())
+                    .map(javax.management.MBeanParameterInfo::getType)
+                    .toArray(String[]::new));
+        }
+
+        if (candidates.isEmpty()) {
+            throw new JMXError()
+                    .setMessage(String.format("operation '%s' with %d parameters not found", operationName, argsCount));
+        }
+
+        if (candidates.size() > 1) {
+            List<String> signatures = new ArrayList<>();
+            for (String[] candidate : candidates) {
+                signatures.add("(" + String.join(", ", candidate) + ")");
+            }
+            throw new JMXError()
+                    .setMessage(String.format("operation '%s' with %d parameters is overloaded, provide the signature: %s",
+                            operationName, argsCount, String.join(", ", signatures)));
+        }
+
+        return candidates.get(0);
+    }
+
+    /**
+     * convertArguments converts the string arguments into the parameter types of the signature.
+     * Only primitive types, their wrappers and strings are supported.
+     *
+     * @param arguments string representation of the arguments
+     * @param signature fully qualified parameter types
+     * @return Object[] the converted arguments
+     * @throws JMXError the arguments don't match the signature or can't be converted
+     */
+    public static Object[] convertArguments(List<String> arguments, String[] signature) throws JMXError {
+        int argsCount = arguments == null ? 0 : arguments.size();
+        if (argsCount != signature.length) {
+            throw new JMXError()
+                    .setMessage(String.format("expected %d arguments for signature (%s), got %d",
+                            signature.length, String.join(", ", signature), argsCount));
+        }
+
+        Object[] params = new Object[argsCount];
+        for (int i = 0; i < argsCount; i++) {
+            params[i] = convertArgument(arguments.get(i), signature[i]);
+        }
+        return params;
+    }
+
+    private static Object convertArgument(String value, String type) throws JMXError {
+        try {
+            switch (type) {
+                case "java.lang.String":
+                    return value;
+                case "boolean":
+                case "java.lang.Boolean":
+                    if (!"true".equalsIgnoreCase(value) && !"false".equalsIgnoreCase(value)) {
+                        throw new IllegalArgumentException("not a boolean");
+                    }
+                    return Boolean.valueOf(value);
+                case "int":
+                case "java.lang.Integer":
+                    return Integer.valueOf(value);
+                case "long":
+                case "java.lang.Long":
+                    return Long.valueOf(value);
+                case "short":
+                case "java.lang.Short":
+                    return Short.valueOf(value);
+                case "byte":
+                case "java.lang.Byte":
+                    return Byte.valueOf(value);
+                case "double":
+                case "java.lang.Double":
+                    return Double.valueOf(value);
+                case "float":
+                case "java.lang.Float":
+                    return Float.valueOf(value);
+                case "char":
+                case "java.lang.Character":
+                    if (value.length() != 1) {
+                        throw new IllegalArgumentException("not a single character");
+                    }
+                    return value.charAt(0);
+                default:
+                    throw new JMXError()
+                            .setMessage(String.format("unsupported parameter type: '%s'", type));
+            }
+        } catch (IllegalArgumentException e) {
+            throw new JMXError()
+                    .setMessage(String.format("can't convert argument '%s' to '%s': %s", value, type, e.getMessage()));
+        }
+    }
+
+    /**
+     * toString formats the operation results that can't be parsed as attribute values.
+     *
+     * @param result value returned by the operation
+     * @return String the result representation
+     */
+    public static String toString(Object result) {
+        if (result instanceof Object[]) {
+            return Arrays.deepToString((Object[]) result);
+        } else if (result instanceof long[]) {
+            return Arrays.toString((long[]) result);
+        } else if (result instanceof int[]) {
+            return Arrays.toString((int[]) result);
+        } else if (result instanceof double[]) {
+            return Arrays.toString((double[]) result);
+        } else if (result instanceof boolean[]) {
+            return Arrays.toString((boolean[]) result);
+        } else if (result instanceof byte[]) {
+            return Arrays.toString((byte[]) result);
+        }
+        return String.valueOf(result);
+    }
+}
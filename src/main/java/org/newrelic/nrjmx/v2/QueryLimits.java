@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.newrelic.nrjmx.v2.nrprotocol.AttributeResponse;
+import org.newrelic.nrjmx.v2.nrprotocol.JMXConfig;
+import org.newrelic.nrjmx.v2.nrprotocol.QueryLimit;
+import org.newrelic.nrjmx.v2.nrprotocol.Truncation;
+
+import javax.management.ObjectInstance;
+import java.util.ArrayDeque;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Comparator;
+import java.util.Deque;
+import java.util.List;
+import java.util.stream.Collectors;
+
+/**
+ * QueryLimits enforces the cardinality limits of the queries before the values are fetched and keeps track
+ * of the truncations, so the consumers can tell what was dropped and which limit was hit.
+ */
+public class QueryLimits {
+
+    /* MAX_DROPPED_NAMES defines how many dropped names are reported for each truncation, droppedCount has the total. */
+    final static int MAX_DROPPED_NAMES = 100;
+
+    /* MAX_TRUNCATIONS defines how many truncations we can keep in memory. When limit is reached old ones are discarded
+     * and counted by the evictedBefore of the oldest truncation kept. */
+    final static int MAX_TRUNCATIONS = 1000;
+
+    private final long maxMBeansPerPattern;
+    private final long maxAttributesPerMBean;
+    private final long maxResponsesPerCall;
+
+    private Deque<Truncation> truncations = new ArrayDeque<>();
+
+    /**
+     * QueryLimits constructor. Limits lower than 1 are disabled.
+     *
+     * @param maxMBeansPerPattern   long maximum mBeans returned for a pattern
+     * @param maxAttributesPerMBean long maximum attributes requested for an mBean
+     * @param maxResponsesPerCall   long maximum attribute responses returned by a call
+     */
+    public QueryLimits(long maxMBeansPerPattern, long maxAttributesPerMBean, long maxResponsesPerCall) {
+        this.maxMBeansPerPattern = maxMBeansPerPattern;
+        this.maxAttributesPerMBean = maxAttributesPerMBean;
+        this.maxResponsesPerCall = maxResponsesPerCall;
+    }
+
+    /**
+     * fromConfig returns the QueryLimits of the JMXConfig or null when there are no limits configured.
+     *
+     * @param jmxConfig JMX configuration
+     * @return QueryLimits or null
+     */
+    public static QueryLimits fromConfig(JMXConfig jmxConfig) {
+        if (jmxConfig.maxMBeansPerPattern < 1 && jmxConfig.maxAttributesPerMBean < 1 && jmxConfig.maxResponsesPerCall < 1) {
+            return null;
+        }
+        return new QueryLimits(jmxConfig.maxMBeansPerPattern, jmxConfig.maxAttributesPerMBean, jmxConfig.maxResponsesPerCall);
+    }
+
+    /**
+     * limitMBeans sorts the mBeans by name and keeps the first ones up to maxMBeansPerPattern.
+     * The mBeans are sorted for any limit, so the same ones are kept in every collection.
+     *
+     * @param pattern String mBean pattern of the query
+     * @param mBeans  Collection<ObjectInstance> returned by the query
+     * @return List<ObjectInstance> mBeans to fetch, sorted by name
+     */
+    public List<ObjectInstance> limitMBeans(String pattern, Collection<ObjectInstance> mBeans) {
+        List<ObjectInstance> sorted = mBeans.stream()
+                .sorted(Comparator.comparing(mBean -> mBean.getObjectName().toString()))
+                .collect(Collectors.toList());
+
+        if (maxMBeansPerPattern < 1 || sorted.size() <= maxMBeansPerPattern) {
+            return sorted;
+        }
+
+        List<ObjectInstance> kept = new ArrayList<>(sorted.subList(0, (int) maxMBeansPerPattern));
+        List<String> dropped = sorted.subList((int) maxMBeansPerPattern, sorted.size())
+                .stream()
+                .map(mBean -> mBean.getObjectName().toString())
+                .collect(Collectors.toList());
+
+        record(QueryLimit.MBEANS_PER_PATTERN, maxMBeansPerPattern, pattern, dropped);
+        return kept;
+    }
+
+    /**
+     * limitAttributes keeps the first attributes up to maxAttributesPerMBean.
+     *
+     * @param mBeanName  String mBean of the attributes
+     * @param attributes List<String> attribute names to request
+     * @return List<String> attribute names to fetch
+     */
+    public List<String> limitAttributes(String mBeanName, List<String> attributes) {
+        if (maxAttributesPerMBean < 1 || attributes.size() <= maxAttributesPerMBean) {
+            return attributes;
+        }
+
+        record(QueryLimit.ATTRIBUTES_PER_MBEAN, maxAttributesPerMBean, mBeanName,
+                attributes.subList((int) maxAttributesPerMBean, attributes.size()));
+        return new ArrayList<>(attributes.subList(0, (int) maxAttributesPerMBean));
+    }
+
+    /**
+     * isResponseLimitReached returns true when the call already returned maxResponsesPerCall responses.
+     *
+     * @param responses int responses of the call
+     * @return boolean
+     */
+    public boolean isResponseLimitReached(int responses) {
+        return maxResponsesPerCall > 0 && responses >= maxResponsesPerCall;
+    }
+
+    /**
+     * limitResponses removes the responses over maxResponsesPerCall and reports them with the mBeans
+     * that were not fetched.
+     *
+     * @param pattern       String mBean pattern of the call
+     * @param output        List<AttributeResponse> responses of the call
+     * @param skippedMBeans List<String> mBeans not fetched because the limit was reached
+     */
+    public void limitResponses(String pattern, List<AttributeResponse> output, List<String> skippedMBeans) {
+        if (maxResponsesPerCall < 1 || (output.size() <= maxResponsesPerCall && skippedMBeans.isEmpty())) {
+            return;
+        }
+
+        List<String> dropped = new ArrayList<>();
+        if (output.size() > maxResponsesPerCall) {
+            List<AttributeResponse> extra = output.subList((int) maxResponsesPerCall, output.size());
+            for (AttributeResponse response : extra) {
+                dropped.add(response.getName());
+            }
+            extra.clear();
+        }
+        dropped.addAll(skippedMBeans);
+
+        record(QueryLimit.RESPONSES_PER_CALL, maxResponsesPerCall, pattern, dropped);
+    }
+
+    /**
+     * Returns all the truncations and clear them.
+     *
+     * @return List<Truncation> truncations since the previous call
+     */
+    public List<Truncation> getTruncations() {
+        synchronized (this) {
+            List<Truncation> result = new ArrayList<>(this.truncations);
+            this.truncations = new ArrayDeque<>();
+            return result;
+        }
+    }
+
+    private void record(QueryLimit limit, long limitValue, String mBeanName, List<String> dropped) {
+        Truncation truncation = new Truncation()
+                .setLimit(limit)
+                .setLimitValue(limitValue)
+                .setMBeanName(mBeanName)
+                .setDroppedCount(dropped.size())
+                .setDropped(new ArrayList<>(dropped.subList(0, Math.min(dropped.size(), MAX_DROPPED_NAMES))))
+                .setTimestamp(System.currentTimeMillis());
+
+        synchronized (this) {
+            if (truncations.size() >= MAX_TRUNCATIONS) {
+                // The discarded truncations are counted by the oldest one kept.
+                Truncation evicted = truncations.pollFirst();
+                Truncation oldest = truncations.isEmpty() ? truncation : truncations.peekFirst();
+                oldest.setEvictedBefore(oldest.evictedBefore + evicted.evictedBefore + 1);
+            }
+            truncations.addLast(truncation);
+        }
+    }
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.apache.thrift.TException;
+import org.apache.thrift.protocol.TMessage;
+import org.apache.thrift.protocol.TProtocol;
+import org.apache.thrift.protocol.TProtocolDecorator;
+
+/**
+ * RequestIdProtocol keeps the sequence id of the request being processed, so the internal stats can be joined
+ * with the stats measured by the client for the same request. The server processes one request at a time.
+ */
+public class RequestIdProtocol extends TProtocolDecorator {
+    private static volatile int current;
+
+    public RequestIdProtocol(TProtocol protocol) {
+        super(protocol);
+    }
+
+    @Override
+    public TMessage readMessageBegin() throws TException {
+        TMessage message = super.readMessageBegin();
+        current = message.seqid;
+        return message;
+    }
+
+    /**
+     * current returns the sequence id of the last received request.
+     *
+     * @return int the request sequence id, 0 before the first request.
+     */
+    public static int current() {
+        return current;
+    }
+}
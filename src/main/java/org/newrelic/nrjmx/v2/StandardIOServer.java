@@ -48,7 +48,7 @@ public class StandardIOServer extends TServer {
                 inputTransport = inputTransportFactory_.getTransport(client);
                 outputTransport = outputTransportFactory_.getTransport(client);
 
-                TProtocol inputProtocol = inputProtocolFactory_.getProtocol(inputTransport);
+                TProtocol inputProtocol = new RequestIdProtocol(inputProtocolFactory_.getProtocol(inputTransport));
                 TProtocol outputProtocol = outputProtocolFactory_.getProtocol(outputTransport);
 
                 while (!stopped_) {
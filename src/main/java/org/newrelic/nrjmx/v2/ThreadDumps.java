@@ -0,0 +1,126 @@
+/*
+ * Copyright 2026 New Relic Corporation. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package org.newrelic.nrjmx.v2;
+
+import org.newrelic.nrjmx.v2.nrprotocol.LockInfo;
+import org.newrelic.nrjmx.v2.nrprotocol.StackFrame;
+import org.newrelic.nrjmx.v2.nrprotocol.ThreadInfo;
+import org.newrelic.nrjmx.v2.nrprotocol.ThreadState;
+
+import javax.management.openmbean.CompositeData;
+import java.lang.management.MonitorInfo;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * ThreadDumps converts the ThreadMXBean responses into the nrprotocol thread dump structures.
+ */
+public class ThreadDumps {
+
+    /**
+     * fromCompositeData converts the CompositeData returned by the ThreadMXBean operations.
+     * Null entries, returned for threads that are no longer alive, are skipped.
+     *
+     * @param data     CompositeData[] returned by dumpAllThreads or getThreadInfo
+     * @param maxDepth int maximum number of stack frames for each thread, 0 or negative for all of them
+     * @return List<ThreadInfo> the converted threads
+     */
+    public static List<ThreadInfo> fromCompositeData(CompositeData[] data, int maxDepth) {
+        List<ThreadInfo> result = new ArrayList<>();
+        if (data == null) {
+            return result;
+        }
+
+        for (CompositeData cd : data) {
+            if (cd == null) {
+                continue;
+            }
+            result.add(toThreadInfo(java.lang.management.ThreadInfo.from(cd), maxDepth));
+        }
+        return result;
+    }
+
+    /**
+     * toThreadInfo converts a java.lang.management.ThreadInfo.
+     *
+     * @param info     java.lang.management.ThreadInfo to convert
+     * @param maxDepth int maximum number of stack frames, 0 or negative for all of them
+     * @return ThreadInfo the converted thread
+     */
+    static ThreadInfo toThreadInfo(java.lang.management.ThreadInfo info, int maxDepth) {
+        ThreadInfo threadInfo = new ThreadInfo()
+                .setThreadId(info.getThreadId())
+                .setThreadName(info.getThreadName())
+                .setThreadState(toThreadState(info.getThreadState()))
+                .setBlockedCount(info.getBlockedCount())
+                .setBlockedTimeMs(info.getBlockedTime())
+                .setWaitedCount(info.getWaitedCount())
+                .setWaitedTimeMs(info.getWaitedTime())
+                .setLockOwnerId(info.getLockOwnerId())
+                .setLockOwnerName(info.getLockOwnerName() == null ? "" : info.getLockOwnerName())
+                .setInNative(info.isInNative())
+                .setSuspended(info.isSuspended())
+                .setStackTrace(new ArrayList<>())
+                .setLockedSynchronizers(new ArrayList<>());
+
+        if (info.getLockInfo() != null) {
+            threadInfo.setLockInfo(toLockInfo(info.getLockInfo()));
+        }
+
+        StackTraceElement[] stackTrace = info.getStackTrace();
+        int depth = stackTrace.length;
+        if (maxDepth > 0 && maxDepth < depth) {
+            depth = maxDepth;
+        }
+
+        for (int i = 0; i < depth; i++) {
+            StackTraceElement element = stackTrace[i];
+            threadInfo.getStackTrace().add(new StackFrame()
+                    .setClassName(element.getClassName())
+                    .setMethodName(element.getMethodName())
+                    .setFileName(element.getFileName() == null ? "" : element.getFileName())
+                    .setLineNumber(element.getLineNumber())
+                    .setNativeMethod(element.isNativeMethod())
+                    .setLockedMonitors(new ArrayList<>()));
+        }
+
+        for (MonitorInfo monitor : info.getLockedMonitors()) {
+            int frame = monitor.getLockedStackDepth();
+            if (frame >= 0 && frame < depth) {
+                threadInfo.getStackTrace().get(frame).getLockedMonitors().add(toLockInfo(monitor));
+            }
+        }
+
+        for (java.lang.management.LockInfo synchronizer : info.getLockedSynchronizers()) {
+            threadInfo.getLockedSynchronizers().add(toLockInfo(synchronizer));
+        }
+
+        return threadInfo;
+    }
+
+    private static LockInfo toLockInfo(java.lang.management.LockInfo lockInfo) {
+        return new LockInfo()
+                .setClassName(lockInfo.getClassName())
+                .setIdentityHashCode(lockInfo.getIdentityHashCode());
+    }
+
+    private static ThreadState toThreadState(Thread.State state) {
+        switch (state) {
+            case NEW:
+                return ThreadState.NEW;
+            case RUNNABLE:
+                return ThreadState.RUNNABLE;
+            case BLOCKED:
+                return ThreadState.BLOCKED;
+            case WAITING:
+                return ThreadState.WAITING;
+            case TIMED_WAITING:
+                return ThreadState.TIMED_WAITING;
+            default:
+                return ThreadState.TERMINATED;
+        }
+    }
+}
@@ -0,0 +1,718 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class AttributeError implements org.apache.thrift.TBase<AttributeError, AttributeError._Fields>, java.io.Serializable, Cloneable, Comparable<AttributeError> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("AttributeError");
+
+  private static final org.apache.thrift.protocol.TField KIND_FIELD_DESC = new org.apache.thrift.protocol.TField("kind", org.apache.thrift.protocol.TType.I32, (short)1);
+  private static final org.apache.thrift.protocol.TField EXCEPTION_CLASS_FIELD_DESC = new org.apache.thrift.protocol.TField("exceptionClass", org.apache.thrift.protocol.TType.STRING, (short)2);
+  private static final org.apache.thrift.protocol.TField CAUSE_CLASS_FIELD_DESC = new org.apache.thrift.protocol.TField("causeClass", org.apache.thrift.protocol.TType.STRING, (short)3);
+  private static final org.apache.thrift.protocol.TField CAUSE_MESSAGE_FIELD_DESC = new org.apache.thrift.protocol.TField("causeMessage", org.apache.thrift.protocol.TType.STRING, (short)4);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new AttributeErrorStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new AttributeErrorTupleSchemeFactory();
+
+  /**
+   * 
+   * @see ErrorKind
+   */
+  public @org.apache.thrift.annotation.Nullable ErrorKind kind; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String exceptionClass; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String causeClass; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String causeMessage; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    /**
+     * 
+     * @see ErrorKind
+     */
+    KIND((short)1, "kind"),
+    EXCEPTION_CLASS((short)2, "exceptionClass"),
+    CAUSE_CLASS((short)3, "causeClass"),
+    CAUSE_MESSAGE((short)4, "causeMessage");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // KIND
+          return KIND;
+        case 2: // EXCEPTION_CLASS
+          return EXCEPTION_CLASS;
+        case 3: // CAUSE_CLASS
+          return CAUSE_CLASS;
+        case 4: // CAUSE_MESSAGE
+          return CAUSE_MESSAGE;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.KIND, new org.apache.thrift.meta_data.FieldMetaData("kind", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.EnumMetaData(org.apache.thrift.protocol.TType.ENUM, ErrorKind.class)));
+    tmpMap.put(_Fields.EXCEPTION_CLASS, new org.apache.thrift.meta_data.FieldMetaData("exceptionClass", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.CAUSE_CLASS, new org.apache.thrift.meta_data.FieldMetaData("causeClass", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.CAUSE_MESSAGE, new org.apache.thrift.meta_data.FieldMetaData("causeMessage", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(AttributeError.class, metaDataMap);
+  }
+
+  public AttributeError() {
+  }
+
+  public AttributeError(
+    ErrorKind kind,
+    java.lang.String exceptionClass,
+    java.lang.String causeClass,
+    java.lang.String causeMessage)
+  {
+    this();
+    this.kind = kind;
+    this.exceptionClass = exceptionClass;
+    this.causeClass = causeClass;
+    this.causeMessage = causeMessage;
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public AttributeError(AttributeError other) {
+    if (other.isSetKind()) {
+      this.kind = other.kind;
+    }
+    if (other.isSetExceptionClass()) {
+      this.exceptionClass = other.exceptionClass;
+    }
+    if (other.isSetCauseClass()) {
+      this.causeClass = other.causeClass;
+    }
+    if (other.isSetCauseMessage()) {
+      this.causeMessage = other.causeMessage;
+    }
+  }
+
+  @Override
+  public AttributeError deepCopy() {
+    return new AttributeError(this);
+  }
+
+  @Override
+  public void clear() {
+    this.kind = null;
+    this.exceptionClass = null;
+    this.causeClass = null;
+    this.causeMessage = null;
+  }
+
+  /**
+   * 
+   * @see ErrorKind
+   */
+  @org.apache.thrift.annotation.Nullable
+  public ErrorKind getKind() {
+    return this.kind;
+  }
+
+  /**
+   * 
+   * @see ErrorKind
+   */
+  public AttributeError setKind(@org.apache.thrift.annotation.Nullable ErrorKind kind) {
+    this.kind = kind;
+    return this;
+  }
+
+  public void unsetKind() {
+    this.kind = null;
+  }
+
+  /** Returns true if field kind is set (has been assigned a value) and false otherwise */
+  public boolean isSetKind() {
+    return this.kind != null;
+  }
+
+  public void setKindIsSet(boolean value) {
+    if (!value) {
+      this.kind = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getExceptionClass() {
+    return this.exceptionClass;
+  }
+
+  public AttributeError setExceptionClass(@org.apache.thrift.annotation.Nullable java.lang.String exceptionClass) {
+    this.exceptionClass = exceptionClass;
+    return this;
+  }
+
+  public void unsetExceptionClass() {
+    this.exceptionClass = null;
+  }
+
+  /** Returns true if field exceptionClass is set (has been assigned a value) and false otherwise */
+  public boolean isSetExceptionClass() {
+    return this.exceptionClass != null;
+  }
+
+  public void setExceptionClassIsSet(boolean value) {
+    if (!value) {
+      this.exceptionClass = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getCauseClass() {
+    return this.causeClass;
+  }
+
+  public AttributeError setCauseClass(@org.apache.thrift.annotation.Nullable java.lang.String causeClass) {
+    this.causeClass = causeClass;
+    return this;
+  }
+
+  public void unsetCauseClass() {
+    this.causeClass = null;
+  }
+
+  /** Returns true if field causeClass is set (has been assigned a value) and false otherwise */
+  public boolean isSetCauseClass() {
+    return this.causeClass != null;
+  }
+
+  public void setCauseClassIsSet(boolean value) {
+    if (!value) {
+      this.causeClass = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getCauseMessage() {
+    return this.causeMessage;
+  }
+
+  public AttributeError setCauseMessage(@org.apache.thrift.annotation.Nullable java.lang.String causeMessage) {
+    this.causeMessage = causeMessage;
+    return this;
+  }
+
+  public void unsetCauseMessage() {
+    this.causeMessage = null;
+  }
+
+  /** Returns true if field causeMessage is set (has been assigned a value) and false otherwise */
+  public boolean isSetCauseMessage() {
+    return this.causeMessage != null;
+  }
+
+  public void setCauseMessageIsSet(boolean value) {
+    if (!value) {
+      this.causeMessage = null;
+    }
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case KIND:
+      if (value == null) {
+        unsetKind();
+      } else {
+        setKind((ErrorKind)value);
+      }
+      break;
+
+    case EXCEPTION_CLASS:
+      if (value == null) {
+        unsetExceptionClass();
+      } else {
+        setExceptionClass((java.lang.String)value);
+      }
+      break;
+
+    case CAUSE_CLASS:
+      if (value == null) {
+        unsetCauseClass();
+      } else {
+        setCauseClass((java.lang.String)value);
+      }
+      break;
+
+    case CAUSE_MESSAGE:
+      if (value == null) {
+        unsetCauseMessage();
+      } else {
+        setCauseMessage((java.lang.String)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case KIND:
+      return getKind();
+
+    case EXCEPTION_CLASS:
+      return getExceptionClass();
+
+    case CAUSE_CLASS:
+      return getCauseClass();
+
+    case CAUSE_MESSAGE:
+      return getCauseMessage();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case KIND:
+      return isSetKind();
+    case EXCEPTION_CLASS:
+      return isSetExceptionClass();
+    case CAUSE_CLASS:
+      return isSetCauseClass();
+    case CAUSE_MESSAGE:
+      return isSetCauseMessage();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof AttributeError)
+      return this.equals((AttributeError)that);
+    return false;
+  }
+
+  public boolean equals(AttributeError that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_kind = true && this.isSetKind();
+    boolean that_present_kind = true && that.isSetKind();
+    if (this_present_kind || that_present_kind) {
+      if (!(this_present_kind && that_present_kind))
+        return false;
+      if (!this.kind.equals(that.kind))
+        return false;
+    }
+
+    boolean this_present_exceptionClass = true && this.isSetExceptionClass();
+    boolean that_present_exceptionClass = true && that.isSetExceptionClass();
+    if (this_present_exceptionClass || that_present_exceptionClass) {
+      if (!(this_present_exceptionClass && that_present_exceptionClass))
+        return false;
+      if (!this.exceptionClass.equals(that.exceptionClass))
+        return false;
+    }
+
+    boolean this_present_causeClass = true && this.isSetCauseClass();
+    boolean that_present_causeClass = true && that.isSetCauseClass();
+    if (this_present_causeClass || that_present_causeClass) {
+      if (!(this_present_causeClass && that_present_causeClass))
+        return false;
+      if (!this.causeClass.equals(that.causeClass))
+        return false;
+    }
+
+    boolean this_present_causeMessage = true && this.isSetCauseMessage();
+    boolean that_present_causeMessage = true && that.isSetCauseMessage();
+    if (this_present_causeMessage || that_present_causeMessage) {
+      if (!(this_present_causeMessage && that_present_causeMessage))
+        return false;
+      if (!this.causeMessage.equals(that.causeMessage))
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((isSetKind()) ? 131071 : 524287);
+    if (isSetKind())
+      hashCode = hashCode * 8191 + kind.getValue();
+
+    hashCode = hashCode * 8191 + ((isSetExceptionClass()) ? 131071 : 524287);
+    if (isSetExceptionClass())
+      hashCode = hashCode * 8191 + exceptionClass.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetCauseClass()) ? 131071 : 524287);
+    if (isSetCauseClass())
+      hashCode = hashCode * 8191 + causeClass.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetCauseMessage()) ? 131071 : 524287);
+    if (isSetCauseMessage())
+      hashCode = hashCode * 8191 + causeMessage.hashCode();
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(AttributeError other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetKind(), other.isSetKind());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetKind()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.kind, other.kind);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetExceptionClass(), other.isSetExceptionClass());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetExceptionClass()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.exceptionClass, other.exceptionClass);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetCauseClass(), other.isSetCauseClass());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetCauseClass()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.causeClass, other.causeClass);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetCauseMessage(), other.isSetCauseMessage());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetCauseMessage()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.causeMessage, other.causeMessage);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("AttributeError(");
+    boolean first = true;
+
+    sb.append("kind:");
+    if (this.kind == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.kind);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("exceptionClass:");
+    if (this.exceptionClass == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.exceptionClass);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("causeClass:");
+    if (this.causeClass == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.causeClass);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("causeMessage:");
+    if (this.causeMessage == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.causeMessage);
+    }
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class AttributeErrorStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public AttributeErrorStandardScheme getScheme() {
+      return new AttributeErrorStandardScheme();
+    }
+  }
+
+  private static class AttributeErrorStandardScheme extends org.apache.thrift.scheme.StandardScheme<AttributeError> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, AttributeError struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // KIND
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.kind = org.newrelic.nrjmx.v2.nrprotocol.ErrorKind.findByValue(iprot.readI32());
+              struct.setKindIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // EXCEPTION_CLASS
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.exceptionClass = iprot.readString();
+              struct.setExceptionClassIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // CAUSE_CLASS
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.causeClass = iprot.readString();
+              struct.setCauseClassIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // CAUSE_MESSAGE
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.causeMessage = iprot.readString();
+              struct.setCauseMessageIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, AttributeError struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      if (struct.kind != null) {
+        oprot.writeFieldBegin(KIND_FIELD_DESC);
+        oprot.writeI32(struct.kind.getValue());
+        oprot.writeFieldEnd();
+      }
+      if (struct.exceptionClass != null) {
+        oprot.writeFieldBegin(EXCEPTION_CLASS_FIELD_DESC);
+        oprot.writeString(struct.exceptionClass);
+        oprot.writeFieldEnd();
+      }
+      if (struct.causeClass != null) {
+        oprot.writeFieldBegin(CAUSE_CLASS_FIELD_DESC);
+        oprot.writeString(struct.causeClass);
+        oprot.writeFieldEnd();
+      }
+      if (struct.causeMessage != null) {
+        oprot.writeFieldBegin(CAUSE_MESSAGE_FIELD_DESC);
+        oprot.writeString(struct.causeMessage);
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class AttributeErrorTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public AttributeErrorTupleScheme getScheme() {
+      return new AttributeErrorTupleScheme();
+    }
+  }
+
+  private static class AttributeErrorTupleScheme extends org.apache.thrift.scheme.TupleScheme<AttributeError> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, AttributeError struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetKind()) {
+        optionals.set(0);
+      }
+      if (struct.isSetExceptionClass()) {
+        optionals.set(1);
+      }
+      if (struct.isSetCauseClass()) {
+        optionals.set(2);
+      }
+      if (struct.isSetCauseMessage()) {
+        optionals.set(3);
+      }
+      oprot.writeBitSet(optionals, 4);
+      if (struct.isSetKind()) {
+        oprot.writeI32(struct.kind.getValue());
+      }
+      if (struct.isSetExceptionClass()) {
+        oprot.writeString(struct.exceptionClass);
+      }
+      if (struct.isSetCauseClass()) {
+        oprot.writeString(struct.causeClass);
+      }
+      if (struct.isSetCauseMessage()) {
+        oprot.writeString(struct.causeMessage);
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, AttributeError struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(4);
+      if (incoming.get(0)) {
+        struct.kind = org.newrelic.nrjmx.v2.nrprotocol.ErrorKind.findByValue(iprot.readI32());
+        struct.setKindIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.exceptionClass = iprot.readString();
+        struct.setExceptionClassIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.causeClass = iprot.readString();
+        struct.setCauseClassIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.causeMessage = iprot.readString();
+        struct.setCauseMessageIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
@@ -17,6 +17,7 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
   private static final org.apache.thrift.protocol.TField DOUBLE_VALUE_FIELD_DESC = new org.apache.thrift.protocol.TField("doubleValue", org.apache.thrift.protocol.TType.DOUBLE, (short)5);
   private static final org.apache.thrift.protocol.TField INT_VALUE_FIELD_DESC = new org.apache.thrift.protocol.TField("intValue", org.apache.thrift.protocol.TType.I64, (short)6);
   private static final org.apache.thrift.protocol.TField BOOL_VALUE_FIELD_DESC = new org.apache.thrift.protocol.TField("boolValue", org.apache.thrift.protocol.TType.BOOL, (short)7);
+  private static final org.apache.thrift.protocol.TField ERROR_FIELD_DESC = new org.apache.thrift.protocol.TField("error", org.apache.thrift.protocol.TType.STRUCT, (short)8);
 
   private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new AttributeResponseStandardSchemeFactory();
   private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new AttributeResponseTupleSchemeFactory();
@@ -32,6 +33,7 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
   public double doubleValue; // required
   public long intValue; // required
   public boolean boolValue; // required
+  public @org.apache.thrift.annotation.Nullable AttributeError error; // optional
 
   /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
   public enum _Fields implements org.apache.thrift.TFieldIdEnum {
@@ -45,7 +47,8 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
     STRING_VALUE((short)4, "stringValue"),
     DOUBLE_VALUE((short)5, "doubleValue"),
     INT_VALUE((short)6, "intValue"),
-    BOOL_VALUE((short)7, "boolValue");
+    BOOL_VALUE((short)7, "boolValue"),
+    ERROR((short)8, "error");
 
     private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
 
@@ -75,6 +78,8 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
           return INT_VALUE;
         case 7: // BOOL_VALUE
           return BOOL_VALUE;
+        case 8: // ERROR
+          return ERROR;
         default:
           return null;
       }
@@ -122,6 +127,7 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
   private static final int __INTVALUE_ISSET_ID = 1;
   private static final int __BOOLVALUE_ISSET_ID = 2;
   private byte __isset_bitfield = 0;
+  private static final _Fields optionals[] = {_Fields.ERROR};
   public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
   static {
     java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
@@ -139,6 +145,8 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
     tmpMap.put(_Fields.BOOL_VALUE, new org.apache.thrift.meta_data.FieldMetaData("boolValue", org.apache.thrift.TFieldRequirementType.DEFAULT, 
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.ERROR, new org.apache.thrift.meta_data.FieldMetaData("error", org.apache.thrift.TFieldRequirementType.OPTIONAL, 
+        new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, AttributeError.class)));
     metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
     org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(AttributeResponse.class, metaDataMap);
   }
@@ -188,6 +196,9 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
     this.doubleValue = other.doubleValue;
     this.intValue = other.intValue;
     this.boolValue = other.boolValue;
+    if (other.isSetError()) {
+      this.error = new AttributeError(other.error);
+    }
   }
 
   @Override
@@ -207,6 +218,7 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
     this.intValue = 0;
     setBoolValueIsSet(false);
     this.boolValue = false;
+    this.error = null;
   }
 
   @org.apache.thrift.annotation.Nullable
@@ -386,6 +398,31 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
     __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __BOOLVALUE_ISSET_ID, value);
   }
 
+  @org.apache.thrift.annotation.Nullable
+  public AttributeError getError() {
+    return this.error;
+  }
+
+  public AttributeResponse setError(@org.apache.thrift.annotation.Nullable AttributeError error) {
+    this.error = error;
+    return this;
+  }
+
+  public void unsetError() {
+    this.error = null;
+  }
+
+  /** Returns true if field error is set (has been assigned a value) and false otherwise */
+  public boolean isSetError() {
+    return this.error != null;
+  }
+
+  public void setErrorIsSet(boolean value) {
+    if (!value) {
+      this.error = null;
+    }
+  }
+
   @Override
   public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
     switch (field) {
@@ -445,6 +482,14 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
       }
       break;
 
+    case ERROR:
+      if (value == null) {
+        unsetError();
+      } else {
+        setError((AttributeError)value);
+      }
+      break;
+
     }
   }
 
@@ -473,6 +518,9 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
     case BOOL_VALUE:
       return isBoolValue();
 
+    case ERROR:
+      return getError();
+
     }
     throw new java.lang.IllegalStateException();
   }
@@ -499,6 +547,8 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
       return isSetIntValue();
     case BOOL_VALUE:
       return isSetBoolValue();
+    case ERROR:
+      return isSetError();
     }
     throw new java.lang.IllegalStateException();
   }
@@ -579,6 +629,15 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
         return false;
     }
 
+    boolean this_present_error = true && this.isSetError();
+    boolean that_present_error = true && that.isSetError();
+    if (this_present_error || that_present_error) {
+      if (!(this_present_error && that_present_error))
+        return false;
+      if (!this.error.equals(that.error))
+        return false;
+    }
+
     return true;
   }
 
@@ -608,6 +667,10 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
 
     hashCode = hashCode * 8191 + ((boolValue) ? 131071 : 524287);
 
+    hashCode = hashCode * 8191 + ((isSetError()) ? 131071 : 524287);
+    if (isSetError())
+      hashCode = hashCode * 8191 + error.hashCode();
+
     return hashCode;
   }
 
@@ -689,6 +752,16 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
         return lastComparison;
       }
     }
+    lastComparison = java.lang.Boolean.compare(isSetError(), other.isSetError());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetError()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.error, other.error);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
     return 0;
   }
 
@@ -756,6 +829,16 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
     sb.append("boolValue:");
     sb.append(this.boolValue);
     first = false;
+    if (isSetError()) {
+      if (!first) sb.append(", ");
+      sb.append("error:");
+      if (this.error == null) {
+        sb.append("null");
+      } else {
+        sb.append(this.error);
+      }
+      first = false;
+    }
     sb.append(")");
     return sb.toString();
   }
@@ -763,6 +846,9 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
   public void validate() throws org.apache.thrift.TException {
     // check for required fields
     // check for sub-struct validity
+    if (error != null) {
+      error.validate();
+    }
   }
 
   private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
@@ -859,6 +945,15 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
               org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
             }
             break;
+          case 8: // ERROR
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRUCT) {
+              struct.error = new AttributeError();
+              struct.error.read(iprot);
+              struct.setErrorIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
           default:
             org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
         }
@@ -904,6 +999,13 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
       oprot.writeFieldBegin(BOOL_VALUE_FIELD_DESC);
       oprot.writeBool(struct.boolValue);
       oprot.writeFieldEnd();
+      if (struct.error != null) {
+        if (struct.isSetError()) {
+          oprot.writeFieldBegin(ERROR_FIELD_DESC);
+          struct.error.write(oprot);
+          oprot.writeFieldEnd();
+        }
+      }
       oprot.writeFieldStop();
       oprot.writeStructEnd();
     }
@@ -944,7 +1046,10 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
       if (struct.isSetBoolValue()) {
         optionals.set(6);
       }
-      oprot.writeBitSet(optionals, 7);
+      if (struct.isSetError()) {
+        optionals.set(7);
+      }
+      oprot.writeBitSet(optionals, 8);
       if (struct.isSetStatusMsg()) {
         oprot.writeString(struct.statusMsg);
       }
@@ -966,12 +1071,15 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
       if (struct.isSetBoolValue()) {
         oprot.writeBool(struct.boolValue);
       }
+      if (struct.isSetError()) {
+        struct.error.write(oprot);
+      }
     }
 
     @Override
     public void read(org.apache.thrift.protocol.TProtocol prot, AttributeResponse struct) throws org.apache.thrift.TException {
       org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
-      java.util.BitSet incoming = iprot.readBitSet(7);
+      java.util.BitSet incoming = iprot.readBitSet(8);
       if (incoming.get(0)) {
         struct.statusMsg = iprot.readString();
         struct.setStatusMsgIsSet(true);
@@ -1000,6 +1108,11 @@ public class AttributeResponse implements org.apache.thrift.TBase<AttributeRespo
         struct.boolValue = iprot.readBool();
         struct.setBoolValueIsSet(true);
       }
+      if (incoming.get(7)) {
+        struct.error = new AttributeError();
+        struct.error.read(iprot);
+        struct.setErrorIsSet(true);
+      }
     }
   }
 
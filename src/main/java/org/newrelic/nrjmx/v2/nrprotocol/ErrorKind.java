@@ -0,0 +1,67 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+
+public enum ErrorKind implements org.apache.thrift.TEnum {
+  UNKNOWN(1),
+  ATTRIBUTE_NOT_FOUND(2),
+  MBEAN_NOT_FOUND(3),
+  UNSUPPORTED_OPERATION(4),
+  UNSUPPORTED_TYPE(5),
+  SERIALIZATION(6),
+  SECURITY(7),
+  NULL_VALUE(8),
+  GETTER_EXCEPTION(9),
+  NOT_RETURNED(10);
+
+  private final int value;
+
+  private ErrorKind(int value) {
+    this.value = value;
+  }
+
+  /**
+   * Get the integer value of this enum value, as defined in the Thrift IDL.
+   */
+  @Override
+  public int getValue() {
+    return value;
+  }
+
+  /**
+   * Find a the enum type by its integer value, as defined in the Thrift IDL.
+   * @return null if the value is not found.
+   */
+  @org.apache.thrift.annotation.Nullable
+  public static ErrorKind findByValue(int value) { 
+    switch (value) {
+      case 1:
+        return UNKNOWN;
+      case 2:
+        return ATTRIBUTE_NOT_FOUND;
+      case 3:
+        return MBEAN_NOT_FOUND;
+      case 4:
+        return UNSUPPORTED_OPERATION;
+      case 5:
+        return UNSUPPORTED_TYPE;
+      case 6:
+        return SERIALIZATION;
+      case 7:
+        return SECURITY;
+      case 8:
+        return NULL_VALUE;
+      case 9:
+        return GETTER_EXCEPTION;
+      case 10:
+        return NOT_RETURNED;
+      default:
+        return null;
+    }
+  }
+}
@@ -0,0 +1,1085 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class InternalMetric implements org.apache.thrift.TBase<InternalMetric, InternalMetric._Fields>, java.io.Serializable, Cloneable, Comparable<InternalMetric> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("InternalMetric");
+
+  private static final org.apache.thrift.protocol.TField STAT_TYPE_FIELD_DESC = new org.apache.thrift.protocol.TField("statType", org.apache.thrift.protocol.TType.STRING, (short)1);
+  private static final org.apache.thrift.protocol.TField COUNT_FIELD_DESC = new org.apache.thrift.protocol.TField("count", org.apache.thrift.protocol.TType.I64, (short)2);
+  private static final org.apache.thrift.protocol.TField ERRORS_FIELD_DESC = new org.apache.thrift.protocol.TField("errors", org.apache.thrift.protocol.TType.I64, (short)3);
+  private static final org.apache.thrift.protocol.TField TOTAL_MS_FIELD_DESC = new org.apache.thrift.protocol.TField("totalMs", org.apache.thrift.protocol.TType.DOUBLE, (short)4);
+  private static final org.apache.thrift.protocol.TField MAX_MS_FIELD_DESC = new org.apache.thrift.protocol.TField("maxMs", org.apache.thrift.protocol.TType.DOUBLE, (short)5);
+  private static final org.apache.thrift.protocol.TField BUCKET_BOUNDS_MS_FIELD_DESC = new org.apache.thrift.protocol.TField("bucketBoundsMs", org.apache.thrift.protocol.TType.LIST, (short)6);
+  private static final org.apache.thrift.protocol.TField BUCKET_COUNTS_FIELD_DESC = new org.apache.thrift.protocol.TField("bucketCounts", org.apache.thrift.protocol.TType.LIST, (short)7);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new InternalMetricStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new InternalMetricTupleSchemeFactory();
+
+  public @org.apache.thrift.annotation.Nullable java.lang.String statType; // required
+  public long count; // required
+  public long errors; // required
+  public double totalMs; // required
+  public double maxMs; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<java.lang.Double> bucketBoundsMs; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<java.lang.Long> bucketCounts; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    STAT_TYPE((short)1, "statType"),
+    COUNT((short)2, "count"),
+    ERRORS((short)3, "errors"),
+    TOTAL_MS((short)4, "totalMs"),
+    MAX_MS((short)5, "maxMs"),
+    BUCKET_BOUNDS_MS((short)6, "bucketBoundsMs"),
+    BUCKET_COUNTS((short)7, "bucketCounts");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // STAT_TYPE
+          return STAT_TYPE;
+        case 2: // COUNT
+          return COUNT;
+        case 3: // ERRORS
+          return ERRORS;
+        case 4: // TOTAL_MS
+          return TOTAL_MS;
+        case 5: // MAX_MS
+          return MAX_MS;
+        case 6: // BUCKET_BOUNDS_MS
+          return BUCKET_BOUNDS_MS;
+        case 7: // BUCKET_COUNTS
+          return BUCKET_COUNTS;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __COUNT_ISSET_ID = 0;
+  private static final int __ERRORS_ISSET_ID = 1;
+  private static final int __TOTALMS_ISSET_ID = 2;
+  private static final int __MAXMS_ISSET_ID = 3;
+  private byte __isset_bitfield = 0;
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.STAT_TYPE, new org.apache.thrift.meta_data.FieldMetaData("statType", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.COUNT, new org.apache.thrift.meta_data.FieldMetaData("count", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.ERRORS, new org.apache.thrift.meta_data.FieldMetaData("errors", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.TOTAL_MS, new org.apache.thrift.meta_data.FieldMetaData("totalMs", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.DOUBLE)));
+    tmpMap.put(_Fields.MAX_MS, new org.apache.thrift.meta_data.FieldMetaData("maxMs", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.DOUBLE)));
+    tmpMap.put(_Fields.BUCKET_BOUNDS_MS, new org.apache.thrift.meta_data.FieldMetaData("bucketBoundsMs", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.DOUBLE))));
+    tmpMap.put(_Fields.BUCKET_COUNTS, new org.apache.thrift.meta_data.FieldMetaData("bucketCounts", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64))));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(InternalMetric.class, metaDataMap);
+  }
+
+  public InternalMetric() {
+  }
+
+  public InternalMetric(
+    java.lang.String statType,
+    long count,
+    long errors,
+    double totalMs,
+    double maxMs,
+    java.util.List<java.lang.Double> bucketBoundsMs,
+    java.util.List<java.lang.Long> bucketCounts)
+  {
+    this();
+    this.statType = statType;
+    this.count = count;
+    setCountIsSet(true);
+    this.errors = errors;
+    setErrorsIsSet(true);
+    this.totalMs = totalMs;
+    setTotalMsIsSet(true);
+    this.maxMs = maxMs;
+    setMaxMsIsSet(true);
+    this.bucketBoundsMs = bucketBoundsMs;
+    this.bucketCounts = bucketCounts;
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public InternalMetric(InternalMetric other) {
+    __isset_bitfield = other.__isset_bitfield;
+    if (other.isSetStatType()) {
+      this.statType = other.statType;
+    }
+    this.count = other.count;
+    this.errors = other.errors;
+    this.totalMs = other.totalMs;
+    this.maxMs = other.maxMs;
+    if (other.isSetBucketBoundsMs()) {
+      java.util.List<java.lang.Double> __this__bucketBoundsMs = new java.util.ArrayList<java.lang.Double>(other.bucketBoundsMs);
+      this.bucketBoundsMs = __this__bucketBoundsMs;
+    }
+    if (other.isSetBucketCounts()) {
+      java.util.List<java.lang.Long> __this__bucketCounts = new java.util.ArrayList<java.lang.Long>(other.bucketCounts);
+      this.bucketCounts = __this__bucketCounts;
+    }
+  }
+
+  @Override
+  public InternalMetric deepCopy() {
+    return new InternalMetric(this);
+  }
+
+  @Override
+  public void clear() {
+    this.statType = null;
+    setCountIsSet(false);
+    this.count = 0;
+    setErrorsIsSet(false);
+    this.errors = 0;
+    setTotalMsIsSet(false);
+    this.totalMs = 0.0;
+    setMaxMsIsSet(false);
+    this.maxMs = 0.0;
+    this.bucketBoundsMs = null;
+    this.bucketCounts = null;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getStatType() {
+    return this.statType;
+  }
+
+  public InternalMetric setStatType(@org.apache.thrift.annotation.Nullable java.lang.String statType) {
+    this.statType = statType;
+    return this;
+  }
+
+  public void unsetStatType() {
+    this.statType = null;
+  }
+
+  /** Returns true if field statType is set (has been assigned a value) and false otherwise */
+  public boolean isSetStatType() {
+    return this.statType != null;
+  }
+
+  public void setStatTypeIsSet(boolean value) {
+    if (!value) {
+      this.statType = null;
+    }
+  }
+
+  public long getCount() {
+    return this.count;
+  }
+
+  public InternalMetric setCount(long count) {
+    this.count = count;
+    setCountIsSet(true);
+    return this;
+  }
+
+  public void unsetCount() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __COUNT_ISSET_ID);
+  }
+
+  /** Returns true if field count is set (has been assigned a value) and false otherwise */
+  public boolean isSetCount() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __COUNT_ISSET_ID);
+  }
+
+  public void setCountIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __COUNT_ISSET_ID, value);
+  }
+
+  public long getErrors() {
+    return this.errors;
+  }
+
+  public InternalMetric setErrors(long errors) {
+    this.errors = errors;
+    setErrorsIsSet(true);
+    return this;
+  }
+
+  public void unsetErrors() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __ERRORS_ISSET_ID);
+  }
+
+  /** Returns true if field errors is set (has been assigned a value) and false otherwise */
+  public boolean isSetErrors() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __ERRORS_ISSET_ID);
+  }
+
+  public void setErrorsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __ERRORS_ISSET_ID, value);
+  }
+
+  public double getTotalMs() {
+    return this.totalMs;
+  }
+
+  public InternalMetric setTotalMs(double totalMs) {
+    this.totalMs = totalMs;
+    setTotalMsIsSet(true);
+    return this;
+  }
+
+  public void unsetTotalMs() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __TOTALMS_ISSET_ID);
+  }
+
+  /** Returns true if field totalMs is set (has been assigned a value) and false otherwise */
+  public boolean isSetTotalMs() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __TOTALMS_ISSET_ID);
+  }
+
+  public void setTotalMsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __TOTALMS_ISSET_ID, value);
+  }
+
+  public double getMaxMs() {
+    return this.maxMs;
+  }
+
+  public InternalMetric setMaxMs(double maxMs) {
+    this.maxMs = maxMs;
+    setMaxMsIsSet(true);
+    return this;
+  }
+
+  public void unsetMaxMs() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __MAXMS_ISSET_ID);
+  }
+
+  /** Returns true if field maxMs is set (has been assigned a value) and false otherwise */
+  public boolean isSetMaxMs() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __MAXMS_ISSET_ID);
+  }
+
+  public void setMaxMsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXMS_ISSET_ID, value);
+  }
+
+  public int getBucketBoundsMsSize() {
+    return (this.bucketBoundsMs == null) ? 0 : this.bucketBoundsMs.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<java.lang.Double> getBucketBoundsMsIterator() {
+    return (this.bucketBoundsMs == null) ? null : this.bucketBoundsMs.iterator();
+  }
+
+  public void addToBucketBoundsMs(double elem) {
+    if (this.bucketBoundsMs == null) {
+      this.bucketBoundsMs = new java.util.ArrayList<java.lang.Double>();
+    }
+    this.bucketBoundsMs.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<java.lang.Double> getBucketBoundsMs() {
+    return this.bucketBoundsMs;
+  }
+
+  public InternalMetric setBucketBoundsMs(@org.apache.thrift.annotation.Nullable java.util.List<java.lang.Double> bucketBoundsMs) {
+    this.bucketBoundsMs = bucketBoundsMs;
+    return this;
+  }
+
+  public void unsetBucketBoundsMs() {
+    this.bucketBoundsMs = null;
+  }
+
+  /** Returns true if field bucketBoundsMs is set (has been assigned a value) and false otherwise */
+  public boolean isSetBucketBoundsMs() {
+    return this.bucketBoundsMs != null;
+  }
+
+  public void setBucketBoundsMsIsSet(boolean value) {
+    if (!value) {
+      this.bucketBoundsMs = null;
+    }
+  }
+
+  public int getBucketCountsSize() {
+    return (this.bucketCounts == null) ? 0 : this.bucketCounts.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<java.lang.Long> getBucketCountsIterator() {
+    return (this.bucketCounts == null) ? null : this.bucketCounts.iterator();
+  }
+
+  public void addToBucketCounts(long elem) {
+    if (this.bucketCounts == null) {
+      this.bucketCounts = new java.util.ArrayList<java.lang.Long>();
+    }
+    this.bucketCounts.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<java.lang.Long> getBucketCounts() {
+    return this.bucketCounts;
+  }
+
+  public InternalMetric setBucketCounts(@org.apache.thrift.annotation.Nullable java.util.List<java.lang.Long> bucketCounts) {
+    this.bucketCounts = bucketCounts;
+    return this;
+  }
+
+  public void unsetBucketCounts() {
+    this.bucketCounts = null;
+  }
+
+  /** Returns true if field bucketCounts is set (has been assigned a value) and false otherwise */
+  public boolean isSetBucketCounts() {
+    return this.bucketCounts != null;
+  }
+
+  public void setBucketCountsIsSet(boolean value) {
+    if (!value) {
+      this.bucketCounts = null;
+    }
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case STAT_TYPE:
+      if (value == null) {
+        unsetStatType();
+      } else {
+        setStatType((java.lang.String)value);
+      }
+      break;
+
+    case COUNT:
+      if (value == null) {
+        unsetCount();
+      } else {
+        setCount((java.lang.Long)value);
+      }
+      break;
+
+    case ERRORS:
+      if (value == null) {
+        unsetErrors();
+      } else {
+        setErrors((java.lang.Long)value);
+      }
+      break;
+
+    case TOTAL_MS:
+      if (value == null) {
+        unsetTotalMs();
+      } else {
+        setTotalMs((java.lang.Double)value);
+      }
+      break;
+
+    case MAX_MS:
+      if (value == null) {
+        unsetMaxMs();
+      } else {
+        setMaxMs((java.lang.Double)value);
+      }
+      break;
+
+    case BUCKET_BOUNDS_MS:
+      if (value == null) {
+        unsetBucketBoundsMs();
+      } else {
+        setBucketBoundsMs((java.util.List<java.lang.Double>)value);
+      }
+      break;
+
+    case BUCKET_COUNTS:
+      if (value == null) {
+        unsetBucketCounts();
+      } else {
+        setBucketCounts((java.util.List<java.lang.Long>)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case STAT_TYPE:
+      return getStatType();
+
+    case COUNT:
+      return getCount();
+
+    case ERRORS:
+      return getErrors();
+
+    case TOTAL_MS:
+      return getTotalMs();
+
+    case MAX_MS:
+      return getMaxMs();
+
+    case BUCKET_BOUNDS_MS:
+      return getBucketBoundsMs();
+
+    case BUCKET_COUNTS:
+      return getBucketCounts();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case STAT_TYPE:
+      return isSetStatType();
+    case COUNT:
+      return isSetCount();
+    case ERRORS:
+      return isSetErrors();
+    case TOTAL_MS:
+      return isSetTotalMs();
+    case MAX_MS:
+      return isSetMaxMs();
+    case BUCKET_BOUNDS_MS:
+      return isSetBucketBoundsMs();
+    case BUCKET_COUNTS:
+      return isSetBucketCounts();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof InternalMetric)
+      return this.equals((InternalMetric)that);
+    return false;
+  }
+
+  public boolean equals(InternalMetric that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_statType = true && this.isSetStatType();
+    boolean that_present_statType = true && that.isSetStatType();
+    if (this_present_statType || that_present_statType) {
+      if (!(this_present_statType && that_present_statType))
+        return false;
+      if (!this.statType.equals(that.statType))
+        return false;
+    }
+
+    boolean this_present_count = true;
+    boolean that_present_count = true;
+    if (this_present_count || that_present_count) {
+      if (!(this_present_count && that_present_count))
+        return false;
+      if (this.count != that.count)
+        return false;
+    }
+
+    boolean this_present_errors = true;
+    boolean that_present_errors = true;
+    if (this_present_errors || that_present_errors) {
+      if (!(this_present_errors && that_present_errors))
+        return false;
+      if (this.errors != that.errors)
+        return false;
+    }
+
+    boolean this_present_totalMs = true;
+    boolean that_present_totalMs = true;
+    if (this_present_totalMs || that_present_totalMs) {
+      if (!(this_present_totalMs && that_present_totalMs))
+        return false;
+      if (this.totalMs != that.totalMs)
+        return false;
+    }
+
+    boolean this_present_maxMs = true;
+    boolean that_present_maxMs = true;
+    if (this_present_maxMs || that_present_maxMs) {
+      if (!(this_present_maxMs && that_present_maxMs))
+        return false;
+      if (this.maxMs != that.maxMs)
+        return false;
+    }
+
+    boolean this_present_bucketBoundsMs = true && this.isSetBucketBoundsMs();
+    boolean that_present_bucketBoundsMs = true && that.isSetBucketBoundsMs();
+    if (this_present_bucketBoundsMs || that_present_bucketBoundsMs) {
+      if (!(this_present_bucketBoundsMs && that_present_bucketBoundsMs))
+        return false;
+      if (!this.bucketBoundsMs.equals(that.bucketBoundsMs))
+        return false;
+    }
+
+    boolean this_present_bucketCounts = true && this.isSetBucketCounts();
+    boolean that_present_bucketCounts = true && that.isSetBucketCounts();
+    if (this_present_bucketCounts || that_present_bucketCounts) {
+      if (!(this_present_bucketCounts && that_present_bucketCounts))
+        return false;
+      if (!this.bucketCounts.equals(that.bucketCounts))
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((isSetStatType()) ? 131071 : 524287);
+    if (isSetStatType())
+      hashCode = hashCode * 8191 + statType.hashCode();
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(count);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(errors);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(totalMs);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(maxMs);
+
+    hashCode = hashCode * 8191 + ((isSetBucketBoundsMs()) ? 131071 : 524287);
+    if (isSetBucketBoundsMs())
+      hashCode = hashCode * 8191 + bucketBoundsMs.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetBucketCounts()) ? 131071 : 524287);
+    if (isSetBucketCounts())
+      hashCode = hashCode * 8191 + bucketCounts.hashCode();
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(InternalMetric other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetStatType(), other.isSetStatType());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetStatType()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.statType, other.statType);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetCount(), other.isSetCount());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetCount()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.count, other.count);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetErrors(), other.isSetErrors());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetErrors()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.errors, other.errors);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetTotalMs(), other.isSetTotalMs());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetTotalMs()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.totalMs, other.totalMs);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMaxMs(), other.isSetMaxMs());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMaxMs()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.maxMs, other.maxMs);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetBucketBoundsMs(), other.isSetBucketBoundsMs());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetBucketBoundsMs()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.bucketBoundsMs, other.bucketBoundsMs);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetBucketCounts(), other.isSetBucketCounts());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetBucketCounts()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.bucketCounts, other.bucketCounts);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("InternalMetric(");
+    boolean first = true;
+
+    sb.append("statType:");
+    if (this.statType == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.statType);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("count:");
+    sb.append(this.count);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("errors:");
+    sb.append(this.errors);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("totalMs:");
+    sb.append(this.totalMs);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("maxMs:");
+    sb.append(this.maxMs);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("bucketBoundsMs:");
+    if (this.bucketBoundsMs == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.bucketBoundsMs);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("bucketCounts:");
+    if (this.bucketCounts == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.bucketCounts);
+    }
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class InternalMetricStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public InternalMetricStandardScheme getScheme() {
+      return new InternalMetricStandardScheme();
+    }
+  }
+
+  private static class InternalMetricStandardScheme extends org.apache.thrift.scheme.StandardScheme<InternalMetric> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, InternalMetric struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // STAT_TYPE
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.statType = iprot.readString();
+              struct.setStatTypeIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // COUNT
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.count = iprot.readI64();
+              struct.setCountIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // ERRORS
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.errors = iprot.readI64();
+              struct.setErrorsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // TOTAL_MS
+            if (schemeField.type == org.apache.thrift.protocol.TType.DOUBLE) {
+              struct.totalMs = iprot.readDouble();
+              struct.setTotalMsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // MAX_MS
+            if (schemeField.type == org.apache.thrift.protocol.TType.DOUBLE) {
+              struct.maxMs = iprot.readDouble();
+              struct.setMaxMsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 6: // BUCKET_BOUNDS_MS
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list40 = iprot.readListBegin();
+                struct.bucketBoundsMs = new java.util.ArrayList<java.lang.Double>(_list40.size);
+                double _elem41;
+                for (int _i42 = 0; _i42 < _list40.size; ++_i42)
+                {
+                  _elem41 = iprot.readDouble();
+                  struct.bucketBoundsMs.add(_elem41);
+                }
+                iprot.readListEnd();
+              }
+              struct.setBucketBoundsMsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 7: // BUCKET_COUNTS
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list43 = iprot.readListBegin();
+                struct.bucketCounts = new java.util.ArrayList<java.lang.Long>(_list43.size);
+                long _elem44;
+                for (int _i45 = 0; _i45 < _list43.size; ++_i45)
+                {
+                  _elem44 = iprot.readI64();
+                  struct.bucketCounts.add(_elem44);
+                }
+                iprot.readListEnd();
+              }
+              struct.setBucketCountsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, InternalMetric struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      if (struct.statType != null) {
+        oprot.writeFieldBegin(STAT_TYPE_FIELD_DESC);
+        oprot.writeString(struct.statType);
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(COUNT_FIELD_DESC);
+      oprot.writeI64(struct.count);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(ERRORS_FIELD_DESC);
+      oprot.writeI64(struct.errors);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(TOTAL_MS_FIELD_DESC);
+      oprot.writeDouble(struct.totalMs);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(MAX_MS_FIELD_DESC);
+      oprot.writeDouble(struct.maxMs);
+      oprot.writeFieldEnd();
+      if (struct.bucketBoundsMs != null) {
+        oprot.writeFieldBegin(BUCKET_BOUNDS_MS_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.DOUBLE, struct.bucketBoundsMs.size()));
+          for (double _iter46 : struct.bucketBoundsMs)
+          {
+            oprot.writeDouble(_iter46);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      if (struct.bucketCounts != null) {
+        oprot.writeFieldBegin(BUCKET_COUNTS_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.I64, struct.bucketCounts.size()));
+          for (long _iter47 : struct.bucketCounts)
+          {
+            oprot.writeI64(_iter47);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class InternalMetricTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public InternalMetricTupleScheme getScheme() {
+      return new InternalMetricTupleScheme();
+    }
+  }
+
+  private static class InternalMetricTupleScheme extends org.apache.thrift.scheme.TupleScheme<InternalMetric> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, InternalMetric struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetStatType()) {
+        optionals.set(0);
+      }
+      if (struct.isSetCount()) {
+        optionals.set(1);
+      }
+      if (struct.isSetErrors()) {
+        optionals.set(2);
+      }
+      if (struct.isSetTotalMs()) {
+        optionals.set(3);
+      }
+      if (struct.isSetMaxMs()) {
+        optionals.set(4);
+      }
+      if (struct.isSetBucketBoundsMs()) {
+        optionals.set(5);
+      }
+      if (struct.isSetBucketCounts()) {
+        optionals.set(6);
+      }
+      oprot.writeBitSet(optionals, 7);
+      if (struct.isSetStatType()) {
+        oprot.writeString(struct.statType);
+      }
+      if (struct.isSetCount()) {
+        oprot.writeI64(struct.count);
+      }
+      if (struct.isSetErrors()) {
+        oprot.writeI64(struct.errors);
+      }
+      if (struct.isSetTotalMs()) {
+        oprot.writeDouble(struct.totalMs);
+      }
+      if (struct.isSetMaxMs()) {
+        oprot.writeDouble(struct.maxMs);
+      }
+      if (struct.isSetBucketBoundsMs()) {
+        {
+          oprot.writeI32(struct.bucketBoundsMs.size());
+          for (double _iter48 : struct.bucketBoundsMs)
+          {
+            oprot.writeDouble(_iter48);
+          }
+        }
+      }
+      if (struct.isSetBucketCounts()) {
+        {
+          oprot.writeI32(struct.bucketCounts.size());
+          for (long _iter49 : struct.bucketCounts)
+          {
+            oprot.writeI64(_iter49);
+          }
+        }
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, InternalMetric struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(7);
+      if (incoming.get(0)) {
+        struct.statType = iprot.readString();
+        struct.setStatTypeIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.count = iprot.readI64();
+        struct.setCountIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.errors = iprot.readI64();
+        struct.setErrorsIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.totalMs = iprot.readDouble();
+        struct.setTotalMsIsSet(true);
+      }
+      if (incoming.get(4)) {
+        struct.maxMs = iprot.readDouble();
+        struct.setMaxMsIsSet(true);
+      }
+      if (incoming.get(5)) {
+        {
+          org.apache.thrift.protocol.TList _list50 = iprot.readListBegin(org.apache.thrift.protocol.TType.DOUBLE);
+          struct.bucketBoundsMs = new java.util.ArrayList<java.lang.Double>(_list50.size);
+          double _elem51;
+          for (int _i52 = 0; _i52 < _list50.size; ++_i52)
+          {
+            _elem51 = iprot.readDouble();
+            struct.bucketBoundsMs.add(_elem51);
+          }
+        }
+        struct.setBucketBoundsMsIsSet(true);
+      }
+      if (incoming.get(6)) {
+        {
+          org.apache.thrift.protocol.TList _list53 = iprot.readListBegin(org.apache.thrift.protocol.TType.I64);
+          struct.bucketCounts = new java.util.ArrayList<java.lang.Long>(_list53.size);
+          long _elem54;
+          for (int _i55 = 0; _i55 < _list53.size; ++_i55)
+          {
+            _elem54 = iprot.readI64();
+            struct.bucketCounts.add(_elem54);
+          }
+        }
+        struct.setBucketCountsIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
@@ -0,0 +1,926 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class InternalMetrics implements org.apache.thrift.TBase<InternalMetrics, InternalMetrics._Fields>, java.io.Serializable, Cloneable, Comparable<InternalMetrics> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("InternalMetrics");
+
+  private static final org.apache.thrift.protocol.TField START_TIMESTAMP_FIELD_DESC = new org.apache.thrift.protocol.TField("startTimestamp", org.apache.thrift.protocol.TType.I64, (short)1);
+  private static final org.apache.thrift.protocol.TField METRICS_FIELD_DESC = new org.apache.thrift.protocol.TField("metrics", org.apache.thrift.protocol.TType.LIST, (short)2);
+  private static final org.apache.thrift.protocol.TField SAMPLES_ENABLED_FIELD_DESC = new org.apache.thrift.protocol.TField("samplesEnabled", org.apache.thrift.protocol.TType.BOOL, (short)3);
+  private static final org.apache.thrift.protocol.TField MAX_SAMPLES_FIELD_DESC = new org.apache.thrift.protocol.TField("maxSamples", org.apache.thrift.protocol.TType.I64, (short)4);
+  private static final org.apache.thrift.protocol.TField BUFFERED_SAMPLES_FIELD_DESC = new org.apache.thrift.protocol.TField("bufferedSamples", org.apache.thrift.protocol.TType.I64, (short)5);
+  private static final org.apache.thrift.protocol.TField EVICTED_SAMPLES_FIELD_DESC = new org.apache.thrift.protocol.TField("evictedSamples", org.apache.thrift.protocol.TType.I64, (short)6);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new InternalMetricsStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new InternalMetricsTupleSchemeFactory();
+
+  public long startTimestamp; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<InternalMetric> metrics; // required
+  public boolean samplesEnabled; // required
+  public long maxSamples; // required
+  public long bufferedSamples; // required
+  public long evictedSamples; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    START_TIMESTAMP((short)1, "startTimestamp"),
+    METRICS((short)2, "metrics"),
+    SAMPLES_ENABLED((short)3, "samplesEnabled"),
+    MAX_SAMPLES((short)4, "maxSamples"),
+    BUFFERED_SAMPLES((short)5, "bufferedSamples"),
+    EVICTED_SAMPLES((short)6, "evictedSamples");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // START_TIMESTAMP
+          return START_TIMESTAMP;
+        case 2: // METRICS
+          return METRICS;
+        case 3: // SAMPLES_ENABLED
+          return SAMPLES_ENABLED;
+        case 4: // MAX_SAMPLES
+          return MAX_SAMPLES;
+        case 5: // BUFFERED_SAMPLES
+          return BUFFERED_SAMPLES;
+        case 6: // EVICTED_SAMPLES
+          return EVICTED_SAMPLES;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __STARTTIMESTAMP_ISSET_ID = 0;
+  private static final int __SAMPLESENABLED_ISSET_ID = 1;
+  private static final int __MAXSAMPLES_ISSET_ID = 2;
+  private static final int __BUFFEREDSAMPLES_ISSET_ID = 3;
+  private static final int __EVICTEDSAMPLES_ISSET_ID = 4;
+  private byte __isset_bitfield = 0;
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.START_TIMESTAMP, new org.apache.thrift.meta_data.FieldMetaData("startTimestamp", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.METRICS, new org.apache.thrift.meta_data.FieldMetaData("metrics", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, InternalMetric.class))));
+    tmpMap.put(_Fields.SAMPLES_ENABLED, new org.apache.thrift.meta_data.FieldMetaData("samplesEnabled", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.MAX_SAMPLES, new org.apache.thrift.meta_data.FieldMetaData("maxSamples", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.BUFFERED_SAMPLES, new org.apache.thrift.meta_data.FieldMetaData("bufferedSamples", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.EVICTED_SAMPLES, new org.apache.thrift.meta_data.FieldMetaData("evictedSamples", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(InternalMetrics.class, metaDataMap);
+  }
+
+  public InternalMetrics() {
+  }
+
+  public InternalMetrics(
+    long startTimestamp,
+    java.util.List<InternalMetric> metrics,
+    boolean samplesEnabled,
+    long maxSamples,
+    long bufferedSamples,
+    long evictedSamples)
+  {
+    this();
+    this.startTimestamp = startTimestamp;
+    setStartTimestampIsSet(true);
+    this.metrics = metrics;
+    this.samplesEnabled = samplesEnabled;
+    setSamplesEnabledIsSet(true);
+    this.maxSamples = maxSamples;
+    setMaxSamplesIsSet(true);
+    this.bufferedSamples = bufferedSamples;
+    setBufferedSamplesIsSet(true);
+    this.evictedSamples = evictedSamples;
+    setEvictedSamplesIsSet(true);
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public InternalMetrics(InternalMetrics other) {
+    __isset_bitfield = other.__isset_bitfield;
+    this.startTimestamp = other.startTimestamp;
+    if (other.isSetMetrics()) {
+      java.util.List<InternalMetric> __this__metrics = new java.util.ArrayList<InternalMetric>(other.metrics.size());
+      for (InternalMetric other_element : other.metrics) {
+        __this__metrics.add(new InternalMetric(other_element));
+      }
+      this.metrics = __this__metrics;
+    }
+    this.samplesEnabled = other.samplesEnabled;
+    this.maxSamples = other.maxSamples;
+    this.bufferedSamples = other.bufferedSamples;
+    this.evictedSamples = other.evictedSamples;
+  }
+
+  @Override
+  public InternalMetrics deepCopy() {
+    return new InternalMetrics(this);
+  }
+
+  @Override
+  public void clear() {
+    setStartTimestampIsSet(false);
+    this.startTimestamp = 0;
+    this.metrics = null;
+    setSamplesEnabledIsSet(false);
+    this.samplesEnabled = false;
+    setMaxSamplesIsSet(false);
+    this.maxSamples = 0;
+    setBufferedSamplesIsSet(false);
+    this.bufferedSamples = 0;
+    setEvictedSamplesIsSet(false);
+    this.evictedSamples = 0;
+  }
+
+  public long getStartTimestamp() {
+    return this.startTimestamp;
+  }
+
+  public InternalMetrics setStartTimestamp(long startTimestamp) {
+    this.startTimestamp = startTimestamp;
+    setStartTimestampIsSet(true);
+    return this;
+  }
+
+  public void unsetStartTimestamp() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __STARTTIMESTAMP_ISSET_ID);
+  }
+
+  /** Returns true if field startTimestamp is set (has been assigned a value) and false otherwise */
+  public boolean isSetStartTimestamp() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __STARTTIMESTAMP_ISSET_ID);
+  }
+
+  public void setStartTimestampIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __STARTTIMESTAMP_ISSET_ID, value);
+  }
+
+  public int getMetricsSize() {
+    return (this.metrics == null) ? 0 : this.metrics.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<InternalMetric> getMetricsIterator() {
+    return (this.metrics == null) ? null : this.metrics.iterator();
+  }
+
+  public void addToMetrics(InternalMetric elem) {
+    if (this.metrics == null) {
+      this.metrics = new java.util.ArrayList<InternalMetric>();
+    }
+    this.metrics.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<InternalMetric> getMetrics() {
+    return this.metrics;
+  }
+
+  public InternalMetrics setMetrics(@org.apache.thrift.annotation.Nullable java.util.List<InternalMetric> metrics) {
+    this.metrics = metrics;
+    return this;
+  }
+
+  public void unsetMetrics() {
+    this.metrics = null;
+  }
+
+  /** Returns true if field metrics is set (has been assigned a value) and false otherwise */
+  public boolean isSetMetrics() {
+    return this.metrics != null;
+  }
+
+  public void setMetricsIsSet(boolean value) {
+    if (!value) {
+      this.metrics = null;
+    }
+  }
+
+  public boolean isSamplesEnabled() {
+    return this.samplesEnabled;
+  }
+
+  public InternalMetrics setSamplesEnabled(boolean samplesEnabled) {
+    this.samplesEnabled = samplesEnabled;
+    setSamplesEnabledIsSet(true);
+    return this;
+  }
+
+  public void unsetSamplesEnabled() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __SAMPLESENABLED_ISSET_ID);
+  }
+
+  /** Returns true if field samplesEnabled is set (has been assigned a value) and false otherwise */
+  public boolean isSetSamplesEnabled() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __SAMPLESENABLED_ISSET_ID);
+  }
+
+  public void setSamplesEnabledIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __SAMPLESENABLED_ISSET_ID, value);
+  }
+
+  public long getMaxSamples() {
+    return this.maxSamples;
+  }
+
+  public InternalMetrics setMaxSamples(long maxSamples) {
+    this.maxSamples = maxSamples;
+    setMaxSamplesIsSet(true);
+    return this;
+  }
+
+  public void unsetMaxSamples() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __MAXSAMPLES_ISSET_ID);
+  }
+
+  /** Returns true if field maxSamples is set (has been assigned a value) and false otherwise */
+  public boolean isSetMaxSamples() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __MAXSAMPLES_ISSET_ID);
+  }
+
+  public void setMaxSamplesIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXSAMPLES_ISSET_ID, value);
+  }
+
+  public long getBufferedSamples() {
+    return this.bufferedSamples;
+  }
+
+  public InternalMetrics setBufferedSamples(long bufferedSamples) {
+    this.bufferedSamples = bufferedSamples;
+    setBufferedSamplesIsSet(true);
+    return this;
+  }
+
+  public void unsetBufferedSamples() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __BUFFEREDSAMPLES_ISSET_ID);
+  }
+
+  /** Returns true if field bufferedSamples is set (has been assigned a value) and false otherwise */
+  public boolean isSetBufferedSamples() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __BUFFEREDSAMPLES_ISSET_ID);
+  }
+
+  public void setBufferedSamplesIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __BUFFEREDSAMPLES_ISSET_ID, value);
+  }
+
+  public long getEvictedSamples() {
+    return this.evictedSamples;
+  }
+
+  public InternalMetrics setEvictedSamples(long evictedSamples) {
+    this.evictedSamples = evictedSamples;
+    setEvictedSamplesIsSet(true);
+    return this;
+  }
+
+  public void unsetEvictedSamples() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __EVICTEDSAMPLES_ISSET_ID);
+  }
+
+  /** Returns true if field evictedSamples is set (has been assigned a value) and false otherwise */
+  public boolean isSetEvictedSamples() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __EVICTEDSAMPLES_ISSET_ID);
+  }
+
+  public void setEvictedSamplesIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __EVICTEDSAMPLES_ISSET_ID, value);
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case START_TIMESTAMP:
+      if (value == null) {
+        unsetStartTimestamp();
+      } else {
+        setStartTimestamp((java.lang.Long)value);
+      }
+      break;
+
+    case METRICS:
+      if (value == null) {
+        unsetMetrics();
+      } else {
+        setMetrics((java.util.List<InternalMetric>)value);
+      }
+      break;
+
+    case SAMPLES_ENABLED:
+      if (value == null) {
+        unsetSamplesEnabled();
+      } else {
+        setSamplesEnabled((java.lang.Boolean)value);
+      }
+      break;
+
+    case MAX_SAMPLES:
+      if (value == null) {
+        unsetMaxSamples();
+      } else {
+        setMaxSamples((java.lang.Long)value);
+      }
+      break;
+
+    case BUFFERED_SAMPLES:
+      if (value == null) {
+        unsetBufferedSamples();
+      } else {
+        setBufferedSamples((java.lang.Long)value);
+      }
+      break;
+
+    case EVICTED_SAMPLES:
+      if (value == null) {
+        unsetEvictedSamples();
+      } else {
+        setEvictedSamples((java.lang.Long)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case START_TIMESTAMP:
+      return getStartTimestamp();
+
+    case METRICS:
+      return getMetrics();
+
+    case SAMPLES_ENABLED:
+      return isSamplesEnabled();
+
+    case MAX_SAMPLES:
+      return getMaxSamples();
+
+    case BUFFERED_SAMPLES:
+      return getBufferedSamples();
+
+    case EVICTED_SAMPLES:
+      return getEvictedSamples();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case START_TIMESTAMP:
+      return isSetStartTimestamp();
+    case METRICS:
+      return isSetMetrics();
+    case SAMPLES_ENABLED:
+      return isSetSamplesEnabled();
+    case MAX_SAMPLES:
+      return isSetMaxSamples();
+    case BUFFERED_SAMPLES:
+      return isSetBufferedSamples();
+    case EVICTED_SAMPLES:
+      return isSetEvictedSamples();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof InternalMetrics)
+      return this.equals((InternalMetrics)that);
+    return false;
+  }
+
+  public boolean equals(InternalMetrics that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_startTimestamp = true;
+    boolean that_present_startTimestamp = true;
+    if (this_present_startTimestamp || that_present_startTimestamp) {
+      if (!(this_present_startTimestamp && that_present_startTimestamp))
+        return false;
+      if (this.startTimestamp != that.startTimestamp)
+        return false;
+    }
+
+    boolean this_present_metrics = true && this.isSetMetrics();
+    boolean that_present_metrics = true && that.isSetMetrics();
+    if (this_present_metrics || that_present_metrics) {
+      if (!(this_present_metrics && that_present_metrics))
+        return false;
+      if (!this.metrics.equals(that.metrics))
+        return false;
+    }
+
+    boolean this_present_samplesEnabled = true;
+    boolean that_present_samplesEnabled = true;
+    if (this_present_samplesEnabled || that_present_samplesEnabled) {
+      if (!(this_present_samplesEnabled && that_present_samplesEnabled))
+        return false;
+      if (this.samplesEnabled != that.samplesEnabled)
+        return false;
+    }
+
+    boolean this_present_maxSamples = true;
+    boolean that_present_maxSamples = true;
+    if (this_present_maxSamples || that_present_maxSamples) {
+      if (!(this_present_maxSamples && that_present_maxSamples))
+        return false;
+      if (this.maxSamples != that.maxSamples)
+        return false;
+    }
+
+    boolean this_present_bufferedSamples = true;
+    boolean that_present_bufferedSamples = true;
+    if (this_present_bufferedSamples || that_present_bufferedSamples) {
+      if (!(this_present_bufferedSamples && that_present_bufferedSamples))
+        return false;
+      if (this.bufferedSamples != that.bufferedSamples)
+        return false;
+    }
+
+    boolean this_present_evictedSamples = true;
+    boolean that_present_evictedSamples = true;
+    if (this_present_evictedSamples || that_present_evictedSamples) {
+      if (!(this_present_evictedSamples && that_present_evictedSamples))
+        return false;
+      if (this.evictedSamples != that.evictedSamples)
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(startTimestamp);
+
+    hashCode = hashCode * 8191 + ((isSetMetrics()) ? 131071 : 524287);
+    if (isSetMetrics())
+      hashCode = hashCode * 8191 + metrics.hashCode();
+
+    hashCode = hashCode * 8191 + ((samplesEnabled) ? 131071 : 524287);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(maxSamples);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(bufferedSamples);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(evictedSamples);
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(InternalMetrics other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetStartTimestamp(), other.isSetStartTimestamp());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetStartTimestamp()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.startTimestamp, other.startTimestamp);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMetrics(), other.isSetMetrics());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMetrics()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.metrics, other.metrics);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetSamplesEnabled(), other.isSetSamplesEnabled());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetSamplesEnabled()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.samplesEnabled, other.samplesEnabled);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMaxSamples(), other.isSetMaxSamples());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMaxSamples()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.maxSamples, other.maxSamples);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetBufferedSamples(), other.isSetBufferedSamples());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetBufferedSamples()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.bufferedSamples, other.bufferedSamples);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetEvictedSamples(), other.isSetEvictedSamples());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetEvictedSamples()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.evictedSamples, other.evictedSamples);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("InternalMetrics(");
+    boolean first = true;
+
+    sb.append("startTimestamp:");
+    sb.append(this.startTimestamp);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("metrics:");
+    if (this.metrics == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.metrics);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("samplesEnabled:");
+    sb.append(this.samplesEnabled);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("maxSamples:");
+    sb.append(this.maxSamples);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("bufferedSamples:");
+    sb.append(this.bufferedSamples);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("evictedSamples:");
+    sb.append(this.evictedSamples);
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class InternalMetricsStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public InternalMetricsStandardScheme getScheme() {
+      return new InternalMetricsStandardScheme();
+    }
+  }
+
+  private static class InternalMetricsStandardScheme extends org.apache.thrift.scheme.StandardScheme<InternalMetrics> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, InternalMetrics struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // START_TIMESTAMP
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.startTimestamp = iprot.readI64();
+              struct.setStartTimestampIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // METRICS
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list56 = iprot.readListBegin();
+                struct.metrics = new java.util.ArrayList<InternalMetric>(_list56.size);
+                @org.apache.thrift.annotation.Nullable InternalMetric _elem57;
+                for (int _i58 = 0; _i58 < _list56.size; ++_i58)
+                {
+                  _elem57 = new InternalMetric();
+                  _elem57.read(iprot);
+                  struct.metrics.add(_elem57);
+                }
+                iprot.readListEnd();
+              }
+              struct.setMetricsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // SAMPLES_ENABLED
+            if (schemeField.type == org.apache.thrift.protocol.TType.BOOL) {
+              struct.samplesEnabled = iprot.readBool();
+              struct.setSamplesEnabledIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // MAX_SAMPLES
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.maxSamples = iprot.readI64();
+              struct.setMaxSamplesIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // BUFFERED_SAMPLES
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.bufferedSamples = iprot.readI64();
+              struct.setBufferedSamplesIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 6: // EVICTED_SAMPLES
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.evictedSamples = iprot.readI64();
+              struct.setEvictedSamplesIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, InternalMetrics struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      oprot.writeFieldBegin(START_TIMESTAMP_FIELD_DESC);
+      oprot.writeI64(struct.startTimestamp);
+      oprot.writeFieldEnd();
+      if (struct.metrics != null) {
+        oprot.writeFieldBegin(METRICS_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRUCT, struct.metrics.size()));
+          for (InternalMetric _iter59 : struct.metrics)
+          {
+            _iter59.write(oprot);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(SAMPLES_ENABLED_FIELD_DESC);
+      oprot.writeBool(struct.samplesEnabled);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(MAX_SAMPLES_FIELD_DESC);
+      oprot.writeI64(struct.maxSamples);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(BUFFERED_SAMPLES_FIELD_DESC);
+      oprot.writeI64(struct.bufferedSamples);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(EVICTED_SAMPLES_FIELD_DESC);
+      oprot.writeI64(struct.evictedSamples);
+      oprot.writeFieldEnd();
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class InternalMetricsTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public InternalMetricsTupleScheme getScheme() {
+      return new InternalMetricsTupleScheme();
+    }
+  }
+
+  private static class InternalMetricsTupleScheme extends org.apache.thrift.scheme.TupleScheme<InternalMetrics> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, InternalMetrics struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetStartTimestamp()) {
+        optionals.set(0);
+      }
+      if (struct.isSetMetrics()) {
+        optionals.set(1);
+      }
+      if (struct.isSetSamplesEnabled()) {
+        optionals.set(2);
+      }
+      if (struct.isSetMaxSamples()) {
+        optionals.set(3);
+      }
+      if (struct.isSetBufferedSamples()) {
+        optionals.set(4);
+      }
+      if (struct.isSetEvictedSamples()) {
+        optionals.set(5);
+      }
+      oprot.writeBitSet(optionals, 6);
+      if (struct.isSetStartTimestamp()) {
+        oprot.writeI64(struct.startTimestamp);
+      }
+      if (struct.isSetMetrics()) {
+        {
+          oprot.writeI32(struct.metrics.size());
+          for (InternalMetric _iter60 : struct.metrics)
+          {
+            _iter60.write(oprot);
+          }
+        }
+      }
+      if (struct.isSetSamplesEnabled()) {
+        oprot.writeBool(struct.samplesEnabled);
+      }
+      if (struct.isSetMaxSamples()) {
+        oprot.writeI64(struct.maxSamples);
+      }
+      if (struct.isSetBufferedSamples()) {
+        oprot.writeI64(struct.bufferedSamples);
+      }
+      if (struct.isSetEvictedSamples()) {
+        oprot.writeI64(struct.evictedSamples);
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, InternalMetrics struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(6);
+      if (incoming.get(0)) {
+        struct.startTimestamp = iprot.readI64();
+        struct.setStartTimestampIsSet(true);
+      }
+      if (incoming.get(1)) {
+        {
+          org.apache.thrift.protocol.TList _list61 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRUCT);
+          struct.metrics = new java.util.ArrayList<InternalMetric>(_list61.size);
+          @org.apache.thrift.annotation.Nullable InternalMetric _elem62;
+          for (int _i63 = 0; _i63 < _list61.size; ++_i63)
+          {
+            _elem62 = new InternalMetric();
+            _elem62.read(iprot);
+            struct.metrics.add(_elem62);
+          }
+        }
+        struct.setMetricsIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.samplesEnabled = iprot.readBool();
+        struct.setSamplesEnabledIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.maxSamples = iprot.readI64();
+        struct.setMaxSamplesIsSet(true);
+      }
+      if (incoming.get(4)) {
+        struct.bufferedSamples = iprot.readI64();
+        struct.setBufferedSamplesIsSet(true);
+      }
+      if (incoming.get(5)) {
+        struct.evictedSamples = iprot.readI64();
+        struct.setEvictedSamplesIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
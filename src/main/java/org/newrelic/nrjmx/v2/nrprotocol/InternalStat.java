@@ -17,6 +17,7 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
   private static final org.apache.thrift.protocol.TField MILLISECONDS_FIELD_DESC = new org.apache.thrift.protocol.TField("milliseconds", org.apache.thrift.protocol.TType.DOUBLE, (short)5);
   private static final org.apache.thrift.protocol.TField START_TIMESTAMP_FIELD_DESC = new org.apache.thrift.protocol.TField("startTimestamp", org.apache.thrift.protocol.TType.I64, (short)6);
   private static final org.apache.thrift.protocol.TField SUCCESSFUL_FIELD_DESC = new org.apache.thrift.protocol.TField("successful", org.apache.thrift.protocol.TType.BOOL, (short)7);
+  private static final org.apache.thrift.protocol.TField REQUEST_ID_FIELD_DESC = new org.apache.thrift.protocol.TField("requestId", org.apache.thrift.protocol.TType.I32, (short)8);
 
   private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new InternalStatStandardSchemeFactory();
   private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new InternalStatTupleSchemeFactory();
@@ -28,6 +29,7 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
   public double milliseconds; // required
   public long startTimestamp; // required
   public boolean successful; // required
+  public int requestId; // required
 
   /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
   public enum _Fields implements org.apache.thrift.TFieldIdEnum {
@@ -37,7 +39,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     RESPONSE_COUNT((short)4, "responseCount"),
     MILLISECONDS((short)5, "milliseconds"),
     START_TIMESTAMP((short)6, "startTimestamp"),
-    SUCCESSFUL((short)7, "successful");
+    SUCCESSFUL((short)7, "successful"),
+    REQUEST_ID((short)8, "requestId");
 
     private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
 
@@ -67,6 +70,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
           return START_TIMESTAMP;
         case 7: // SUCCESSFUL
           return SUCCESSFUL;
+        case 8: // REQUEST_ID
+          return REQUEST_ID;
         default:
           return null;
       }
@@ -114,6 +119,7 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
   private static final int __MILLISECONDS_ISSET_ID = 1;
   private static final int __STARTTIMESTAMP_ISSET_ID = 2;
   private static final int __SUCCESSFUL_ISSET_ID = 3;
+  private static final int __REQUESTID_ISSET_ID = 4;
   private byte __isset_bitfield = 0;
   public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
   static {
@@ -133,6 +139,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
     tmpMap.put(_Fields.SUCCESSFUL, new org.apache.thrift.meta_data.FieldMetaData("successful", org.apache.thrift.TFieldRequirementType.DEFAULT, 
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.REQUEST_ID, new org.apache.thrift.meta_data.FieldMetaData("requestId", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I32)));
     metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
     org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(InternalStat.class, metaDataMap);
   }
@@ -147,7 +155,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     long responseCount,
     double milliseconds,
     long startTimestamp,
-    boolean successful)
+    boolean successful,
+    int requestId)
   {
     this();
     this.statType = statType;
@@ -161,6 +170,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     setStartTimestampIsSet(true);
     this.successful = successful;
     setSuccessfulIsSet(true);
+    this.requestId = requestId;
+    setRequestIdIsSet(true);
   }
 
   /**
@@ -182,6 +193,7 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     this.milliseconds = other.milliseconds;
     this.startTimestamp = other.startTimestamp;
     this.successful = other.successful;
+    this.requestId = other.requestId;
   }
 
   @Override
@@ -202,6 +214,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     this.startTimestamp = 0;
     setSuccessfulIsSet(false);
     this.successful = false;
+    setRequestIdIsSet(false);
+    this.requestId = 0;
   }
 
   @org.apache.thrift.annotation.Nullable
@@ -387,6 +401,29 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __SUCCESSFUL_ISSET_ID, value);
   }
 
+  public int getRequestId() {
+    return this.requestId;
+  }
+
+  public InternalStat setRequestId(int requestId) {
+    this.requestId = requestId;
+    setRequestIdIsSet(true);
+    return this;
+  }
+
+  public void unsetRequestId() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __REQUESTID_ISSET_ID);
+  }
+
+  /** Returns true if field requestId is set (has been assigned a value) and false otherwise */
+  public boolean isSetRequestId() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __REQUESTID_ISSET_ID);
+  }
+
+  public void setRequestIdIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __REQUESTID_ISSET_ID, value);
+  }
+
   @Override
   public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
     switch (field) {
@@ -446,6 +483,14 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       }
       break;
 
+    case REQUEST_ID:
+      if (value == null) {
+        unsetRequestId();
+      } else {
+        setRequestId((java.lang.Integer)value);
+      }
+      break;
+
     }
   }
 
@@ -474,6 +519,9 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     case SUCCESSFUL:
       return isSuccessful();
 
+    case REQUEST_ID:
+      return getRequestId();
+
     }
     throw new java.lang.IllegalStateException();
   }
@@ -500,6 +548,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       return isSetStartTimestamp();
     case SUCCESSFUL:
       return isSetSuccessful();
+    case REQUEST_ID:
+      return isSetRequestId();
     }
     throw new java.lang.IllegalStateException();
   }
@@ -580,6 +630,15 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
         return false;
     }
 
+    boolean this_present_requestId = true;
+    boolean that_present_requestId = true;
+    if (this_present_requestId || that_present_requestId) {
+      if (!(this_present_requestId && that_present_requestId))
+        return false;
+      if (this.requestId != that.requestId)
+        return false;
+    }
+
     return true;
   }
 
@@ -607,6 +666,8 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
 
     hashCode = hashCode * 8191 + ((successful) ? 131071 : 524287);
 
+    hashCode = hashCode * 8191 + requestId;
+
     return hashCode;
   }
 
@@ -688,6 +749,16 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
         return lastComparison;
       }
     }
+    lastComparison = java.lang.Boolean.compare(isSetRequestId(), other.isSetRequestId());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetRequestId()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.requestId, other.requestId);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
     return 0;
   }
 
@@ -751,6 +822,10 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
     sb.append("successful:");
     sb.append(this.successful);
     first = false;
+    if (!first) sb.append(", ");
+    sb.append("requestId:");
+    sb.append(this.requestId);
+    first = false;
     sb.append(")");
     return sb.toString();
   }
@@ -817,13 +892,13 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
           case 3: // ATTRS
             if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
               {
-                org.apache.thrift.protocol.TList _list0 = iprot.readListBegin();
-                struct.attrs = new java.util.ArrayList<java.lang.String>(_list0.size);
-                @org.apache.thrift.annotation.Nullable java.lang.String _elem1;
-                for (int _i2 = 0; _i2 < _list0.size; ++_i2)
+                org.apache.thrift.protocol.TList _list32 = iprot.readListBegin();
+                struct.attrs = new java.util.ArrayList<java.lang.String>(_list32.size);
+                @org.apache.thrift.annotation.Nullable java.lang.String _elem33;
+                for (int _i34 = 0; _i34 < _list32.size; ++_i34)
                 {
-                  _elem1 = iprot.readString();
-                  struct.attrs.add(_elem1);
+                  _elem33 = iprot.readString();
+                  struct.attrs.add(_elem33);
                 }
                 iprot.readListEnd();
               }
@@ -864,6 +939,14 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
               org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
             }
             break;
+          case 8: // REQUEST_ID
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.requestId = iprot.readI32();
+              struct.setRequestIdIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
           default:
             org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
         }
@@ -894,9 +977,9 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
         oprot.writeFieldBegin(ATTRS_FIELD_DESC);
         {
           oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRING, struct.attrs.size()));
-          for (java.lang.String _iter3 : struct.attrs)
+          for (java.lang.String _iter35 : struct.attrs)
           {
-            oprot.writeString(_iter3);
+            oprot.writeString(_iter35);
           }
           oprot.writeListEnd();
         }
@@ -914,6 +997,9 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       oprot.writeFieldBegin(SUCCESSFUL_FIELD_DESC);
       oprot.writeBool(struct.successful);
       oprot.writeFieldEnd();
+      oprot.writeFieldBegin(REQUEST_ID_FIELD_DESC);
+      oprot.writeI32(struct.requestId);
+      oprot.writeFieldEnd();
       oprot.writeFieldStop();
       oprot.writeStructEnd();
     }
@@ -954,7 +1040,10 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       if (struct.isSetSuccessful()) {
         optionals.set(6);
       }
-      oprot.writeBitSet(optionals, 7);
+      if (struct.isSetRequestId()) {
+        optionals.set(7);
+      }
+      oprot.writeBitSet(optionals, 8);
       if (struct.isSetStatType()) {
         oprot.writeString(struct.statType);
       }
@@ -964,9 +1053,9 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       if (struct.isSetAttrs()) {
         {
           oprot.writeI32(struct.attrs.size());
-          for (java.lang.String _iter4 : struct.attrs)
+          for (java.lang.String _iter36 : struct.attrs)
           {
-            oprot.writeString(_iter4);
+            oprot.writeString(_iter36);
           }
         }
       }
@@ -982,12 +1071,15 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       if (struct.isSetSuccessful()) {
         oprot.writeBool(struct.successful);
       }
+      if (struct.isSetRequestId()) {
+        oprot.writeI32(struct.requestId);
+      }
     }
 
     @Override
     public void read(org.apache.thrift.protocol.TProtocol prot, InternalStat struct) throws org.apache.thrift.TException {
       org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
-      java.util.BitSet incoming = iprot.readBitSet(7);
+      java.util.BitSet incoming = iprot.readBitSet(8);
       if (incoming.get(0)) {
         struct.statType = iprot.readString();
         struct.setStatTypeIsSet(true);
@@ -998,13 +1090,13 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
       }
       if (incoming.get(2)) {
         {
-          org.apache.thrift.protocol.TList _list5 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRING);
-          struct.attrs = new java.util.ArrayList<java.lang.String>(_list5.size);
-          @org.apache.thrift.annotation.Nullable java.lang.String _elem6;
-          for (int _i7 = 0; _i7 < _list5.size; ++_i7)
+          org.apache.thrift.protocol.TList _list37 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRING);
+          struct.attrs = new java.util.ArrayList<java.lang.String>(_list37.size);
+          @org.apache.thrift.annotation.Nullable java.lang.String _elem38;
+          for (int _i39 = 0; _i39 < _list37.size; ++_i39)
           {
-            _elem6 = iprot.readString();
-            struct.attrs.add(_elem6);
+            _elem38 = iprot.readString();
+            struct.attrs.add(_elem38);
           }
         }
         struct.setAttrsIsSet(true);
@@ -1025,6 +1117,10 @@ public class InternalStat implements org.apache.thrift.TBase<InternalStat, Inter
         struct.successful = iprot.readBool();
         struct.setSuccessfulIsSet(true);
       }
+      if (incoming.get(7)) {
+        struct.requestId = iprot.readI32();
+        struct.setRequestIdIsSet(true);
+      }
     }
   }
 
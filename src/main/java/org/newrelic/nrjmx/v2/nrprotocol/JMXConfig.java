@@ -27,6 +27,10 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
   private static final org.apache.thrift.protocol.TField VERBOSE_FIELD_DESC = new org.apache.thrift.protocol.TField("verbose", org.apache.thrift.protocol.TType.BOOL, (short)15);
   private static final org.apache.thrift.protocol.TField ENABLE_INTERNAL_STATS_FIELD_DESC = new org.apache.thrift.protocol.TField("enableInternalStats", org.apache.thrift.protocol.TType.BOOL, (short)16);
   private static final org.apache.thrift.protocol.TField MAX_INTERNAL_STATS_SIZE_FIELD_DESC = new org.apache.thrift.protocol.TField("maxInternalStatsSize", org.apache.thrift.protocol.TType.I64, (short)17);
+  private static final org.apache.thrift.protocol.TField DISCOVERY_CACHE_TTL_MS_FIELD_DESC = new org.apache.thrift.protocol.TField("discoveryCacheTtlMs", org.apache.thrift.protocol.TType.I64, (short)18);
+  private static final org.apache.thrift.protocol.TField MAX_MBEANS_PER_PATTERN_FIELD_DESC = new org.apache.thrift.protocol.TField("maxMBeansPerPattern", org.apache.thrift.protocol.TType.I64, (short)19);
+  private static final org.apache.thrift.protocol.TField MAX_ATTRIBUTES_PER_MBEAN_FIELD_DESC = new org.apache.thrift.protocol.TField("maxAttributesPerMBean", org.apache.thrift.protocol.TType.I64, (short)20);
+  private static final org.apache.thrift.protocol.TField MAX_RESPONSES_PER_CALL_FIELD_DESC = new org.apache.thrift.protocol.TField("maxResponsesPerCall", org.apache.thrift.protocol.TType.I64, (short)21);
 
   private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new JMXConfigStandardSchemeFactory();
   private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new JMXConfigTupleSchemeFactory();
@@ -48,6 +52,10 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
   public boolean verbose; // required
   public boolean enableInternalStats; // required
   public long maxInternalStatsSize; // required
+  public long discoveryCacheTtlMs; // required
+  public long maxMBeansPerPattern; // required
+  public long maxAttributesPerMBean; // required
+  public long maxResponsesPerCall; // required
 
   /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
   public enum _Fields implements org.apache.thrift.TFieldIdEnum {
@@ -67,7 +75,11 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     REQUEST_TIMEOUT_MS((short)14, "requestTimeoutMs"),
     VERBOSE((short)15, "verbose"),
     ENABLE_INTERNAL_STATS((short)16, "enableInternalStats"),
-    MAX_INTERNAL_STATS_SIZE((short)17, "maxInternalStatsSize");
+    MAX_INTERNAL_STATS_SIZE((short)17, "maxInternalStatsSize"),
+    DISCOVERY_CACHE_TTL_MS((short)18, "discoveryCacheTtlMs"),
+    MAX_MBEANS_PER_PATTERN((short)19, "maxMBeansPerPattern"),
+    MAX_ATTRIBUTES_PER_MBEAN((short)20, "maxAttributesPerMBean"),
+    MAX_RESPONSES_PER_CALL((short)21, "maxResponsesPerCall");
 
     private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
 
@@ -117,6 +129,14 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
           return ENABLE_INTERNAL_STATS;
         case 17: // MAX_INTERNAL_STATS_SIZE
           return MAX_INTERNAL_STATS_SIZE;
+        case 18: // DISCOVERY_CACHE_TTL_MS
+          return DISCOVERY_CACHE_TTL_MS;
+        case 19: // MAX_MBEANS_PER_PATTERN
+          return MAX_MBEANS_PER_PATTERN;
+        case 20: // MAX_ATTRIBUTES_PER_MBEAN
+          return MAX_ATTRIBUTES_PER_MBEAN;
+        case 21: // MAX_RESPONSES_PER_CALL
+          return MAX_RESPONSES_PER_CALL;
         default:
           return null;
       }
@@ -168,7 +188,11 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
   private static final int __VERBOSE_ISSET_ID = 5;
   private static final int __ENABLEINTERNALSTATS_ISSET_ID = 6;
   private static final int __MAXINTERNALSTATSSIZE_ISSET_ID = 7;
-  private byte __isset_bitfield = 0;
+  private static final int __DISCOVERYCACHETTLMS_ISSET_ID = 8;
+  private static final int __MAXMBEANSPERPATTERN_ISSET_ID = 9;
+  private static final int __MAXATTRIBUTESPERMBEAN_ISSET_ID = 10;
+  private static final int __MAXRESPONSESPERCALL_ISSET_ID = 11;
+  private short __isset_bitfield = 0;
   private static final _Fields optionals[] = {_Fields.URI_PATH};
   public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
   static {
@@ -207,6 +231,14 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
     tmpMap.put(_Fields.MAX_INTERNAL_STATS_SIZE, new org.apache.thrift.meta_data.FieldMetaData("maxInternalStatsSize", org.apache.thrift.TFieldRequirementType.DEFAULT, 
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.DISCOVERY_CACHE_TTL_MS, new org.apache.thrift.meta_data.FieldMetaData("discoveryCacheTtlMs", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.MAX_MBEANS_PER_PATTERN, new org.apache.thrift.meta_data.FieldMetaData("maxMBeansPerPattern", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.MAX_ATTRIBUTES_PER_MBEAN, new org.apache.thrift.meta_data.FieldMetaData("maxAttributesPerMBean", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.MAX_RESPONSES_PER_CALL, new org.apache.thrift.meta_data.FieldMetaData("maxResponsesPerCall", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
     metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
     org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(JMXConfig.class, metaDataMap);
   }
@@ -230,7 +262,11 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     long requestTimeoutMs,
     boolean verbose,
     boolean enableInternalStats,
-    long maxInternalStatsSize)
+    long maxInternalStatsSize,
+    long discoveryCacheTtlMs,
+    long maxMBeansPerPattern,
+    long maxAttributesPerMBean,
+    long maxResponsesPerCall)
   {
     this();
     this.connectionURL = connectionURL;
@@ -257,6 +293,14 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     setEnableInternalStatsIsSet(true);
     this.maxInternalStatsSize = maxInternalStatsSize;
     setMaxInternalStatsSizeIsSet(true);
+    this.discoveryCacheTtlMs = discoveryCacheTtlMs;
+    setDiscoveryCacheTtlMsIsSet(true);
+    this.maxMBeansPerPattern = maxMBeansPerPattern;
+    setMaxMBeansPerPatternIsSet(true);
+    this.maxAttributesPerMBean = maxAttributesPerMBean;
+    setMaxAttributesPerMBeanIsSet(true);
+    this.maxResponsesPerCall = maxResponsesPerCall;
+    setMaxResponsesPerCallIsSet(true);
   }
 
   /**
@@ -299,6 +343,10 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     this.verbose = other.verbose;
     this.enableInternalStats = other.enableInternalStats;
     this.maxInternalStatsSize = other.maxInternalStatsSize;
+    this.discoveryCacheTtlMs = other.discoveryCacheTtlMs;
+    this.maxMBeansPerPattern = other.maxMBeansPerPattern;
+    this.maxAttributesPerMBean = other.maxAttributesPerMBean;
+    this.maxResponsesPerCall = other.maxResponsesPerCall;
   }
 
   @Override
@@ -333,6 +381,14 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     this.enableInternalStats = false;
     setMaxInternalStatsSizeIsSet(false);
     this.maxInternalStatsSize = 0;
+    setDiscoveryCacheTtlMsIsSet(false);
+    this.discoveryCacheTtlMs = 0;
+    setMaxMBeansPerPatternIsSet(false);
+    this.maxMBeansPerPattern = 0;
+    setMaxAttributesPerMBeanIsSet(false);
+    this.maxAttributesPerMBean = 0;
+    setMaxResponsesPerCallIsSet(false);
+    this.maxResponsesPerCall = 0;
   }
 
   @org.apache.thrift.annotation.Nullable
@@ -744,6 +800,98 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXINTERNALSTATSSIZE_ISSET_ID, value);
   }
 
+  public long getDiscoveryCacheTtlMs() {
+    return this.discoveryCacheTtlMs;
+  }
+
+  public JMXConfig setDiscoveryCacheTtlMs(long discoveryCacheTtlMs) {
+    this.discoveryCacheTtlMs = discoveryCacheTtlMs;
+    setDiscoveryCacheTtlMsIsSet(true);
+    return this;
+  }
+
+  public void unsetDiscoveryCacheTtlMs() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __DISCOVERYCACHETTLMS_ISSET_ID);
+  }
+
+  /** Returns true if field discoveryCacheTtlMs is set (has been assigned a value) and false otherwise */
+  public boolean isSetDiscoveryCacheTtlMs() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __DISCOVERYCACHETTLMS_ISSET_ID);
+  }
+
+  public void setDiscoveryCacheTtlMsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __DISCOVERYCACHETTLMS_ISSET_ID, value);
+  }
+
+  public long getMaxMBeansPerPattern() {
+    return this.maxMBeansPerPattern;
+  }
+
+  public JMXConfig setMaxMBeansPerPattern(long maxMBeansPerPattern) {
+    this.maxMBeansPerPattern = maxMBeansPerPattern;
+    setMaxMBeansPerPatternIsSet(true);
+    return this;
+  }
+
+  public void unsetMaxMBeansPerPattern() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __MAXMBEANSPERPATTERN_ISSET_ID);
+  }
+
+  /** Returns true if field maxMBeansPerPattern is set (has been assigned a value) and false otherwise */
+  public boolean isSetMaxMBeansPerPattern() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __MAXMBEANSPERPATTERN_ISSET_ID);
+  }
+
+  public void setMaxMBeansPerPatternIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXMBEANSPERPATTERN_ISSET_ID, value);
+  }
+
+  public long getMaxAttributesPerMBean() {
+    return this.maxAttributesPerMBean;
+  }
+
+  public JMXConfig setMaxAttributesPerMBean(long maxAttributesPerMBean) {
+    this.maxAttributesPerMBean = maxAttributesPerMBean;
+    setMaxAttributesPerMBeanIsSet(true);
+    return this;
+  }
+
+  public void unsetMaxAttributesPerMBean() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __MAXATTRIBUTESPERMBEAN_ISSET_ID);
+  }
+
+  /** Returns true if field maxAttributesPerMBean is set (has been assigned a value) and false otherwise */
+  public boolean isSetMaxAttributesPerMBean() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __MAXATTRIBUTESPERMBEAN_ISSET_ID);
+  }
+
+  public void setMaxAttributesPerMBeanIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXATTRIBUTESPERMBEAN_ISSET_ID, value);
+  }
+
+  public long getMaxResponsesPerCall() {
+    return this.maxResponsesPerCall;
+  }
+
+  public JMXConfig setMaxResponsesPerCall(long maxResponsesPerCall) {
+    this.maxResponsesPerCall = maxResponsesPerCall;
+    setMaxResponsesPerCallIsSet(true);
+    return this;
+  }
+
+  public void unsetMaxResponsesPerCall() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __MAXRESPONSESPERCALL_ISSET_ID);
+  }
+
+  /** Returns true if field maxResponsesPerCall is set (has been assigned a value) and false otherwise */
+  public boolean isSetMaxResponsesPerCall() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __MAXRESPONSESPERCALL_ISSET_ID);
+  }
+
+  public void setMaxResponsesPerCallIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXRESPONSESPERCALL_ISSET_ID, value);
+  }
+
   @Override
   public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
     switch (field) {
@@ -883,6 +1031,38 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
       }
       break;
 
+    case DISCOVERY_CACHE_TTL_MS:
+      if (value == null) {
+        unsetDiscoveryCacheTtlMs();
+      } else {
+        setDiscoveryCacheTtlMs((java.lang.Long)value);
+      }
+      break;
+
+    case MAX_MBEANS_PER_PATTERN:
+      if (value == null) {
+        unsetMaxMBeansPerPattern();
+      } else {
+        setMaxMBeansPerPattern((java.lang.Long)value);
+      }
+      break;
+
+    case MAX_ATTRIBUTES_PER_MBEAN:
+      if (value == null) {
+        unsetMaxAttributesPerMBean();
+      } else {
+        setMaxAttributesPerMBean((java.lang.Long)value);
+      }
+      break;
+
+    case MAX_RESPONSES_PER_CALL:
+      if (value == null) {
+        unsetMaxResponsesPerCall();
+      } else {
+        setMaxResponsesPerCall((java.lang.Long)value);
+      }
+      break;
+
     }
   }
 
@@ -941,6 +1121,18 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     case MAX_INTERNAL_STATS_SIZE:
       return getMaxInternalStatsSize();
 
+    case DISCOVERY_CACHE_TTL_MS:
+      return getDiscoveryCacheTtlMs();
+
+    case MAX_MBEANS_PER_PATTERN:
+      return getMaxMBeansPerPattern();
+
+    case MAX_ATTRIBUTES_PER_MBEAN:
+      return getMaxAttributesPerMBean();
+
+    case MAX_RESPONSES_PER_CALL:
+      return getMaxResponsesPerCall();
+
     }
     throw new java.lang.IllegalStateException();
   }
@@ -987,6 +1179,14 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
       return isSetEnableInternalStats();
     case MAX_INTERNAL_STATS_SIZE:
       return isSetMaxInternalStatsSize();
+    case DISCOVERY_CACHE_TTL_MS:
+      return isSetDiscoveryCacheTtlMs();
+    case MAX_MBEANS_PER_PATTERN:
+      return isSetMaxMBeansPerPattern();
+    case MAX_ATTRIBUTES_PER_MBEAN:
+      return isSetMaxAttributesPerMBean();
+    case MAX_RESPONSES_PER_CALL:
+      return isSetMaxResponsesPerCall();
     }
     throw new java.lang.IllegalStateException();
   }
@@ -1157,6 +1357,42 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
         return false;
     }
 
+    boolean this_present_discoveryCacheTtlMs = true;
+    boolean that_present_discoveryCacheTtlMs = true;
+    if (this_present_discoveryCacheTtlMs || that_present_discoveryCacheTtlMs) {
+      if (!(this_present_discoveryCacheTtlMs && that_present_discoveryCacheTtlMs))
+        return false;
+      if (this.discoveryCacheTtlMs != that.discoveryCacheTtlMs)
+        return false;
+    }
+
+    boolean this_present_maxMBeansPerPattern = true;
+    boolean that_present_maxMBeansPerPattern = true;
+    if (this_present_maxMBeansPerPattern || that_present_maxMBeansPerPattern) {
+      if (!(this_present_maxMBeansPerPattern && that_present_maxMBeansPerPattern))
+        return false;
+      if (this.maxMBeansPerPattern != that.maxMBeansPerPattern)
+        return false;
+    }
+
+    boolean this_present_maxAttributesPerMBean = true;
+    boolean that_present_maxAttributesPerMBean = true;
+    if (this_present_maxAttributesPerMBean || that_present_maxAttributesPerMBean) {
+      if (!(this_present_maxAttributesPerMBean && that_present_maxAttributesPerMBean))
+        return false;
+      if (this.maxAttributesPerMBean != that.maxAttributesPerMBean)
+        return false;
+    }
+
+    boolean this_present_maxResponsesPerCall = true;
+    boolean that_present_maxResponsesPerCall = true;
+    if (this_present_maxResponsesPerCall || that_present_maxResponsesPerCall) {
+      if (!(this_present_maxResponsesPerCall && that_present_maxResponsesPerCall))
+        return false;
+      if (this.maxResponsesPerCall != that.maxResponsesPerCall)
+        return false;
+    }
+
     return true;
   }
 
@@ -1216,6 +1452,14 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
 
     hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(maxInternalStatsSize);
 
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(discoveryCacheTtlMs);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(maxMBeansPerPattern);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(maxAttributesPerMBean);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(maxResponsesPerCall);
+
     return hashCode;
   }
 
@@ -1397,6 +1641,46 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
         return lastComparison;
       }
     }
+    lastComparison = java.lang.Boolean.compare(isSetDiscoveryCacheTtlMs(), other.isSetDiscoveryCacheTtlMs());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetDiscoveryCacheTtlMs()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.discoveryCacheTtlMs, other.discoveryCacheTtlMs);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMaxMBeansPerPattern(), other.isSetMaxMBeansPerPattern());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMaxMBeansPerPattern()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.maxMBeansPerPattern, other.maxMBeansPerPattern);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMaxAttributesPerMBean(), other.isSetMaxAttributesPerMBean());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMaxAttributesPerMBean()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.maxAttributesPerMBean, other.maxAttributesPerMBean);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMaxResponsesPerCall(), other.isSetMaxResponsesPerCall());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMaxResponsesPerCall()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.maxResponsesPerCall, other.maxResponsesPerCall);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
     return 0;
   }
 
@@ -1526,6 +1810,22 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
     sb.append("maxInternalStatsSize:");
     sb.append(this.maxInternalStatsSize);
     first = false;
+    if (!first) sb.append(", ");
+    sb.append("discoveryCacheTtlMs:");
+    sb.append(this.discoveryCacheTtlMs);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("maxMBeansPerPattern:");
+    sb.append(this.maxMBeansPerPattern);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("maxAttributesPerMBean:");
+    sb.append(this.maxAttributesPerMBean);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("maxResponsesPerCall:");
+    sb.append(this.maxResponsesPerCall);
+    first = false;
     sb.append(")");
     return sb.toString();
   }
@@ -1709,6 +2009,38 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
               org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
             }
             break;
+          case 18: // DISCOVERY_CACHE_TTL_MS
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.discoveryCacheTtlMs = iprot.readI64();
+              struct.setDiscoveryCacheTtlMsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 19: // MAX_MBEANS_PER_PATTERN
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.maxMBeansPerPattern = iprot.readI64();
+              struct.setMaxMBeansPerPatternIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 20: // MAX_ATTRIBUTES_PER_MBEAN
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.maxAttributesPerMBean = iprot.readI64();
+              struct.setMaxAttributesPerMBeanIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 21: // MAX_RESPONSES_PER_CALL
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.maxResponsesPerCall = iprot.readI64();
+              struct.setMaxResponsesPerCallIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
           default:
             org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
         }
@@ -1796,6 +2128,18 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
       oprot.writeFieldBegin(MAX_INTERNAL_STATS_SIZE_FIELD_DESC);
       oprot.writeI64(struct.maxInternalStatsSize);
       oprot.writeFieldEnd();
+      oprot.writeFieldBegin(DISCOVERY_CACHE_TTL_MS_FIELD_DESC);
+      oprot.writeI64(struct.discoveryCacheTtlMs);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(MAX_MBEANS_PER_PATTERN_FIELD_DESC);
+      oprot.writeI64(struct.maxMBeansPerPattern);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(MAX_ATTRIBUTES_PER_MBEAN_FIELD_DESC);
+      oprot.writeI64(struct.maxAttributesPerMBean);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(MAX_RESPONSES_PER_CALL_FIELD_DESC);
+      oprot.writeI64(struct.maxResponsesPerCall);
+      oprot.writeFieldEnd();
       oprot.writeFieldStop();
       oprot.writeStructEnd();
     }
@@ -1866,7 +2210,19 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
       if (struct.isSetMaxInternalStatsSize()) {
         optionals.set(16);
       }
-      oprot.writeBitSet(optionals, 17);
+      if (struct.isSetDiscoveryCacheTtlMs()) {
+        optionals.set(17);
+      }
+      if (struct.isSetMaxMBeansPerPattern()) {
+        optionals.set(18);
+      }
+      if (struct.isSetMaxAttributesPerMBean()) {
+        optionals.set(19);
+      }
+      if (struct.isSetMaxResponsesPerCall()) {
+        optionals.set(20);
+      }
+      oprot.writeBitSet(optionals, 21);
       if (struct.isSetConnectionURL()) {
         oprot.writeString(struct.connectionURL);
       }
@@ -1918,12 +2274,24 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
       if (struct.isSetMaxInternalStatsSize()) {
         oprot.writeI64(struct.maxInternalStatsSize);
       }
+      if (struct.isSetDiscoveryCacheTtlMs()) {
+        oprot.writeI64(struct.discoveryCacheTtlMs);
+      }
+      if (struct.isSetMaxMBeansPerPattern()) {
+        oprot.writeI64(struct.maxMBeansPerPattern);
+      }
+      if (struct.isSetMaxAttributesPerMBean()) {
+        oprot.writeI64(struct.maxAttributesPerMBean);
+      }
+      if (struct.isSetMaxResponsesPerCall()) {
+        oprot.writeI64(struct.maxResponsesPerCall);
+      }
     }
 
     @Override
     public void read(org.apache.thrift.protocol.TProtocol prot, JMXConfig struct) throws org.apache.thrift.TException {
       org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
-      java.util.BitSet incoming = iprot.readBitSet(17);
+      java.util.BitSet incoming = iprot.readBitSet(21);
       if (incoming.get(0)) {
         struct.connectionURL = iprot.readString();
         struct.setConnectionURLIsSet(true);
@@ -1992,6 +2360,22 @@ public class JMXConfig implements org.apache.thrift.TBase<JMXConfig, JMXConfig._
         struct.maxInternalStatsSize = iprot.readI64();
         struct.setMaxInternalStatsSizeIsSet(true);
       }
+      if (incoming.get(17)) {
+        struct.discoveryCacheTtlMs = iprot.readI64();
+        struct.setDiscoveryCacheTtlMsIsSet(true);
+      }
+      if (incoming.get(18)) {
+        struct.maxMBeansPerPattern = iprot.readI64();
+        struct.setMaxMBeansPerPatternIsSet(true);
+      }
+      if (incoming.get(19)) {
+        struct.maxAttributesPerMBean = iprot.readI64();
+        struct.setMaxAttributesPerMBeanIsSet(true);
+      }
+      if (incoming.get(20)) {
+        struct.maxResponsesPerCall = iprot.readI64();
+        struct.setMaxResponsesPerCallIsSet(true);
+      }
     }
   }
 
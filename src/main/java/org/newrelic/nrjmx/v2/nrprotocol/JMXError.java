@@ -13,6 +13,7 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
   private static final org.apache.thrift.protocol.TField MESSAGE_FIELD_DESC = new org.apache.thrift.protocol.TField("message", org.apache.thrift.protocol.TType.STRING, (short)1);
   private static final org.apache.thrift.protocol.TField CAUSE_MESSAGE_FIELD_DESC = new org.apache.thrift.protocol.TField("causeMessage", org.apache.thrift.protocol.TType.STRING, (short)2);
   private static final org.apache.thrift.protocol.TField STACKTRACE_FIELD_DESC = new org.apache.thrift.protocol.TField("stacktrace", org.apache.thrift.protocol.TType.STRING, (short)3);
+  private static final org.apache.thrift.protocol.TField CAUSE_CLASS_FIELD_DESC = new org.apache.thrift.protocol.TField("causeClass", org.apache.thrift.protocol.TType.STRING, (short)4);
 
   private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new JMXErrorStandardSchemeFactory();
   private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new JMXErrorTupleSchemeFactory();
@@ -20,12 +21,14 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
   public @org.apache.thrift.annotation.Nullable java.lang.String message; // required
   public @org.apache.thrift.annotation.Nullable java.lang.String causeMessage; // required
   public @org.apache.thrift.annotation.Nullable java.lang.String stacktrace; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String causeClass; // optional
 
   /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
   public enum _Fields implements org.apache.thrift.TFieldIdEnum {
     MESSAGE((short)1, "message"),
     CAUSE_MESSAGE((short)2, "causeMessage"),
-    STACKTRACE((short)3, "stacktrace");
+    STACKTRACE((short)3, "stacktrace"),
+    CAUSE_CLASS((short)4, "causeClass");
 
     private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
 
@@ -47,6 +50,8 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
           return CAUSE_MESSAGE;
         case 3: // STACKTRACE
           return STACKTRACE;
+        case 4: // CAUSE_CLASS
+          return CAUSE_CLASS;
         default:
           return null;
       }
@@ -90,6 +95,7 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
   }
 
   // isset id assignments
+  private static final _Fields optionals[] = {_Fields.CAUSE_CLASS};
   public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
   static {
     java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
@@ -99,6 +105,8 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
     tmpMap.put(_Fields.STACKTRACE, new org.apache.thrift.meta_data.FieldMetaData("stacktrace", org.apache.thrift.TFieldRequirementType.DEFAULT, 
         new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.CAUSE_CLASS, new org.apache.thrift.meta_data.FieldMetaData("causeClass", org.apache.thrift.TFieldRequirementType.OPTIONAL, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
     metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
     org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(JMXError.class, metaDataMap);
   }
@@ -130,6 +138,9 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
     if (other.isSetStacktrace()) {
       this.stacktrace = other.stacktrace;
     }
+    if (other.isSetCauseClass()) {
+      this.causeClass = other.causeClass;
+    }
   }
 
   @Override
@@ -142,6 +153,7 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
     this.message = null;
     this.causeMessage = null;
     this.stacktrace = null;
+    this.causeClass = null;
   }
 
   @org.apache.thrift.annotation.Nullable
@@ -219,6 +231,31 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
     }
   }
 
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getCauseClass() {
+    return this.causeClass;
+  }
+
+  public JMXError setCauseClass(@org.apache.thrift.annotation.Nullable java.lang.String causeClass) {
+    this.causeClass = causeClass;
+    return this;
+  }
+
+  public void unsetCauseClass() {
+    this.causeClass = null;
+  }
+
+  /** Returns true if field causeClass is set (has been assigned a value) and false otherwise */
+  public boolean isSetCauseClass() {
+    return this.causeClass != null;
+  }
+
+  public void setCauseClassIsSet(boolean value) {
+    if (!value) {
+      this.causeClass = null;
+    }
+  }
+
   @Override
   public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
     switch (field) {
@@ -246,6 +283,14 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
       }
       break;
 
+    case CAUSE_CLASS:
+      if (value == null) {
+        unsetCauseClass();
+      } else {
+        setCauseClass((java.lang.String)value);
+      }
+      break;
+
     }
   }
 
@@ -262,6 +307,9 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
     case STACKTRACE:
       return getStacktrace();
 
+    case CAUSE_CLASS:
+      return getCauseClass();
+
     }
     throw new java.lang.IllegalStateException();
   }
@@ -280,6 +328,8 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
       return isSetCauseMessage();
     case STACKTRACE:
       return isSetStacktrace();
+    case CAUSE_CLASS:
+      return isSetCauseClass();
     }
     throw new java.lang.IllegalStateException();
   }
@@ -324,6 +374,15 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
         return false;
     }
 
+    boolean this_present_causeClass = true && this.isSetCauseClass();
+    boolean that_present_causeClass = true && that.isSetCauseClass();
+    if (this_present_causeClass || that_present_causeClass) {
+      if (!(this_present_causeClass && that_present_causeClass))
+        return false;
+      if (!this.causeClass.equals(that.causeClass))
+        return false;
+    }
+
     return true;
   }
 
@@ -343,6 +402,10 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
     if (isSetStacktrace())
       hashCode = hashCode * 8191 + stacktrace.hashCode();
 
+    hashCode = hashCode * 8191 + ((isSetCauseClass()) ? 131071 : 524287);
+    if (isSetCauseClass())
+      hashCode = hashCode * 8191 + causeClass.hashCode();
+
     return hashCode;
   }
 
@@ -384,6 +447,16 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
         return lastComparison;
       }
     }
+    lastComparison = java.lang.Boolean.compare(isSetCauseClass(), other.isSetCauseClass());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetCauseClass()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.causeClass, other.causeClass);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
     return 0;
   }
 
@@ -431,6 +504,16 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
       sb.append(this.stacktrace);
     }
     first = false;
+    if (isSetCauseClass()) {
+      if (!first) sb.append(", ");
+      sb.append("causeClass:");
+      if (this.causeClass == null) {
+        sb.append("null");
+      } else {
+        sb.append(this.causeClass);
+      }
+      first = false;
+    }
     sb.append(")");
     return sb.toString();
   }
@@ -500,6 +583,14 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
               org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
             }
             break;
+          case 4: // CAUSE_CLASS
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.causeClass = iprot.readString();
+              struct.setCauseClassIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
           default:
             org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
         }
@@ -531,6 +622,13 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
         oprot.writeString(struct.stacktrace);
         oprot.writeFieldEnd();
       }
+      if (struct.causeClass != null) {
+        if (struct.isSetCauseClass()) {
+          oprot.writeFieldBegin(CAUSE_CLASS_FIELD_DESC);
+          oprot.writeString(struct.causeClass);
+          oprot.writeFieldEnd();
+        }
+      }
       oprot.writeFieldStop();
       oprot.writeStructEnd();
     }
@@ -559,7 +657,10 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
       if (struct.isSetStacktrace()) {
         optionals.set(2);
       }
-      oprot.writeBitSet(optionals, 3);
+      if (struct.isSetCauseClass()) {
+        optionals.set(3);
+      }
+      oprot.writeBitSet(optionals, 4);
       if (struct.isSetMessage()) {
         oprot.writeString(struct.message);
       }
@@ -569,12 +670,15 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
       if (struct.isSetStacktrace()) {
         oprot.writeString(struct.stacktrace);
       }
+      if (struct.isSetCauseClass()) {
+        oprot.writeString(struct.causeClass);
+      }
     }
 
     @Override
     public void read(org.apache.thrift.protocol.TProtocol prot, JMXError struct) throws org.apache.thrift.TException {
       org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
-      java.util.BitSet incoming = iprot.readBitSet(3);
+      java.util.BitSet incoming = iprot.readBitSet(4);
       if (incoming.get(0)) {
         struct.message = iprot.readString();
         struct.setMessageIsSet(true);
@@ -587,6 +691,10 @@ public class JMXError extends org.apache.thrift.TException implements org.apache
         struct.stacktrace = iprot.readString();
         struct.setStacktraceIsSet(true);
       }
+      if (incoming.get(3)) {
+        struct.causeClass = iprot.readString();
+        struct.setCauseClassIsSet(true);
+      }
     }
   }
 
@@ -0,0 +1,857 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class MBeanOperationInfo implements org.apache.thrift.TBase<MBeanOperationInfo, MBeanOperationInfo._Fields>, java.io.Serializable, Cloneable, Comparable<MBeanOperationInfo> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("MBeanOperationInfo");
+
+  private static final org.apache.thrift.protocol.TField NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("name", org.apache.thrift.protocol.TType.STRING, (short)1);
+  private static final org.apache.thrift.protocol.TField RETURN_TYPE_FIELD_DESC = new org.apache.thrift.protocol.TField("returnType", org.apache.thrift.protocol.TType.STRING, (short)2);
+  private static final org.apache.thrift.protocol.TField DESCRIPTION_FIELD_DESC = new org.apache.thrift.protocol.TField("description", org.apache.thrift.protocol.TType.STRING, (short)3);
+  private static final org.apache.thrift.protocol.TField SIGNATURE_FIELD_DESC = new org.apache.thrift.protocol.TField("signature", org.apache.thrift.protocol.TType.LIST, (short)4);
+  private static final org.apache.thrift.protocol.TField IMPACT_FIELD_DESC = new org.apache.thrift.protocol.TField("impact", org.apache.thrift.protocol.TType.I32, (short)5);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new MBeanOperationInfoStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new MBeanOperationInfoTupleSchemeFactory();
+
+  public @org.apache.thrift.annotation.Nullable java.lang.String name; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String returnType; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String description; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<MBeanParameterInfo> signature; // required
+  public int impact; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    NAME((short)1, "name"),
+    RETURN_TYPE((short)2, "returnType"),
+    DESCRIPTION((short)3, "description"),
+    SIGNATURE((short)4, "signature"),
+    IMPACT((short)5, "impact");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // NAME
+          return NAME;
+        case 2: // RETURN_TYPE
+          return RETURN_TYPE;
+        case 3: // DESCRIPTION
+          return DESCRIPTION;
+        case 4: // SIGNATURE
+          return SIGNATURE;
+        case 5: // IMPACT
+          return IMPACT;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __IMPACT_ISSET_ID = 0;
+  private byte __isset_bitfield = 0;
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.NAME, new org.apache.thrift.meta_data.FieldMetaData("name", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.RETURN_TYPE, new org.apache.thrift.meta_data.FieldMetaData("returnType", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.DESCRIPTION, new org.apache.thrift.meta_data.FieldMetaData("description", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.SIGNATURE, new org.apache.thrift.meta_data.FieldMetaData("signature", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, MBeanParameterInfo.class))));
+    tmpMap.put(_Fields.IMPACT, new org.apache.thrift.meta_data.FieldMetaData("impact", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I32)));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(MBeanOperationInfo.class, metaDataMap);
+  }
+
+  public MBeanOperationInfo() {
+  }
+
+  public MBeanOperationInfo(
+    java.lang.String name,
+    java.lang.String returnType,
+    java.lang.String description,
+    java.util.List<MBeanParameterInfo> signature,
+    int impact)
+  {
+    this();
+    this.name = name;
+    this.returnType = returnType;
+    this.description = description;
+    this.signature = signature;
+    this.impact = impact;
+    setImpactIsSet(true);
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public MBeanOperationInfo(MBeanOperationInfo other) {
+    __isset_bitfield = other.__isset_bitfield;
+    if (other.isSetName()) {
+      this.name = other.name;
+    }
+    if (other.isSetReturnType()) {
+      this.returnType = other.returnType;
+    }
+    if (other.isSetDescription()) {
+      this.description = other.description;
+    }
+    if (other.isSetSignature()) {
+      java.util.List<MBeanParameterInfo> __this__signature = new java.util.ArrayList<MBeanParameterInfo>(other.signature.size());
+      for (MBeanParameterInfo other_element : other.signature) {
+        __this__signature.add(new MBeanParameterInfo(other_element));
+      }
+      this.signature = __this__signature;
+    }
+    this.impact = other.impact;
+  }
+
+  @Override
+  public MBeanOperationInfo deepCopy() {
+    return new MBeanOperationInfo(this);
+  }
+
+  @Override
+  public void clear() {
+    this.name = null;
+    this.returnType = null;
+    this.description = null;
+    this.signature = null;
+    setImpactIsSet(false);
+    this.impact = 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getName() {
+    return this.name;
+  }
+
+  public MBeanOperationInfo setName(@org.apache.thrift.annotation.Nullable java.lang.String name) {
+    this.name = name;
+    return this;
+  }
+
+  public void unsetName() {
+    this.name = null;
+  }
+
+  /** Returns true if field name is set (has been assigned a value) and false otherwise */
+  public boolean isSetName() {
+    return this.name != null;
+  }
+
+  public void setNameIsSet(boolean value) {
+    if (!value) {
+      this.name = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getReturnType() {
+    return this.returnType;
+  }
+
+  public MBeanOperationInfo setReturnType(@org.apache.thrift.annotation.Nullable java.lang.String returnType) {
+    this.returnType = returnType;
+    return this;
+  }
+
+  public void unsetReturnType() {
+    this.returnType = null;
+  }
+
+  /** Returns true if field returnType is set (has been assigned a value) and false otherwise */
+  public boolean isSetReturnType() {
+    return this.returnType != null;
+  }
+
+  public void setReturnTypeIsSet(boolean value) {
+    if (!value) {
+      this.returnType = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getDescription() {
+    return this.description;
+  }
+
+  public MBeanOperationInfo setDescription(@org.apache.thrift.annotation.Nullable java.lang.String description) {
+    this.description = description;
+    return this;
+  }
+
+  public void unsetDescription() {
+    this.description = null;
+  }
+
+  /** Returns true if field description is set (has been assigned a value) and false otherwise */
+  public boolean isSetDescription() {
+    return this.description != null;
+  }
+
+  public void setDescriptionIsSet(boolean value) {
+    if (!value) {
+      this.description = null;
+    }
+  }
+
+  public int getSignatureSize() {
+    return (this.signature == null) ? 0 : this.signature.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<MBeanParameterInfo> getSignatureIterator() {
+    return (this.signature == null) ? null : this.signature.iterator();
+  }
+
+  public void addToSignature(MBeanParameterInfo elem) {
+    if (this.signature == null) {
+      this.signature = new java.util.ArrayList<MBeanParameterInfo>();
+    }
+    this.signature.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<MBeanParameterInfo> getSignature() {
+    return this.signature;
+  }
+
+  public MBeanOperationInfo setSignature(@org.apache.thrift.annotation.Nullable java.util.List<MBeanParameterInfo> signature) {
+    this.signature = signature;
+    return this;
+  }
+
+  public void unsetSignature() {
+    this.signature = null;
+  }
+
+  /** Returns true if field signature is set (has been assigned a value) and false otherwise */
+  public boolean isSetSignature() {
+    return this.signature != null;
+  }
+
+  public void setSignatureIsSet(boolean value) {
+    if (!value) {
+      this.signature = null;
+    }
+  }
+
+  public int getImpact() {
+    return this.impact;
+  }
+
+  public MBeanOperationInfo setImpact(int impact) {
+    this.impact = impact;
+    setImpactIsSet(true);
+    return this;
+  }
+
+  public void unsetImpact() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __IMPACT_ISSET_ID);
+  }
+
+  /** Returns true if field impact is set (has been assigned a value) and false otherwise */
+  public boolean isSetImpact() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __IMPACT_ISSET_ID);
+  }
+
+  public void setImpactIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __IMPACT_ISSET_ID, value);
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case NAME:
+      if (value == null) {
+        unsetName();
+      } else {
+        setName((java.lang.String)value);
+      }
+      break;
+
+    case RETURN_TYPE:
+      if (value == null) {
+        unsetReturnType();
+      } else {
+        setReturnType((java.lang.String)value);
+      }
+      break;
+
+    case DESCRIPTION:
+      if (value == null) {
+        unsetDescription();
+      } else {
+        setDescription((java.lang.String)value);
+      }
+      break;
+
+    case SIGNATURE:
+      if (value == null) {
+        unsetSignature();
+      } else {
+        setSignature((java.util.List<MBeanParameterInfo>)value);
+      }
+      break;
+
+    case IMPACT:
+      if (value == null) {
+        unsetImpact();
+      } else {
+        setImpact((java.lang.Integer)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case NAME:
+      return getName();
+
+    case RETURN_TYPE:
+      return getReturnType();
+
+    case DESCRIPTION:
+      return getDescription();
+
+    case SIGNATURE:
+      return getSignature();
+
+    case IMPACT:
+      return getImpact();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case NAME:
+      return isSetName();
+    case RETURN_TYPE:
+      return isSetReturnType();
+    case DESCRIPTION:
+      return isSetDescription();
+    case SIGNATURE:
+      return isSetSignature();
+    case IMPACT:
+      return isSetImpact();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof MBeanOperationInfo)
+      return this.equals((MBeanOperationInfo)that);
+    return false;
+  }
+
+  public boolean equals(MBeanOperationInfo that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_name = true && this.isSetName();
+    boolean that_present_name = true && that.isSetName();
+    if (this_present_name || that_present_name) {
+      if (!(this_present_name && that_present_name))
+        return false;
+      if (!this.name.equals(that.name))
+        return false;
+    }
+
+    boolean this_present_returnType = true && this.isSetReturnType();
+    boolean that_present_returnType = true && that.isSetReturnType();
+    if (this_present_returnType || that_present_returnType) {
+      if (!(this_present_returnType && that_present_returnType))
+        return false;
+      if (!this.returnType.equals(that.returnType))
+        return false;
+    }
+
+    boolean this_present_description = true && this.isSetDescription();
+    boolean that_present_description = true && that.isSetDescription();
+    if (this_present_description || that_present_description) {
+      if (!(this_present_description && that_present_description))
+        return false;
+      if (!this.description.equals(that.description))
+        return false;
+    }
+
+    boolean this_present_signature = true && this.isSetSignature();
+    boolean that_present_signature = true && that.isSetSignature();
+    if (this_present_signature || that_present_signature) {
+      if (!(this_present_signature && that_present_signature))
+        return false;
+      if (!this.signature.equals(that.signature))
+        return false;
+    }
+
+    boolean this_present_impact = true;
+    boolean that_present_impact = true;
+    if (this_present_impact || that_present_impact) {
+      if (!(this_present_impact && that_present_impact))
+        return false;
+      if (this.impact != that.impact)
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((isSetName()) ? 131071 : 524287);
+    if (isSetName())
+      hashCode = hashCode * 8191 + name.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetReturnType()) ? 131071 : 524287);
+    if (isSetReturnType())
+      hashCode = hashCode * 8191 + returnType.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetDescription()) ? 131071 : 524287);
+    if (isSetDescription())
+      hashCode = hashCode * 8191 + description.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetSignature()) ? 131071 : 524287);
+    if (isSetSignature())
+      hashCode = hashCode * 8191 + signature.hashCode();
+
+    hashCode = hashCode * 8191 + impact;
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(MBeanOperationInfo other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetName(), other.isSetName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.name, other.name);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetReturnType(), other.isSetReturnType());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetReturnType()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.returnType, other.returnType);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetDescription(), other.isSetDescription());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetDescription()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.description, other.description);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetSignature(), other.isSetSignature());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetSignature()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.signature, other.signature);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetImpact(), other.isSetImpact());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetImpact()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.impact, other.impact);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("MBeanOperationInfo(");
+    boolean first = true;
+
+    sb.append("name:");
+    if (this.name == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.name);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("returnType:");
+    if (this.returnType == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.returnType);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("description:");
+    if (this.description == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.description);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("signature:");
+    if (this.signature == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.signature);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("impact:");
+    sb.append(this.impact);
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class MBeanOperationInfoStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public MBeanOperationInfoStandardScheme getScheme() {
+      return new MBeanOperationInfoStandardScheme();
+    }
+  }
+
+  private static class MBeanOperationInfoStandardScheme extends org.apache.thrift.scheme.StandardScheme<MBeanOperationInfo> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, MBeanOperationInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.name = iprot.readString();
+              struct.setNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // RETURN_TYPE
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.returnType = iprot.readString();
+              struct.setReturnTypeIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // DESCRIPTION
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.description = iprot.readString();
+              struct.setDescriptionIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // SIGNATURE
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list8 = iprot.readListBegin();
+                struct.signature = new java.util.ArrayList<MBeanParameterInfo>(_list8.size);
+                @org.apache.thrift.annotation.Nullable MBeanParameterInfo _elem9;
+                for (int _i10 = 0; _i10 < _list8.size; ++_i10)
+                {
+                  _elem9 = new MBeanParameterInfo();
+                  _elem9.read(iprot);
+                  struct.signature.add(_elem9);
+                }
+                iprot.readListEnd();
+              }
+              struct.setSignatureIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // IMPACT
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.impact = iprot.readI32();
+              struct.setImpactIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, MBeanOperationInfo struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      if (struct.name != null) {
+        oprot.writeFieldBegin(NAME_FIELD_DESC);
+        oprot.writeString(struct.name);
+        oprot.writeFieldEnd();
+      }
+      if (struct.returnType != null) {
+        oprot.writeFieldBegin(RETURN_TYPE_FIELD_DESC);
+        oprot.writeString(struct.returnType);
+        oprot.writeFieldEnd();
+      }
+      if (struct.description != null) {
+        oprot.writeFieldBegin(DESCRIPTION_FIELD_DESC);
+        oprot.writeString(struct.description);
+        oprot.writeFieldEnd();
+      }
+      if (struct.signature != null) {
+        oprot.writeFieldBegin(SIGNATURE_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRUCT, struct.signature.size()));
+          for (MBeanParameterInfo _iter11 : struct.signature)
+          {
+            _iter11.write(oprot);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(IMPACT_FIELD_DESC);
+      oprot.writeI32(struct.impact);
+      oprot.writeFieldEnd();
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class MBeanOperationInfoTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public MBeanOperationInfoTupleScheme getScheme() {
+      return new MBeanOperationInfoTupleScheme();
+    }
+  }
+
+  private static class MBeanOperationInfoTupleScheme extends org.apache.thrift.scheme.TupleScheme<MBeanOperationInfo> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, MBeanOperationInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetName()) {
+        optionals.set(0);
+      }
+      if (struct.isSetReturnType()) {
+        optionals.set(1);
+      }
+      if (struct.isSetDescription()) {
+        optionals.set(2);
+      }
+      if (struct.isSetSignature()) {
+        optionals.set(3);
+      }
+      if (struct.isSetImpact()) {
+        optionals.set(4);
+      }
+      oprot.writeBitSet(optionals, 5);
+      if (struct.isSetName()) {
+        oprot.writeString(struct.name);
+      }
+      if (struct.isSetReturnType()) {
+        oprot.writeString(struct.returnType);
+      }
+      if (struct.isSetDescription()) {
+        oprot.writeString(struct.description);
+      }
+      if (struct.isSetSignature()) {
+        {
+          oprot.writeI32(struct.signature.size());
+          for (MBeanParameterInfo _iter12 : struct.signature)
+          {
+            _iter12.write(oprot);
+          }
+        }
+      }
+      if (struct.isSetImpact()) {
+        oprot.writeI32(struct.impact);
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, MBeanOperationInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(5);
+      if (incoming.get(0)) {
+        struct.name = iprot.readString();
+        struct.setNameIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.returnType = iprot.readString();
+        struct.setReturnTypeIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.description = iprot.readString();
+        struct.setDescriptionIsSet(true);
+      }
+      if (incoming.get(3)) {
+        {
+          org.apache.thrift.protocol.TList _list13 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRUCT);
+          struct.signature = new java.util.ArrayList<MBeanParameterInfo>(_list13.size);
+          @org.apache.thrift.annotation.Nullable MBeanParameterInfo _elem14;
+          for (int _i15 = 0; _i15 < _list13.size; ++_i15)
+          {
+            _elem14 = new MBeanParameterInfo();
+            _elem14.read(iprot);
+            struct.signature.add(_elem14);
+          }
+        }
+        struct.setSignatureIsSet(true);
+      }
+      if (incoming.get(4)) {
+        struct.impact = iprot.readI32();
+        struct.setImpactIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
@@ -0,0 +1,46 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+
+public enum QueryLimit implements org.apache.thrift.TEnum {
+  MBEANS_PER_PATTERN(0),
+  ATTRIBUTES_PER_MBEAN(1),
+  RESPONSES_PER_CALL(2);
+
+  private final int value;
+
+  private QueryLimit(int value) {
+    this.value = value;
+  }
+
+  /**
+   * Get the integer value of this enum value, as defined in the Thrift IDL.
+   */
+  @Override
+  public int getValue() {
+    return value;
+  }
+
+  /**
+   * Find a the enum type by its integer value, as defined in the Thrift IDL.
+   * @return null if the value is not found.
+   */
+  @org.apache.thrift.annotation.Nullable
+  public static QueryLimit findByValue(int value) { 
+    switch (value) {
+      case 0:
+        return MBEANS_PER_PATTERN;
+      case 1:
+        return ATTRIBUTES_PER_MBEAN;
+      case 2:
+        return RESPONSES_PER_CALL;
+      default:
+        return null;
+    }
+  }
+}
@@ -0,0 +1,1017 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class ServerInfo implements org.apache.thrift.TBase<ServerInfo, ServerInfo._Fields>, java.io.Serializable, Cloneable, Comparable<ServerInfo> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("ServerInfo");
+
+  private static final org.apache.thrift.protocol.TField M_BEAN_SERVER_ID_FIELD_DESC = new org.apache.thrift.protocol.TField("mBeanServerId", org.apache.thrift.protocol.TType.STRING, (short)1);
+  private static final org.apache.thrift.protocol.TField SPECIFICATION_VERSION_FIELD_DESC = new org.apache.thrift.protocol.TField("specificationVersion", org.apache.thrift.protocol.TType.STRING, (short)2);
+  private static final org.apache.thrift.protocol.TField IMPLEMENTATION_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("implementationName", org.apache.thrift.protocol.TType.STRING, (short)3);
+  private static final org.apache.thrift.protocol.TField IMPLEMENTATION_VERSION_FIELD_DESC = new org.apache.thrift.protocol.TField("implementationVersion", org.apache.thrift.protocol.TType.STRING, (short)4);
+  private static final org.apache.thrift.protocol.TField IMPLEMENTATION_VENDOR_FIELD_DESC = new org.apache.thrift.protocol.TField("implementationVendor", org.apache.thrift.protocol.TType.STRING, (short)5);
+  private static final org.apache.thrift.protocol.TField DEFAULT_DOMAIN_FIELD_DESC = new org.apache.thrift.protocol.TField("defaultDomain", org.apache.thrift.protocol.TType.STRING, (short)6);
+  private static final org.apache.thrift.protocol.TField CONNECTION_ID_FIELD_DESC = new org.apache.thrift.protocol.TField("connectionId", org.apache.thrift.protocol.TType.STRING, (short)7);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new ServerInfoStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new ServerInfoTupleSchemeFactory();
+
+  public @org.apache.thrift.annotation.Nullable java.lang.String mBeanServerId; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String specificationVersion; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String implementationName; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String implementationVersion; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String implementationVendor; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String defaultDomain; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String connectionId; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    M_BEAN_SERVER_ID((short)1, "mBeanServerId"),
+    SPECIFICATION_VERSION((short)2, "specificationVersion"),
+    IMPLEMENTATION_NAME((short)3, "implementationName"),
+    IMPLEMENTATION_VERSION((short)4, "implementationVersion"),
+    IMPLEMENTATION_VENDOR((short)5, "implementationVendor"),
+    DEFAULT_DOMAIN((short)6, "defaultDomain"),
+    CONNECTION_ID((short)7, "connectionId");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // M_BEAN_SERVER_ID
+          return M_BEAN_SERVER_ID;
+        case 2: // SPECIFICATION_VERSION
+          return SPECIFICATION_VERSION;
+        case 3: // IMPLEMENTATION_NAME
+          return IMPLEMENTATION_NAME;
+        case 4: // IMPLEMENTATION_VERSION
+          return IMPLEMENTATION_VERSION;
+        case 5: // IMPLEMENTATION_VENDOR
+          return IMPLEMENTATION_VENDOR;
+        case 6: // DEFAULT_DOMAIN
+          return DEFAULT_DOMAIN;
+        case 7: // CONNECTION_ID
+          return CONNECTION_ID;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.M_BEAN_SERVER_ID, new org.apache.thrift.meta_data.FieldMetaData("mBeanServerId", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.SPECIFICATION_VERSION, new org.apache.thrift.meta_data.FieldMetaData("specificationVersion", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.IMPLEMENTATION_NAME, new org.apache.thrift.meta_data.FieldMetaData("implementationName", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.IMPLEMENTATION_VERSION, new org.apache.thrift.meta_data.FieldMetaData("implementationVersion", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.IMPLEMENTATION_VENDOR, new org.apache.thrift.meta_data.FieldMetaData("implementationVendor", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.DEFAULT_DOMAIN, new org.apache.thrift.meta_data.FieldMetaData("defaultDomain", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.CONNECTION_ID, new org.apache.thrift.meta_data.FieldMetaData("connectionId", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(ServerInfo.class, metaDataMap);
+  }
+
+  public ServerInfo() {
+  }
+
+  public ServerInfo(
+    java.lang.String mBeanServerId,
+    java.lang.String specificationVersion,
+    java.lang.String implementationName,
+    java.lang.String implementationVersion,
+    java.lang.String implementationVendor,
+    java.lang.String defaultDomain,
+    java.lang.String connectionId)
+  {
+    this();
+    this.mBeanServerId = mBeanServerId;
+    this.specificationVersion = specificationVersion;
+    this.implementationName = implementationName;
+    this.implementationVersion = implementationVersion;
+    this.implementationVendor = implementationVendor;
+    this.defaultDomain = defaultDomain;
+    this.connectionId = connectionId;
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public ServerInfo(ServerInfo other) {
+    if (other.isSetMBeanServerId()) {
+      this.mBeanServerId = other.mBeanServerId;
+    }
+    if (other.isSetSpecificationVersion()) {
+      this.specificationVersion = other.specificationVersion;
+    }
+    if (other.isSetImplementationName()) {
+      this.implementationName = other.implementationName;
+    }
+    if (other.isSetImplementationVersion()) {
+      this.implementationVersion = other.implementationVersion;
+    }
+    if (other.isSetImplementationVendor()) {
+      this.implementationVendor = other.implementationVendor;
+    }
+    if (other.isSetDefaultDomain()) {
+      this.defaultDomain = other.defaultDomain;
+    }
+    if (other.isSetConnectionId()) {
+      this.connectionId = other.connectionId;
+    }
+  }
+
+  @Override
+  public ServerInfo deepCopy() {
+    return new ServerInfo(this);
+  }
+
+  @Override
+  public void clear() {
+    this.mBeanServerId = null;
+    this.specificationVersion = null;
+    this.implementationName = null;
+    this.implementationVersion = null;
+    this.implementationVendor = null;
+    this.defaultDomain = null;
+    this.connectionId = null;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getMBeanServerId() {
+    return this.mBeanServerId;
+  }
+
+  public ServerInfo setMBeanServerId(@org.apache.thrift.annotation.Nullable java.lang.String mBeanServerId) {
+    this.mBeanServerId = mBeanServerId;
+    return this;
+  }
+
+  public void unsetMBeanServerId() {
+    this.mBeanServerId = null;
+  }
+
+  /** Returns true if field mBeanServerId is set (has been assigned a value) and false otherwise */
+  public boolean isSetMBeanServerId() {
+    return this.mBeanServerId != null;
+  }
+
+  public void setMBeanServerIdIsSet(boolean value) {
+    if (!value) {
+      this.mBeanServerId = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getSpecificationVersion() {
+    return this.specificationVersion;
+  }
+
+  public ServerInfo setSpecificationVersion(@org.apache.thrift.annotation.Nullable java.lang.String specificationVersion) {
+    this.specificationVersion = specificationVersion;
+    return this;
+  }
+
+  public void unsetSpecificationVersion() {
+    this.specificationVersion = null;
+  }
+
+  /** Returns true if field specificationVersion is set (has been assigned a value) and false otherwise */
+  public boolean isSetSpecificationVersion() {
+    return this.specificationVersion != null;
+  }
+
+  public void setSpecificationVersionIsSet(boolean value) {
+    if (!value) {
+      this.specificationVersion = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getImplementationName() {
+    return this.implementationName;
+  }
+
+  public ServerInfo setImplementationName(@org.apache.thrift.annotation.Nullable java.lang.String implementationName) {
+    this.implementationName = implementationName;
+    return this;
+  }
+
+  public void unsetImplementationName() {
+    this.implementationName = null;
+  }
+
+  /** Returns true if field implementationName is set (has been assigned a value) and false otherwise */
+  public boolean isSetImplementationName() {
+    return this.implementationName != null;
+  }
+
+  public void setImplementationNameIsSet(boolean value) {
+    if (!value) {
+      this.implementationName = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getImplementationVersion() {
+    return this.implementationVersion;
+  }
+
+  public ServerInfo setImplementationVersion(@org.apache.thrift.annotation.Nullable java.lang.String implementationVersion) {
+    this.implementationVersion = implementationVersion;
+    return this;
+  }
+
+  public void unsetImplementationVersion() {
+    this.implementationVersion = null;
+  }
+
+  /** Returns true if field implementationVersion is set (has been assigned a value) and false otherwise */
+  public boolean isSetImplementationVersion() {
+    return this.implementationVersion != null;
+  }
+
+  public void setImplementationVersionIsSet(boolean value) {
+    if (!value) {
+      this.implementationVersion = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getImplementationVendor() {
+    return this.implementationVendor;
+  }
+
+  public ServerInfo setImplementationVendor(@org.apache.thrift.annotation.Nullable java.lang.String implementationVendor) {
+    this.implementationVendor = implementationVendor;
+    return this;
+  }
+
+  public void unsetImplementationVendor() {
+    this.implementationVendor = null;
+  }
+
+  /** Returns true if field implementationVendor is set (has been assigned a value) and false otherwise */
+  public boolean isSetImplementationVendor() {
+    return this.implementationVendor != null;
+  }
+
+  public void setImplementationVendorIsSet(boolean value) {
+    if (!value) {
+      this.implementationVendor = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getDefaultDomain() {
+    return this.defaultDomain;
+  }
+
+  public ServerInfo setDefaultDomain(@org.apache.thrift.annotation.Nullable java.lang.String defaultDomain) {
+    this.defaultDomain = defaultDomain;
+    return this;
+  }
+
+  public void unsetDefaultDomain() {
+    this.defaultDomain = null;
+  }
+
+  /** Returns true if field defaultDomain is set (has been assigned a value) and false otherwise */
+  public boolean isSetDefaultDomain() {
+    return this.defaultDomain != null;
+  }
+
+  public void setDefaultDomainIsSet(boolean value) {
+    if (!value) {
+      this.defaultDomain = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getConnectionId() {
+    return this.connectionId;
+  }
+
+  public ServerInfo setConnectionId(@org.apache.thrift.annotation.Nullable java.lang.String connectionId) {
+    this.connectionId = connectionId;
+    return this;
+  }
+
+  public void unsetConnectionId() {
+    this.connectionId = null;
+  }
+
+  /** Returns true if field connectionId is set (has been assigned a value) and false otherwise */
+  public boolean isSetConnectionId() {
+    return this.connectionId != null;
+  }
+
+  public void setConnectionIdIsSet(boolean value) {
+    if (!value) {
+      this.connectionId = null;
+    }
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case M_BEAN_SERVER_ID:
+      if (value == null) {
+        unsetMBeanServerId();
+      } else {
+        setMBeanServerId((java.lang.String)value);
+      }
+      break;
+
+    case SPECIFICATION_VERSION:
+      if (value == null) {
+        unsetSpecificationVersion();
+      } else {
+        setSpecificationVersion((java.lang.String)value);
+      }
+      break;
+
+    case IMPLEMENTATION_NAME:
+      if (value == null) {
+        unsetImplementationName();
+      } else {
+        setImplementationName((java.lang.String)value);
+      }
+      break;
+
+    case IMPLEMENTATION_VERSION:
+      if (value == null) {
+        unsetImplementationVersion();
+      } else {
+        setImplementationVersion((java.lang.String)value);
+      }
+      break;
+
+    case IMPLEMENTATION_VENDOR:
+      if (value == null) {
+        unsetImplementationVendor();
+      } else {
+        setImplementationVendor((java.lang.String)value);
+      }
+      break;
+
+    case DEFAULT_DOMAIN:
+      if (value == null) {
+        unsetDefaultDomain();
+      } else {
+        setDefaultDomain((java.lang.String)value);
+      }
+      break;
+
+    case CONNECTION_ID:
+      if (value == null) {
+        unsetConnectionId();
+      } else {
+        setConnectionId((java.lang.String)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case M_BEAN_SERVER_ID:
+      return getMBeanServerId();
+
+    case SPECIFICATION_VERSION:
+      return getSpecificationVersion();
+
+    case IMPLEMENTATION_NAME:
+      return getImplementationName();
+
+    case IMPLEMENTATION_VERSION:
+      return getImplementationVersion();
+
+    case IMPLEMENTATION_VENDOR:
+      return getImplementationVendor();
+
+    case DEFAULT_DOMAIN:
+      return getDefaultDomain();
+
+    case CONNECTION_ID:
+      return getConnectionId();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case M_BEAN_SERVER_ID:
+      return isSetMBeanServerId();
+    case SPECIFICATION_VERSION:
+      return isSetSpecificationVersion();
+    case IMPLEMENTATION_NAME:
+      return isSetImplementationName();
+    case IMPLEMENTATION_VERSION:
+      return isSetImplementationVersion();
+    case IMPLEMENTATION_VENDOR:
+      return isSetImplementationVendor();
+    case DEFAULT_DOMAIN:
+      return isSetDefaultDomain();
+    case CONNECTION_ID:
+      return isSetConnectionId();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof ServerInfo)
+      return this.equals((ServerInfo)that);
+    return false;
+  }
+
+  public boolean equals(ServerInfo that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_mBeanServerId = true && this.isSetMBeanServerId();
+    boolean that_present_mBeanServerId = true && that.isSetMBeanServerId();
+    if (this_present_mBeanServerId || that_present_mBeanServerId) {
+      if (!(this_present_mBeanServerId && that_present_mBeanServerId))
+        return false;
+      if (!this.mBeanServerId.equals(that.mBeanServerId))
+        return false;
+    }
+
+    boolean this_present_specificationVersion = true && this.isSetSpecificationVersion();
+    boolean that_present_specificationVersion = true && that.isSetSpecificationVersion();
+    if (this_present_specificationVersion || that_present_specificationVersion) {
+      if (!(this_present_specificationVersion && that_present_specificationVersion))
+        return false;
+      if (!this.specificationVersion.equals(that.specificationVersion))
+        return false;
+    }
+
+    boolean this_present_implementationName = true && this.isSetImplementationName();
+    boolean that_present_implementationName = true && that.isSetImplementationName();
+    if (this_present_implementationName || that_present_implementationName) {
+      if (!(this_present_implementationName && that_present_implementationName))
+        return false;
+      if (!this.implementationName.equals(that.implementationName))
+        return false;
+    }
+
+    boolean this_present_implementationVersion = true && this.isSetImplementationVersion();
+    boolean that_present_implementationVersion = true && that.isSetImplementationVersion();
+    if (this_present_implementationVersion || that_present_implementationVersion) {
+      if (!(this_present_implementationVersion && that_present_implementationVersion))
+        return false;
+      if (!this.implementationVersion.equals(that.implementationVersion))
+        return false;
+    }
+
+    boolean this_present_implementationVendor = true && this.isSetImplementationVendor();
+    boolean that_present_implementationVendor = true && that.isSetImplementationVendor();
+    if (this_present_implementationVendor || that_present_implementationVendor) {
+      if (!(this_present_implementationVendor && that_present_implementationVendor))
+        return false;
+      if (!this.implementationVendor.equals(that.implementationVendor))
+        return false;
+    }
+
+    boolean this_present_defaultDomain = true && this.isSetDefaultDomain();
+    boolean that_present_defaultDomain = true && that.isSetDefaultDomain();
+    if (this_present_defaultDomain || that_present_defaultDomain) {
+      if (!(this_present_defaultDomain && that_present_defaultDomain))
+        return false;
+      if (!this.defaultDomain.equals(that.defaultDomain))
+        return false;
+    }
+
+    boolean this_present_connectionId = true && this.isSetConnectionId();
+    boolean that_present_connectionId = true && that.isSetConnectionId();
+    if (this_present_connectionId || that_present_connectionId) {
+      if (!(this_present_connectionId && that_present_connectionId))
+        return false;
+      if (!this.connectionId.equals(that.connectionId))
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((isSetMBeanServerId()) ? 131071 : 524287);
+    if (isSetMBeanServerId())
+      hashCode = hashCode * 8191 + mBeanServerId.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetSpecificationVersion()) ? 131071 : 524287);
+    if (isSetSpecificationVersion())
+      hashCode = hashCode * 8191 + specificationVersion.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetImplementationName()) ? 131071 : 524287);
+    if (isSetImplementationName())
+      hashCode = hashCode * 8191 + implementationName.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetImplementationVersion()) ? 131071 : 524287);
+    if (isSetImplementationVersion())
+      hashCode = hashCode * 8191 + implementationVersion.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetImplementationVendor()) ? 131071 : 524287);
+    if (isSetImplementationVendor())
+      hashCode = hashCode * 8191 + implementationVendor.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetDefaultDomain()) ? 131071 : 524287);
+    if (isSetDefaultDomain())
+      hashCode = hashCode * 8191 + defaultDomain.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetConnectionId()) ? 131071 : 524287);
+    if (isSetConnectionId())
+      hashCode = hashCode * 8191 + connectionId.hashCode();
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(ServerInfo other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetMBeanServerId(), other.isSetMBeanServerId());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMBeanServerId()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.mBeanServerId, other.mBeanServerId);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetSpecificationVersion(), other.isSetSpecificationVersion());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetSpecificationVersion()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.specificationVersion, other.specificationVersion);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetImplementationName(), other.isSetImplementationName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetImplementationName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.implementationName, other.implementationName);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetImplementationVersion(), other.isSetImplementationVersion());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetImplementationVersion()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.implementationVersion, other.implementationVersion);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetImplementationVendor(), other.isSetImplementationVendor());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetImplementationVendor()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.implementationVendor, other.implementationVendor);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetDefaultDomain(), other.isSetDefaultDomain());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetDefaultDomain()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.defaultDomain, other.defaultDomain);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetConnectionId(), other.isSetConnectionId());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetConnectionId()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.connectionId, other.connectionId);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("ServerInfo(");
+    boolean first = true;
+
+    sb.append("mBeanServerId:");
+    if (this.mBeanServerId == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.mBeanServerId);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("specificationVersion:");
+    if (this.specificationVersion == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.specificationVersion);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("implementationName:");
+    if (this.implementationName == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.implementationName);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("implementationVersion:");
+    if (this.implementationVersion == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.implementationVersion);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("implementationVendor:");
+    if (this.implementationVendor == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.implementationVendor);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("defaultDomain:");
+    if (this.defaultDomain == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.defaultDomain);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("connectionId:");
+    if (this.connectionId == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.connectionId);
+    }
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class ServerInfoStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public ServerInfoStandardScheme getScheme() {
+      return new ServerInfoStandardScheme();
+    }
+  }
+
+  private static class ServerInfoStandardScheme extends org.apache.thrift.scheme.StandardScheme<ServerInfo> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, ServerInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // M_BEAN_SERVER_ID
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.mBeanServerId = iprot.readString();
+              struct.setMBeanServerIdIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // SPECIFICATION_VERSION
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.specificationVersion = iprot.readString();
+              struct.setSpecificationVersionIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // IMPLEMENTATION_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.implementationName = iprot.readString();
+              struct.setImplementationNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // IMPLEMENTATION_VERSION
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.implementationVersion = iprot.readString();
+              struct.setImplementationVersionIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // IMPLEMENTATION_VENDOR
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.implementationVendor = iprot.readString();
+              struct.setImplementationVendorIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 6: // DEFAULT_DOMAIN
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.defaultDomain = iprot.readString();
+              struct.setDefaultDomainIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 7: // CONNECTION_ID
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.connectionId = iprot.readString();
+              struct.setConnectionIdIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, ServerInfo struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      if (struct.mBeanServerId != null) {
+        oprot.writeFieldBegin(M_BEAN_SERVER_ID_FIELD_DESC);
+        oprot.writeString(struct.mBeanServerId);
+        oprot.writeFieldEnd();
+      }
+      if (struct.specificationVersion != null) {
+        oprot.writeFieldBegin(SPECIFICATION_VERSION_FIELD_DESC);
+        oprot.writeString(struct.specificationVersion);
+        oprot.writeFieldEnd();
+      }
+      if (struct.implementationName != null) {
+        oprot.writeFieldBegin(IMPLEMENTATION_NAME_FIELD_DESC);
+        oprot.writeString(struct.implementationName);
+        oprot.writeFieldEnd();
+      }
+      if (struct.implementationVersion != null) {
+        oprot.writeFieldBegin(IMPLEMENTATION_VERSION_FIELD_DESC);
+        oprot.writeString(struct.implementationVersion);
+        oprot.writeFieldEnd();
+      }
+      if (struct.implementationVendor != null) {
+        oprot.writeFieldBegin(IMPLEMENTATION_VENDOR_FIELD_DESC);
+        oprot.writeString(struct.implementationVendor);
+        oprot.writeFieldEnd();
+      }
+      if (struct.defaultDomain != null) {
+        oprot.writeFieldBegin(DEFAULT_DOMAIN_FIELD_DESC);
+        oprot.writeString(struct.defaultDomain);
+        oprot.writeFieldEnd();
+      }
+      if (struct.connectionId != null) {
+        oprot.writeFieldBegin(CONNECTION_ID_FIELD_DESC);
+        oprot.writeString(struct.connectionId);
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class ServerInfoTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public ServerInfoTupleScheme getScheme() {
+      return new ServerInfoTupleScheme();
+    }
+  }
+
+  private static class ServerInfoTupleScheme extends org.apache.thrift.scheme.TupleScheme<ServerInfo> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, ServerInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetMBeanServerId()) {
+        optionals.set(0);
+      }
+      if (struct.isSetSpecificationVersion()) {
+        optionals.set(1);
+      }
+      if (struct.isSetImplementationName()) {
+        optionals.set(2);
+      }
+      if (struct.isSetImplementationVersion()) {
+        optionals.set(3);
+      }
+      if (struct.isSetImplementationVendor()) {
+        optionals.set(4);
+      }
+      if (struct.isSetDefaultDomain()) {
+        optionals.set(5);
+      }
+      if (struct.isSetConnectionId()) {
+        optionals.set(6);
+      }
+      oprot.writeBitSet(optionals, 7);
+      if (struct.isSetMBeanServerId()) {
+        oprot.writeString(struct.mBeanServerId);
+      }
+      if (struct.isSetSpecificationVersion()) {
+        oprot.writeString(struct.specificationVersion);
+      }
+      if (struct.isSetImplementationName()) {
+        oprot.writeString(struct.implementationName);
+      }
+      if (struct.isSetImplementationVersion()) {
+        oprot.writeString(struct.implementationVersion);
+      }
+      if (struct.isSetImplementationVendor()) {
+        oprot.writeString(struct.implementationVendor);
+      }
+      if (struct.isSetDefaultDomain()) {
+        oprot.writeString(struct.defaultDomain);
+      }
+      if (struct.isSetConnectionId()) {
+        oprot.writeString(struct.connectionId);
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, ServerInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(7);
+      if (incoming.get(0)) {
+        struct.mBeanServerId = iprot.readString();
+        struct.setMBeanServerIdIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.specificationVersion = iprot.readString();
+        struct.setSpecificationVersionIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.implementationName = iprot.readString();
+        struct.setImplementationNameIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.implementationVersion = iprot.readString();
+        struct.setImplementationVersionIsSet(true);
+      }
+      if (incoming.get(4)) {
+        struct.implementationVendor = iprot.readString();
+        struct.setImplementationVendorIsSet(true);
+      }
+      if (incoming.get(5)) {
+        struct.defaultDomain = iprot.readString();
+        struct.setDefaultDomainIsSet(true);
+      }
+      if (incoming.get(6)) {
+        struct.connectionId = iprot.readString();
+        struct.setConnectionIdIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
@@ -0,0 +1,953 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class StackFrame implements org.apache.thrift.TBase<StackFrame, StackFrame._Fields>, java.io.Serializable, Cloneable, Comparable<StackFrame> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("StackFrame");
+
+  private static final org.apache.thrift.protocol.TField CLASS_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("className", org.apache.thrift.protocol.TType.STRING, (short)1);
+  private static final org.apache.thrift.protocol.TField METHOD_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("methodName", org.apache.thrift.protocol.TType.STRING, (short)2);
+  private static final org.apache.thrift.protocol.TField FILE_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("fileName", org.apache.thrift.protocol.TType.STRING, (short)3);
+  private static final org.apache.thrift.protocol.TField LINE_NUMBER_FIELD_DESC = new org.apache.thrift.protocol.TField("lineNumber", org.apache.thrift.protocol.TType.I32, (short)4);
+  private static final org.apache.thrift.protocol.TField NATIVE_METHOD_FIELD_DESC = new org.apache.thrift.protocol.TField("nativeMethod", org.apache.thrift.protocol.TType.BOOL, (short)5);
+  private static final org.apache.thrift.protocol.TField LOCKED_MONITORS_FIELD_DESC = new org.apache.thrift.protocol.TField("lockedMonitors", org.apache.thrift.protocol.TType.LIST, (short)6);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new StackFrameStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new StackFrameTupleSchemeFactory();
+
+  public @org.apache.thrift.annotation.Nullable java.lang.String className; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String methodName; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String fileName; // required
+  public int lineNumber; // required
+  public boolean nativeMethod; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<LockInfo> lockedMonitors; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    CLASS_NAME((short)1, "className"),
+    METHOD_NAME((short)2, "methodName"),
+    FILE_NAME((short)3, "fileName"),
+    LINE_NUMBER((short)4, "lineNumber"),
+    NATIVE_METHOD((short)5, "nativeMethod"),
+    LOCKED_MONITORS((short)6, "lockedMonitors");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // CLASS_NAME
+          return CLASS_NAME;
+        case 2: // METHOD_NAME
+          return METHOD_NAME;
+        case 3: // FILE_NAME
+          return FILE_NAME;
+        case 4: // LINE_NUMBER
+          return LINE_NUMBER;
+        case 5: // NATIVE_METHOD
+          return NATIVE_METHOD;
+        case 6: // LOCKED_MONITORS
+          return LOCKED_MONITORS;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __LINENUMBER_ISSET_ID = 0;
+  private static final int __NATIVEMETHOD_ISSET_ID = 1;
+  private byte __isset_bitfield = 0;
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.CLASS_NAME, new org.apache.thrift.meta_data.FieldMetaData("className", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.METHOD_NAME, new org.apache.thrift.meta_data.FieldMetaData("methodName", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.FILE_NAME, new org.apache.thrift.meta_data.FieldMetaData("fileName", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.LINE_NUMBER, new org.apache.thrift.meta_data.FieldMetaData("lineNumber", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I32)));
+    tmpMap.put(_Fields.NATIVE_METHOD, new org.apache.thrift.meta_data.FieldMetaData("nativeMethod", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.LOCKED_MONITORS, new org.apache.thrift.meta_data.FieldMetaData("lockedMonitors", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, LockInfo.class))));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(StackFrame.class, metaDataMap);
+  }
+
+  public StackFrame() {
+  }
+
+  public StackFrame(
+    java.lang.String className,
+    java.lang.String methodName,
+    java.lang.String fileName,
+    int lineNumber,
+    boolean nativeMethod,
+    java.util.List<LockInfo> lockedMonitors)
+  {
+    this();
+    this.className = className;
+    this.methodName = methodName;
+    this.fileName = fileName;
+    this.lineNumber = lineNumber;
+    setLineNumberIsSet(true);
+    this.nativeMethod = nativeMethod;
+    setNativeMethodIsSet(true);
+    this.lockedMonitors = lockedMonitors;
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public StackFrame(StackFrame other) {
+    __isset_bitfield = other.__isset_bitfield;
+    if (other.isSetClassName()) {
+      this.className = other.className;
+    }
+    if (other.isSetMethodName()) {
+      this.methodName = other.methodName;
+    }
+    if (other.isSetFileName()) {
+      this.fileName = other.fileName;
+    }
+    this.lineNumber = other.lineNumber;
+    this.nativeMethod = other.nativeMethod;
+    if (other.isSetLockedMonitors()) {
+      java.util.List<LockInfo> __this__lockedMonitors = new java.util.ArrayList<LockInfo>(other.lockedMonitors.size());
+      for (LockInfo other_element : other.lockedMonitors) {
+        __this__lockedMonitors.add(new LockInfo(other_element));
+      }
+      this.lockedMonitors = __this__lockedMonitors;
+    }
+  }
+
+  @Override
+  public StackFrame deepCopy() {
+    return new StackFrame(this);
+  }
+
+  @Override
+  public void clear() {
+    this.className = null;
+    this.methodName = null;
+    this.fileName = null;
+    setLineNumberIsSet(false);
+    this.lineNumber = 0;
+    setNativeMethodIsSet(false);
+    this.nativeMethod = false;
+    this.lockedMonitors = null;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getClassName() {
+    return this.className;
+  }
+
+  public StackFrame setClassName(@org.apache.thrift.annotation.Nullable java.lang.String className) {
+    this.className = className;
+    return this;
+  }
+
+  public void unsetClassName() {
+    this.className = null;
+  }
+
+  /** Returns true if field className is set (has been assigned a value) and false otherwise */
+  public boolean isSetClassName() {
+    return this.className != null;
+  }
+
+  public void setClassNameIsSet(boolean value) {
+    if (!value) {
+      this.className = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getMethodName() {
+    return this.methodName;
+  }
+
+  public StackFrame setMethodName(@org.apache.thrift.annotation.Nullable java.lang.String methodName) {
+    this.methodName = methodName;
+    return this;
+  }
+
+  public void unsetMethodName() {
+    this.methodName = null;
+  }
+
+  /** Returns true if field methodName is set (has been assigned a value) and false otherwise */
+  public boolean isSetMethodName() {
+    return this.methodName != null;
+  }
+
+  public void setMethodNameIsSet(boolean value) {
+    if (!value) {
+      this.methodName = null;
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getFileName() {
+    return this.fileName;
+  }
+
+  public StackFrame setFileName(@org.apache.thrift.annotation.Nullable java.lang.String fileName) {
+    this.fileName = fileName;
+    return this;
+  }
+
+  public void unsetFileName() {
+    this.fileName = null;
+  }
+
+  /** Returns true if field fileName is set (has been assigned a value) and false otherwise */
+  public boolean isSetFileName() {
+    return this.fileName != null;
+  }
+
+  public void setFileNameIsSet(boolean value) {
+    if (!value) {
+      this.fileName = null;
+    }
+  }
+
+  public int getLineNumber() {
+    return this.lineNumber;
+  }
+
+  public StackFrame setLineNumber(int lineNumber) {
+    this.lineNumber = lineNumber;
+    setLineNumberIsSet(true);
+    return this;
+  }
+
+  public void unsetLineNumber() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __LINENUMBER_ISSET_ID);
+  }
+
+  /** Returns true if field lineNumber is set (has been assigned a value) and false otherwise */
+  public boolean isSetLineNumber() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __LINENUMBER_ISSET_ID);
+  }
+
+  public void setLineNumberIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __LINENUMBER_ISSET_ID, value);
+  }
+
+  public boolean isNativeMethod() {
+    return this.nativeMethod;
+  }
+
+  public StackFrame setNativeMethod(boolean nativeMethod) {
+    this.nativeMethod = nativeMethod;
+    setNativeMethodIsSet(true);
+    return this;
+  }
+
+  public void unsetNativeMethod() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __NATIVEMETHOD_ISSET_ID);
+  }
+
+  /** Returns true if field nativeMethod is set (has been assigned a value) and false otherwise */
+  public boolean isSetNativeMethod() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __NATIVEMETHOD_ISSET_ID);
+  }
+
+  public void setNativeMethodIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __NATIVEMETHOD_ISSET_ID, value);
+  }
+
+  public int getLockedMonitorsSize() {
+    return (this.lockedMonitors == null) ? 0 : this.lockedMonitors.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<LockInfo> getLockedMonitorsIterator() {
+    return (this.lockedMonitors == null) ? null : this.lockedMonitors.iterator();
+  }
+
+  public void addToLockedMonitors(LockInfo elem) {
+    if (this.lockedMonitors == null) {
+      this.lockedMonitors = new java.util.ArrayList<LockInfo>();
+    }
+    this.lockedMonitors.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<LockInfo> getLockedMonitors() {
+    return this.lockedMonitors;
+  }
+
+  public StackFrame setLockedMonitors(@org.apache.thrift.annotation.Nullable java.util.List<LockInfo> lockedMonitors) {
+    this.lockedMonitors = lockedMonitors;
+    return this;
+  }
+
+  public void unsetLockedMonitors() {
+    this.lockedMonitors = null;
+  }
+
+  /** Returns true if field lockedMonitors is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockedMonitors() {
+    return this.lockedMonitors != null;
+  }
+
+  public void setLockedMonitorsIsSet(boolean value) {
+    if (!value) {
+      this.lockedMonitors = null;
+    }
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case CLASS_NAME:
+      if (value == null) {
+        unsetClassName();
+      } else {
+        setClassName((java.lang.String)value);
+      }
+      break;
+
+    case METHOD_NAME:
+      if (value == null) {
+        unsetMethodName();
+      } else {
+        setMethodName((java.lang.String)value);
+      }
+      break;
+
+    case FILE_NAME:
+      if (value == null) {
+        unsetFileName();
+      } else {
+        setFileName((java.lang.String)value);
+      }
+      break;
+
+    case LINE_NUMBER:
+      if (value == null) {
+        unsetLineNumber();
+      } else {
+        setLineNumber((java.lang.Integer)value);
+      }
+      break;
+
+    case NATIVE_METHOD:
+      if (value == null) {
+        unsetNativeMethod();
+      } else {
+        setNativeMethod((java.lang.Boolean)value);
+      }
+      break;
+
+    case LOCKED_MONITORS:
+      if (value == null) {
+        unsetLockedMonitors();
+      } else {
+        setLockedMonitors((java.util.List<LockInfo>)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case CLASS_NAME:
+      return getClassName();
+
+    case METHOD_NAME:
+      return getMethodName();
+
+    case FILE_NAME:
+      return getFileName();
+
+    case LINE_NUMBER:
+      return getLineNumber();
+
+    case NATIVE_METHOD:
+      return isNativeMethod();
+
+    case LOCKED_MONITORS:
+      return getLockedMonitors();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case CLASS_NAME:
+      return isSetClassName();
+    case METHOD_NAME:
+      return isSetMethodName();
+    case FILE_NAME:
+      return isSetFileName();
+    case LINE_NUMBER:
+      return isSetLineNumber();
+    case NATIVE_METHOD:
+      return isSetNativeMethod();
+    case LOCKED_MONITORS:
+      return isSetLockedMonitors();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof StackFrame)
+      return this.equals((StackFrame)that);
+    return false;
+  }
+
+  public boolean equals(StackFrame that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_className = true && this.isSetClassName();
+    boolean that_present_className = true && that.isSetClassName();
+    if (this_present_className || that_present_className) {
+      if (!(this_present_className && that_present_className))
+        return false;
+      if (!this.className.equals(that.className))
+        return false;
+    }
+
+    boolean this_present_methodName = true && this.isSetMethodName();
+    boolean that_present_methodName = true && that.isSetMethodName();
+    if (this_present_methodName || that_present_methodName) {
+      if (!(this_present_methodName && that_present_methodName))
+        return false;
+      if (!this.methodName.equals(that.methodName))
+        return false;
+    }
+
+    boolean this_present_fileName = true && this.isSetFileName();
+    boolean that_present_fileName = true && that.isSetFileName();
+    if (this_present_fileName || that_present_fileName) {
+      if (!(this_present_fileName && that_present_fileName))
+        return false;
+      if (!this.fileName.equals(that.fileName))
+        return false;
+    }
+
+    boolean this_present_lineNumber = true;
+    boolean that_present_lineNumber = true;
+    if (this_present_lineNumber || that_present_lineNumber) {
+      if (!(this_present_lineNumber && that_present_lineNumber))
+        return false;
+      if (this.lineNumber != that.lineNumber)
+        return false;
+    }
+
+    boolean this_present_nativeMethod = true;
+    boolean that_present_nativeMethod = true;
+    if (this_present_nativeMethod || that_present_nativeMethod) {
+      if (!(this_present_nativeMethod && that_present_nativeMethod))
+        return false;
+      if (this.nativeMethod != that.nativeMethod)
+        return false;
+    }
+
+    boolean this_present_lockedMonitors = true && this.isSetLockedMonitors();
+    boolean that_present_lockedMonitors = true && that.isSetLockedMonitors();
+    if (this_present_lockedMonitors || that_present_lockedMonitors) {
+      if (!(this_present_lockedMonitors && that_present_lockedMonitors))
+        return false;
+      if (!this.lockedMonitors.equals(that.lockedMonitors))
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((isSetClassName()) ? 131071 : 524287);
+    if (isSetClassName())
+      hashCode = hashCode * 8191 + className.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetMethodName()) ? 131071 : 524287);
+    if (isSetMethodName())
+      hashCode = hashCode * 8191 + methodName.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetFileName()) ? 131071 : 524287);
+    if (isSetFileName())
+      hashCode = hashCode * 8191 + fileName.hashCode();
+
+    hashCode = hashCode * 8191 + lineNumber;
+
+    hashCode = hashCode * 8191 + ((nativeMethod) ? 131071 : 524287);
+
+    hashCode = hashCode * 8191 + ((isSetLockedMonitors()) ? 131071 : 524287);
+    if (isSetLockedMonitors())
+      hashCode = hashCode * 8191 + lockedMonitors.hashCode();
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(StackFrame other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetClassName(), other.isSetClassName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetClassName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.className, other.className);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMethodName(), other.isSetMethodName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMethodName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.methodName, other.methodName);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetFileName(), other.isSetFileName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetFileName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.fileName, other.fileName);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLineNumber(), other.isSetLineNumber());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLineNumber()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lineNumber, other.lineNumber);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetNativeMethod(), other.isSetNativeMethod());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetNativeMethod()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.nativeMethod, other.nativeMethod);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLockedMonitors(), other.isSetLockedMonitors());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockedMonitors()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockedMonitors, other.lockedMonitors);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("StackFrame(");
+    boolean first = true;
+
+    sb.append("className:");
+    if (this.className == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.className);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("methodName:");
+    if (this.methodName == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.methodName);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("fileName:");
+    if (this.fileName == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.fileName);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("lineNumber:");
+    sb.append(this.lineNumber);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("nativeMethod:");
+    sb.append(this.nativeMethod);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("lockedMonitors:");
+    if (this.lockedMonitors == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.lockedMonitors);
+    }
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class StackFrameStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public StackFrameStandardScheme getScheme() {
+      return new StackFrameStandardScheme();
+    }
+  }
+
+  private static class StackFrameStandardScheme extends org.apache.thrift.scheme.StandardScheme<StackFrame> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, StackFrame struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // CLASS_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.className = iprot.readString();
+              struct.setClassNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // METHOD_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.methodName = iprot.readString();
+              struct.setMethodNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // FILE_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.fileName = iprot.readString();
+              struct.setFileNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // LINE_NUMBER
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.lineNumber = iprot.readI32();
+              struct.setLineNumberIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // NATIVE_METHOD
+            if (schemeField.type == org.apache.thrift.protocol.TType.BOOL) {
+              struct.nativeMethod = iprot.readBool();
+              struct.setNativeMethodIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 6: // LOCKED_MONITORS
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list64 = iprot.readListBegin();
+                struct.lockedMonitors = new java.util.ArrayList<LockInfo>(_list64.size);
+                @org.apache.thrift.annotation.Nullable LockInfo _elem65;
+                for (int _i66 = 0; _i66 < _list64.size; ++_i66)
+                {
+                  _elem65 = new LockInfo();
+                  _elem65.read(iprot);
+                  struct.lockedMonitors.add(_elem65);
+                }
+                iprot.readListEnd();
+              }
+              struct.setLockedMonitorsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, StackFrame struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      if (struct.className != null) {
+        oprot.writeFieldBegin(CLASS_NAME_FIELD_DESC);
+        oprot.writeString(struct.className);
+        oprot.writeFieldEnd();
+      }
+      if (struct.methodName != null) {
+        oprot.writeFieldBegin(METHOD_NAME_FIELD_DESC);
+        oprot.writeString(struct.methodName);
+        oprot.writeFieldEnd();
+      }
+      if (struct.fileName != null) {
+        oprot.writeFieldBegin(FILE_NAME_FIELD_DESC);
+        oprot.writeString(struct.fileName);
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(LINE_NUMBER_FIELD_DESC);
+      oprot.writeI32(struct.lineNumber);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(NATIVE_METHOD_FIELD_DESC);
+      oprot.writeBool(struct.nativeMethod);
+      oprot.writeFieldEnd();
+      if (struct.lockedMonitors != null) {
+        oprot.writeFieldBegin(LOCKED_MONITORS_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRUCT, struct.lockedMonitors.size()));
+          for (LockInfo _iter67 : struct.lockedMonitors)
+          {
+            _iter67.write(oprot);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class StackFrameTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public StackFrameTupleScheme getScheme() {
+      return new StackFrameTupleScheme();
+    }
+  }
+
+  private static class StackFrameTupleScheme extends org.apache.thrift.scheme.TupleScheme<StackFrame> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, StackFrame struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetClassName()) {
+        optionals.set(0);
+      }
+      if (struct.isSetMethodName()) {
+        optionals.set(1);
+      }
+      if (struct.isSetFileName()) {
+        optionals.set(2);
+      }
+      if (struct.isSetLineNumber()) {
+        optionals.set(3);
+      }
+      if (struct.isSetNativeMethod()) {
+        optionals.set(4);
+      }
+      if (struct.isSetLockedMonitors()) {
+        optionals.set(5);
+      }
+      oprot.writeBitSet(optionals, 6);
+      if (struct.isSetClassName()) {
+        oprot.writeString(struct.className);
+      }
+      if (struct.isSetMethodName()) {
+        oprot.writeString(struct.methodName);
+      }
+      if (struct.isSetFileName()) {
+        oprot.writeString(struct.fileName);
+      }
+      if (struct.isSetLineNumber()) {
+        oprot.writeI32(struct.lineNumber);
+      }
+      if (struct.isSetNativeMethod()) {
+        oprot.writeBool(struct.nativeMethod);
+      }
+      if (struct.isSetLockedMonitors()) {
+        {
+          oprot.writeI32(struct.lockedMonitors.size());
+          for (LockInfo _iter68 : struct.lockedMonitors)
+          {
+            _iter68.write(oprot);
+          }
+        }
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, StackFrame struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(6);
+      if (incoming.get(0)) {
+        struct.className = iprot.readString();
+        struct.setClassNameIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.methodName = iprot.readString();
+        struct.setMethodNameIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.fileName = iprot.readString();
+        struct.setFileNameIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.lineNumber = iprot.readI32();
+        struct.setLineNumberIsSet(true);
+      }
+      if (incoming.get(4)) {
+        struct.nativeMethod = iprot.readBool();
+        struct.setNativeMethodIsSet(true);
+      }
+      if (incoming.get(5)) {
+        {
+          org.apache.thrift.protocol.TList _list69 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRUCT);
+          struct.lockedMonitors = new java.util.ArrayList<LockInfo>(_list69.size);
+          @org.apache.thrift.annotation.Nullable LockInfo _elem70;
+          for (int _i71 = 0; _i71 < _list69.size; ++_i71)
+          {
+            _elem70 = new LockInfo();
+            _elem70.read(iprot);
+            struct.lockedMonitors.add(_elem70);
+          }
+        }
+        struct.setLockedMonitorsIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
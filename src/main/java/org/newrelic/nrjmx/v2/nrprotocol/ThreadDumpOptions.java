@@ -0,0 +1,574 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class ThreadDumpOptions implements org.apache.thrift.TBase<ThreadDumpOptions, ThreadDumpOptions._Fields>, java.io.Serializable, Cloneable, Comparable<ThreadDumpOptions> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("ThreadDumpOptions");
+
+  private static final org.apache.thrift.protocol.TField LOCKED_MONITORS_FIELD_DESC = new org.apache.thrift.protocol.TField("lockedMonitors", org.apache.thrift.protocol.TType.BOOL, (short)1);
+  private static final org.apache.thrift.protocol.TField LOCKED_SYNCHRONIZERS_FIELD_DESC = new org.apache.thrift.protocol.TField("lockedSynchronizers", org.apache.thrift.protocol.TType.BOOL, (short)2);
+  private static final org.apache.thrift.protocol.TField MAX_DEPTH_FIELD_DESC = new org.apache.thrift.protocol.TField("maxDepth", org.apache.thrift.protocol.TType.I32, (short)3);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new ThreadDumpOptionsStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new ThreadDumpOptionsTupleSchemeFactory();
+
+  public boolean lockedMonitors; // required
+  public boolean lockedSynchronizers; // required
+  public int maxDepth; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    LOCKED_MONITORS((short)1, "lockedMonitors"),
+    LOCKED_SYNCHRONIZERS((short)2, "lockedSynchronizers"),
+    MAX_DEPTH((short)3, "maxDepth");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // LOCKED_MONITORS
+          return LOCKED_MONITORS;
+        case 2: // LOCKED_SYNCHRONIZERS
+          return LOCKED_SYNCHRONIZERS;
+        case 3: // MAX_DEPTH
+          return MAX_DEPTH;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __LOCKEDMONITORS_ISSET_ID = 0;
+  private static final int __LOCKEDSYNCHRONIZERS_ISSET_ID = 1;
+  private static final int __MAXDEPTH_ISSET_ID = 2;
+  private byte __isset_bitfield = 0;
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.LOCKED_MONITORS, new org.apache.thrift.meta_data.FieldMetaData("lockedMonitors", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.LOCKED_SYNCHRONIZERS, new org.apache.thrift.meta_data.FieldMetaData("lockedSynchronizers", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.MAX_DEPTH, new org.apache.thrift.meta_data.FieldMetaData("maxDepth", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I32)));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(ThreadDumpOptions.class, metaDataMap);
+  }
+
+  public ThreadDumpOptions() {
+  }
+
+  public ThreadDumpOptions(
+    boolean lockedMonitors,
+    boolean lockedSynchronizers,
+    int maxDepth)
+  {
+    this();
+    this.lockedMonitors = lockedMonitors;
+    setLockedMonitorsIsSet(true);
+    this.lockedSynchronizers = lockedSynchronizers;
+    setLockedSynchronizersIsSet(true);
+    this.maxDepth = maxDepth;
+    setMaxDepthIsSet(true);
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public ThreadDumpOptions(ThreadDumpOptions other) {
+    __isset_bitfield = other.__isset_bitfield;
+    this.lockedMonitors = other.lockedMonitors;
+    this.lockedSynchronizers = other.lockedSynchronizers;
+    this.maxDepth = other.maxDepth;
+  }
+
+  @Override
+  public ThreadDumpOptions deepCopy() {
+    return new ThreadDumpOptions(this);
+  }
+
+  @Override
+  public void clear() {
+    setLockedMonitorsIsSet(false);
+    this.lockedMonitors = false;
+    setLockedSynchronizersIsSet(false);
+    this.lockedSynchronizers = false;
+    setMaxDepthIsSet(false);
+    this.maxDepth = 0;
+  }
+
+  public boolean isLockedMonitors() {
+    return this.lockedMonitors;
+  }
+
+  public ThreadDumpOptions setLockedMonitors(boolean lockedMonitors) {
+    this.lockedMonitors = lockedMonitors;
+    setLockedMonitorsIsSet(true);
+    return this;
+  }
+
+  public void unsetLockedMonitors() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __LOCKEDMONITORS_ISSET_ID);
+  }
+
+  /** Returns true if field lockedMonitors is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockedMonitors() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __LOCKEDMONITORS_ISSET_ID);
+  }
+
+  public void setLockedMonitorsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __LOCKEDMONITORS_ISSET_ID, value);
+  }
+
+  public boolean isLockedSynchronizers() {
+    return this.lockedSynchronizers;
+  }
+
+  public ThreadDumpOptions setLockedSynchronizers(boolean lockedSynchronizers) {
+    this.lockedSynchronizers = lockedSynchronizers;
+    setLockedSynchronizersIsSet(true);
+    return this;
+  }
+
+  public void unsetLockedSynchronizers() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __LOCKEDSYNCHRONIZERS_ISSET_ID);
+  }
+
+  /** Returns true if field lockedSynchronizers is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockedSynchronizers() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __LOCKEDSYNCHRONIZERS_ISSET_ID);
+  }
+
+  public void setLockedSynchronizersIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __LOCKEDSYNCHRONIZERS_ISSET_ID, value);
+  }
+
+  public int getMaxDepth() {
+    return this.maxDepth;
+  }
+
+  public ThreadDumpOptions setMaxDepth(int maxDepth) {
+    this.maxDepth = maxDepth;
+    setMaxDepthIsSet(true);
+    return this;
+  }
+
+  public void unsetMaxDepth() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __MAXDEPTH_ISSET_ID);
+  }
+
+  /** Returns true if field maxDepth is set (has been assigned a value) and false otherwise */
+  public boolean isSetMaxDepth() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __MAXDEPTH_ISSET_ID);
+  }
+
+  public void setMaxDepthIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __MAXDEPTH_ISSET_ID, value);
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case LOCKED_MONITORS:
+      if (value == null) {
+        unsetLockedMonitors();
+      } else {
+        setLockedMonitors((java.lang.Boolean)value);
+      }
+      break;
+
+    case LOCKED_SYNCHRONIZERS:
+      if (value == null) {
+        unsetLockedSynchronizers();
+      } else {
+        setLockedSynchronizers((java.lang.Boolean)value);
+      }
+      break;
+
+    case MAX_DEPTH:
+      if (value == null) {
+        unsetMaxDepth();
+      } else {
+        setMaxDepth((java.lang.Integer)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case LOCKED_MONITORS:
+      return isLockedMonitors();
+
+    case LOCKED_SYNCHRONIZERS:
+      return isLockedSynchronizers();
+
+    case MAX_DEPTH:
+      return getMaxDepth();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case LOCKED_MONITORS:
+      return isSetLockedMonitors();
+    case LOCKED_SYNCHRONIZERS:
+      return isSetLockedSynchronizers();
+    case MAX_DEPTH:
+      return isSetMaxDepth();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof ThreadDumpOptions)
+      return this.equals((ThreadDumpOptions)that);
+    return false;
+  }
+
+  public boolean equals(ThreadDumpOptions that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_lockedMonitors = true;
+    boolean that_present_lockedMonitors = true;
+    if (this_present_lockedMonitors || that_present_lockedMonitors) {
+      if (!(this_present_lockedMonitors && that_present_lockedMonitors))
+        return false;
+      if (this.lockedMonitors != that.lockedMonitors)
+        return false;
+    }
+
+    boolean this_present_lockedSynchronizers = true;
+    boolean that_present_lockedSynchronizers = true;
+    if (this_present_lockedSynchronizers || that_present_lockedSynchronizers) {
+      if (!(this_present_lockedSynchronizers && that_present_lockedSynchronizers))
+        return false;
+      if (this.lockedSynchronizers != that.lockedSynchronizers)
+        return false;
+    }
+
+    boolean this_present_maxDepth = true;
+    boolean that_present_maxDepth = true;
+    if (this_present_maxDepth || that_present_maxDepth) {
+      if (!(this_present_maxDepth && that_present_maxDepth))
+        return false;
+      if (this.maxDepth != that.maxDepth)
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((lockedMonitors) ? 131071 : 524287);
+
+    hashCode = hashCode * 8191 + ((lockedSynchronizers) ? 131071 : 524287);
+
+    hashCode = hashCode * 8191 + maxDepth;
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(ThreadDumpOptions other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetLockedMonitors(), other.isSetLockedMonitors());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockedMonitors()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockedMonitors, other.lockedMonitors);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLockedSynchronizers(), other.isSetLockedSynchronizers());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockedSynchronizers()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockedSynchronizers, other.lockedSynchronizers);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMaxDepth(), other.isSetMaxDepth());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMaxDepth()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.maxDepth, other.maxDepth);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("ThreadDumpOptions(");
+    boolean first = true;
+
+    sb.append("lockedMonitors:");
+    sb.append(this.lockedMonitors);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("lockedSynchronizers:");
+    sb.append(this.lockedSynchronizers);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("maxDepth:");
+    sb.append(this.maxDepth);
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class ThreadDumpOptionsStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public ThreadDumpOptionsStandardScheme getScheme() {
+      return new ThreadDumpOptionsStandardScheme();
+    }
+  }
+
+  private static class ThreadDumpOptionsStandardScheme extends org.apache.thrift.scheme.StandardScheme<ThreadDumpOptions> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, ThreadDumpOptions struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // LOCKED_MONITORS
+            if (schemeField.type == org.apache.thrift.protocol.TType.BOOL) {
+              struct.lockedMonitors = iprot.readBool();
+              struct.setLockedMonitorsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // LOCKED_SYNCHRONIZERS
+            if (schemeField.type == org.apache.thrift.protocol.TType.BOOL) {
+              struct.lockedSynchronizers = iprot.readBool();
+              struct.setLockedSynchronizersIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // MAX_DEPTH
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.maxDepth = iprot.readI32();
+              struct.setMaxDepthIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, ThreadDumpOptions struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      oprot.writeFieldBegin(LOCKED_MONITORS_FIELD_DESC);
+      oprot.writeBool(struct.lockedMonitors);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(LOCKED_SYNCHRONIZERS_FIELD_DESC);
+      oprot.writeBool(struct.lockedSynchronizers);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(MAX_DEPTH_FIELD_DESC);
+      oprot.writeI32(struct.maxDepth);
+      oprot.writeFieldEnd();
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class ThreadDumpOptionsTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public ThreadDumpOptionsTupleScheme getScheme() {
+      return new ThreadDumpOptionsTupleScheme();
+    }
+  }
+
+  private static class ThreadDumpOptionsTupleScheme extends org.apache.thrift.scheme.TupleScheme<ThreadDumpOptions> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, ThreadDumpOptions struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetLockedMonitors()) {
+        optionals.set(0);
+      }
+      if (struct.isSetLockedSynchronizers()) {
+        optionals.set(1);
+      }
+      if (struct.isSetMaxDepth()) {
+        optionals.set(2);
+      }
+      oprot.writeBitSet(optionals, 3);
+      if (struct.isSetLockedMonitors()) {
+        oprot.writeBool(struct.lockedMonitors);
+      }
+      if (struct.isSetLockedSynchronizers()) {
+        oprot.writeBool(struct.lockedSynchronizers);
+      }
+      if (struct.isSetMaxDepth()) {
+        oprot.writeI32(struct.maxDepth);
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, ThreadDumpOptions struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(3);
+      if (incoming.get(0)) {
+        struct.lockedMonitors = iprot.readBool();
+        struct.setLockedMonitorsIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.lockedSynchronizers = iprot.readBool();
+        struct.setLockedSynchronizersIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.maxDepth = iprot.readI32();
+        struct.setMaxDepthIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
@@ -0,0 +1,1818 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class ThreadInfo implements org.apache.thrift.TBase<ThreadInfo, ThreadInfo._Fields>, java.io.Serializable, Cloneable, Comparable<ThreadInfo> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("ThreadInfo");
+
+  private static final org.apache.thrift.protocol.TField THREAD_ID_FIELD_DESC = new org.apache.thrift.protocol.TField("threadId", org.apache.thrift.protocol.TType.I64, (short)1);
+  private static final org.apache.thrift.protocol.TField THREAD_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("threadName", org.apache.thrift.protocol.TType.STRING, (short)2);
+  private static final org.apache.thrift.protocol.TField THREAD_STATE_FIELD_DESC = new org.apache.thrift.protocol.TField("threadState", org.apache.thrift.protocol.TType.I32, (short)3);
+  private static final org.apache.thrift.protocol.TField BLOCKED_COUNT_FIELD_DESC = new org.apache.thrift.protocol.TField("blockedCount", org.apache.thrift.protocol.TType.I64, (short)4);
+  private static final org.apache.thrift.protocol.TField BLOCKED_TIME_MS_FIELD_DESC = new org.apache.thrift.protocol.TField("blockedTimeMs", org.apache.thrift.protocol.TType.I64, (short)5);
+  private static final org.apache.thrift.protocol.TField WAITED_COUNT_FIELD_DESC = new org.apache.thrift.protocol.TField("waitedCount", org.apache.thrift.protocol.TType.I64, (short)6);
+  private static final org.apache.thrift.protocol.TField WAITED_TIME_MS_FIELD_DESC = new org.apache.thrift.protocol.TField("waitedTimeMs", org.apache.thrift.protocol.TType.I64, (short)7);
+  private static final org.apache.thrift.protocol.TField LOCK_INFO_FIELD_DESC = new org.apache.thrift.protocol.TField("lockInfo", org.apache.thrift.protocol.TType.STRUCT, (short)8);
+  private static final org.apache.thrift.protocol.TField LOCK_OWNER_ID_FIELD_DESC = new org.apache.thrift.protocol.TField("lockOwnerId", org.apache.thrift.protocol.TType.I64, (short)9);
+  private static final org.apache.thrift.protocol.TField LOCK_OWNER_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("lockOwnerName", org.apache.thrift.protocol.TType.STRING, (short)10);
+  private static final org.apache.thrift.protocol.TField IN_NATIVE_FIELD_DESC = new org.apache.thrift.protocol.TField("inNative", org.apache.thrift.protocol.TType.BOOL, (short)11);
+  private static final org.apache.thrift.protocol.TField SUSPENDED_FIELD_DESC = new org.apache.thrift.protocol.TField("suspended", org.apache.thrift.protocol.TType.BOOL, (short)12);
+  private static final org.apache.thrift.protocol.TField STACK_TRACE_FIELD_DESC = new org.apache.thrift.protocol.TField("stackTrace", org.apache.thrift.protocol.TType.LIST, (short)13);
+  private static final org.apache.thrift.protocol.TField LOCKED_SYNCHRONIZERS_FIELD_DESC = new org.apache.thrift.protocol.TField("lockedSynchronizers", org.apache.thrift.protocol.TType.LIST, (short)14);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new ThreadInfoStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new ThreadInfoTupleSchemeFactory();
+
+  public long threadId; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String threadName; // required
+  /**
+   * 
+   * @see ThreadState
+   */
+  public @org.apache.thrift.annotation.Nullable ThreadState threadState; // required
+  public long blockedCount; // required
+  public long blockedTimeMs; // required
+  public long waitedCount; // required
+  public long waitedTimeMs; // required
+  public @org.apache.thrift.annotation.Nullable LockInfo lockInfo; // optional
+  public long lockOwnerId; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String lockOwnerName; // required
+  public boolean inNative; // required
+  public boolean suspended; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<StackFrame> stackTrace; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<LockInfo> lockedSynchronizers; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    THREAD_ID((short)1, "threadId"),
+    THREAD_NAME((short)2, "threadName"),
+    /**
+     * 
+     * @see ThreadState
+     */
+    THREAD_STATE((short)3, "threadState"),
+    BLOCKED_COUNT((short)4, "blockedCount"),
+    BLOCKED_TIME_MS((short)5, "blockedTimeMs"),
+    WAITED_COUNT((short)6, "waitedCount"),
+    WAITED_TIME_MS((short)7, "waitedTimeMs"),
+    LOCK_INFO((short)8, "lockInfo"),
+    LOCK_OWNER_ID((short)9, "lockOwnerId"),
+    LOCK_OWNER_NAME((short)10, "lockOwnerName"),
+    IN_NATIVE((short)11, "inNative"),
+    SUSPENDED((short)12, "suspended"),
+    STACK_TRACE((short)13, "stackTrace"),
+    LOCKED_SYNCHRONIZERS((short)14, "lockedSynchronizers");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // THREAD_ID
+          return THREAD_ID;
+        case 2: // THREAD_NAME
+          return THREAD_NAME;
+        case 3: // THREAD_STATE
+          return THREAD_STATE;
+        case 4: // BLOCKED_COUNT
+          return BLOCKED_COUNT;
+        case 5: // BLOCKED_TIME_MS
+          return BLOCKED_TIME_MS;
+        case 6: // WAITED_COUNT
+          return WAITED_COUNT;
+        case 7: // WAITED_TIME_MS
+          return WAITED_TIME_MS;
+        case 8: // LOCK_INFO
+          return LOCK_INFO;
+        case 9: // LOCK_OWNER_ID
+          return LOCK_OWNER_ID;
+        case 10: // LOCK_OWNER_NAME
+          return LOCK_OWNER_NAME;
+        case 11: // IN_NATIVE
+          return IN_NATIVE;
+        case 12: // SUSPENDED
+          return SUSPENDED;
+        case 13: // STACK_TRACE
+          return STACK_TRACE;
+        case 14: // LOCKED_SYNCHRONIZERS
+          return LOCKED_SYNCHRONIZERS;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __THREADID_ISSET_ID = 0;
+  private static final int __BLOCKEDCOUNT_ISSET_ID = 1;
+  private static final int __BLOCKEDTIMEMS_ISSET_ID = 2;
+  private static final int __WAITEDCOUNT_ISSET_ID = 3;
+  private static final int __WAITEDTIMEMS_ISSET_ID = 4;
+  private static final int __LOCKOWNERID_ISSET_ID = 5;
+  private static final int __INNATIVE_ISSET_ID = 6;
+  private static final int __SUSPENDED_ISSET_ID = 7;
+  private byte __isset_bitfield = 0;
+  private static final _Fields optionals[] = {_Fields.LOCK_INFO};
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.THREAD_ID, new org.apache.thrift.meta_data.FieldMetaData("threadId", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.THREAD_NAME, new org.apache.thrift.meta_data.FieldMetaData("threadName", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.THREAD_STATE, new org.apache.thrift.meta_data.FieldMetaData("threadState", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.EnumMetaData(org.apache.thrift.protocol.TType.ENUM, ThreadState.class)));
+    tmpMap.put(_Fields.BLOCKED_COUNT, new org.apache.thrift.meta_data.FieldMetaData("blockedCount", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.BLOCKED_TIME_MS, new org.apache.thrift.meta_data.FieldMetaData("blockedTimeMs", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.WAITED_COUNT, new org.apache.thrift.meta_data.FieldMetaData("waitedCount", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.WAITED_TIME_MS, new org.apache.thrift.meta_data.FieldMetaData("waitedTimeMs", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.LOCK_INFO, new org.apache.thrift.meta_data.FieldMetaData("lockInfo", org.apache.thrift.TFieldRequirementType.OPTIONAL, 
+        new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, LockInfo.class)));
+    tmpMap.put(_Fields.LOCK_OWNER_ID, new org.apache.thrift.meta_data.FieldMetaData("lockOwnerId", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.LOCK_OWNER_NAME, new org.apache.thrift.meta_data.FieldMetaData("lockOwnerName", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.IN_NATIVE, new org.apache.thrift.meta_data.FieldMetaData("inNative", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.SUSPENDED, new org.apache.thrift.meta_data.FieldMetaData("suspended", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.BOOL)));
+    tmpMap.put(_Fields.STACK_TRACE, new org.apache.thrift.meta_data.FieldMetaData("stackTrace", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, StackFrame.class))));
+    tmpMap.put(_Fields.LOCKED_SYNCHRONIZERS, new org.apache.thrift.meta_data.FieldMetaData("lockedSynchronizers", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.StructMetaData(org.apache.thrift.protocol.TType.STRUCT, LockInfo.class))));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(ThreadInfo.class, metaDataMap);
+  }
+
+  public ThreadInfo() {
+  }
+
+  public ThreadInfo(
+    long threadId,
+    java.lang.String threadName,
+    ThreadState threadState,
+    long blockedCount,
+    long blockedTimeMs,
+    long waitedCount,
+    long waitedTimeMs,
+    long lockOwnerId,
+    java.lang.String lockOwnerName,
+    boolean inNative,
+    boolean suspended,
+    java.util.List<StackFrame> stackTrace,
+    java.util.List<LockInfo> lockedSynchronizers)
+  {
+    this();
+    this.threadId = threadId;
+    setThreadIdIsSet(true);
+    this.threadName = threadName;
+    this.threadState = threadState;
+    this.blockedCount = blockedCount;
+    setBlockedCountIsSet(true);
+    this.blockedTimeMs = blockedTimeMs;
+    setBlockedTimeMsIsSet(true);
+    this.waitedCount = waitedCount;
+    setWaitedCountIsSet(true);
+    this.waitedTimeMs = waitedTimeMs;
+    setWaitedTimeMsIsSet(true);
+    this.lockOwnerId = lockOwnerId;
+    setLockOwnerIdIsSet(true);
+    this.lockOwnerName = lockOwnerName;
+    this.inNative = inNative;
+    setInNativeIsSet(true);
+    this.suspended = suspended;
+    setSuspendedIsSet(true);
+    this.stackTrace = stackTrace;
+    this.lockedSynchronizers = lockedSynchronizers;
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public ThreadInfo(ThreadInfo other) {
+    __isset_bitfield = other.__isset_bitfield;
+    this.threadId = other.threadId;
+    if (other.isSetThreadName()) {
+      this.threadName = other.threadName;
+    }
+    if (other.isSetThreadState()) {
+      this.threadState = other.threadState;
+    }
+    this.blockedCount = other.blockedCount;
+    this.blockedTimeMs = other.blockedTimeMs;
+    this.waitedCount = other.waitedCount;
+    this.waitedTimeMs = other.waitedTimeMs;
+    if (other.isSetLockInfo()) {
+      this.lockInfo = new LockInfo(other.lockInfo);
+    }
+    this.lockOwnerId = other.lockOwnerId;
+    if (other.isSetLockOwnerName()) {
+      this.lockOwnerName = other.lockOwnerName;
+    }
+    this.inNative = other.inNative;
+    this.suspended = other.suspended;
+    if (other.isSetStackTrace()) {
+      java.util.List<StackFrame> __this__stackTrace = new java.util.ArrayList<StackFrame>(other.stackTrace.size());
+      for (StackFrame other_element : other.stackTrace) {
+        __this__stackTrace.add(new StackFrame(other_element));
+      }
+      this.stackTrace = __this__stackTrace;
+    }
+    if (other.isSetLockedSynchronizers()) {
+      java.util.List<LockInfo> __this__lockedSynchronizers = new java.util.ArrayList<LockInfo>(other.lockedSynchronizers.size());
+      for (LockInfo other_element : other.lockedSynchronizers) {
+        __this__lockedSynchronizers.add(new LockInfo(other_element));
+      }
+      this.lockedSynchronizers = __this__lockedSynchronizers;
+    }
+  }
+
+  @Override
+  public ThreadInfo deepCopy() {
+    return new ThreadInfo(this);
+  }
+
+  @Override
+  public void clear() {
+    setThreadIdIsSet(false);
+    this.threadId = 0;
+    this.threadName = null;
+    this.threadState = null;
+    setBlockedCountIsSet(false);
+    this.blockedCount = 0;
+    setBlockedTimeMsIsSet(false);
+    this.blockedTimeMs = 0;
+    setWaitedCountIsSet(false);
+    this.waitedCount = 0;
+    setWaitedTimeMsIsSet(false);
+    this.waitedTimeMs = 0;
+    this.lockInfo = null;
+    setLockOwnerIdIsSet(false);
+    this.lockOwnerId = 0;
+    this.lockOwnerName = null;
+    setInNativeIsSet(false);
+    this.inNative = false;
+    setSuspendedIsSet(false);
+    this.suspended = false;
+    this.stackTrace = null;
+    this.lockedSynchronizers = null;
+  }
+
+  public long getThreadId() {
+    return this.threadId;
+  }
+
+  public ThreadInfo setThreadId(long threadId) {
+    this.threadId = threadId;
+    setThreadIdIsSet(true);
+    return this;
+  }
+
+  public void unsetThreadId() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __THREADID_ISSET_ID);
+  }
+
+  /** Returns true if field threadId is set (has been assigned a value) and false otherwise */
+  public boolean isSetThreadId() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __THREADID_ISSET_ID);
+  }
+
+  public void setThreadIdIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __THREADID_ISSET_ID, value);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getThreadName() {
+    return this.threadName;
+  }
+
+  public ThreadInfo setThreadName(@org.apache.thrift.annotation.Nullable java.lang.String threadName) {
+    this.threadName = threadName;
+    return this;
+  }
+
+  public void unsetThreadName() {
+    this.threadName = null;
+  }
+
+  /** Returns true if field threadName is set (has been assigned a value) and false otherwise */
+  public boolean isSetThreadName() {
+    return this.threadName != null;
+  }
+
+  public void setThreadNameIsSet(boolean value) {
+    if (!value) {
+      this.threadName = null;
+    }
+  }
+
+  /**
+   * 
+   * @see ThreadState
+   */
+  @org.apache.thrift.annotation.Nullable
+  public ThreadState getThreadState() {
+    return this.threadState;
+  }
+
+  /**
+   * 
+   * @see ThreadState
+   */
+  public ThreadInfo setThreadState(@org.apache.thrift.annotation.Nullable ThreadState threadState) {
+    this.threadState = threadState;
+    return this;
+  }
+
+  public void unsetThreadState() {
+    this.threadState = null;
+  }
+
+  /** Returns true if field threadState is set (has been assigned a value) and false otherwise */
+  public boolean isSetThreadState() {
+    return this.threadState != null;
+  }
+
+  public void setThreadStateIsSet(boolean value) {
+    if (!value) {
+      this.threadState = null;
+    }
+  }
+
+  public long getBlockedCount() {
+    return this.blockedCount;
+  }
+
+  public ThreadInfo setBlockedCount(long blockedCount) {
+    this.blockedCount = blockedCount;
+    setBlockedCountIsSet(true);
+    return this;
+  }
+
+  public void unsetBlockedCount() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __BLOCKEDCOUNT_ISSET_ID);
+  }
+
+  /** Returns true if field blockedCount is set (has been assigned a value) and false otherwise */
+  public boolean isSetBlockedCount() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __BLOCKEDCOUNT_ISSET_ID);
+  }
+
+  public void setBlockedCountIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __BLOCKEDCOUNT_ISSET_ID, value);
+  }
+
+  public long getBlockedTimeMs() {
+    return this.blockedTimeMs;
+  }
+
+  public ThreadInfo setBlockedTimeMs(long blockedTimeMs) {
+    this.blockedTimeMs = blockedTimeMs;
+    setBlockedTimeMsIsSet(true);
+    return this;
+  }
+
+  public void unsetBlockedTimeMs() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __BLOCKEDTIMEMS_ISSET_ID);
+  }
+
+  /** Returns true if field blockedTimeMs is set (has been assigned a value) and false otherwise */
+  public boolean isSetBlockedTimeMs() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __BLOCKEDTIMEMS_ISSET_ID);
+  }
+
+  public void setBlockedTimeMsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __BLOCKEDTIMEMS_ISSET_ID, value);
+  }
+
+  public long getWaitedCount() {
+    return this.waitedCount;
+  }
+
+  public ThreadInfo setWaitedCount(long waitedCount) {
+    this.waitedCount = waitedCount;
+    setWaitedCountIsSet(true);
+    return this;
+  }
+
+  public void unsetWaitedCount() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __WAITEDCOUNT_ISSET_ID);
+  }
+
+  /** Returns true if field waitedCount is set (has been assigned a value) and false otherwise */
+  public boolean isSetWaitedCount() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __WAITEDCOUNT_ISSET_ID);
+  }
+
+  public void setWaitedCountIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __WAITEDCOUNT_ISSET_ID, value);
+  }
+
+  public long getWaitedTimeMs() {
+    return this.waitedTimeMs;
+  }
+
+  public ThreadInfo setWaitedTimeMs(long waitedTimeMs) {
+    this.waitedTimeMs = waitedTimeMs;
+    setWaitedTimeMsIsSet(true);
+    return this;
+  }
+
+  public void unsetWaitedTimeMs() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __WAITEDTIMEMS_ISSET_ID);
+  }
+
+  /** Returns true if field waitedTimeMs is set (has been assigned a value) and false otherwise */
+  public boolean isSetWaitedTimeMs() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __WAITEDTIMEMS_ISSET_ID);
+  }
+
+  public void setWaitedTimeMsIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __WAITEDTIMEMS_ISSET_ID, value);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public LockInfo getLockInfo() {
+    return this.lockInfo;
+  }
+
+  public ThreadInfo setLockInfo(@org.apache.thrift.annotation.Nullable LockInfo lockInfo) {
+    this.lockInfo = lockInfo;
+    return this;
+  }
+
+  public void unsetLockInfo() {
+    this.lockInfo = null;
+  }
+
+  /** Returns true if field lockInfo is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockInfo() {
+    return this.lockInfo != null;
+  }
+
+  public void setLockInfoIsSet(boolean value) {
+    if (!value) {
+      this.lockInfo = null;
+    }
+  }
+
+  public long getLockOwnerId() {
+    return this.lockOwnerId;
+  }
+
+  public ThreadInfo setLockOwnerId(long lockOwnerId) {
+    this.lockOwnerId = lockOwnerId;
+    setLockOwnerIdIsSet(true);
+    return this;
+  }
+
+  public void unsetLockOwnerId() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __LOCKOWNERID_ISSET_ID);
+  }
+
+  /** Returns true if field lockOwnerId is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockOwnerId() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __LOCKOWNERID_ISSET_ID);
+  }
+
+  public void setLockOwnerIdIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __LOCKOWNERID_ISSET_ID, value);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getLockOwnerName() {
+    return this.lockOwnerName;
+  }
+
+  public ThreadInfo setLockOwnerName(@org.apache.thrift.annotation.Nullable java.lang.String lockOwnerName) {
+    this.lockOwnerName = lockOwnerName;
+    return this;
+  }
+
+  public void unsetLockOwnerName() {
+    this.lockOwnerName = null;
+  }
+
+  /** Returns true if field lockOwnerName is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockOwnerName() {
+    return this.lockOwnerName != null;
+  }
+
+  public void setLockOwnerNameIsSet(boolean value) {
+    if (!value) {
+      this.lockOwnerName = null;
+    }
+  }
+
+  public boolean isInNative() {
+    return this.inNative;
+  }
+
+  public ThreadInfo setInNative(boolean inNative) {
+    this.inNative = inNative;
+    setInNativeIsSet(true);
+    return this;
+  }
+
+  public void unsetInNative() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __INNATIVE_ISSET_ID);
+  }
+
+  /** Returns true if field inNative is set (has been assigned a value) and false otherwise */
+  public boolean isSetInNative() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __INNATIVE_ISSET_ID);
+  }
+
+  public void setInNativeIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __INNATIVE_ISSET_ID, value);
+  }
+
+  public boolean isSuspended() {
+    return this.suspended;
+  }
+
+  public ThreadInfo setSuspended(boolean suspended) {
+    this.suspended = suspended;
+    setSuspendedIsSet(true);
+    return this;
+  }
+
+  public void unsetSuspended() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __SUSPENDED_ISSET_ID);
+  }
+
+  /** Returns true if field suspended is set (has been assigned a value) and false otherwise */
+  public boolean isSetSuspended() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __SUSPENDED_ISSET_ID);
+  }
+
+  public void setSuspendedIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __SUSPENDED_ISSET_ID, value);
+  }
+
+  public int getStackTraceSize() {
+    return (this.stackTrace == null) ? 0 : this.stackTrace.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<StackFrame> getStackTraceIterator() {
+    return (this.stackTrace == null) ? null : this.stackTrace.iterator();
+  }
+
+  public void addToStackTrace(StackFrame elem) {
+    if (this.stackTrace == null) {
+      this.stackTrace = new java.util.ArrayList<StackFrame>();
+    }
+    this.stackTrace.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<StackFrame> getStackTrace() {
+    return this.stackTrace;
+  }
+
+  public ThreadInfo setStackTrace(@org.apache.thrift.annotation.Nullable java.util.List<StackFrame> stackTrace) {
+    this.stackTrace = stackTrace;
+    return this;
+  }
+
+  public void unsetStackTrace() {
+    this.stackTrace = null;
+  }
+
+  /** Returns true if field stackTrace is set (has been assigned a value) and false otherwise */
+  public boolean isSetStackTrace() {
+    return this.stackTrace != null;
+  }
+
+  public void setStackTraceIsSet(boolean value) {
+    if (!value) {
+      this.stackTrace = null;
+    }
+  }
+
+  public int getLockedSynchronizersSize() {
+    return (this.lockedSynchronizers == null) ? 0 : this.lockedSynchronizers.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<LockInfo> getLockedSynchronizersIterator() {
+    return (this.lockedSynchronizers == null) ? null : this.lockedSynchronizers.iterator();
+  }
+
+  public void addToLockedSynchronizers(LockInfo elem) {
+    if (this.lockedSynchronizers == null) {
+      this.lockedSynchronizers = new java.util.ArrayList<LockInfo>();
+    }
+    this.lockedSynchronizers.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<LockInfo> getLockedSynchronizers() {
+    return this.lockedSynchronizers;
+  }
+
+  public ThreadInfo setLockedSynchronizers(@org.apache.thrift.annotation.Nullable java.util.List<LockInfo> lockedSynchronizers) {
+    this.lockedSynchronizers = lockedSynchronizers;
+    return this;
+  }
+
+  public void unsetLockedSynchronizers() {
+    this.lockedSynchronizers = null;
+  }
+
+  /** Returns true if field lockedSynchronizers is set (has been assigned a value) and false otherwise */
+  public boolean isSetLockedSynchronizers() {
+    return this.lockedSynchronizers != null;
+  }
+
+  public void setLockedSynchronizersIsSet(boolean value) {
+    if (!value) {
+      this.lockedSynchronizers = null;
+    }
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case THREAD_ID:
+      if (value == null) {
+        unsetThreadId();
+      } else {
+        setThreadId((java.lang.Long)value);
+      }
+      break;
+
+    case THREAD_NAME:
+      if (value == null) {
+        unsetThreadName();
+      } else {
+        setThreadName((java.lang.String)value);
+      }
+      break;
+
+    case THREAD_STATE:
+      if (value == null) {
+        unsetThreadState();
+      } else {
+        setThreadState((ThreadState)value);
+      }
+      break;
+
+    case BLOCKED_COUNT:
+      if (value == null) {
+        unsetBlockedCount();
+      } else {
+        setBlockedCount((java.lang.Long)value);
+      }
+      break;
+
+    case BLOCKED_TIME_MS:
+      if (value == null) {
+        unsetBlockedTimeMs();
+      } else {
+        setBlockedTimeMs((java.lang.Long)value);
+      }
+      break;
+
+    case WAITED_COUNT:
+      if (value == null) {
+        unsetWaitedCount();
+      } else {
+        setWaitedCount((java.lang.Long)value);
+      }
+      break;
+
+    case WAITED_TIME_MS:
+      if (value == null) {
+        unsetWaitedTimeMs();
+      } else {
+        setWaitedTimeMs((java.lang.Long)value);
+      }
+      break;
+
+    case LOCK_INFO:
+      if (value == null) {
+        unsetLockInfo();
+      } else {
+        setLockInfo((LockInfo)value);
+      }
+      break;
+
+    case LOCK_OWNER_ID:
+      if (value == null) {
+        unsetLockOwnerId();
+      } else {
+        setLockOwnerId((java.lang.Long)value);
+      }
+      break;
+
+    case LOCK_OWNER_NAME:
+      if (value == null) {
+        unsetLockOwnerName();
+      } else {
+        setLockOwnerName((java.lang.String)value);
+      }
+      break;
+
+    case IN_NATIVE:
+      if (value == null) {
+        unsetInNative();
+      } else {
+        setInNative((java.lang.Boolean)value);
+      }
+      break;
+
+    case SUSPENDED:
+      if (value == null) {
+        unsetSuspended();
+      } else {
+        setSuspended((java.lang.Boolean)value);
+      }
+      break;
+
+    case STACK_TRACE:
+      if (value == null) {
+        unsetStackTrace();
+      } else {
+        setStackTrace((java.util.List<StackFrame>)value);
+      }
+      break;
+
+    case LOCKED_SYNCHRONIZERS:
+      if (value == null) {
+        unsetLockedSynchronizers();
+      } else {
+        setLockedSynchronizers((java.util.List<LockInfo>)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case THREAD_ID:
+      return getThreadId();
+
+    case THREAD_NAME:
+      return getThreadName();
+
+    case THREAD_STATE:
+      return getThreadState();
+
+    case BLOCKED_COUNT:
+      return getBlockedCount();
+
+    case BLOCKED_TIME_MS:
+      return getBlockedTimeMs();
+
+    case WAITED_COUNT:
+      return getWaitedCount();
+
+    case WAITED_TIME_MS:
+      return getWaitedTimeMs();
+
+    case LOCK_INFO:
+      return getLockInfo();
+
+    case LOCK_OWNER_ID:
+      return getLockOwnerId();
+
+    case LOCK_OWNER_NAME:
+      return getLockOwnerName();
+
+    case IN_NATIVE:
+      return isInNative();
+
+    case SUSPENDED:
+      return isSuspended();
+
+    case STACK_TRACE:
+      return getStackTrace();
+
+    case LOCKED_SYNCHRONIZERS:
+      return getLockedSynchronizers();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case THREAD_ID:
+      return isSetThreadId();
+    case THREAD_NAME:
+      return isSetThreadName();
+    case THREAD_STATE:
+      return isSetThreadState();
+    case BLOCKED_COUNT:
+      return isSetBlockedCount();
+    case BLOCKED_TIME_MS:
+      return isSetBlockedTimeMs();
+    case WAITED_COUNT:
+      return isSetWaitedCount();
+    case WAITED_TIME_MS:
+      return isSetWaitedTimeMs();
+    case LOCK_INFO:
+      return isSetLockInfo();
+    case LOCK_OWNER_ID:
+      return isSetLockOwnerId();
+    case LOCK_OWNER_NAME:
+      return isSetLockOwnerName();
+    case IN_NATIVE:
+      return isSetInNative();
+    case SUSPENDED:
+      return isSetSuspended();
+    case STACK_TRACE:
+      return isSetStackTrace();
+    case LOCKED_SYNCHRONIZERS:
+      return isSetLockedSynchronizers();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof ThreadInfo)
+      return this.equals((ThreadInfo)that);
+    return false;
+  }
+
+  public boolean equals(ThreadInfo that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_threadId = true;
+    boolean that_present_threadId = true;
+    if (this_present_threadId || that_present_threadId) {
+      if (!(this_present_threadId && that_present_threadId))
+        return false;
+      if (this.threadId != that.threadId)
+        return false;
+    }
+
+    boolean this_present_threadName = true && this.isSetThreadName();
+    boolean that_present_threadName = true && that.isSetThreadName();
+    if (this_present_threadName || that_present_threadName) {
+      if (!(this_present_threadName && that_present_threadName))
+        return false;
+      if (!this.threadName.equals(that.threadName))
+        return false;
+    }
+
+    boolean this_present_threadState = true && this.isSetThreadState();
+    boolean that_present_threadState = true && that.isSetThreadState();
+    if (this_present_threadState || that_present_threadState) {
+      if (!(this_present_threadState && that_present_threadState))
+        return false;
+      if (!this.threadState.equals(that.threadState))
+        return false;
+    }
+
+    boolean this_present_blockedCount = true;
+    boolean that_present_blockedCount = true;
+    if (this_present_blockedCount || that_present_blockedCount) {
+      if (!(this_present_blockedCount && that_present_blockedCount))
+        return false;
+      if (this.blockedCount != that.blockedCount)
+        return false;
+    }
+
+    boolean this_present_blockedTimeMs = true;
+    boolean that_present_blockedTimeMs = true;
+    if (this_present_blockedTimeMs || that_present_blockedTimeMs) {
+      if (!(this_present_blockedTimeMs && that_present_blockedTimeMs))
+        return false;
+      if (this.blockedTimeMs != that.blockedTimeMs)
+        return false;
+    }
+
+    boolean this_present_waitedCount = true;
+    boolean that_present_waitedCount = true;
+    if (this_present_waitedCount || that_present_waitedCount) {
+      if (!(this_present_waitedCount && that_present_waitedCount))
+        return false;
+      if (this.waitedCount != that.waitedCount)
+        return false;
+    }
+
+    boolean this_present_waitedTimeMs = true;
+    boolean that_present_waitedTimeMs = true;
+    if (this_present_waitedTimeMs || that_present_waitedTimeMs) {
+      if (!(this_present_waitedTimeMs && that_present_waitedTimeMs))
+        return false;
+      if (this.waitedTimeMs != that.waitedTimeMs)
+        return false;
+    }
+
+    boolean this_present_lockInfo = true && this.isSetLockInfo();
+    boolean that_present_lockInfo = true && that.isSetLockInfo();
+    if (this_present_lockInfo || that_present_lockInfo) {
+      if (!(this_present_lockInfo && that_present_lockInfo))
+        return false;
+      if (!this.lockInfo.equals(that.lockInfo))
+        return false;
+    }
+
+    boolean this_present_lockOwnerId = true;
+    boolean that_present_lockOwnerId = true;
+    if (this_present_lockOwnerId || that_present_lockOwnerId) {
+      if (!(this_present_lockOwnerId && that_present_lockOwnerId))
+        return false;
+      if (this.lockOwnerId != that.lockOwnerId)
+        return false;
+    }
+
+    boolean this_present_lockOwnerName = true && this.isSetLockOwnerName();
+    boolean that_present_lockOwnerName = true && that.isSetLockOwnerName();
+    if (this_present_lockOwnerName || that_present_lockOwnerName) {
+      if (!(this_present_lockOwnerName && that_present_lockOwnerName))
+        return false;
+      if (!this.lockOwnerName.equals(that.lockOwnerName))
+        return false;
+    }
+
+    boolean this_present_inNative = true;
+    boolean that_present_inNative = true;
+    if (this_present_inNative || that_present_inNative) {
+      if (!(this_present_inNative && that_present_inNative))
+        return false;
+      if (this.inNative != that.inNative)
+        return false;
+    }
+
+    boolean this_present_suspended = true;
+    boolean that_present_suspended = true;
+    if (this_present_suspended || that_present_suspended) {
+      if (!(this_present_suspended && that_present_suspended))
+        return false;
+      if (this.suspended != that.suspended)
+        return false;
+    }
+
+    boolean this_present_stackTrace = true && this.isSetStackTrace();
+    boolean that_present_stackTrace = true && that.isSetStackTrace();
+    if (this_present_stackTrace || that_present_stackTrace) {
+      if (!(this_present_stackTrace && that_present_stackTrace))
+        return false;
+      if (!this.stackTrace.equals(that.stackTrace))
+        return false;
+    }
+
+    boolean this_present_lockedSynchronizers = true && this.isSetLockedSynchronizers();
+    boolean that_present_lockedSynchronizers = true && that.isSetLockedSynchronizers();
+    if (this_present_lockedSynchronizers || that_present_lockedSynchronizers) {
+      if (!(this_present_lockedSynchronizers && that_present_lockedSynchronizers))
+        return false;
+      if (!this.lockedSynchronizers.equals(that.lockedSynchronizers))
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(threadId);
+
+    hashCode = hashCode * 8191 + ((isSetThreadName()) ? 131071 : 524287);
+    if (isSetThreadName())
+      hashCode = hashCode * 8191 + threadName.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetThreadState()) ? 131071 : 524287);
+    if (isSetThreadState())
+      hashCode = hashCode * 8191 + threadState.getValue();
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(blockedCount);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(blockedTimeMs);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(waitedCount);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(waitedTimeMs);
+
+    hashCode = hashCode * 8191 + ((isSetLockInfo()) ? 131071 : 524287);
+    if (isSetLockInfo())
+      hashCode = hashCode * 8191 + lockInfo.hashCode();
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(lockOwnerId);
+
+    hashCode = hashCode * 8191 + ((isSetLockOwnerName()) ? 131071 : 524287);
+    if (isSetLockOwnerName())
+      hashCode = hashCode * 8191 + lockOwnerName.hashCode();
+
+    hashCode = hashCode * 8191 + ((inNative) ? 131071 : 524287);
+
+    hashCode = hashCode * 8191 + ((suspended) ? 131071 : 524287);
+
+    hashCode = hashCode * 8191 + ((isSetStackTrace()) ? 131071 : 524287);
+    if (isSetStackTrace())
+      hashCode = hashCode * 8191 + stackTrace.hashCode();
+
+    hashCode = hashCode * 8191 + ((isSetLockedSynchronizers()) ? 131071 : 524287);
+    if (isSetLockedSynchronizers())
+      hashCode = hashCode * 8191 + lockedSynchronizers.hashCode();
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(ThreadInfo other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetThreadId(), other.isSetThreadId());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetThreadId()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.threadId, other.threadId);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetThreadName(), other.isSetThreadName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetThreadName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.threadName, other.threadName);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetThreadState(), other.isSetThreadState());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetThreadState()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.threadState, other.threadState);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetBlockedCount(), other.isSetBlockedCount());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetBlockedCount()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.blockedCount, other.blockedCount);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetBlockedTimeMs(), other.isSetBlockedTimeMs());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetBlockedTimeMs()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.blockedTimeMs, other.blockedTimeMs);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetWaitedCount(), other.isSetWaitedCount());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetWaitedCount()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.waitedCount, other.waitedCount);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetWaitedTimeMs(), other.isSetWaitedTimeMs());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetWaitedTimeMs()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.waitedTimeMs, other.waitedTimeMs);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLockInfo(), other.isSetLockInfo());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockInfo()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockInfo, other.lockInfo);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLockOwnerId(), other.isSetLockOwnerId());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockOwnerId()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockOwnerId, other.lockOwnerId);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLockOwnerName(), other.isSetLockOwnerName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockOwnerName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockOwnerName, other.lockOwnerName);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetInNative(), other.isSetInNative());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetInNative()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.inNative, other.inNative);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetSuspended(), other.isSetSuspended());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetSuspended()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.suspended, other.suspended);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetStackTrace(), other.isSetStackTrace());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetStackTrace()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.stackTrace, other.stackTrace);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLockedSynchronizers(), other.isSetLockedSynchronizers());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLockedSynchronizers()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.lockedSynchronizers, other.lockedSynchronizers);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("ThreadInfo(");
+    boolean first = true;
+
+    sb.append("threadId:");
+    sb.append(this.threadId);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("threadName:");
+    if (this.threadName == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.threadName);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("threadState:");
+    if (this.threadState == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.threadState);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("blockedCount:");
+    sb.append(this.blockedCount);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("blockedTimeMs:");
+    sb.append(this.blockedTimeMs);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("waitedCount:");
+    sb.append(this.waitedCount);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("waitedTimeMs:");
+    sb.append(this.waitedTimeMs);
+    first = false;
+    if (isSetLockInfo()) {
+      if (!first) sb.append(", ");
+      sb.append("lockInfo:");
+      if (this.lockInfo == null) {
+        sb.append("null");
+      } else {
+        sb.append(this.lockInfo);
+      }
+      first = false;
+    }
+    if (!first) sb.append(", ");
+    sb.append("lockOwnerId:");
+    sb.append(this.lockOwnerId);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("lockOwnerName:");
+    if (this.lockOwnerName == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.lockOwnerName);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("inNative:");
+    sb.append(this.inNative);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("suspended:");
+    sb.append(this.suspended);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("stackTrace:");
+    if (this.stackTrace == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.stackTrace);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("lockedSynchronizers:");
+    if (this.lockedSynchronizers == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.lockedSynchronizers);
+    }
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+    if (lockInfo != null) {
+      lockInfo.validate();
+    }
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class ThreadInfoStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public ThreadInfoStandardScheme getScheme() {
+      return new ThreadInfoStandardScheme();
+    }
+  }
+
+  private static class ThreadInfoStandardScheme extends org.apache.thrift.scheme.StandardScheme<ThreadInfo> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, ThreadInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // THREAD_ID
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.threadId = iprot.readI64();
+              struct.setThreadIdIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // THREAD_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.threadName = iprot.readString();
+              struct.setThreadNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // THREAD_STATE
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.threadState = org.newrelic.nrjmx.v2.nrprotocol.ThreadState.findByValue(iprot.readI32());
+              struct.setThreadStateIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // BLOCKED_COUNT
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.blockedCount = iprot.readI64();
+              struct.setBlockedCountIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // BLOCKED_TIME_MS
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.blockedTimeMs = iprot.readI64();
+              struct.setBlockedTimeMsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 6: // WAITED_COUNT
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.waitedCount = iprot.readI64();
+              struct.setWaitedCountIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 7: // WAITED_TIME_MS
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.waitedTimeMs = iprot.readI64();
+              struct.setWaitedTimeMsIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 8: // LOCK_INFO
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRUCT) {
+              struct.lockInfo = new LockInfo();
+              struct.lockInfo.read(iprot);
+              struct.setLockInfoIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 9: // LOCK_OWNER_ID
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.lockOwnerId = iprot.readI64();
+              struct.setLockOwnerIdIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 10: // LOCK_OWNER_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.lockOwnerName = iprot.readString();
+              struct.setLockOwnerNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 11: // IN_NATIVE
+            if (schemeField.type == org.apache.thrift.protocol.TType.BOOL) {
+              struct.inNative = iprot.readBool();
+              struct.setInNativeIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 12: // SUSPENDED
+            if (schemeField.type == org.apache.thrift.protocol.TType.BOOL) {
+              struct.suspended = iprot.readBool();
+              struct.setSuspendedIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 13: // STACK_TRACE
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list72 = iprot.readListBegin();
+                struct.stackTrace = new java.util.ArrayList<StackFrame>(_list72.size);
+                @org.apache.thrift.annotation.Nullable StackFrame _elem73;
+                for (int _i74 = 0; _i74 < _list72.size; ++_i74)
+                {
+                  _elem73 = new StackFrame();
+                  _elem73.read(iprot);
+                  struct.stackTrace.add(_elem73);
+                }
+                iprot.readListEnd();
+              }
+              struct.setStackTraceIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 14: // LOCKED_SYNCHRONIZERS
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list75 = iprot.readListBegin();
+                struct.lockedSynchronizers = new java.util.ArrayList<LockInfo>(_list75.size);
+                @org.apache.thrift.annotation.Nullable LockInfo _elem76;
+                for (int _i77 = 0; _i77 < _list75.size; ++_i77)
+                {
+                  _elem76 = new LockInfo();
+                  _elem76.read(iprot);
+                  struct.lockedSynchronizers.add(_elem76);
+                }
+                iprot.readListEnd();
+              }
+              struct.setLockedSynchronizersIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, ThreadInfo struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      oprot.writeFieldBegin(THREAD_ID_FIELD_DESC);
+      oprot.writeI64(struct.threadId);
+      oprot.writeFieldEnd();
+      if (struct.threadName != null) {
+        oprot.writeFieldBegin(THREAD_NAME_FIELD_DESC);
+        oprot.writeString(struct.threadName);
+        oprot.writeFieldEnd();
+      }
+      if (struct.threadState != null) {
+        oprot.writeFieldBegin(THREAD_STATE_FIELD_DESC);
+        oprot.writeI32(struct.threadState.getValue());
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(BLOCKED_COUNT_FIELD_DESC);
+      oprot.writeI64(struct.blockedCount);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(BLOCKED_TIME_MS_FIELD_DESC);
+      oprot.writeI64(struct.blockedTimeMs);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(WAITED_COUNT_FIELD_DESC);
+      oprot.writeI64(struct.waitedCount);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(WAITED_TIME_MS_FIELD_DESC);
+      oprot.writeI64(struct.waitedTimeMs);
+      oprot.writeFieldEnd();
+      if (struct.lockInfo != null) {
+        if (struct.isSetLockInfo()) {
+          oprot.writeFieldBegin(LOCK_INFO_FIELD_DESC);
+          struct.lockInfo.write(oprot);
+          oprot.writeFieldEnd();
+        }
+      }
+      oprot.writeFieldBegin(LOCK_OWNER_ID_FIELD_DESC);
+      oprot.writeI64(struct.lockOwnerId);
+      oprot.writeFieldEnd();
+      if (struct.lockOwnerName != null) {
+        oprot.writeFieldBegin(LOCK_OWNER_NAME_FIELD_DESC);
+        oprot.writeString(struct.lockOwnerName);
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(IN_NATIVE_FIELD_DESC);
+      oprot.writeBool(struct.inNative);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(SUSPENDED_FIELD_DESC);
+      oprot.writeBool(struct.suspended);
+      oprot.writeFieldEnd();
+      if (struct.stackTrace != null) {
+        oprot.writeFieldBegin(STACK_TRACE_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRUCT, struct.stackTrace.size()));
+          for (StackFrame _iter78 : struct.stackTrace)
+          {
+            _iter78.write(oprot);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      if (struct.lockedSynchronizers != null) {
+        oprot.writeFieldBegin(LOCKED_SYNCHRONIZERS_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRUCT, struct.lockedSynchronizers.size()));
+          for (LockInfo _iter79 : struct.lockedSynchronizers)
+          {
+            _iter79.write(oprot);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class ThreadInfoTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public ThreadInfoTupleScheme getScheme() {
+      return new ThreadInfoTupleScheme();
+    }
+  }
+
+  private static class ThreadInfoTupleScheme extends org.apache.thrift.scheme.TupleScheme<ThreadInfo> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, ThreadInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetThreadId()) {
+        optionals.set(0);
+      }
+      if (struct.isSetThreadName()) {
+        optionals.set(1);
+      }
+      if (struct.isSetThreadState()) {
+        optionals.set(2);
+      }
+      if (struct.isSetBlockedCount()) {
+        optionals.set(3);
+      }
+      if (struct.isSetBlockedTimeMs()) {
+        optionals.set(4);
+      }
+      if (struct.isSetWaitedCount()) {
+        optionals.set(5);
+      }
+      if (struct.isSetWaitedTimeMs()) {
+        optionals.set(6);
+      }
+      if (struct.isSetLockInfo()) {
+        optionals.set(7);
+      }
+      if (struct.isSetLockOwnerId()) {
+        optionals.set(8);
+      }
+      if (struct.isSetLockOwnerName()) {
+        optionals.set(9);
+      }
+      if (struct.isSetInNative()) {
+        optionals.set(10);
+      }
+      if (struct.isSetSuspended()) {
+        optionals.set(11);
+      }
+      if (struct.isSetStackTrace()) {
+        optionals.set(12);
+      }
+      if (struct.isSetLockedSynchronizers()) {
+        optionals.set(13);
+      }
+      oprot.writeBitSet(optionals, 14);
+      if (struct.isSetThreadId()) {
+        oprot.writeI64(struct.threadId);
+      }
+      if (struct.isSetThreadName()) {
+        oprot.writeString(struct.threadName);
+      }
+      if (struct.isSetThreadState()) {
+        oprot.writeI32(struct.threadState.getValue());
+      }
+      if (struct.isSetBlockedCount()) {
+        oprot.writeI64(struct.blockedCount);
+      }
+      if (struct.isSetBlockedTimeMs()) {
+        oprot.writeI64(struct.blockedTimeMs);
+      }
+      if (struct.isSetWaitedCount()) {
+        oprot.writeI64(struct.waitedCount);
+      }
+      if (struct.isSetWaitedTimeMs()) {
+        oprot.writeI64(struct.waitedTimeMs);
+      }
+      if (struct.isSetLockInfo()) {
+        struct.lockInfo.write(oprot);
+      }
+      if (struct.isSetLockOwnerId()) {
+        oprot.writeI64(struct.lockOwnerId);
+      }
+      if (struct.isSetLockOwnerName()) {
+        oprot.writeString(struct.lockOwnerName);
+      }
+      if (struct.isSetInNative()) {
+        oprot.writeBool(struct.inNative);
+      }
+      if (struct.isSetSuspended()) {
+        oprot.writeBool(struct.suspended);
+      }
+      if (struct.isSetStackTrace()) {
+        {
+          oprot.writeI32(struct.stackTrace.size());
+          for (StackFrame _iter80 : struct.stackTrace)
+          {
+            _iter80.write(oprot);
+          }
+        }
+      }
+      if (struct.isSetLockedSynchronizers()) {
+        {
+          oprot.writeI32(struct.lockedSynchronizers.size());
+          for (LockInfo _iter81 : struct.lockedSynchronizers)
+          {
+            _iter81.write(oprot);
+          }
+        }
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, ThreadInfo struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(14);
+      if (incoming.get(0)) {
+        struct.threadId = iprot.readI64();
+        struct.setThreadIdIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.threadName = iprot.readString();
+        struct.setThreadNameIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.threadState = org.newrelic.nrjmx.v2.nrprotocol.ThreadState.findByValue(iprot.readI32());
+        struct.setThreadStateIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.blockedCount = iprot.readI64();
+        struct.setBlockedCountIsSet(true);
+      }
+      if (incoming.get(4)) {
+        struct.blockedTimeMs = iprot.readI64();
+        struct.setBlockedTimeMsIsSet(true);
+      }
+      if (incoming.get(5)) {
+        struct.waitedCount = iprot.readI64();
+        struct.setWaitedCountIsSet(true);
+      }
+      if (incoming.get(6)) {
+        struct.waitedTimeMs = iprot.readI64();
+        struct.setWaitedTimeMsIsSet(true);
+      }
+      if (incoming.get(7)) {
+        struct.lockInfo = new LockInfo();
+        struct.lockInfo.read(iprot);
+        struct.setLockInfoIsSet(true);
+      }
+      if (incoming.get(8)) {
+        struct.lockOwnerId = iprot.readI64();
+        struct.setLockOwnerIdIsSet(true);
+      }
+      if (incoming.get(9)) {
+        struct.lockOwnerName = iprot.readString();
+        struct.setLockOwnerNameIsSet(true);
+      }
+      if (incoming.get(10)) {
+        struct.inNative = iprot.readBool();
+        struct.setInNativeIsSet(true);
+      }
+      if (incoming.get(11)) {
+        struct.suspended = iprot.readBool();
+        struct.setSuspendedIsSet(true);
+      }
+      if (incoming.get(12)) {
+        {
+          org.apache.thrift.protocol.TList _list82 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRUCT);
+          struct.stackTrace = new java.util.ArrayList<StackFrame>(_list82.size);
+          @org.apache.thrift.annotation.Nullable StackFrame _elem83;
+          for (int _i84 = 0; _i84 < _list82.size; ++_i84)
+          {
+            _elem83 = new StackFrame();
+            _elem83.read(iprot);
+            struct.stackTrace.add(_elem83);
+          }
+        }
+        struct.setStackTraceIsSet(true);
+      }
+      if (incoming.get(13)) {
+        {
+          org.apache.thrift.protocol.TList _list85 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRUCT);
+          struct.lockedSynchronizers = new java.util.ArrayList<LockInfo>(_list85.size);
+          @org.apache.thrift.annotation.Nullable LockInfo _elem86;
+          for (int _i87 = 0; _i87 < _list85.size; ++_i87)
+          {
+            _elem86 = new LockInfo();
+            _elem86.read(iprot);
+            struct.lockedSynchronizers.add(_elem86);
+          }
+        }
+        struct.setLockedSynchronizersIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+
@@ -0,0 +1,55 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+
+public enum ThreadState implements org.apache.thrift.TEnum {
+  NEW(1),
+  RUNNABLE(2),
+  BLOCKED(3),
+  WAITING(4),
+  TIMED_WAITING(5),
+  TERMINATED(6);
+
+  private final int value;
+
+  private ThreadState(int value) {
+    this.value = value;
+  }
+
+  /**
+   * Get the integer value of this enum value, as defined in the Thrift IDL.
+   */
+  @Override
+  public int getValue() {
+    return value;
+  }
+
+  /**
+   * Find a the enum type by its integer value, as defined in the Thrift IDL.
+   * @return null if the value is not found.
+   */
+  @org.apache.thrift.annotation.Nullable
+  public static ThreadState findByValue(int value) { 
+    switch (value) {
+      case 1:
+        return NEW;
+      case 2:
+        return RUNNABLE;
+      case 3:
+        return BLOCKED;
+      case 4:
+        return WAITING;
+      case 5:
+        return TIMED_WAITING;
+      case 6:
+        return TERMINATED;
+      default:
+        return null;
+    }
+  }
+}
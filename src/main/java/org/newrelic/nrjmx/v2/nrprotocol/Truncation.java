@@ -0,0 +1,1051 @@
+/**
+ * Autogenerated by Thrift Compiler (0.21.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ *  @generated
+ */
+package org.newrelic.nrjmx.v2.nrprotocol;
+
+@SuppressWarnings({"cast", "rawtypes", "serial", "unchecked", "unused"})
+public class Truncation implements org.apache.thrift.TBase<Truncation, Truncation._Fields>, java.io.Serializable, Cloneable, Comparable<Truncation> {
+  private static final org.apache.thrift.protocol.TStruct STRUCT_DESC = new org.apache.thrift.protocol.TStruct("Truncation");
+
+  private static final org.apache.thrift.protocol.TField LIMIT_FIELD_DESC = new org.apache.thrift.protocol.TField("limit", org.apache.thrift.protocol.TType.I32, (short)1);
+  private static final org.apache.thrift.protocol.TField LIMIT_VALUE_FIELD_DESC = new org.apache.thrift.protocol.TField("limitValue", org.apache.thrift.protocol.TType.I64, (short)2);
+  private static final org.apache.thrift.protocol.TField M_BEAN_NAME_FIELD_DESC = new org.apache.thrift.protocol.TField("mBeanName", org.apache.thrift.protocol.TType.STRING, (short)3);
+  private static final org.apache.thrift.protocol.TField DROPPED_COUNT_FIELD_DESC = new org.apache.thrift.protocol.TField("droppedCount", org.apache.thrift.protocol.TType.I64, (short)4);
+  private static final org.apache.thrift.protocol.TField DROPPED_FIELD_DESC = new org.apache.thrift.protocol.TField("dropped", org.apache.thrift.protocol.TType.LIST, (short)5);
+  private static final org.apache.thrift.protocol.TField TIMESTAMP_FIELD_DESC = new org.apache.thrift.protocol.TField("timestamp", org.apache.thrift.protocol.TType.I64, (short)6);
+  private static final org.apache.thrift.protocol.TField EVICTED_BEFORE_FIELD_DESC = new org.apache.thrift.protocol.TField("evictedBefore", org.apache.thrift.protocol.TType.I64, (short)7);
+
+  private static final org.apache.thrift.scheme.SchemeFactory STANDARD_SCHEME_FACTORY = new TruncationStandardSchemeFactory();
+  private static final org.apache.thrift.scheme.SchemeFactory TUPLE_SCHEME_FACTORY = new TruncationTupleSchemeFactory();
+
+  /**
+   * 
+   * @see QueryLimit
+   */
+  public @org.apache.thrift.annotation.Nullable QueryLimit limit; // required
+  public long limitValue; // required
+  public @org.apache.thrift.annotation.Nullable java.lang.String mBeanName; // required
+  public long droppedCount; // required
+  public @org.apache.thrift.annotation.Nullable java.util.List<java.lang.String> dropped; // required
+  public long timestamp; // required
+  public long evictedBefore; // required
+
+  /** The set of fields this struct contains, along with convenience methods for finding and manipulating them. */
+  public enum _Fields implements org.apache.thrift.TFieldIdEnum {
+    /**
+     * 
+     * @see QueryLimit
+     */
+    LIMIT((short)1, "limit"),
+    LIMIT_VALUE((short)2, "limitValue"),
+    M_BEAN_NAME((short)3, "mBeanName"),
+    DROPPED_COUNT((short)4, "droppedCount"),
+    DROPPED((short)5, "dropped"),
+    TIMESTAMP((short)6, "timestamp"),
+    EVICTED_BEFORE((short)7, "evictedBefore");
+
+    private static final java.util.Map<java.lang.String, _Fields> byName = new java.util.HashMap<java.lang.String, _Fields>();
+
+    static {
+      for (_Fields field : java.util.EnumSet.allOf(_Fields.class)) {
+        byName.put(field.getFieldName(), field);
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByThriftId(int fieldId) {
+      switch(fieldId) {
+        case 1: // LIMIT
+          return LIMIT;
+        case 2: // LIMIT_VALUE
+          return LIMIT_VALUE;
+        case 3: // M_BEAN_NAME
+          return M_BEAN_NAME;
+        case 4: // DROPPED_COUNT
+          return DROPPED_COUNT;
+        case 5: // DROPPED
+          return DROPPED;
+        case 6: // TIMESTAMP
+          return TIMESTAMP;
+        case 7: // EVICTED_BEFORE
+          return EVICTED_BEFORE;
+        default:
+          return null;
+      }
+    }
+
+    /**
+     * Find the _Fields constant that matches fieldId, throwing an exception
+     * if it is not found.
+     */
+    public static _Fields findByThriftIdOrThrow(int fieldId) {
+      _Fields fields = findByThriftId(fieldId);
+      if (fields == null) throw new java.lang.IllegalArgumentException("Field " + fieldId + " doesn't exist!");
+      return fields;
+    }
+
+    /**
+     * Find the _Fields constant that matches name, or null if its not found.
+     */
+    @org.apache.thrift.annotation.Nullable
+    public static _Fields findByName(java.lang.String name) {
+      return byName.get(name);
+    }
+
+    private final short _thriftId;
+    private final java.lang.String _fieldName;
+
+    _Fields(short thriftId, java.lang.String fieldName) {
+      _thriftId = thriftId;
+      _fieldName = fieldName;
+    }
+
+    @Override
+    public short getThriftFieldId() {
+      return _thriftId;
+    }
+
+    @Override
+    public java.lang.String getFieldName() {
+      return _fieldName;
+    }
+  }
+
+  // isset id assignments
+  private static final int __LIMITVALUE_ISSET_ID = 0;
+  private static final int __DROPPEDCOUNT_ISSET_ID = 1;
+  private static final int __TIMESTAMP_ISSET_ID = 2;
+  private static final int __EVICTEDBEFORE_ISSET_ID = 3;
+  private byte __isset_bitfield = 0;
+  public static final java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> metaDataMap;
+  static {
+    java.util.Map<_Fields, org.apache.thrift.meta_data.FieldMetaData> tmpMap = new java.util.EnumMap<_Fields, org.apache.thrift.meta_data.FieldMetaData>(_Fields.class);
+    tmpMap.put(_Fields.LIMIT, new org.apache.thrift.meta_data.FieldMetaData("limit", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.EnumMetaData(org.apache.thrift.protocol.TType.ENUM, QueryLimit.class)));
+    tmpMap.put(_Fields.LIMIT_VALUE, new org.apache.thrift.meta_data.FieldMetaData("limitValue", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.M_BEAN_NAME, new org.apache.thrift.meta_data.FieldMetaData("mBeanName", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING)));
+    tmpMap.put(_Fields.DROPPED_COUNT, new org.apache.thrift.meta_data.FieldMetaData("droppedCount", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.DROPPED, new org.apache.thrift.meta_data.FieldMetaData("dropped", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.ListMetaData(org.apache.thrift.protocol.TType.LIST, 
+            new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.STRING))));
+    tmpMap.put(_Fields.TIMESTAMP, new org.apache.thrift.meta_data.FieldMetaData("timestamp", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    tmpMap.put(_Fields.EVICTED_BEFORE, new org.apache.thrift.meta_data.FieldMetaData("evictedBefore", org.apache.thrift.TFieldRequirementType.DEFAULT, 
+        new org.apache.thrift.meta_data.FieldValueMetaData(org.apache.thrift.protocol.TType.I64)));
+    metaDataMap = java.util.Collections.unmodifiableMap(tmpMap);
+    org.apache.thrift.meta_data.FieldMetaData.addStructMetaDataMap(Truncation.class, metaDataMap);
+  }
+
+  public Truncation() {
+  }
+
+  public Truncation(
+    QueryLimit limit,
+    long limitValue,
+    java.lang.String mBeanName,
+    long droppedCount,
+    java.util.List<java.lang.String> dropped,
+    long timestamp,
+    long evictedBefore)
+  {
+    this();
+    this.limit = limit;
+    this.limitValue = limitValue;
+    setLimitValueIsSet(true);
+    this.mBeanName = mBeanName;
+    this.droppedCount = droppedCount;
+    setDroppedCountIsSet(true);
+    this.dropped = dropped;
+    this.timestamp = timestamp;
+    setTimestampIsSet(true);
+    this.evictedBefore = evictedBefore;
+    setEvictedBeforeIsSet(true);
+  }
+
+  /**
+   * Performs a deep copy on <i>other</i>.
+   */
+  public Truncation(Truncation other) {
+    __isset_bitfield = other.__isset_bitfield;
+    if (other.isSetLimit()) {
+      this.limit = other.limit;
+    }
+    this.limitValue = other.limitValue;
+    if (other.isSetMBeanName()) {
+      this.mBeanName = other.mBeanName;
+    }
+    this.droppedCount = other.droppedCount;
+    if (other.isSetDropped()) {
+      java.util.List<java.lang.String> __this__dropped = new java.util.ArrayList<java.lang.String>(other.dropped);
+      this.dropped = __this__dropped;
+    }
+    this.timestamp = other.timestamp;
+    this.evictedBefore = other.evictedBefore;
+  }
+
+  @Override
+  public Truncation deepCopy() {
+    return new Truncation(this);
+  }
+
+  @Override
+  public void clear() {
+    this.limit = null;
+    setLimitValueIsSet(false);
+    this.limitValue = 0;
+    this.mBeanName = null;
+    setDroppedCountIsSet(false);
+    this.droppedCount = 0;
+    this.dropped = null;
+    setTimestampIsSet(false);
+    this.timestamp = 0;
+    setEvictedBeforeIsSet(false);
+    this.evictedBefore = 0;
+  }
+
+  /**
+   * 
+   * @see QueryLimit
+   */
+  @org.apache.thrift.annotation.Nullable
+  public QueryLimit getLimit() {
+    return this.limit;
+  }
+
+  /**
+   * 
+   * @see QueryLimit
+   */
+  public Truncation setLimit(@org.apache.thrift.annotation.Nullable QueryLimit limit) {
+    this.limit = limit;
+    return this;
+  }
+
+  public void unsetLimit() {
+    this.limit = null;
+  }
+
+  /** Returns true if field limit is set (has been assigned a value) and false otherwise */
+  public boolean isSetLimit() {
+    return this.limit != null;
+  }
+
+  public void setLimitIsSet(boolean value) {
+    if (!value) {
+      this.limit = null;
+    }
+  }
+
+  public long getLimitValue() {
+    return this.limitValue;
+  }
+
+  public Truncation setLimitValue(long limitValue) {
+    this.limitValue = limitValue;
+    setLimitValueIsSet(true);
+    return this;
+  }
+
+  public void unsetLimitValue() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __LIMITVALUE_ISSET_ID);
+  }
+
+  /** Returns true if field limitValue is set (has been assigned a value) and false otherwise */
+  public boolean isSetLimitValue() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __LIMITVALUE_ISSET_ID);
+  }
+
+  public void setLimitValueIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __LIMITVALUE_ISSET_ID, value);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.lang.String getMBeanName() {
+    return this.mBeanName;
+  }
+
+  public Truncation setMBeanName(@org.apache.thrift.annotation.Nullable java.lang.String mBeanName) {
+    this.mBeanName = mBeanName;
+    return this;
+  }
+
+  public void unsetMBeanName() {
+    this.mBeanName = null;
+  }
+
+  /** Returns true if field mBeanName is set (has been assigned a value) and false otherwise */
+  public boolean isSetMBeanName() {
+    return this.mBeanName != null;
+  }
+
+  public void setMBeanNameIsSet(boolean value) {
+    if (!value) {
+      this.mBeanName = null;
+    }
+  }
+
+  public long getDroppedCount() {
+    return this.droppedCount;
+  }
+
+  public Truncation setDroppedCount(long droppedCount) {
+    this.droppedCount = droppedCount;
+    setDroppedCountIsSet(true);
+    return this;
+  }
+
+  public void unsetDroppedCount() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __DROPPEDCOUNT_ISSET_ID);
+  }
+
+  /** Returns true if field droppedCount is set (has been assigned a value) and false otherwise */
+  public boolean isSetDroppedCount() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __DROPPEDCOUNT_ISSET_ID);
+  }
+
+  public void setDroppedCountIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __DROPPEDCOUNT_ISSET_ID, value);
+  }
+
+  public int getDroppedSize() {
+    return (this.dropped == null) ? 0 : this.dropped.size();
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.Iterator<java.lang.String> getDroppedIterator() {
+    return (this.dropped == null) ? null : this.dropped.iterator();
+  }
+
+  public void addToDropped(java.lang.String elem) {
+    if (this.dropped == null) {
+      this.dropped = new java.util.ArrayList<java.lang.String>();
+    }
+    this.dropped.add(elem);
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  public java.util.List<java.lang.String> getDropped() {
+    return this.dropped;
+  }
+
+  public Truncation setDropped(@org.apache.thrift.annotation.Nullable java.util.List<java.lang.String> dropped) {
+    this.dropped = dropped;
+    return this;
+  }
+
+  public void unsetDropped() {
+    this.dropped = null;
+  }
+
+  /** Returns true if field dropped is set (has been assigned a value) and false otherwise */
+  public boolean isSetDropped() {
+    return this.dropped != null;
+  }
+
+  public void setDroppedIsSet(boolean value) {
+    if (!value) {
+      this.dropped = null;
+    }
+  }
+
+  public long getTimestamp() {
+    return this.timestamp;
+  }
+
+  public Truncation setTimestamp(long timestamp) {
+    this.timestamp = timestamp;
+    setTimestampIsSet(true);
+    return this;
+  }
+
+  public void unsetTimestamp() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __TIMESTAMP_ISSET_ID);
+  }
+
+  /** Returns true if field timestamp is set (has been assigned a value) and false otherwise */
+  public boolean isSetTimestamp() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __TIMESTAMP_ISSET_ID);
+  }
+
+  public void setTimestampIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __TIMESTAMP_ISSET_ID, value);
+  }
+
+  public long getEvictedBefore() {
+    return this.evictedBefore;
+  }
+
+  public Truncation setEvictedBefore(long evictedBefore) {
+    this.evictedBefore = evictedBefore;
+    setEvictedBeforeIsSet(true);
+    return this;
+  }
+
+  public void unsetEvictedBefore() {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.clearBit(__isset_bitfield, __EVICTEDBEFORE_ISSET_ID);
+  }
+
+  /** Returns true if field evictedBefore is set (has been assigned a value) and false otherwise */
+  public boolean isSetEvictedBefore() {
+    return org.apache.thrift.EncodingUtils.testBit(__isset_bitfield, __EVICTEDBEFORE_ISSET_ID);
+  }
+
+  public void setEvictedBeforeIsSet(boolean value) {
+    __isset_bitfield = org.apache.thrift.EncodingUtils.setBit(__isset_bitfield, __EVICTEDBEFORE_ISSET_ID, value);
+  }
+
+  @Override
+  public void setFieldValue(_Fields field, @org.apache.thrift.annotation.Nullable java.lang.Object value) {
+    switch (field) {
+    case LIMIT:
+      if (value == null) {
+        unsetLimit();
+      } else {
+        setLimit((QueryLimit)value);
+      }
+      break;
+
+    case LIMIT_VALUE:
+      if (value == null) {
+        unsetLimitValue();
+      } else {
+        setLimitValue((java.lang.Long)value);
+      }
+      break;
+
+    case M_BEAN_NAME:
+      if (value == null) {
+        unsetMBeanName();
+      } else {
+        setMBeanName((java.lang.String)value);
+      }
+      break;
+
+    case DROPPED_COUNT:
+      if (value == null) {
+        unsetDroppedCount();
+      } else {
+        setDroppedCount((java.lang.Long)value);
+      }
+      break;
+
+    case DROPPED:
+      if (value == null) {
+        unsetDropped();
+      } else {
+        setDropped((java.util.List<java.lang.String>)value);
+      }
+      break;
+
+    case TIMESTAMP:
+      if (value == null) {
+        unsetTimestamp();
+      } else {
+        setTimestamp((java.lang.Long)value);
+      }
+      break;
+
+    case EVICTED_BEFORE:
+      if (value == null) {
+        unsetEvictedBefore();
+      } else {
+        setEvictedBefore((java.lang.Long)value);
+      }
+      break;
+
+    }
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public java.lang.Object getFieldValue(_Fields field) {
+    switch (field) {
+    case LIMIT:
+      return getLimit();
+
+    case LIMIT_VALUE:
+      return getLimitValue();
+
+    case M_BEAN_NAME:
+      return getMBeanName();
+
+    case DROPPED_COUNT:
+      return getDroppedCount();
+
+    case DROPPED:
+      return getDropped();
+
+    case TIMESTAMP:
+      return getTimestamp();
+
+    case EVICTED_BEFORE:
+      return getEvictedBefore();
+
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  /** Returns true if field corresponding to fieldID is set (has been assigned a value) and false otherwise */
+  @Override
+  public boolean isSet(_Fields field) {
+    if (field == null) {
+      throw new java.lang.IllegalArgumentException();
+    }
+
+    switch (field) {
+    case LIMIT:
+      return isSetLimit();
+    case LIMIT_VALUE:
+      return isSetLimitValue();
+    case M_BEAN_NAME:
+      return isSetMBeanName();
+    case DROPPED_COUNT:
+      return isSetDroppedCount();
+    case DROPPED:
+      return isSetDropped();
+    case TIMESTAMP:
+      return isSetTimestamp();
+    case EVICTED_BEFORE:
+      return isSetEvictedBefore();
+    }
+    throw new java.lang.IllegalStateException();
+  }
+
+  @Override
+  public boolean equals(java.lang.Object that) {
+    if (that instanceof Truncation)
+      return this.equals((Truncation)that);
+    return false;
+  }
+
+  public boolean equals(Truncation that) {
+    if (that == null)
+      return false;
+    if (this == that)
+      return true;
+
+    boolean this_present_limit = true && this.isSetLimit();
+    boolean that_present_limit = true && that.isSetLimit();
+    if (this_present_limit || that_present_limit) {
+      if (!(this_present_limit && that_present_limit))
+        return false;
+      if (!this.limit.equals(that.limit))
+        return false;
+    }
+
+    boolean this_present_limitValue = true;
+    boolean that_present_limitValue = true;
+    if (this_present_limitValue || that_present_limitValue) {
+      if (!(this_present_limitValue && that_present_limitValue))
+        return false;
+      if (this.limitValue != that.limitValue)
+        return false;
+    }
+
+    boolean this_present_mBeanName = true && this.isSetMBeanName();
+    boolean that_present_mBeanName = true && that.isSetMBeanName();
+    if (this_present_mBeanName || that_present_mBeanName) {
+      if (!(this_present_mBeanName && that_present_mBeanName))
+        return false;
+      if (!this.mBeanName.equals(that.mBeanName))
+        return false;
+    }
+
+    boolean this_present_droppedCount = true;
+    boolean that_present_droppedCount = true;
+    if (this_present_droppedCount || that_present_droppedCount) {
+      if (!(this_present_droppedCount && that_present_droppedCount))
+        return false;
+      if (this.droppedCount != that.droppedCount)
+        return false;
+    }
+
+    boolean this_present_dropped = true && this.isSetDropped();
+    boolean that_present_dropped = true && that.isSetDropped();
+    if (this_present_dropped || that_present_dropped) {
+      if (!(this_present_dropped && that_present_dropped))
+        return false;
+      if (!this.dropped.equals(that.dropped))
+        return false;
+    }
+
+    boolean this_present_timestamp = true;
+    boolean that_present_timestamp = true;
+    if (this_present_timestamp || that_present_timestamp) {
+      if (!(this_present_timestamp && that_present_timestamp))
+        return false;
+      if (this.timestamp != that.timestamp)
+        return false;
+    }
+
+    boolean this_present_evictedBefore = true;
+    boolean that_present_evictedBefore = true;
+    if (this_present_evictedBefore || that_present_evictedBefore) {
+      if (!(this_present_evictedBefore && that_present_evictedBefore))
+        return false;
+      if (this.evictedBefore != that.evictedBefore)
+        return false;
+    }
+
+    return true;
+  }
+
+  @Override
+  public int hashCode() {
+    int hashCode = 1;
+
+    hashCode = hashCode * 8191 + ((isSetLimit()) ? 131071 : 524287);
+    if (isSetLimit())
+      hashCode = hashCode * 8191 + limit.getValue();
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(limitValue);
+
+    hashCode = hashCode * 8191 + ((isSetMBeanName()) ? 131071 : 524287);
+    if (isSetMBeanName())
+      hashCode = hashCode * 8191 + mBeanName.hashCode();
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(droppedCount);
+
+    hashCode = hashCode * 8191 + ((isSetDropped()) ? 131071 : 524287);
+    if (isSetDropped())
+      hashCode = hashCode * 8191 + dropped.hashCode();
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(timestamp);
+
+    hashCode = hashCode * 8191 + org.apache.thrift.TBaseHelper.hashCode(evictedBefore);
+
+    return hashCode;
+  }
+
+  @Override
+  public int compareTo(Truncation other) {
+    if (!getClass().equals(other.getClass())) {
+      return getClass().getName().compareTo(other.getClass().getName());
+    }
+
+    int lastComparison = 0;
+
+    lastComparison = java.lang.Boolean.compare(isSetLimit(), other.isSetLimit());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLimit()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.limit, other.limit);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetLimitValue(), other.isSetLimitValue());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetLimitValue()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.limitValue, other.limitValue);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetMBeanName(), other.isSetMBeanName());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetMBeanName()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.mBeanName, other.mBeanName);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetDroppedCount(), other.isSetDroppedCount());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetDroppedCount()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.droppedCount, other.droppedCount);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetDropped(), other.isSetDropped());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetDropped()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.dropped, other.dropped);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetTimestamp(), other.isSetTimestamp());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetTimestamp()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.timestamp, other.timestamp);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    lastComparison = java.lang.Boolean.compare(isSetEvictedBefore(), other.isSetEvictedBefore());
+    if (lastComparison != 0) {
+      return lastComparison;
+    }
+    if (isSetEvictedBefore()) {
+      lastComparison = org.apache.thrift.TBaseHelper.compareTo(this.evictedBefore, other.evictedBefore);
+      if (lastComparison != 0) {
+        return lastComparison;
+      }
+    }
+    return 0;
+  }
+
+  @org.apache.thrift.annotation.Nullable
+  @Override
+  public _Fields fieldForId(int fieldId) {
+    return _Fields.findByThriftId(fieldId);
+  }
+
+  @Override
+  public void read(org.apache.thrift.protocol.TProtocol iprot) throws org.apache.thrift.TException {
+    scheme(iprot).read(iprot, this);
+  }
+
+  @Override
+  public void write(org.apache.thrift.protocol.TProtocol oprot) throws org.apache.thrift.TException {
+    scheme(oprot).write(oprot, this);
+  }
+
+  @Override
+  public java.lang.String toString() {
+    java.lang.StringBuilder sb = new java.lang.StringBuilder("Truncation(");
+    boolean first = true;
+
+    sb.append("limit:");
+    if (this.limit == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.limit);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("limitValue:");
+    sb.append(this.limitValue);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("mBeanName:");
+    if (this.mBeanName == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.mBeanName);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("droppedCount:");
+    sb.append(this.droppedCount);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("dropped:");
+    if (this.dropped == null) {
+      sb.append("null");
+    } else {
+      sb.append(this.dropped);
+    }
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("timestamp:");
+    sb.append(this.timestamp);
+    first = false;
+    if (!first) sb.append(", ");
+    sb.append("evictedBefore:");
+    sb.append(this.evictedBefore);
+    first = false;
+    sb.append(")");
+    return sb.toString();
+  }
+
+  public void validate() throws org.apache.thrift.TException {
+    // check for required fields
+    // check for sub-struct validity
+  }
+
+  private void writeObject(java.io.ObjectOutputStream out) throws java.io.IOException {
+    try {
+      write(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(out)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private void readObject(java.io.ObjectInputStream in) throws java.io.IOException, java.lang.ClassNotFoundException {
+    try {
+      // it doesn't seem like you should have to do this, but java serialization is wacky, and doesn't call the default constructor.
+      __isset_bitfield = 0;
+      read(new org.apache.thrift.protocol.TCompactProtocol(new org.apache.thrift.transport.TIOStreamTransport(in)));
+    } catch (org.apache.thrift.TException te) {
+      throw new java.io.IOException(te);
+    }
+  }
+
+  private static class TruncationStandardSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public TruncationStandardScheme getScheme() {
+      return new TruncationStandardScheme();
+    }
+  }
+
+  private static class TruncationStandardScheme extends org.apache.thrift.scheme.StandardScheme<Truncation> {
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol iprot, Truncation struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TField schemeField;
+      iprot.readStructBegin();
+      while (true)
+      {
+        schemeField = iprot.readFieldBegin();
+        if (schemeField.type == org.apache.thrift.protocol.TType.STOP) { 
+          break;
+        }
+        switch (schemeField.id) {
+          case 1: // LIMIT
+            if (schemeField.type == org.apache.thrift.protocol.TType.I32) {
+              struct.limit = org.newrelic.nrjmx.v2.nrprotocol.QueryLimit.findByValue(iprot.readI32());
+              struct.setLimitIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 2: // LIMIT_VALUE
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.limitValue = iprot.readI64();
+              struct.setLimitValueIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 3: // M_BEAN_NAME
+            if (schemeField.type == org.apache.thrift.protocol.TType.STRING) {
+              struct.mBeanName = iprot.readString();
+              struct.setMBeanNameIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 4: // DROPPED_COUNT
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.droppedCount = iprot.readI64();
+              struct.setDroppedCountIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 5: // DROPPED
+            if (schemeField.type == org.apache.thrift.protocol.TType.LIST) {
+              {
+                org.apache.thrift.protocol.TList _list0 = iprot.readListBegin();
+                struct.dropped = new java.util.ArrayList<java.lang.String>(_list0.size);
+                @org.apache.thrift.annotation.Nullable java.lang.String _elem1;
+                for (int _i2 = 0; _i2 < _list0.size; ++_i2)
+                {
+                  _elem1 = iprot.readString();
+                  struct.dropped.add(_elem1);
+                }
+                iprot.readListEnd();
+              }
+              struct.setDroppedIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 6: // TIMESTAMP
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.timestamp = iprot.readI64();
+              struct.setTimestampIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          case 7: // EVICTED_BEFORE
+            if (schemeField.type == org.apache.thrift.protocol.TType.I64) {
+              struct.evictedBefore = iprot.readI64();
+              struct.setEvictedBeforeIsSet(true);
+            } else { 
+              org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+            }
+            break;
+          default:
+            org.apache.thrift.protocol.TProtocolUtil.skip(iprot, schemeField.type);
+        }
+        iprot.readFieldEnd();
+      }
+      iprot.readStructEnd();
+
+      // check for required fields of primitive type, which can't be checked in the validate method
+      struct.validate();
+    }
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol oprot, Truncation struct) throws org.apache.thrift.TException {
+      struct.validate();
+
+      oprot.writeStructBegin(STRUCT_DESC);
+      if (struct.limit != null) {
+        oprot.writeFieldBegin(LIMIT_FIELD_DESC);
+        oprot.writeI32(struct.limit.getValue());
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(LIMIT_VALUE_FIELD_DESC);
+      oprot.writeI64(struct.limitValue);
+      oprot.writeFieldEnd();
+      if (struct.mBeanName != null) {
+        oprot.writeFieldBegin(M_BEAN_NAME_FIELD_DESC);
+        oprot.writeString(struct.mBeanName);
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(DROPPED_COUNT_FIELD_DESC);
+      oprot.writeI64(struct.droppedCount);
+      oprot.writeFieldEnd();
+      if (struct.dropped != null) {
+        oprot.writeFieldBegin(DROPPED_FIELD_DESC);
+        {
+          oprot.writeListBegin(new org.apache.thrift.protocol.TList(org.apache.thrift.protocol.TType.STRING, struct.dropped.size()));
+          for (java.lang.String _iter3 : struct.dropped)
+          {
+            oprot.writeString(_iter3);
+          }
+          oprot.writeListEnd();
+        }
+        oprot.writeFieldEnd();
+      }
+      oprot.writeFieldBegin(TIMESTAMP_FIELD_DESC);
+      oprot.writeI64(struct.timestamp);
+      oprot.writeFieldEnd();
+      oprot.writeFieldBegin(EVICTED_BEFORE_FIELD_DESC);
+      oprot.writeI64(struct.evictedBefore);
+      oprot.writeFieldEnd();
+      oprot.writeFieldStop();
+      oprot.writeStructEnd();
+    }
+
+  }
+
+  private static class TruncationTupleSchemeFactory implements org.apache.thrift.scheme.SchemeFactory {
+    @Override
+    public TruncationTupleScheme getScheme() {
+      return new TruncationTupleScheme();
+    }
+  }
+
+  private static class TruncationTupleScheme extends org.apache.thrift.scheme.TupleScheme<Truncation> {
+
+    @Override
+    public void write(org.apache.thrift.protocol.TProtocol prot, Truncation struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol oprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet optionals = new java.util.BitSet();
+      if (struct.isSetLimit()) {
+        optionals.set(0);
+      }
+      if (struct.isSetLimitValue()) {
+        optionals.set(1);
+      }
+      if (struct.isSetMBeanName()) {
+        optionals.set(2);
+      }
+      if (struct.isSetDroppedCount()) {
+        optionals.set(3);
+      }
+      if (struct.isSetDropped()) {
+        optionals.set(4);
+      }
+      if (struct.isSetTimestamp()) {
+        optionals.set(5);
+      }
+      if (struct.isSetEvictedBefore()) {
+        optionals.set(6);
+      }
+      oprot.writeBitSet(optionals, 7);
+      if (struct.isSetLimit()) {
+        oprot.writeI32(struct.limit.getValue());
+      }
+      if (struct.isSetLimitValue()) {
+        oprot.writeI64(struct.limitValue);
+      }
+      if (struct.isSetMBeanName()) {
+        oprot.writeString(struct.mBeanName);
+      }
+      if (struct.isSetDroppedCount()) {
+        oprot.writeI64(struct.droppedCount);
+      }
+      if (struct.isSetDropped()) {
+        {
+          oprot.writeI32(struct.dropped.size());
+          for (java.lang.String _iter4 : struct.dropped)
+          {
+            oprot.writeString(_iter4);
+          }
+        }
+      }
+      if (struct.isSetTimestamp()) {
+        oprot.writeI64(struct.timestamp);
+      }
+      if (struct.isSetEvictedBefore()) {
+        oprot.writeI64(struct.evictedBefore);
+      }
+    }
+
+    @Override
+    public void read(org.apache.thrift.protocol.TProtocol prot, Truncation struct) throws org.apache.thrift.TException {
+      org.apache.thrift.protocol.TTupleProtocol iprot = (org.apache.thrift.protocol.TTupleProtocol) prot;
+      java.util.BitSet incoming = iprot.readBitSet(7);
+      if (incoming.get(0)) {
+        struct.limit = org.newrelic.nrjmx.v2.nrprotocol.QueryLimit.findByValue(iprot.readI32());
+        struct.setLimitIsSet(true);
+      }
+      if (incoming.get(1)) {
+        struct.limitValue = iprot.readI64();
+        struct.setLimitValueIsSet(true);
+      }
+      if (incoming.get(2)) {
+        struct.mBeanName = iprot.readString();
+        struct.setMBeanNameIsSet(true);
+      }
+      if (incoming.get(3)) {
+        struct.droppedCount = iprot.readI64();
+        struct.setDroppedCountIsSet(true);
+      }
+      if (incoming.get(4)) {
+        {
+          org.apache.thrift.protocol.TList _list5 = iprot.readListBegin(org.apache.thrift.protocol.TType.STRING);
+          struct.dropped = new java.util.ArrayList<java.lang.String>(_list5.size);
+          @org.apache.thrift.annotation.Nullable java.lang.String _elem6;
+          for (int _i7 = 0; _i7 < _list5.size; ++_i7)
+          {
+            _elem6 = iprot.readString();
+            struct.dropped.add(_elem6);
+          }
+        }
+        struct.setDroppedIsSet(true);
+      }
+      if (incoming.get(5)) {
+        struct.timestamp = iprot.readI64();
+        struct.setTimestampIsSet(true);
+      }
+      if (incoming.get(6)) {
+        struct.evictedBefore = iprot.readI64();
+        struct.setEvictedBeforeIsSet(true);
+      }
+    }
+  }
+
+  private static <S extends org.apache.thrift.scheme.IScheme> S scheme(org.apache.thrift.protocol.TProtocol proto) {
+    return (org.apache.thrift.scheme.StandardScheme.class.equals(proto.getScheme()) ? STANDARD_SCHEME_FACTORY : TUPLE_SCHEME_FACTORY).getScheme();
+  }
+}
+